@@ -37,3 +37,4 @@ cp -r /usr/share/libvirt/cpu_map /var/lib/kubevirt-node-labeller
 
 virsh domcapabilities --machine q35 --arch x86_64 --virttype $VIRTTYPE | virsh hypervisor-cpu-baseline --features /dev/stdin --machine q35 --arch x86_64 --virttype $VIRTTYPE > /var/lib/kubevirt-node-labeller/supported_features.xml
 virsh capabilities > /var/lib/kubevirt-node-labeller/capabilities.xml
+virsh version > /var/lib/kubevirt-node-labeller/virsh_version.txt
@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"kubevirt.io/client-go/log"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+)
+
+const pollInterval = 500 * time.Millisecond
+
+// follow copies the content of the file at path to out as it grows, until ctx is done.
+// virtlogd rotates the file by renaming it and creating a new one, the renamed file is drained before
+// following the new one.
+func follow(ctx context.Context, path string, out io.Writer, interval time.Duration) error {
+	var file *os.File
+	defer func() {
+		if file != nil {
+			file.Close()
+		}
+	}()
+
+	for {
+		if file == nil {
+			// #nosec No risk for path injection, the path is set by virt-controller
+			f, err := os.Open(path)
+			if err == nil {
+				file = f
+			} else if !errors.Is(err, os.ErrNotExist) {
+				return err
+			}
+		}
+
+		if file != nil {
+			if _, err := io.Copy(out, file); err != nil {
+				return err
+			}
+			replaced, err := isReplaced(file, path)
+			if err != nil {
+				return err
+			}
+			if replaced {
+				// Catch up with what was written before the rotation
+				if _, err := io.Copy(out, file); err != nil {
+					return err
+				}
+				file.Close()
+				file = nil
+				continue
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			if file != nil {
+				_, err := io.Copy(out, file)
+				return err
+			}
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// isReplaced returns true if path doesn't point to file anymore, and rewinds file if it was truncated
+func isReplaced(file *os.File, path string) (bool, error) {
+	pathInfo, err := os.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	} else if err != nil {
+		return false, err
+	}
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+	if !os.SameFile(fileInfo, pathInfo) {
+		return true, nil
+	}
+
+	offset, err := file.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return false, err
+	}
+	if fileInfo.Size() < offset {
+		_, err = file.Seek(0, io.SeekStart)
+		return false, err
+	}
+	return false, nil
+}
+
+// waitForLauncherExit returns once the virt-launcher serving the socket is gone, or fails if it doesn't start in time
+func waitForLauncherExit(ctx context.Context, socketPath string, startTimeout time.Duration, interval time.Duration) error {
+	started := false
+	deadline := time.Now().Add(startTimeout)
+	for {
+		conn, err := net.DialTimeout("unix", socketPath, time.Second)
+		if err == nil {
+			conn.Close()
+			started = true
+		} else if started {
+			return nil
+		} else if time.Now().After(deadline) {
+			return fmt.Errorf("virt-launcher didn't start within %s", startTimeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+func main() {
+	logFile := pflag.String("logfile", "", "Path of the log file to follow")
+	socketPath := pflag.String("socket", cmdclient.SocketOnGuest(), "Socket of virt-launcher, the log is followed until it is gone")
+	socketTimeout := pflag.Duration("socket-timeout", 5*time.Minute, "Time to wait for virt-launcher to start")
+	pflag.Parse()
+
+	log.InitializeLogging("virt-tail")
+
+	if *logFile == "" {
+		log.Log.Error("--logfile is required")
+		os.Exit(1)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-signals
+		cancel()
+	}()
+
+	launcherErr := make(chan error, 1)
+	go func() {
+		launcherErr <- waitForLauncherExit(ctx, *socketPath, *socketTimeout, pollInterval)
+		cancel()
+	}()
+
+	if err := follow(ctx, *logFile, os.Stdout, pollInterval); err != nil {
+		log.Log.Reason(err).Errorf("Failed to follow %s", *logFile)
+		os.Exit(1)
+	}
+	if err := <-launcherErr; err != nil {
+		log.Log.Reason(err).Error("Stopped following the log")
+		os.Exit(1)
+	}
+}
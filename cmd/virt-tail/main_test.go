@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// syncBuffer is read by the test while follow writes into it
+type syncBuffer struct {
+	lock sync.Mutex
+	buf  bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buf.String()
+}
+
+var _ = Describe("virt-tail", func() {
+	const interval = 10 * time.Millisecond
+
+	var (
+		dir     string
+		logFile string
+		out     *syncBuffer
+		ctx     context.Context
+		cancel  context.CancelFunc
+		done    chan error
+	)
+
+	appendLog := func(path, content string) {
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = f.WriteString(content)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(f.Close()).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		logFile = filepath.Join(dir, "virt-serial0-log")
+		out = &syncBuffer{}
+		ctx, cancel = context.WithCancel(context.Background())
+		done = make(chan error, 1)
+	})
+
+	startFollowing := func() {
+		go func() {
+			done <- follow(ctx, logFile, out, interval)
+		}()
+	}
+
+	It("should wait for the log file and follow it as it grows", func() {
+		startFollowing()
+		Consistently(out.String, 5*interval, interval).Should(BeEmpty())
+
+		appendLog(logFile, "booting\n")
+		Eventually(out.String).Should(Equal("booting\n"))
+		appendLog(logFile, "login: ")
+		Eventually(out.String).Should(Equal("booting\nlogin: "))
+
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should follow the log across rotations", func() {
+		appendLog(logFile, "first\n")
+		startFollowing()
+		Eventually(out.String).Should(Equal("first\n"))
+
+		appendLog(logFile, "before rotation\n")
+		Expect(os.Rename(logFile, logFile+".0")).To(Succeed())
+		appendLog(logFile, "after rotation\n")
+
+		Eventually(out.String).Should(Equal("first\nbefore rotation\nafter rotation\n"))
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+	})
+
+	It("should drain the log when stopped", func() {
+		appendLog(logFile, "first\n")
+		startFollowing()
+		Eventually(out.String).Should(Equal("first\n"))
+
+		appendLog(logFile, "last\n")
+		cancel()
+		Eventually(done).Should(Receive(BeNil()))
+		Expect(out.String()).To(Equal("first\nlast\n"))
+	})
+
+	Context("waiting for virt-launcher", func() {
+		var socketPath string
+
+		BeforeEach(func() {
+			// Unix socket paths are short, the temporary directory of the test can be too long
+			socketDir, err := os.MkdirTemp("", "virt-tail")
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(os.RemoveAll, socketDir)
+			socketPath = filepath.Join(socketDir, "launcher-sock")
+		})
+
+		It("should return once virt-launcher is gone", func() {
+			listener, err := net.Listen("unix", socketPath)
+			Expect(err).ToNot(HaveOccurred())
+
+			exited := make(chan error, 1)
+			go func() {
+				exited <- waitForLauncherExit(ctx, socketPath, time.Minute, interval)
+			}()
+			Consistently(exited, 5*interval, interval).ShouldNot(Receive())
+
+			Expect(listener.Close()).To(Succeed())
+			Eventually(exited).Should(Receive(BeNil()))
+		})
+
+		It("should fail if virt-launcher doesn't start", func() {
+			Expect(waitForLauncherExit(ctx, socketPath, 5*interval, interval)).To(MatchError(ContainSubstring("didn't start")))
+		})
+	})
+})
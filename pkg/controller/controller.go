@@ -365,21 +365,50 @@ func VMIHasHotplugVolumes(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// AttachmentPods returns the volume attachment pods of the owner pod.
 func AttachmentPods(ownerPod *k8sv1.Pod, podInformer cache.SharedIndexInformer) ([]*k8sv1.Pod, error) {
+	return ownedPods(ownerPod, podInformer, func(pod *k8sv1.Pod) bool {
+		_, isHostDevicePod := pod.Annotations[v1.HotplugHostDeviceAnnotation]
+		return !isHostDevicePod
+	})
+}
+
+// HostDeviceAttachmentPods returns the host device attachment pods of the owner pod.
+func HostDeviceAttachmentPods(ownerPod *k8sv1.Pod, podInformer cache.SharedIndexInformer) ([]*k8sv1.Pod, error) {
+	return ownedPods(ownerPod, podInformer, func(pod *k8sv1.Pod) bool {
+		_, isHostDevicePod := pod.Annotations[v1.HotplugHostDeviceAnnotation]
+		return isHostDevicePod
+	})
+}
+
+func ownedPods(ownerPod *k8sv1.Pod, podInformer cache.SharedIndexInformer, filter func(pod *k8sv1.Pod) bool) ([]*k8sv1.Pod, error) {
 	objs, err := podInformer.GetIndexer().ByIndex(cache.NamespaceIndex, ownerPod.Namespace)
 	if err != nil {
 		return nil, err
 	}
-	attachmentPods := []*k8sv1.Pod{}
+	pods := []*k8sv1.Pod{}
 	for _, obj := range objs {
 		pod := obj.(*k8sv1.Pod)
 		ownerRef := GetControllerOf(pod)
-		if ownerRef == nil || ownerRef.UID != ownerPod.UID {
+		if ownerRef == nil || ownerRef.UID != ownerPod.UID || !filter(pod) {
 			continue
 		}
-		attachmentPods = append(attachmentPods, pod)
+		pods = append(pods, pod)
 	}
-	return attachmentPods, nil
+	return pods, nil
+}
+
+// VMIHasHotplugHostDevices returns true if the VMI spec or status references hotpluggable host devices.
+func VMIHasHotplugHostDevices(vmi *v1.VirtualMachineInstance) bool {
+	if len(vmi.Status.HostDeviceStatus) > 0 {
+		return true
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if hostDevice.Hotpluggable {
+			return true
+		}
+	}
+	return false
 }
 
 func ApplyNetworkInterfaceRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineInterfaceRequest) *v1.VirtualMachineInstanceSpec {
@@ -20,6 +20,7 @@
 package controller
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math/rand"
@@ -39,6 +40,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -46,8 +48,12 @@ import (
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	extclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
@@ -78,10 +84,18 @@ const (
 	*/
 	OperatorLabel    = kubev1.ManagedByLabel + " in (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
 	NotOperatorLabel = kubev1.ManagedByLabel + " notin (" + kubev1.ManagedByLabelOperatorValue + "," + kubev1.ManagedByLabelOperatorOldValue + " )"
+
+	// GuestEndpointSliceManagedBy is the managed-by label value of the EndpointSlices holding guest agent reported addresses
+	GuestEndpointSliceManagedBy = "virt-controller.kubevirt.io"
+	// GuestEndpointsSelectorIndex indexes the guest endpoints Services by their namespace and VMI selector
+	GuestEndpointsSelectorIndex = "guestEndpointsSelector"
 )
 
 var unexpectedObjectError = errors.New("unexpected object")
 
+// DNSEndpointGVR identifies the ExternalDNS DNSEndpoint custom resource the VMI DNS records are published with
+var DNSEndpointGVR = schema.GroupVersionResource{Group: "externaldns.k8s.io", Version: "v1alpha1", Resource: "dnsendpoints"}
+
 type newSharedInformer func() cache.SharedIndexInformer
 
 type KubeInformerFactory interface {
@@ -297,6 +311,24 @@ type KubeInformerFactory interface {
 	// Pod returns an informer for ALL Pods in the system
 	Pod() cache.SharedIndexInformer
 
+	// Service returns an informer for ALL Services in the system
+	Service() cache.SharedIndexInformer
+
+	// DummyService returns a fake informer, used when the GuestEndpoints feature gate is disabled
+	DummyService() cache.SharedIndexInformer
+
+	// GuestEndpointSlice returns an informer for the EndpointSlices holding guest agent reported addresses
+	GuestEndpointSlice() cache.SharedIndexInformer
+
+	// DummyGuestEndpointSlice returns a fake informer, used when the GuestEndpoints feature gate is disabled
+	DummyGuestEndpointSlice() cache.SharedIndexInformer
+
+	// DNSEndpoint returns an informer for the ExternalDNS DNSEndpoints created for VMIs
+	DNSEndpoint() cache.SharedIndexInformer
+
+	// DummyDNSEndpoint returns a fake informer, used when the ExternalDNS DNSEndpoint CRD is not installed
+	DummyDNSEndpoint() cache.SharedIndexInformer
+
 	K8SInformerFactory() informers.SharedInformerFactory
 }
 
@@ -1271,6 +1303,94 @@ func (f *kubeInformerFactory) Pod() cache.SharedIndexInformer {
 	})
 }
 
+func (f *kubeInformerFactory) Service() cache.SharedIndexInformer {
+	return f.getInformer("serviceInformer", func() cache.SharedIndexInformer {
+		lw := cache.NewListWatchFromClient(f.clientSet.CoreV1().RESTClient(), "services", k8sv1.NamespaceAll, fields.Everything())
+		return cache.NewSharedIndexInformer(lw, &k8sv1.Service{}, f.defaultResync, cache.Indexers{
+			cache.NamespaceIndex:        cache.MetaNamespaceIndexFunc,
+			GuestEndpointsSelectorIndex: GuestEndpointsSelectorIndexFunc,
+		})
+	})
+}
+
+func (f *kubeInformerFactory) DummyService() cache.SharedIndexInformer {
+	return f.getInformer("FakeServiceInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&k8sv1.Service{})
+		if err := informer.AddIndexers(cache.Indexers{GuestEndpointsSelectorIndex: GuestEndpointsSelectorIndexFunc}); err != nil {
+			panic(err)
+		}
+		return informer
+	})
+}
+
+// GuestEndpointsSelectorIndexFunc indexes the Services without pod selector annotated with
+// kubev1.GuestEndpointsNetworkAnnotation by their namespace and kubev1.GuestEndpointsSelectorAnnotation
+func GuestEndpointsSelectorIndexFunc(obj interface{}) ([]string, error) {
+	service, ok := obj.(*k8sv1.Service)
+	if !ok {
+		return nil, unexpectedObjectError
+	}
+	if _, exists := service.Annotations[kubev1.GuestEndpointsNetworkAnnotation]; !exists || len(service.Spec.Selector) > 0 {
+		return nil, nil
+	}
+	return []string{GuestEndpointsSelectorIndexKey(service.Namespace, service.Annotations[kubev1.GuestEndpointsSelectorAnnotation])}, nil
+}
+
+// GuestEndpointsSelectorIndexKey returns the GuestEndpointsSelectorIndex key of a VMI selector in a namespace
+func GuestEndpointsSelectorIndexKey(namespace, selector string) string {
+	return namespace + "/" + selector
+}
+
+func (f *kubeInformerFactory) GuestEndpointSlice() cache.SharedIndexInformer {
+	return f.getInformer("guestEndpointSliceInformer", func() cache.SharedIndexInformer {
+		// Watch only the EndpointSlices managed by KubeVirt
+		labelSelector, err := labels.Parse(fmt.Sprintf("%s=%s", discoveryv1.LabelManagedBy, GuestEndpointSliceManagedBy))
+		if err != nil {
+			panic(err)
+		}
+
+		lw := NewListWatchFromClient(f.clientSet.DiscoveryV1().RESTClient(), "endpointslices", k8sv1.NamespaceAll, fields.Everything(), labelSelector)
+		return cache.NewSharedIndexInformer(lw, &discoveryv1.EndpointSlice{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) DummyGuestEndpointSlice() cache.SharedIndexInformer {
+	return f.getInformer("FakeGuestEndpointSliceInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&discoveryv1.EndpointSlice{})
+		return informer
+	})
+}
+
+func (f *kubeInformerFactory) DNSEndpoint() cache.SharedIndexInformer {
+	return f.getInformer("dnsEndpointInformer", func() cache.SharedIndexInformer {
+		// Watch only the DNSEndpoints created for VMIs
+		labelSelector, err := labels.Parse(kubev1.CreatedByLabel)
+		if err != nil {
+			panic(err)
+		}
+
+		client := f.clientSet.DynamicClient().Resource(DNSEndpointGVR).Namespace(k8sv1.NamespaceAll)
+		lw := &cache.ListWatch{
+			ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+				options.LabelSelector = labelSelector.String()
+				return client.List(context.Background(), options)
+			},
+			WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+				options.LabelSelector = labelSelector.String()
+				return client.Watch(context.Background(), options)
+			},
+		}
+		return cache.NewSharedIndexInformer(lw, &unstructured.Unstructured{}, f.defaultResync, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+}
+
+func (f *kubeInformerFactory) DummyDNSEndpoint() cache.SharedIndexInformer {
+	return f.getInformer("FakeDNSEndpointInformer", func() cache.SharedIndexInformer {
+		informer, _ := testutils.NewFakeInformerFor(&unstructured.Unstructured{})
+		return informer
+	})
+}
+
 // VolumeSnapshotInformer returns an informer for VolumeSnapshots
 func VolumeSnapshotInformer(clientSet kubecli.KubevirtClient, resyncPeriod time.Duration) cache.SharedIndexInformer {
 	restClient := clientSet.KubernetesSnapshotClient().SnapshotV1().RESTClient()
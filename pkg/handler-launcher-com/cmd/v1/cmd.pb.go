@@ -36,6 +36,11 @@ It has these top-level messages:
 	GuestPingResponse
 	FreezeRequest
 	MemoryDumpRequest
+	GuestExecRequest
+	GuestExecResponse
+	GuestFileRequest
+	GuestFileResponse
+	SSHKeyRequest
 */
 package v1
 
@@ -359,12 +364,13 @@ func (m *DiskInfo) GetVirtualSize() uint64 {
 }
 
 type VirtualMachineOptions struct {
-	VirtualMachineSMBios  *SMBios              `protobuf:"bytes,1,opt,name=VirtualMachineSMBios" json:"VirtualMachineSMBios,omitempty"`
-	MemBalloonStatsPeriod uint32               `protobuf:"varint,2,opt,name=MemBalloonStatsPeriod" json:"MemBalloonStatsPeriod,omitempty"`
-	PreallocatedVolumes   []string             `protobuf:"bytes,3,rep,name=PreallocatedVolumes" json:"PreallocatedVolumes,omitempty"`
-	Topology              *Topology            `protobuf:"bytes,4,opt,name=topology" json:"topology,omitempty"`
-	DisksInfo             map[string]*DiskInfo `protobuf:"bytes,5,rep,name=DisksInfo" json:"DisksInfo,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	ExpandDisksEnabled    bool                 `protobuf:"varint,6,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
+	VirtualMachineSMBios    *SMBios              `protobuf:"bytes,1,opt,name=VirtualMachineSMBios" json:"VirtualMachineSMBios,omitempty"`
+	MemBalloonStatsPeriod   uint32               `protobuf:"varint,2,opt,name=MemBalloonStatsPeriod" json:"MemBalloonStatsPeriod,omitempty"`
+	PreallocatedVolumes     []string             `protobuf:"bytes,3,rep,name=PreallocatedVolumes" json:"PreallocatedVolumes,omitempty"`
+	Topology                *Topology            `protobuf:"bytes,4,opt,name=topology" json:"topology,omitempty"`
+	DisksInfo               map[string]*DiskInfo `protobuf:"bytes,5,rep,name=DisksInfo" json:"DisksInfo,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ExpandDisksEnabled      bool                 `protobuf:"varint,6,opt,name=ExpandDisksEnabled" json:"ExpandDisksEnabled,omitempty"`
+	SerialConsoleLogEnabled bool                 `protobuf:"varint,7,opt,name=SerialConsoleLogEnabled" json:"SerialConsoleLogEnabled,omitempty"`
 }
 
 func (m *VirtualMachineOptions) Reset()                    { *m = VirtualMachineOptions{} }
@@ -414,6 +420,13 @@ func (m *VirtualMachineOptions) GetExpandDisksEnabled() bool {
 	return false
 }
 
+func (m *VirtualMachineOptions) GetSerialConsoleLogEnabled() bool {
+	if m != nil {
+		return m.SerialConsoleLogEnabled
+	}
+	return false
+}
+
 type VMIRequest struct {
 	Vmi     *VMI                   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
 	Options *VirtualMachineOptions `protobuf:"bytes,2,opt,name=options" json:"options,omitempty"`
@@ -774,6 +787,246 @@ func (m *MemoryDumpRequest) GetDumpPath() string {
 	return ""
 }
 
+type GuestExecRequest struct {
+	DomainName     string   `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Command        string   `protobuf:"bytes,2,opt,name=command" json:"command,omitempty"`
+	Args           []string `protobuf:"bytes,3,rep,name=args" json:"args,omitempty"`
+	Stdin          []byte   `protobuf:"bytes,4,opt,name=stdin,proto3" json:"stdin,omitempty"`
+	TimeoutSeconds int32    `protobuf:"varint,5,opt,name=timeoutSeconds" json:"timeoutSeconds,omitempty"`
+}
+
+func (m *GuestExecRequest) Reset()                    { *m = GuestExecRequest{} }
+func (m *GuestExecRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecRequest) ProtoMessage()               {}
+func (*GuestExecRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{26} }
+
+func (m *GuestExecRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestExecRequest) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
+}
+
+func (m *GuestExecRequest) GetArgs() []string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *GuestExecRequest) GetStdin() []byte {
+	if m != nil {
+		return m.Stdin
+	}
+	return nil
+}
+
+func (m *GuestExecRequest) GetTimeoutSeconds() int32 {
+	if m != nil {
+		return m.TimeoutSeconds
+	}
+	return 0
+}
+
+type GuestExecResponse struct {
+	Response        *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	ExitCode        int32     `protobuf:"varint,2,opt,name=exitCode" json:"exitCode,omitempty"`
+	StdOut          []byte    `protobuf:"bytes,3,opt,name=stdOut,proto3" json:"stdOut,omitempty"`
+	StdErr          []byte    `protobuf:"bytes,4,opt,name=stdErr,proto3" json:"stdErr,omitempty"`
+	StdOutTruncated bool      `protobuf:"varint,5,opt,name=stdOutTruncated" json:"stdOutTruncated,omitempty"`
+	StdErrTruncated bool      `protobuf:"varint,6,opt,name=stdErrTruncated" json:"stdErrTruncated,omitempty"`
+}
+
+func (m *GuestExecResponse) Reset()                    { *m = GuestExecResponse{} }
+func (m *GuestExecResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestExecResponse) ProtoMessage()               {}
+func (*GuestExecResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{27} }
+
+func (m *GuestExecResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestExecResponse) GetExitCode() int32 {
+	if m != nil {
+		return m.ExitCode
+	}
+	return 0
+}
+
+func (m *GuestExecResponse) GetStdOut() []byte {
+	if m != nil {
+		return m.StdOut
+	}
+	return nil
+}
+
+func (m *GuestExecResponse) GetStdErr() []byte {
+	if m != nil {
+		return m.StdErr
+	}
+	return nil
+}
+
+func (m *GuestExecResponse) GetStdOutTruncated() bool {
+	if m != nil {
+		return m.StdOutTruncated
+	}
+	return false
+}
+
+func (m *GuestExecResponse) GetStdErrTruncated() bool {
+	if m != nil {
+		return m.StdErrTruncated
+	}
+	return false
+}
+
+type GuestFileRequest struct {
+	DomainName string `protobuf:"bytes,1,opt,name=domainName" json:"domainName,omitempty"`
+	Path       string `protobuf:"bytes,2,opt,name=path" json:"path,omitempty"`
+	Mode       string `protobuf:"bytes,3,opt,name=mode" json:"mode,omitempty"`
+	Handle     int64  `protobuf:"varint,4,opt,name=handle" json:"handle,omitempty"`
+	Data       []byte `protobuf:"bytes,5,opt,name=data,proto3" json:"data,omitempty"`
+	Count      int32  `protobuf:"varint,6,opt,name=count" json:"count,omitempty"`
+}
+
+func (m *GuestFileRequest) Reset()                    { *m = GuestFileRequest{} }
+func (m *GuestFileRequest) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileRequest) ProtoMessage()               {}
+func (*GuestFileRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{28} }
+
+func (m *GuestFileRequest) GetDomainName() string {
+	if m != nil {
+		return m.DomainName
+	}
+	return ""
+}
+
+func (m *GuestFileRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GuestFileRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *GuestFileRequest) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+func (m *GuestFileRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GuestFileRequest) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type GuestFileResponse struct {
+	Response *Response `protobuf:"bytes,1,opt,name=response" json:"response,omitempty"`
+	Handle   int64     `protobuf:"varint,2,opt,name=handle" json:"handle,omitempty"`
+	Data     []byte    `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Eof      bool      `protobuf:"varint,4,opt,name=eof" json:"eof,omitempty"`
+}
+
+func (m *GuestFileResponse) Reset()                    { *m = GuestFileResponse{} }
+func (m *GuestFileResponse) String() string            { return proto.CompactTextString(m) }
+func (*GuestFileResponse) ProtoMessage()               {}
+func (*GuestFileResponse) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{29} }
+
+func (m *GuestFileResponse) GetResponse() *Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *GuestFileResponse) GetHandle() int64 {
+	if m != nil {
+		return m.Handle
+	}
+	return 0
+}
+
+func (m *GuestFileResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GuestFileResponse) GetEof() bool {
+	if m != nil {
+		return m.Eof
+	}
+	return false
+}
+
+type SSHKeyRequest struct {
+	Vmi        *VMI   `protobuf:"bytes,1,opt,name=vmi" json:"vmi,omitempty"`
+	User       string `protobuf:"bytes,2,opt,name=user" json:"user,omitempty"`
+	PublicKey  string `protobuf:"bytes,3,opt,name=publicKey" json:"publicKey,omitempty"`
+	TtlSeconds int32  `protobuf:"varint,4,opt,name=ttlSeconds" json:"ttlSeconds,omitempty"`
+}
+
+func (m *SSHKeyRequest) Reset()                    { *m = SSHKeyRequest{} }
+func (m *SSHKeyRequest) String() string            { return proto.CompactTextString(m) }
+func (*SSHKeyRequest) ProtoMessage()               {}
+func (*SSHKeyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{30} }
+
+func (m *SSHKeyRequest) GetVmi() *VMI {
+	if m != nil {
+		return m.Vmi
+	}
+	return nil
+}
+
+func (m *SSHKeyRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *SSHKeyRequest) GetPublicKey() string {
+	if m != nil {
+		return m.PublicKey
+	}
+	return ""
+}
+
+func (m *SSHKeyRequest) GetTtlSeconds() int32 {
+	if m != nil {
+		return m.TtlSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QemuVersionResponse)(nil), "kubevirt.cmd.v1.QemuVersionResponse")
 	proto.RegisterType((*VMI)(nil), "kubevirt.cmd.v1.VMI")
@@ -801,6 +1054,11 @@ func init() {
 	proto.RegisterType((*GuestPingResponse)(nil), "kubevirt.cmd.v1.GuestPingResponse")
 	proto.RegisterType((*FreezeRequest)(nil), "kubevirt.cmd.v1.FreezeRequest")
 	proto.RegisterType((*MemoryDumpRequest)(nil), "kubevirt.cmd.v1.MemoryDumpRequest")
+	proto.RegisterType((*GuestExecRequest)(nil), "kubevirt.cmd.v1.GuestExecRequest")
+	proto.RegisterType((*GuestExecResponse)(nil), "kubevirt.cmd.v1.GuestExecResponse")
+	proto.RegisterType((*GuestFileRequest)(nil), "kubevirt.cmd.v1.GuestFileRequest")
+	proto.RegisterType((*GuestFileResponse)(nil), "kubevirt.cmd.v1.GuestFileResponse")
+	proto.RegisterType((*SSHKeyRequest)(nil), "kubevirt.cmd.v1.SSHKeyRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -839,6 +1097,13 @@ type CmdClient interface {
 	GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error)
 	VirtualMachineMemoryDump(ctx context.Context, in *MemoryDumpRequest, opts ...grpc.CallOption) (*Response, error)
 	GetQemuVersion(ctx context.Context, in *EmptyRequest, opts ...grpc.CallOption) (*QemuVersionResponse, error)
+	GuestExec(ctx context.Context, in *GuestExecRequest, opts ...grpc.CallOption) (*GuestExecResponse, error)
+	GuestFileOpen(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error)
+	GuestFileRead(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error)
+	GuestFileWrite(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error)
+	GuestFileClose(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error)
+	AddSSHKey(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*Response, error)
+	RemoveSSHKey(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*Response, error)
 }
 
 type cmdClient struct {
@@ -1074,6 +1339,69 @@ func (c *cmdClient) GetQemuVersion(ctx context.Context, in *EmptyRequest, opts .
 	return out, nil
 }
 
+func (c *cmdClient) GuestExec(ctx context.Context, in *GuestExecRequest, opts ...grpc.CallOption) (*GuestExecResponse, error) {
+	out := new(GuestExecResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestExec", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileOpen(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	out := new(GuestFileResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileOpen", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileRead(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	out := new(GuestFileResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileRead", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileWrite(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	out := new(GuestFileResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileWrite", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) GuestFileClose(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	out := new(GuestFileResponse)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/GuestFileClose", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) AddSSHKey(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/AddSSHKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cmdClient) RemoveSSHKey(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*Response, error) {
+	out := new(Response)
+	err := grpc.Invoke(ctx, "/kubevirt.cmd.v1.Cmd/RemoveSSHKey", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Cmd service
 
 type CmdServer interface {
@@ -1102,6 +1430,13 @@ type CmdServer interface {
 	GuestPing(context.Context, *GuestPingRequest) (*GuestPingResponse, error)
 	VirtualMachineMemoryDump(context.Context, *MemoryDumpRequest) (*Response, error)
 	GetQemuVersion(context.Context, *EmptyRequest) (*QemuVersionResponse, error)
+	GuestExec(context.Context, *GuestExecRequest) (*GuestExecResponse, error)
+	GuestFileOpen(context.Context, *GuestFileRequest) (*GuestFileResponse, error)
+	GuestFileRead(context.Context, *GuestFileRequest) (*GuestFileResponse, error)
+	GuestFileWrite(context.Context, *GuestFileRequest) (*GuestFileResponse, error)
+	GuestFileClose(context.Context, *GuestFileRequest) (*GuestFileResponse, error)
+	AddSSHKey(context.Context, *SSHKeyRequest) (*Response, error)
+	RemoveSSHKey(context.Context, *SSHKeyRequest) (*Response, error)
 }
 
 func RegisterCmdServer(s *grpc.Server, srv CmdServer) {
@@ -1558,6 +1893,132 @@ func _Cmd_GetQemuVersion_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cmd_GuestExec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestExecRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestExec(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestExec",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestExec(ctx, req.(*GuestExecRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileOpen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileOpen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileOpen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileOpen(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileRead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileRead(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileWrite(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileWrite",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileWrite(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_GuestFileClose_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GuestFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).GuestFileClose(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/GuestFileClose",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).GuestFileClose(ctx, req.(*GuestFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_AddSSHKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).AddSSHKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/AddSSHKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).AddSSHKey(ctx, req.(*SSHKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cmd_RemoveSSHKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CmdServer).RemoveSSHKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kubevirt.cmd.v1.Cmd/RemoveSSHKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CmdServer).RemoveSSHKey(ctx, req.(*SSHKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Cmd_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kubevirt.cmd.v1.Cmd",
 	HandlerType: (*CmdServer)(nil),
@@ -1662,6 +2123,34 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetQemuVersion",
 			Handler:    _Cmd_GetQemuVersion_Handler,
 		},
+		{
+			MethodName: "GuestExec",
+			Handler:    _Cmd_GuestExec_Handler,
+		},
+		{
+			MethodName: "GuestFileOpen",
+			Handler:    _Cmd_GuestFileOpen_Handler,
+		},
+		{
+			MethodName: "GuestFileRead",
+			Handler:    _Cmd_GuestFileRead_Handler,
+		},
+		{
+			MethodName: "GuestFileWrite",
+			Handler:    _Cmd_GuestFileWrite_Handler,
+		},
+		{
+			MethodName: "GuestFileClose",
+			Handler:    _Cmd_GuestFileClose_Handler,
+		},
+		{
+			MethodName: "AddSSHKey",
+			Handler:    _Cmd_AddSSHKey_Handler,
+		},
+		{
+			MethodName: "RemoveSSHKey",
+			Handler:    _Cmd_RemoveSSHKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/handler-launcher-com/cmd/v1/cmd.proto",
@@ -1670,96 +2159,116 @@ var _Cmd_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("pkg/handler-launcher-com/cmd/v1/cmd.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 1454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x5f, 0x4f, 0x1b, 0x49,
-	0x12, 0xc7, 0xd8, 0x10, 0xbb, 0xf8, 0x73, 0xa4, 0xf9, 0x73, 0x73, 0xdc, 0x25, 0xe1, 0x46, 0x27,
-	0x44, 0xa4, 0x04, 0x0e, 0x8e, 0x44, 0xa7, 0x3c, 0x9c, 0x72, 0x18, 0x42, 0xfe, 0xac, 0x89, 0xd3,
-	0x06, 0xa2, 0xcd, 0xae, 0x14, 0x35, 0x33, 0x8d, 0x19, 0x31, 0xd3, 0x3d, 0x3b, 0xdd, 0xe3, 0xc5,
-	0x79, 0xdd, 0xd5, 0x3e, 0xac, 0xb4, 0x9f, 0x68, 0x3f, 0xc8, 0x3e, 0xef, 0x37, 0x59, 0x75, 0x4f,
-	0x8f, 0x19, 0x7b, 0xc6, 0x21, 0xac, 0xfd, 0xe4, 0xae, 0xae, 0xaa, 0x5f, 0x55, 0x57, 0x57, 0x55,
-	0x97, 0x07, 0x1e, 0x86, 0x97, 0xed, 0xad, 0x0b, 0xc2, 0x5c, 0x9f, 0x46, 0x8f, 0x7d, 0x12, 0x33,
-	0xe7, 0x82, 0x46, 0x8f, 0x1d, 0x1e, 0x6c, 0x39, 0x81, 0xbb, 0xd5, 0xd9, 0x56, 0x3f, 0x9b, 0x61,
-	0xc4, 0x25, 0x47, 0x7f, 0xb9, 0x8c, 0xcf, 0x68, 0xc7, 0x8b, 0xe4, 0xa6, 0xda, 0xeb, 0x6c, 0xdb,
-	0xe7, 0xb0, 0xf8, 0x8e, 0x06, 0xf1, 0x29, 0x8d, 0x84, 0xc7, 0x19, 0xa6, 0x22, 0xe4, 0x4c, 0x50,
-	0xf4, 0x04, 0xaa, 0x91, 0x59, 0x5b, 0xa5, 0xb5, 0xd2, 0xc6, 0xcc, 0xce, 0xdf, 0x36, 0x07, 0x54,
-	0x37, 0x53, 0x61, 0xdc, 0x13, 0x45, 0x16, 0xdc, 0xe9, 0x24, 0x48, 0xd6, 0xe4, 0x5a, 0x69, 0xa3,
-	0x86, 0x53, 0xd2, 0x7e, 0x00, 0xe5, 0xd3, 0xc6, 0x2b, 0x2d, 0x10, 0x78, 0xaf, 0x05, 0x67, 0x1a,
-	0x76, 0x16, 0xa7, 0xa4, 0xbd, 0x0d, 0xe5, 0x7a, 0xf3, 0x04, 0xcd, 0xc3, 0xa4, 0xe7, 0x6a, 0xde,
-	0x1c, 0x9e, 0xf4, 0x5c, 0xb4, 0x0a, 0x55, 0xe1, 0x9d, 0xf9, 0x1e, 0x6b, 0x0b, 0x6b, 0x72, 0xad,
-	0xbc, 0x31, 0x87, 0x7b, 0xb4, 0xbd, 0x05, 0x77, 0x5a, 0xc9, 0x3a, 0xa7, 0xb6, 0x04, 0x53, 0x1d,
-	0xe2, 0xc7, 0x54, 0xbb, 0x51, 0xc1, 0x09, 0x61, 0x1f, 0xc0, 0x54, 0x93, 0xb4, 0xa9, 0x50, 0x6c,
-	0x87, 0xc7, 0x4c, 0x6a, 0x8d, 0x0a, 0x4e, 0x08, 0x84, 0xa0, 0x12, 0x33, 0x4f, 0x1a, 0xd7, 0xf5,
-	0x5a, 0xed, 0x09, 0xef, 0x13, 0xb5, 0xca, 0x1a, 0x5a, 0xaf, 0xed, 0x5d, 0x98, 0x6e, 0xd0, 0x80,
-	0x47, 0x5d, 0xb4, 0x02, 0xd3, 0x24, 0xc8, 0x00, 0x19, 0xaa, 0x08, 0xc9, 0xfe, 0xad, 0x04, 0x95,
-	0x3a, 0xf5, 0xfd, 0x9c, 0xaf, 0x5b, 0x30, 0x1d, 0x68, 0x38, 0x2d, 0x3e, 0xb3, 0xf3, 0xd7, 0x5c,
-	0xa4, 0x13, 0x6b, 0xd8, 0x88, 0xa1, 0x47, 0x30, 0x15, 0xaa, 0x63, 0x58, 0xe5, 0xb5, 0xf2, 0xc6,
-	0xcc, 0xce, 0x4a, 0x4e, 0x5e, 0x1f, 0x12, 0x27, 0x42, 0xe8, 0x29, 0xd4, 0x5c, 0x4f, 0x48, 0xc2,
-	0x1c, 0x2a, 0xac, 0x8a, 0xd6, 0xb0, 0x72, 0x1a, 0x26, 0x8e, 0xf8, 0x5a, 0x14, 0x6d, 0x40, 0xc5,
-	0x09, 0x63, 0x61, 0x4d, 0x69, 0x95, 0xa5, 0x9c, 0x4a, 0xbd, 0x79, 0x82, 0xb5, 0x84, 0xfd, 0x1c,
-	0xaa, 0xc7, 0x3c, 0xe4, 0x3e, 0x6f, 0x77, 0xd1, 0x2e, 0x00, 0x8b, 0x03, 0xf2, 0xd1, 0xa1, 0xbe,
-	0x2f, 0xac, 0x92, 0xd6, 0x5d, 0xce, 0xeb, 0x52, 0xdf, 0xc7, 0x35, 0x25, 0xa8, 0x56, 0xc2, 0xfe,
-	0xb9, 0x04, 0xd3, 0xad, 0xc6, 0x9e, 0xc7, 0x05, 0xb2, 0x61, 0x36, 0x20, 0x2c, 0x3e, 0x27, 0x8e,
-	0x8c, 0x23, 0x1a, 0xe9, 0x38, 0xd5, 0x70, 0xdf, 0x9e, 0xca, 0xa2, 0x30, 0xe2, 0x6e, 0xec, 0xa4,
-	0x11, 0x4e, 0xc9, 0x6c, 0x02, 0x96, 0xfb, 0x12, 0x10, 0x2d, 0x40, 0x59, 0x5c, 0xc6, 0x56, 0x45,
-	0xef, 0xaa, 0xa5, 0xba, 0xbc, 0x73, 0x12, 0x78, 0x7e, 0xd7, 0x9a, 0xd2, 0x9b, 0x86, 0xb2, 0x7f,
-	0x2a, 0x41, 0x75, 0xdf, 0x13, 0x97, 0xaf, 0xd8, 0x39, 0xd7, 0x42, 0x3c, 0x0a, 0x88, 0x34, 0x8e,
-	0x18, 0x0a, 0xad, 0xc1, 0xcc, 0x19, 0x71, 0x2e, 0x3d, 0xd6, 0x7e, 0xe1, 0xf9, 0xd4, 0xb8, 0x91,
-	0xdd, 0x42, 0xf7, 0x01, 0x94, 0xbf, 0xc4, 0x6f, 0xa5, 0xf9, 0x53, 0xc1, 0x99, 0x1d, 0x85, 0xa0,
-	0x42, 0x92, 0x0a, 0x54, 0xb4, 0x40, 0x76, 0xcb, 0xfe, 0xbd, 0x0c, 0xcb, 0xa7, 0x09, 0xdd, 0x20,
-	0xce, 0x85, 0xc7, 0xe8, 0xdb, 0x50, 0x7a, 0x9c, 0x09, 0xf4, 0x06, 0x96, 0xfa, 0x19, 0x49, 0xf0,
-	0x4c, 0xa9, 0xe6, 0x13, 0x28, 0x61, 0xe3, 0x42, 0x25, 0xb4, 0x0b, 0xcb, 0x0d, 0x1a, 0xec, 0x11,
-	0xdf, 0xe7, 0x9c, 0xb5, 0x24, 0x91, 0xa2, 0x49, 0x23, 0x8f, 0xbb, 0xfa, 0x50, 0x73, 0xb8, 0x98,
-	0x89, 0xfe, 0x0d, 0x8b, 0xcd, 0x88, 0xaa, 0x7d, 0x87, 0x48, 0xea, 0x9e, 0x72, 0x3f, 0x0e, 0x4c,
-	0x4a, 0xd6, 0x70, 0x11, 0x4b, 0xf5, 0x14, 0x69, 0xd2, 0x44, 0x9f, 0xb6, 0xa8, 0xa7, 0xa4, 0x79,
-	0x84, 0x7b, 0xa2, 0xa8, 0x05, 0x35, 0x75, 0x1b, 0x42, 0x5d, 0x87, 0x49, 0xc6, 0x27, 0x39, 0xbd,
-	0xc2, 0x30, 0x6d, 0xf6, 0xf4, 0x0e, 0x98, 0x8c, 0xba, 0xf8, 0x1a, 0x07, 0x6d, 0x02, 0x3a, 0xb8,
-	0x0a, 0x09, 0x73, 0xf5, 0xd6, 0x01, 0x23, 0x67, 0x3e, 0x75, 0xad, 0xe9, 0xb5, 0xd2, 0x46, 0x15,
-	0x17, 0x70, 0x56, 0xdf, 0xc3, 0x7c, 0x3f, 0x98, 0xca, 0xa7, 0x4b, 0xda, 0x35, 0x59, 0xa1, 0x96,
-	0x68, 0x2b, 0xdb, 0x73, 0x8a, 0x0e, 0x97, 0x26, 0x95, 0x69, 0x47, 0xcf, 0x26, 0xff, 0x5b, 0xb2,
-	0x3b, 0x00, 0xa7, 0x8d, 0x57, 0x98, 0x7e, 0x17, 0x53, 0x21, 0xd1, 0x3a, 0x94, 0x3b, 0x81, 0x67,
-	0xae, 0x31, 0x5f, 0x72, 0x4a, 0x52, 0x09, 0xa0, 0xe7, 0x70, 0x87, 0x27, 0x67, 0x34, 0xc6, 0xd6,
-	0xbf, 0x2c, 0x22, 0x38, 0x55, 0xb3, 0x8f, 0x61, 0xa1, 0xe1, 0xb5, 0x23, 0x22, 0x75, 0xd7, 0xbf,
-	0x9d, 0x75, 0xab, 0xdf, 0xfa, 0xec, 0x35, 0xea, 0x0f, 0x25, 0x98, 0x39, 0xb8, 0xa2, 0x4e, 0x8a,
-	0x78, 0x1f, 0xc0, 0xe5, 0x01, 0xf1, 0xd8, 0x11, 0x09, 0xa8, 0x89, 0x55, 0x66, 0x47, 0x21, 0xd5,
-	0x79, 0x10, 0x10, 0xe6, 0xa6, 0x85, 0x6c, 0x48, 0xd5, 0x41, 0xff, 0x1f, 0xb5, 0xd3, 0x7c, 0xd2,
-	0x6b, 0xb4, 0x0e, 0xf3, 0xd2, 0x0b, 0x28, 0x8f, 0x65, 0x8b, 0x3a, 0x9c, 0xb9, 0x42, 0xa7, 0xd1,
-	0x14, 0x1e, 0xd8, 0xb5, 0xe7, 0x61, 0xf6, 0x20, 0x08, 0x65, 0xd7, 0x78, 0x61, 0xff, 0x0f, 0xaa,
-	0x38, 0xf3, 0x42, 0x89, 0xd8, 0x71, 0xa8, 0x48, 0x8a, 0xa5, 0x8a, 0x53, 0x52, 0x71, 0x02, 0x2a,
-	0x04, 0x69, 0xa7, 0xd5, 0x9c, 0x92, 0xf6, 0x47, 0x98, 0xdf, 0xd7, 0x3e, 0x8f, 0xfa, 0x3c, 0xae,
-	0xc0, 0x74, 0x72, 0x78, 0x63, 0xc1, 0x50, 0x36, 0x83, 0xc5, 0xc4, 0x80, 0x2e, 0xb0, 0x51, 0xad,
-	0xac, 0xc1, 0x8c, 0x7b, 0x8d, 0x96, 0xb6, 0xa6, 0xcc, 0x96, 0x7d, 0x05, 0x77, 0x0f, 0x55, 0x64,
-	0x74, 0x32, 0x8e, 0x68, 0xed, 0x11, 0xdc, 0x6d, 0x0f, 0x62, 0x19, 0x9b, 0x79, 0x86, 0xfd, 0x63,
-	0x09, 0x96, 0xb5, 0xe9, 0x13, 0x41, 0xa3, 0xaf, 0x3c, 0x21, 0x47, 0x35, 0xbf, 0x0b, 0xcb, 0xed,
-	0x22, 0x3c, 0xe3, 0x42, 0x31, 0xd3, 0xfe, 0xa5, 0x04, 0x96, 0x76, 0x43, 0x75, 0x6a, 0xd1, 0x15,
-	0x92, 0x06, 0x23, 0x87, 0xfd, 0x19, 0x58, 0xed, 0x21, 0x90, 0xc6, 0x99, 0xa1, 0x7c, 0xbb, 0x0b,
-	0xb3, 0x49, 0xd9, 0x8c, 0xe6, 0xc2, 0x2a, 0x54, 0xe9, 0x95, 0x27, 0xeb, 0xdc, 0x4d, 0x4c, 0x4e,
-	0xe1, 0x1e, 0xad, 0x72, 0x4f, 0x48, 0xf7, 0x6d, 0x2c, 0xcd, 0xc3, 0x68, 0x28, 0xfb, 0x03, 0x2c,
-	0xe8, 0x48, 0x34, 0xd5, 0xf3, 0xff, 0x85, 0x65, 0x9b, 0x2f, 0xc4, 0xc9, 0xc2, 0x42, 0x7c, 0x6d,
-	0xf2, 0x2c, 0xc1, 0x1e, 0xe9, 0x6c, 0x36, 0x87, 0xb9, 0x17, 0x11, 0xa5, 0x9f, 0xe8, 0x6d, 0xbb,
-	0xd5, 0x53, 0x58, 0x89, 0xd9, 0xb9, 0x56, 0x3d, 0x2e, 0x72, 0x7a, 0x08, 0xd7, 0x7e, 0x0f, 0x77,
-	0x93, 0xb9, 0x6b, 0x3f, 0x0e, 0xc2, 0xdb, 0x1a, 0x5d, 0x85, 0xaa, 0x1b, 0x07, 0x61, 0x93, 0xc8,
-	0x0b, 0x73, 0xf9, 0x3d, 0x7a, 0xe7, 0xd7, 0x05, 0x28, 0xd7, 0x03, 0x17, 0x1d, 0x01, 0x6a, 0x75,
-	0x99, 0xd3, 0xdf, 0xa8, 0xd1, 0xdf, 0x0b, 0x41, 0x13, 0xf3, 0xab, 0xc3, 0x23, 0x65, 0x4f, 0xa0,
-	0xb7, 0xb0, 0xd8, 0x24, 0xb1, 0xa0, 0x63, 0x03, 0x7c, 0x07, 0xcb, 0x27, 0x2c, 0x1c, 0x2b, 0x64,
-	0x0b, 0x96, 0x92, 0x5b, 0x1c, 0x40, 0xbc, 0x9f, 0x53, 0xea, 0xbb, 0xec, 0xcf, 0x83, 0x62, 0x58,
-	0x39, 0x31, 0x77, 0x38, 0x36, 0x47, 0x8f, 0xc1, 0x6a, 0xf1, 0x73, 0x89, 0xe9, 0x19, 0xe7, 0x72,
-	0x6c, 0xa8, 0x18, 0x56, 0x5a, 0x17, 0xb1, 0x74, 0xf9, 0xf7, 0x6c, 0x6c, 0x98, 0x47, 0x80, 0xde,
-	0x78, 0xbe, 0x3f, 0x36, 0xbc, 0x26, 0x2c, 0xed, 0x53, 0x9f, 0xca, 0xf1, 0xc5, 0xf2, 0x3d, 0x2c,
-	0x27, 0xb3, 0xc6, 0x20, 0xe4, 0x3f, 0xf3, 0xff, 0x74, 0x06, 0x66, 0x92, 0x1b, 0x33, 0x5e, 0x55,
-	0x50, 0x4f, 0xe9, 0x98, 0x44, 0x6d, 0x2a, 0x47, 0xf0, 0xf4, 0x6b, 0xb8, 0x57, 0x57, 0xff, 0x7e,
-	0x06, 0xa2, 0xd9, 0x33, 0x30, 0xe2, 0xd5, 0x7b, 0x6d, 0x46, 0xfc, 0xc4, 0xc9, 0x26, 0x77, 0xeb,
-	0x3e, 0x25, 0x2c, 0x0e, 0x47, 0xc0, 0xfc, 0x06, 0x1e, 0xbc, 0xf0, 0x18, 0xf1, 0xbd, 0xc1, 0xc4,
-	0x1f, 0x87, 0xc3, 0x47, 0x80, 0x5e, 0x72, 0x19, 0xfa, 0x71, 0xfb, 0x25, 0x17, 0x72, 0x9f, 0x76,
-	0x3c, 0xf5, 0xaf, 0xf0, 0xcf, 0xe3, 0x35, 0xa0, 0x76, 0x48, 0x65, 0x32, 0xe7, 0xa0, 0x7b, 0x39,
-	0xc9, 0xec, 0xc4, 0xb6, 0xfa, 0x20, 0x3f, 0x3b, 0xf7, 0x0d, 0x60, 0x3a, 0xa9, 0xe6, 0x7b, 0x70,
-	0x7a, 0xaa, 0xb9, 0x09, 0xf3, 0x5f, 0x43, 0x30, 0xfb, 0x66, 0x2e, 0xdd, 0xa2, 0x66, 0x0f, 0xa9,
-	0xec, 0xcd, 0x47, 0x37, 0xc1, 0xda, 0x39, 0x76, 0x6e, 0xb4, 0xd2, 0xa0, 0xd5, 0x43, 0xaa, 0xe7,
-	0x90, 0x1b, 0xfd, 0x5c, 0x2f, 0x06, 0xcc, 0xcd, 0x30, 0x13, 0xe8, 0x5b, 0x1d, 0x82, 0xcc, 0x3c,
-	0x71, 0x13, 0xf4, 0xc3, 0x62, 0xe8, 0xa2, 0x89, 0x64, 0x02, 0xed, 0x41, 0x45, 0xbd, 0xdb, 0x37,
-	0x61, 0x7e, 0xf6, 0xce, 0x0f, 0xa0, 0xa2, 0xe6, 0x1a, 0xf4, 0x8f, 0x3c, 0xc6, 0xf5, 0xbf, 0x84,
-	0xd5, 0x7b, 0x43, 0xb8, 0x99, 0x66, 0x5c, 0xeb, 0xcd, 0x11, 0x05, 0x4d, 0x63, 0x70, 0x7e, 0x19,
-	0x76, 0x27, 0xd9, 0x31, 0x44, 0x57, 0x8f, 0x35, 0x50, 0x35, 0xbd, 0xe7, 0x1e, 0xd9, 0x43, 0xbe,
-	0xc1, 0x64, 0x66, 0x81, 0x9b, 0x7a, 0x9e, 0xba, 0x9b, 0xcc, 0xa7, 0xb5, 0xdb, 0xa7, 0x67, 0xc1,
-	0x77, 0x39, 0x7b, 0x62, 0xaf, 0xf2, 0x61, 0xb2, 0xb3, 0x7d, 0x36, 0xad, 0x3f, 0xe7, 0xfd, 0xe7,
-	0x8f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x79, 0x80, 0xa1, 0x09, 0xfb, 0x13, 0x00, 0x00,
+	// 1763 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xdf, 0x73, 0x1b, 0xb7,
+	0xf1, 0x37, 0x45, 0x4a, 0x26, 0x57, 0x94, 0x62, 0xc1, 0x92, 0x72, 0x5f, 0x7e, 0x63, 0x5b, 0xc5,
+	0x74, 0x3c, 0xca, 0x4c, 0x22, 0xd5, 0xae, 0x93, 0xc9, 0xe4, 0xa1, 0x93, 0x98, 0xa2, 0x7f, 0x44,
+	0x91, 0xcd, 0x80, 0x92, 0xdc, 0xa4, 0x9d, 0xc9, 0x40, 0x77, 0x10, 0x75, 0xa3, 0x3b, 0xe0, 0x7a,
+	0xc0, 0xb1, 0xa6, 0x5f, 0xdb, 0xe9, 0xb4, 0x9d, 0xe9, 0x4b, 0x5f, 0xdb, 0xe9, 0xbf, 0xd5, 0xff,
+	0xa1, 0x7f, 0x45, 0x07, 0x38, 0x1c, 0x79, 0xe4, 0x1d, 0x25, 0xab, 0x64, 0xfb, 0x24, 0xec, 0x62,
+	0xf7, 0x83, 0x0f, 0x16, 0x8b, 0xbd, 0x05, 0x05, 0x1f, 0x47, 0x97, 0xfd, 0xfd, 0x0b, 0xca, 0xbd,
+	0x80, 0xc5, 0x9f, 0x06, 0x34, 0xe1, 0xee, 0x05, 0x8b, 0x3f, 0x75, 0x45, 0xb8, 0xef, 0x86, 0xde,
+	0xfe, 0xe0, 0x91, 0xfe, 0xb3, 0x17, 0xc5, 0x42, 0x09, 0xf4, 0xc1, 0x65, 0x72, 0xc6, 0x06, 0x7e,
+	0xac, 0xf6, 0xb4, 0x6e, 0xf0, 0x08, 0x9f, 0xc3, 0xdd, 0xef, 0x58, 0x98, 0x9c, 0xb2, 0x58, 0xfa,
+	0x82, 0x13, 0x26, 0x23, 0xc1, 0x25, 0x43, 0x9f, 0x41, 0x3d, 0xb6, 0x63, 0xa7, 0xb2, 0x53, 0xd9,
+	0x5d, 0x7d, 0xfc, 0x7f, 0x7b, 0x53, 0xae, 0x7b, 0x99, 0x31, 0x19, 0x99, 0x22, 0x07, 0x6e, 0x0f,
+	0x52, 0x24, 0x67, 0x69, 0xa7, 0xb2, 0xdb, 0x20, 0x99, 0x88, 0x1f, 0x40, 0xf5, 0xf4, 0xe8, 0xa5,
+	0x31, 0x08, 0xfd, 0x6f, 0xa4, 0xe0, 0x06, 0xb6, 0x49, 0x32, 0x11, 0x3f, 0x82, 0x6a, 0xbb, 0x7b,
+	0x82, 0xd6, 0x61, 0xc9, 0xf7, 0xcc, 0xdc, 0x1a, 0x59, 0xf2, 0x3d, 0xd4, 0x82, 0xba, 0xf4, 0xcf,
+	0x02, 0x9f, 0xf7, 0xa5, 0xb3, 0xb4, 0x53, 0xdd, 0x5d, 0x23, 0x23, 0x19, 0xef, 0xc3, 0xed, 0x5e,
+	0x3a, 0x2e, 0xb8, 0x6d, 0xc2, 0xf2, 0x80, 0x06, 0x09, 0x33, 0x34, 0x6a, 0x24, 0x15, 0x70, 0x07,
+	0x96, 0xbb, 0xb4, 0xcf, 0xa4, 0x9e, 0x76, 0x45, 0xc2, 0x95, 0xf1, 0xa8, 0x91, 0x54, 0x40, 0x08,
+	0x6a, 0x09, 0xf7, 0x95, 0xa5, 0x6e, 0xc6, 0x5a, 0x27, 0xfd, 0x77, 0xcc, 0xa9, 0x1a, 0x68, 0x33,
+	0xc6, 0x4f, 0x60, 0xe5, 0x88, 0x85, 0x22, 0x1e, 0xa2, 0x6d, 0x58, 0xa1, 0x61, 0x0e, 0xc8, 0x4a,
+	0x65, 0x48, 0xf8, 0x9f, 0x15, 0xa8, 0xb5, 0x59, 0x10, 0x14, 0xb8, 0xee, 0xc3, 0x4a, 0x68, 0xe0,
+	0x8c, 0xf9, 0xea, 0xe3, 0x0f, 0x0b, 0x91, 0x4e, 0x57, 0x23, 0xd6, 0x0c, 0x7d, 0x02, 0xcb, 0x91,
+	0xde, 0x86, 0x53, 0xdd, 0xa9, 0xee, 0xae, 0x3e, 0xde, 0x2e, 0xd8, 0x9b, 0x4d, 0x92, 0xd4, 0x08,
+	0x7d, 0x0e, 0x0d, 0xcf, 0x97, 0x8a, 0x72, 0x97, 0x49, 0xa7, 0x66, 0x3c, 0x9c, 0x82, 0x87, 0x8d,
+	0x23, 0x19, 0x9b, 0xa2, 0x5d, 0xa8, 0xb9, 0x51, 0x22, 0x9d, 0x65, 0xe3, 0xb2, 0x59, 0x70, 0x69,
+	0x77, 0x4f, 0x88, 0xb1, 0xc0, 0x5f, 0x41, 0xfd, 0x58, 0x44, 0x22, 0x10, 0xfd, 0x21, 0x7a, 0x02,
+	0xc0, 0x93, 0x90, 0xfe, 0xe8, 0xb2, 0x20, 0x90, 0x4e, 0xc5, 0xf8, 0x6e, 0x15, 0x7d, 0x59, 0x10,
+	0x90, 0x86, 0x36, 0xd4, 0x23, 0x89, 0xff, 0x5c, 0x81, 0x95, 0xde, 0xd1, 0x53, 0x5f, 0x48, 0x84,
+	0xa1, 0x19, 0x52, 0x9e, 0x9c, 0x53, 0x57, 0x25, 0x31, 0x8b, 0x4d, 0x9c, 0x1a, 0x64, 0x42, 0xa7,
+	0xb3, 0x28, 0x8a, 0x85, 0x97, 0xb8, 0x59, 0x84, 0x33, 0x31, 0x9f, 0x80, 0xd5, 0x89, 0x04, 0x44,
+	0x77, 0xa0, 0x2a, 0x2f, 0x13, 0xa7, 0x66, 0xb4, 0x7a, 0xa8, 0x0f, 0xef, 0x9c, 0x86, 0x7e, 0x30,
+	0x74, 0x96, 0x8d, 0xd2, 0x4a, 0xf8, 0x0f, 0x15, 0xa8, 0x1f, 0xf8, 0xf2, 0xf2, 0x25, 0x3f, 0x17,
+	0xc6, 0x48, 0xc4, 0x21, 0x55, 0x96, 0x88, 0x95, 0xd0, 0x0e, 0xac, 0x9e, 0x51, 0xf7, 0xd2, 0xe7,
+	0xfd, 0x67, 0x7e, 0xc0, 0x2c, 0x8d, 0xbc, 0x0a, 0xdd, 0x07, 0xd0, 0x7c, 0x69, 0xd0, 0xcb, 0xf2,
+	0xa7, 0x46, 0x72, 0x1a, 0x8d, 0xa0, 0x43, 0x92, 0x19, 0xd4, 0x8c, 0x41, 0x5e, 0x85, 0xff, 0x56,
+	0x83, 0xad, 0xd3, 0x54, 0x3e, 0xa2, 0xee, 0x85, 0xcf, 0xd9, 0xeb, 0x48, 0xf9, 0x82, 0x4b, 0x74,
+	0x08, 0x9b, 0x93, 0x13, 0x69, 0xf0, 0x9c, 0xca, 0x8c, 0x04, 0x4a, 0xa7, 0x49, 0xa9, 0x13, 0x7a,
+	0x02, 0x5b, 0x47, 0x2c, 0x7c, 0x4a, 0x83, 0x40, 0x08, 0xde, 0x53, 0x54, 0xc9, 0x2e, 0x8b, 0x7d,
+	0xe1, 0x99, 0x4d, 0xad, 0x91, 0xf2, 0x49, 0xf4, 0x33, 0xb8, 0xdb, 0x8d, 0x99, 0xd6, 0xbb, 0x54,
+	0x31, 0xef, 0x54, 0x04, 0x49, 0x68, 0x53, 0xb2, 0x41, 0xca, 0xa6, 0x74, 0x4d, 0x51, 0x36, 0x4d,
+	0x9c, 0xda, 0x8c, 0x9a, 0x92, 0xe5, 0x11, 0x19, 0x99, 0xa2, 0x1e, 0x34, 0xf4, 0x69, 0x48, 0x7d,
+	0x1c, 0x36, 0x19, 0x3f, 0x2b, 0xf8, 0x95, 0x86, 0x69, 0x6f, 0xe4, 0xd7, 0xe1, 0x2a, 0x1e, 0x92,
+	0x31, 0x0e, 0xda, 0x03, 0xd4, 0x79, 0x1b, 0x51, 0xee, 0x19, 0x55, 0x87, 0xd3, 0xb3, 0x80, 0x79,
+	0xce, 0xca, 0x4e, 0x65, 0xb7, 0x4e, 0x4a, 0x66, 0xd0, 0x17, 0xf0, 0x61, 0x8f, 0xc5, 0x3e, 0x0d,
+	0xda, 0x82, 0x4b, 0x11, 0xb0, 0x6f, 0x45, 0x3f, 0x73, 0xba, 0x6d, 0x9c, 0x66, 0x4d, 0xb7, 0xde,
+	0xc0, 0xfa, 0x24, 0x0d, 0x9d, 0x89, 0x97, 0x6c, 0x68, 0xf3, 0x49, 0x0f, 0xd1, 0x7e, 0xbe, 0x5a,
+	0x95, 0x85, 0x25, 0x4b, 0x47, 0x5b, 0xc8, 0xbe, 0x5c, 0xfa, 0xa2, 0x82, 0x07, 0x00, 0xa7, 0x47,
+	0x2f, 0x09, 0xfb, 0x4d, 0xc2, 0xa4, 0x42, 0x0f, 0xa1, 0x3a, 0x08, 0x7d, 0x9b, 0x00, 0xc5, 0xcb,
+	0xaa, 0x2d, 0xb5, 0x01, 0xfa, 0x0a, 0x6e, 0x8b, 0x34, 0x3a, 0x76, 0xb1, 0x87, 0xef, 0x17, 0x4b,
+	0x92, 0xb9, 0xe1, 0x63, 0xb8, 0x73, 0xe4, 0xf7, 0x63, 0xaa, 0xcc, 0xf7, 0xe2, 0x66, 0xab, 0x3b,
+	0x93, 0xab, 0x37, 0xc7, 0xa8, 0xbf, 0xab, 0xc0, 0x6a, 0xe7, 0x2d, 0x73, 0x33, 0xc4, 0xfb, 0x00,
+	0x9e, 0x08, 0xa9, 0xcf, 0x5f, 0xd1, 0x90, 0xd9, 0x58, 0xe5, 0x34, 0x1a, 0xa9, 0x2d, 0xc2, 0x90,
+	0x72, 0x2f, 0x2b, 0x01, 0x56, 0xd4, 0xb5, 0xf7, 0xeb, 0xb8, 0x9f, 0x65, 0xa2, 0x19, 0xa3, 0x87,
+	0xb0, 0xae, 0xfc, 0x90, 0x89, 0x44, 0xf5, 0x98, 0x2b, 0xb8, 0x27, 0x4d, 0x02, 0x2e, 0x93, 0x29,
+	0x2d, 0x5e, 0x87, 0x66, 0x27, 0x8c, 0xd4, 0xd0, 0xb2, 0xc0, 0xbf, 0x80, 0x3a, 0xc9, 0x7d, 0xdb,
+	0x64, 0xe2, 0xba, 0x4c, 0xa6, 0xd7, 0xac, 0x4e, 0x32, 0x51, 0xcf, 0x84, 0x4c, 0x4a, 0xda, 0xcf,
+	0xea, 0x40, 0x26, 0xe2, 0x1f, 0x61, 0xfd, 0xc0, 0x70, 0x9e, 0xf7, 0xc3, 0xba, 0x0d, 0x2b, 0xe9,
+	0xe6, 0xed, 0x0a, 0x56, 0xc2, 0x1c, 0xee, 0xa6, 0x0b, 0x98, 0xab, 0x39, 0xef, 0x2a, 0x3b, 0xb0,
+	0xea, 0x8d, 0xd1, 0xb2, 0xa2, 0x96, 0x53, 0xe1, 0xb7, 0xb0, 0xf1, 0x5c, 0x47, 0xc6, 0x24, 0xe3,
+	0x9c, 0xab, 0x7d, 0x02, 0x1b, 0xfd, 0x69, 0x2c, 0xbb, 0x66, 0x71, 0x02, 0xff, 0xbe, 0x02, 0x5b,
+	0x66, 0xe9, 0x13, 0xc9, 0xe2, 0x6f, 0x7d, 0xa9, 0xe6, 0x5d, 0xfe, 0x09, 0x6c, 0xf5, 0xcb, 0xf0,
+	0x2c, 0x85, 0xf2, 0x49, 0xfc, 0x97, 0x0a, 0x38, 0x86, 0x86, 0xae, 0xf1, 0x72, 0x28, 0x15, 0x0b,
+	0xe7, 0x0e, 0xfb, 0x97, 0xe0, 0xf4, 0x67, 0x40, 0x5a, 0x32, 0x33, 0xe7, 0xf1, 0x10, 0x9a, 0xe9,
+	0xb5, 0x99, 0x8f, 0x42, 0x0b, 0xea, 0xec, 0xad, 0xaf, 0xda, 0xc2, 0x4b, 0x97, 0x5c, 0x26, 0x23,
+	0x59, 0xe7, 0x9e, 0x54, 0xde, 0xeb, 0x44, 0xd9, 0x4f, 0xaa, 0x95, 0xf0, 0x0f, 0x70, 0xc7, 0x44,
+	0xa2, 0xab, 0x1b, 0x87, 0xf7, 0xbc, 0xb6, 0xc5, 0x8b, 0xb8, 0x54, 0x7a, 0x11, 0xbf, 0x81, 0x8d,
+	0x1c, 0xf6, 0x5c, 0x7b, 0xc3, 0x02, 0xd6, 0x9e, 0xc5, 0x8c, 0xbd, 0x63, 0x37, 0xad, 0x56, 0x9f,
+	0xc3, 0x76, 0xc2, 0xcf, 0x8d, 0xeb, 0x71, 0x19, 0xe9, 0x19, 0xb3, 0xf8, 0x0d, 0x6c, 0xa4, 0x1d,
+	0xdb, 0x41, 0x12, 0x46, 0x37, 0x5d, 0xb4, 0x05, 0x75, 0x2f, 0x09, 0xa3, 0x2e, 0x55, 0x17, 0xf6,
+	0xf0, 0x47, 0x32, 0xfe, 0x47, 0xc5, 0x86, 0xfc, 0x86, 0x95, 0xd2, 0x9d, 0xac, 0x94, 0xee, 0xb8,
+	0x52, 0xd2, 0x5c, 0xa5, 0xd4, 0x63, 0xdd, 0x19, 0x4b, 0xe5, 0xf9, 0xdc, 0x14, 0xc8, 0x26, 0x49,
+	0x85, 0x92, 0x63, 0x5b, 0x2e, 0x3d, 0xb6, 0x7f, 0x55, 0x60, 0x23, 0x47, 0xf0, 0x7f, 0x95, 0x93,
+	0xcd, 0x2c, 0x27, 0xad, 0xbe, 0x13, 0xc7, 0x96, 0xbf, 0x95, 0xd0, 0x2e, 0x7c, 0x90, 0x5a, 0x1c,
+	0xc7, 0x09, 0x37, 0x5d, 0x89, 0xd9, 0x41, 0x9d, 0x4c, 0xab, 0xad, 0x65, 0x27, 0x8e, 0xc7, 0x96,
+	0x2b, 0x23, 0xcb, 0xbc, 0x1a, 0xff, 0x3d, 0x3b, 0x0d, 0x7d, 0x2f, 0xdf, 0xf7, 0x34, 0x10, 0xd4,
+	0xa2, 0xf1, 0xd1, 0x9a, 0xb1, 0xd6, 0x85, 0x7a, 0x93, 0xe9, 0xf5, 0x32, 0x63, 0xbd, 0x91, 0xf4,
+	0x45, 0x67, 0x36, 0x52, 0x25, 0x56, 0xd2, 0xb6, 0x1e, 0x55, 0xd4, 0xb0, 0x6f, 0x12, 0x33, 0x1e,
+	0xbf, 0x66, 0x56, 0x4c, 0x94, 0x52, 0x01, 0xff, 0x31, 0x3b, 0x8b, 0x94, 0xde, 0xdc, 0xdf, 0x1f,
+	0x4b, 0x67, 0xa9, 0x94, 0x4e, 0x35, 0x47, 0xe7, 0x0e, 0x54, 0x99, 0x38, 0x37, 0xbc, 0xeb, 0x44,
+	0x0f, 0xf1, 0x9f, 0x2a, 0xb0, 0xd6, 0xeb, 0xbd, 0x38, 0x64, 0xc3, 0x9b, 0xde, 0x06, 0xfd, 0x90,
+	0x92, 0x2c, 0x1e, 0x3d, 0xa4, 0x24, 0x8b, 0xd1, 0x47, 0xd0, 0x88, 0x92, 0xb3, 0xc0, 0x77, 0x0f,
+	0xd9, 0xd0, 0xc6, 0x6c, 0xac, 0xd0, 0x07, 0xa0, 0x54, 0x30, 0xf9, 0x99, 0xcf, 0x69, 0x1e, 0xff,
+	0x75, 0x0b, 0xaa, 0xed, 0xd0, 0x43, 0xaf, 0x00, 0xf5, 0x86, 0xdc, 0x9d, 0x6c, 0x76, 0xd0, 0xff,
+	0x97, 0x52, 0x49, 0x49, 0xb7, 0x66, 0x47, 0x0a, 0xdf, 0x42, 0xaf, 0xe1, 0x6e, 0x97, 0x26, 0x92,
+	0x2d, 0x0c, 0xf0, 0x3b, 0xd8, 0x3a, 0xe1, 0xd1, 0x42, 0x21, 0x7b, 0xb0, 0x99, 0x56, 0xc2, 0x29,
+	0xc4, 0xfb, 0x05, 0xa7, 0x89, 0x82, 0x79, 0x35, 0x28, 0x81, 0xed, 0x13, 0x7e, 0x5e, 0x06, 0xfb,
+	0x9f, 0x13, 0x3d, 0x06, 0xa7, 0x27, 0xce, 0x15, 0x61, 0x67, 0x42, 0xa8, 0x85, 0xa1, 0x12, 0xd8,
+	0xee, 0x5d, 0x24, 0xca, 0x13, 0xbf, 0xe5, 0x0b, 0xc3, 0x7c, 0x05, 0xe8, 0xd0, 0x0f, 0x82, 0x85,
+	0xe1, 0x75, 0x61, 0xf3, 0x80, 0x05, 0x4c, 0x2d, 0x2e, 0x96, 0x6f, 0x60, 0x2b, 0xed, 0xd7, 0xa7,
+	0x21, 0x7f, 0x52, 0xf0, 0x9a, 0xee, 0xeb, 0xaf, 0xcd, 0x78, 0x7d, 0x83, 0x46, 0x4e, 0xc7, 0x34,
+	0xee, 0x33, 0x35, 0x07, 0xd3, 0xef, 0xe1, 0x5e, 0x5b, 0xff, 0xf6, 0x30, 0x15, 0xcd, 0xd1, 0x02,
+	0x73, 0x1e, 0xbd, 0xdf, 0xe7, 0x34, 0x48, 0x49, 0x76, 0x85, 0xd7, 0x0e, 0x18, 0xe5, 0x49, 0x34,
+	0x07, 0xe6, 0xaf, 0xe0, 0xc1, 0x33, 0x9f, 0xd3, 0xc0, 0x7f, 0xc7, 0x16, 0x4f, 0xf8, 0x15, 0xa0,
+	0x17, 0x42, 0x45, 0x41, 0xd2, 0x7f, 0x21, 0xa4, 0x3a, 0x60, 0x03, 0xdf, 0x65, 0x72, 0x0e, 0xbc,
+	0x23, 0x68, 0x3c, 0x67, 0x2a, 0x7d, 0x2b, 0xa0, 0x7b, 0x05, 0xcb, 0xfc, 0xab, 0xa7, 0xf5, 0xa0,
+	0xf8, 0xfe, 0x9c, 0x78, 0xc4, 0x98, 0xa4, 0x5a, 0x1f, 0xc1, 0x99, 0x97, 0xc1, 0x75, 0x98, 0x3f,
+	0x9d, 0x81, 0x39, 0xf1, 0x6e, 0x31, 0x25, 0xaa, 0xf9, 0x9c, 0xa9, 0xd1, 0x1b, 0xe3, 0x3a, 0x58,
+	0x5c, 0x98, 0x2e, 0x3c, 0x4f, 0x0c, 0x68, 0xfd, 0x39, 0x33, 0xbd, 0xfc, 0xb5, 0x3c, 0x1f, 0x96,
+	0x03, 0x16, 0xde, 0x01, 0xb7, 0xd0, 0xaf, 0x4d, 0x08, 0x72, 0x3d, 0xf9, 0x75, 0xd0, 0x1f, 0x97,
+	0x43, 0x97, 0x75, 0xf5, 0xb7, 0xd0, 0x53, 0xa8, 0xe9, 0xde, 0xf7, 0x3a, 0xcc, 0x2b, 0xcf, 0xbc,
+	0x03, 0x35, 0xdd, 0x87, 0xa1, 0x8f, 0x8a, 0x18, 0xe3, 0xfe, 0xb1, 0x75, 0x6f, 0xc6, 0x6c, 0xae,
+	0x18, 0x37, 0x46, 0xbd, 0x78, 0x49, 0xd1, 0x98, 0x7e, 0x03, 0xb4, 0xf0, 0x55, 0x26, 0xb9, 0xdb,
+	0xe3, 0x4c, 0xdd, 0x9a, 0x51, 0xcb, 0x8c, 0xf0, 0x8c, 0x5f, 0x40, 0x73, 0xfd, 0xf4, 0x75, 0x35,
+	0x4f, 0x9f, 0x4d, 0xee, 0x87, 0xed, 0x9b, 0xa7, 0x67, 0xc9, 0xaf, 0xe2, 0xb9, 0x58, 0x98, 0xb8,
+	0xce, 0x88, 0x45, 0x3e, 0xb8, 0xf8, 0x2a, 0x93, 0x11, 0xea, 0x2f, 0x61, 0x6d, 0x94, 0x0a, 0xaf,
+	0x23, 0xc6, 0x67, 0x21, 0xe7, 0x1a, 0xcd, 0x16, 0xbe, 0xca, 0xa4, 0x14, 0x99, 0x30, 0xea, 0x2d,
+	0x0e, 0xf9, 0x7b, 0x58, 0x1f, 0xa9, 0xdf, 0xc4, 0xbe, 0x62, 0xff, 0x1d, 0xe8, 0x76, 0x20, 0xe4,
+	0x02, 0xa1, 0x5f, 0x40, 0xe3, 0x6b, 0xcf, 0x4b, 0x7b, 0xd1, 0x92, 0xb6, 0x67, 0xa2, 0x49, 0xbd,
+	0x3a, 0xc5, 0x0e, 0xa1, 0x49, 0x58, 0x28, 0x06, 0x6c, 0x01, 0x60, 0x4f, 0x6b, 0x3f, 0x2c, 0x0d,
+	0x1e, 0x9d, 0xad, 0x98, 0xff, 0xd1, 0xfc, 0xfc, 0xdf, 0x03, 0x00, 0x75, 0xd2, 0x9f, 0x4b, 0xd0,
+	0x19, 0x00, 0x00,
 }
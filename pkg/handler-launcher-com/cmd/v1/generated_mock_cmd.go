@@ -382,6 +382,118 @@ func (_mr *_MockCmdClientRecorder) Exec(arg0, arg1 interface{}, arg2 ...interfac
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Exec", _s...)
 }
 
+func (_m *MockCmdClient) GuestExec(ctx context.Context, in *GuestExecRequest, opts ...grpc.CallOption) (*GuestExecResponse, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GuestExec", _s...)
+	ret0, _ := ret[0].(*GuestExecResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) GuestExec(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", _s...)
+}
+
+func (_m *MockCmdClient) GuestFileOpen(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GuestFileOpen", _s...)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) GuestFileOpen(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileOpen", _s...)
+}
+
+func (_m *MockCmdClient) GuestFileRead(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GuestFileRead", _s...)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) GuestFileRead(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", _s...)
+}
+
+func (_m *MockCmdClient) GuestFileWrite(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", _s...)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) GuestFileWrite(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", _s...)
+}
+
+func (_m *MockCmdClient) GuestFileClose(ctx context.Context, in *GuestFileRequest, opts ...grpc.CallOption) (*GuestFileResponse, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "GuestFileClose", _s...)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) GuestFileClose(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileClose", _s...)
+}
+
+func (_m *MockCmdClient) AddSSHKey(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*Response, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "AddSSHKey", _s...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) AddSSHKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddSSHKey", _s...)
+}
+
+func (_m *MockCmdClient) RemoveSSHKey(ctx context.Context, in *SSHKeyRequest, opts ...grpc.CallOption) (*Response, error) {
+	_s := []interface{}{ctx, in}
+	for _, _x := range opts {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "RemoveSSHKey", _s...)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdClientRecorder) RemoveSSHKey(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveSSHKey", _s...)
+}
+
 func (_m *MockCmdClient) GuestPing(ctx context.Context, in *GuestPingRequest, opts ...grpc.CallOption) (*GuestPingResponse, error) {
 	_s := []interface{}{ctx, in}
 	for _, _x := range opts {
@@ -693,6 +805,83 @@ func (_mr *_MockCmdServerRecorder) Exec(arg0, arg1 interface{}) *gomock.Call {
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Exec", arg0, arg1)
 }
 
+func (_m *MockCmdServer) GuestExec(_param0 context.Context, _param1 *GuestExecRequest) (*GuestExecResponse, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", _param0, _param1)
+	ret0, _ := ret[0].(*GuestExecResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) GuestExec(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1)
+}
+
+func (_m *MockCmdServer) GuestFileOpen(_param0 context.Context, _param1 *GuestFileRequest) (*GuestFileResponse, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileOpen", _param0, _param1)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) GuestFileOpen(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileOpen", arg0, arg1)
+}
+
+func (_m *MockCmdServer) GuestFileRead(_param0 context.Context, _param1 *GuestFileRequest) (*GuestFileResponse, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", _param0, _param1)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) GuestFileRead(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1)
+}
+
+func (_m *MockCmdServer) GuestFileWrite(_param0 context.Context, _param1 *GuestFileRequest) (*GuestFileResponse, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", _param0, _param1)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) GuestFileWrite(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1)
+}
+
+func (_m *MockCmdServer) GuestFileClose(_param0 context.Context, _param1 *GuestFileRequest) (*GuestFileResponse, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileClose", _param0, _param1)
+	ret0, _ := ret[0].(*GuestFileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) GuestFileClose(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileClose", arg0, arg1)
+}
+
+func (_m *MockCmdServer) AddSSHKey(_param0 context.Context, _param1 *SSHKeyRequest) (*Response, error) {
+	ret := _m.ctrl.Call(_m, "AddSSHKey", _param0, _param1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) AddSSHKey(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddSSHKey", arg0, arg1)
+}
+
+func (_m *MockCmdServer) RemoveSSHKey(_param0 context.Context, _param1 *SSHKeyRequest) (*Response, error) {
+	ret := _m.ctrl.Call(_m, "RemoveSSHKey", _param0, _param1)
+	ret0, _ := ret[0].(*Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockCmdServerRecorder) RemoveSSHKey(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveSSHKey", arg0, arg1)
+}
+
 func (_m *MockCmdServer) GuestPing(_param0 context.Context, _param1 *GuestPingRequest) (*GuestPingResponse, error) {
 	ret := _m.ctrl.Call(_m, "GuestPing", _param0, _param1)
 	ret0, _ := ret[0].(*GuestPingResponse)
@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vms
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+// Summarize returns the statistics of a domain exposed by the domainstats subresource of the VMIs.
+// Like for the metrics, the disks and interfaces are named after their alias in the VMI, and the memory is in KiB in
+// the domain statistics.
+func Summarize(domStats *stats.DomainStats, timestamp time.Time) *k6tv1.VirtualMachineInstanceDomainStats {
+	summary := &k6tv1.VirtualMachineInstanceDomainStats{
+		Timestamp: metav1.NewMicroTime(timestamp),
+		VCPUs:     len(domStats.Vcpu),
+	}
+
+	if domStats.Cpu != nil && domStats.Cpu.TimeSet {
+		summary.CPUTimeNanoseconds = int64(domStats.Cpu.Time)
+	}
+
+	if mem := domStats.Memory; mem != nil {
+		summary.Memory = &k6tv1.DomainMemoryStats{
+			ActualBalloonBytes: kibToBytes(mem.ActualBalloonSet, mem.ActualBalloon),
+			AvailableBytes:     kibToBytes(mem.AvailableSet, mem.Available),
+			UnusedBytes:        kibToBytes(mem.UnusedSet, mem.Unused),
+			UsableBytes:        kibToBytes(mem.UsableSet, mem.Usable),
+			RSSBytes:           kibToBytes(mem.RSSSet, mem.RSS),
+			SwapInBytes:        kibToBytes(mem.SwapInSet, mem.SwapIn),
+			SwapOutBytes:       kibToBytes(mem.SwapOutSet, mem.SwapOut),
+		}
+	}
+
+	for _, block := range domStats.Block {
+		// The images backing a disk are reported with a backing index, only the disk itself is of interest
+		if !block.NameSet || block.BackingIndexSet {
+			continue
+		}
+		disk := k6tv1.DomainDiskStats{
+			Name:          block.Name,
+			ReadBytes:     counter(block.RdBytesSet, block.RdBytes),
+			ReadRequests:  counter(block.RdReqsSet, block.RdReqs),
+			WriteBytes:    counter(block.WrBytesSet, block.WrBytes),
+			WriteRequests: counter(block.WrReqsSet, block.WrReqs),
+		}
+		if block.Alias != "" {
+			disk.Name = block.Alias
+		}
+		summary.Disks = append(summary.Disks, disk)
+	}
+
+	for _, net := range domStats.Net {
+		if !net.NameSet {
+			continue
+		}
+		iface := k6tv1.DomainInterfaceStats{
+			Name:      net.Name,
+			RxBytes:   counter(net.RxBytesSet, net.RxBytes),
+			RxPackets: counter(net.RxPktsSet, net.RxPkts),
+			TxBytes:   counter(net.TxBytesSet, net.TxBytes),
+			TxPackets: counter(net.TxPktsSet, net.TxPkts),
+		}
+		if net.AliasSet {
+			iface.Name = net.Alias
+		}
+		summary.Interfaces = append(summary.Interfaces, iface)
+	}
+
+	return summary
+}
+
+func counter(set bool, value uint64) int64 {
+	if !set {
+		return 0
+	}
+	return int64(value)
+}
+
+func kibToBytes(set bool, value uint64) int64 {
+	return counter(set, value) * 1024
+}
@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vms
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	k6tv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
+)
+
+var _ = Describe("Summarize", func() {
+	It("should summarize the domain stats", func() {
+		timestamp := time.Unix(1700000000, 0)
+		domStats := &stats.DomainStats{
+			Cpu:  &stats.DomainStatsCPU{TimeSet: true, Time: 5000000000},
+			Vcpu: []stats.DomainStatsVcpu{{}, {}},
+			Memory: &stats.DomainStatsMemory{
+				ActualBalloonSet: true, ActualBalloon: 2048,
+				AvailableSet: true, Available: 1024,
+				UnusedSet: true, Unused: 512,
+				RSSSet: true, RSS: 4096,
+			},
+			Block: []stats.DomainStatsBlock{
+				{NameSet: true, Name: "vda", Alias: "rootdisk", RdBytesSet: true, RdBytes: 100, RdReqsSet: true, RdReqs: 10, WrBytesSet: true, WrBytes: 200, WrReqsSet: true, WrReqs: 20},
+				{NameSet: true, Name: "vda", BackingIndexSet: true, BackingIndex: 1, RdBytesSet: true, RdBytes: 300},
+				{NameSet: true, Name: "vdb", RdBytesSet: true, RdBytes: 400},
+			},
+			Net: []stats.DomainStatsNet{
+				{NameSet: true, Name: "tap0", AliasSet: true, Alias: "default", RxBytesSet: true, RxBytes: 1000, RxPktsSet: true, RxPkts: 10, TxBytesSet: true, TxBytes: 2000, TxPktsSet: true, TxPkts: 20},
+				{Name: "unnamed"},
+			},
+		}
+
+		Expect(Summarize(domStats, timestamp)).To(Equal(&k6tv1.VirtualMachineInstanceDomainStats{
+			Timestamp:          metav1.NewMicroTime(timestamp),
+			CPUTimeNanoseconds: 5000000000,
+			VCPUs:              2,
+			Memory: &k6tv1.DomainMemoryStats{
+				ActualBalloonBytes: 2048 * 1024,
+				AvailableBytes:     1024 * 1024,
+				UnusedBytes:        512 * 1024,
+				RSSBytes:           4096 * 1024,
+			},
+			Disks: []k6tv1.DomainDiskStats{
+				{Name: "rootdisk", ReadBytes: 100, ReadRequests: 10, WriteBytes: 200, WriteRequests: 20},
+				{Name: "vdb", ReadBytes: 400},
+			},
+			Interfaces: []k6tv1.DomainInterfaceStats{
+				{Name: "default", RxBytes: 1000, RxPackets: 10, TxBytes: 2000, TxPackets: 20},
+			},
+		}))
+	})
+
+	It("should leave out the statistics which aren't reported", func() {
+		summary := Summarize(&stats.DomainStats{Cpu: &stats.DomainStatsCPU{}}, time.Now())
+		Expect(summary.CPUTimeNanoseconds).To(BeZero())
+		Expect(summary.Memory).To(BeNil())
+		Expect(summary.Disks).To(BeEmpty())
+		Expect(summary.Interfaces).To(BeEmpty())
+	})
+})
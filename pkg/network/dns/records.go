@@ -0,0 +1,74 @@
+package dns
+
+import (
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const (
+	RecordTypeA    = "A"
+	RecordTypeAAAA = "AAAA"
+)
+
+// Record is an address record of a VMI interface connected to a secondary network.
+type Record struct {
+	DNSName    string
+	RecordType string
+	Targets    []string
+}
+
+// SecondaryNetworkRecords returns the `<vmi>.<network>.<namespace>[.<domain>]` A and AAAA records
+// of the VMI secondary networks, built from the IP addresses reported by the guest agent.
+// Networks whose name is not a valid DNS label are skipped.
+func SecondaryNetworkRecords(vmi *v1.VirtualMachineInstance, domain string) []Record {
+	if len(validation.IsDNS1123Label(vmi.Name)) > 0 {
+		return nil
+	}
+
+	var records []Record
+	for _, network := range vmispec.FilterMultusNonDefaultNetworks(vmi.Spec.Networks) {
+		if len(validation.IsDNS1123Label(network.Name)) > 0 {
+			continue
+		}
+		ifaceStatus := vmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, network.Name)
+		if ifaceStatus == nil || !vmispec.ContainsInfoSource(ifaceStatus.InfoSource, vmispec.InfoSourceGuestAgent) {
+			continue
+		}
+
+		var ipv4Targets, ipv6Targets []string
+		for _, ip := range ifaceStatus.IPs {
+			parsedIP := net.ParseIP(ip)
+			if parsedIP == nil || parsedIP.IsLinkLocalUnicast() {
+				continue
+			}
+			if parsedIP.To4() != nil {
+				ipv4Targets = append(ipv4Targets, ip)
+			} else {
+				ipv6Targets = append(ipv6Targets, ip)
+			}
+		}
+
+		dnsName := recordName(vmi.Name, network.Name, vmi.Namespace, domain)
+		if len(ipv4Targets) > 0 {
+			records = append(records, Record{DNSName: dnsName, RecordType: RecordTypeA, Targets: ipv4Targets})
+		}
+		if len(ipv6Targets) > 0 {
+			records = append(records, Record{DNSName: dnsName, RecordType: RecordTypeAAAA, Targets: ipv6Targets})
+		}
+	}
+	return records
+}
+
+func recordName(vmiName, networkName, namespace, domain string) string {
+	labels := []string{vmiName, networkName, namespace}
+	if domain = strings.Trim(domain, "."); domain != "" {
+		labels = append(labels, domain)
+	}
+	return strings.Join(labels, ".")
+}
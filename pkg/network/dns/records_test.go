@@ -0,0 +1,56 @@
+package dns
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+var _ = Describe("Secondary network records", func() {
+	newVMI := func(networkName string, infoSource string, ips ...string) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "myvmi", Namespace: "myns"},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Networks: []v1.Network{
+					{Name: "default", NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}}},
+					{Name: networkName, NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "nad"}}},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{
+				Interfaces: []v1.VirtualMachineInstanceNetworkInterface{
+					{Name: "default", IP: "10.244.0.5", IPs: []string{"10.244.0.5"}, InfoSource: vmispec.InfoSourceDomainAndGA},
+					{Name: networkName, IPs: ips, InfoSource: infoSource},
+				},
+			},
+		}
+	}
+
+	It("should publish A and AAAA records of the secondary networks", func() {
+		vmi := newVMI("blue", vmispec.InfoSourceDomainAndGA, "192.168.1.10", "fd00::10", "fe80::1")
+		Expect(SecondaryNetworkRecords(vmi, "vms.example.com.")).To(Equal([]Record{
+			{DNSName: "myvmi.blue.myns.vms.example.com", RecordType: RecordTypeA, Targets: []string{"192.168.1.10"}},
+			{DNSName: "myvmi.blue.myns.vms.example.com", RecordType: RecordTypeAAAA, Targets: []string{"fd00::10"}},
+		}))
+	})
+
+	It("should omit the domain when it is not set", func() {
+		vmi := newVMI("blue", vmispec.InfoSourceGuestAgent, "192.168.1.10")
+		Expect(SecondaryNetworkRecords(vmi, "")).To(Equal([]Record{
+			{DNSName: "myvmi.blue.myns", RecordType: RecordTypeA, Targets: []string{"192.168.1.10"}},
+		}))
+	})
+
+	It("should ignore addresses not reported by the guest agent", func() {
+		vmi := newVMI("blue", vmispec.InfoSourceMultusStatus, "192.168.1.10")
+		Expect(SecondaryNetworkRecords(vmi, "")).To(BeEmpty())
+	})
+
+	It("should ignore networks whose name is not a DNS label", func() {
+		vmi := newVMI("blue_net", vmispec.InfoSourceGuestAgent, "192.168.1.10")
+		Expect(SecondaryNetworkRecords(vmi, "")).To(BeEmpty())
+	})
+})
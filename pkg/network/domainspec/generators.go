@@ -23,7 +23,7 @@ import (
 	"bufio"
 	"fmt"
 	"io"
-
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
@@ -31,6 +31,7 @@ import (
 
 	"github.com/vishvananda/netlink"
 	"golang.org/x/sys/unix"
+	netutils "k8s.io/utils/net"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -38,6 +39,8 @@ import (
 	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	virtnetlink "kubevirt.io/kubevirt/pkg/network/link"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -100,11 +103,20 @@ func NewBridgeLibvirtSpecGenerator(
 	}
 }
 
-func NewPasstLibvirtSpecGenerator(iface *v1.Interface, domain *api.Domain, vmi *v1.VirtualMachineInstance) *PasstLibvirtSpecGenerator {
+func NewPasstLibvirtSpecGenerator(
+	iface *v1.Interface,
+	domain *api.Domain,
+	podInterfaceName string,
+	vmi *v1.VirtualMachineInstance,
+	handler netdriver.NetworkHandler,
+) *PasstLibvirtSpecGenerator {
 	return &PasstLibvirtSpecGenerator{
-		vmiSpecIface: iface,
-		domain:       domain,
-		vmi:          vmi,
+		vmiSpecIface:     iface,
+		domain:           domain,
+		podInterfaceName: podInterfaceName,
+		vmi:              vmi,
+		handler:          handler,
+		podName:          os.Getenv("POD_NAME"),
 	}
 }
 
@@ -294,9 +306,13 @@ func (b *MacvtapLibvirtSpecGenerator) discoverDomainIfaceSpec() (*api.Interface,
 }
 
 type PasstLibvirtSpecGenerator struct {
-	vmiSpecIface *v1.Interface
-	domain       *api.Domain
-	vmi          *v1.VirtualMachineInstance
+	vmiSpecIface     *v1.Interface
+	domain           *api.Domain
+	podInterfaceName string
+	vmi              *v1.VirtualMachineInstance
+	handler          netdriver.NetworkHandler
+	// podName is the name of the virt-launcher pod, it tells the migration target pod apart
+	podName string
 }
 
 func (b *PasstLibvirtSpecGenerator) Generate() error {
@@ -317,8 +333,14 @@ func (b *PasstLibvirtSpecGenerator) Generate() error {
 		return fmt.Errorf("failed to find interface %s in vmi spec", b.vmiSpecIface.Name)
 	}
 
-	ports := b.generatePorts()
-	args := append([]string{"--runas", "107", "-e"}, ports...)
+	ipFamilyArgs, err := b.generateIPFamilyArgs()
+	if err != nil {
+		return err
+	}
+
+	args := append([]string{"--runas", "107", "-e"}, b.generatePorts()...)
+	args = append(args, ipFamilyArgs...)
+	args = append(args, b.generateAddressArgs()...)
 	cmd := exec.Command("/usr/bin/passt", args...)
 	cmd.SysProcAttr = &syscall.SysProcAttr{
 		AmbientCaps: []uintptr{unix.CAP_NET_BIND_SERVICE},
@@ -363,7 +385,7 @@ func (b *PasstLibvirtSpecGenerator) generatePorts() []string {
 	tcpPorts := []string{}
 	udpPorts := []string{}
 
-	if len(b.vmiSpecIface.Ports) == 0 {
+	if util.IsPasstForwardingAllPorts(b.vmiSpecIface) {
 		if istio.ProxyInjectionEnabled(b.vmi) {
 			for _, port := range istio.ReservedPorts() {
 				tcpPorts = append(tcpPorts, fmt.Sprintf("~%s", port))
@@ -373,7 +395,7 @@ func (b *PasstLibvirtSpecGenerator) generatePorts() []string {
 		}
 		udpPorts = append(udpPorts, "all")
 	}
-	for _, port := range b.vmiSpecIface.Ports {
+	for _, port := range b.forwardedPorts() {
 		if strings.EqualFold(port.Protocol, "TCP") || port.Protocol == "" {
 			tcpPorts = append(tcpPorts, fmt.Sprintf("%d", port.Port))
 		} else if strings.EqualFold(port.Protocol, "UDP") {
@@ -392,3 +414,56 @@ func (b *PasstLibvirtSpecGenerator) generatePorts() []string {
 	}
 	return append(tcpPorts, udpPorts...)
 }
+
+func (b *PasstLibvirtSpecGenerator) forwardedPorts() []v1.Port {
+	if util.IsPasstForwardingAllPorts(b.vmiSpecIface) {
+		return nil
+	}
+	return b.vmiSpecIface.Ports
+}
+
+// generateIPFamilyArgs restricts passt to IPv6 when the pod interface has no IPv4 address,
+// as found on IPv6 single stack clusters.
+func (b *PasstLibvirtSpecGenerator) generateIPFamilyArgs() ([]string, error) {
+	hasIPv4, err := b.handler.HasIPv4GlobalUnicastAddress(b.podInterfaceName)
+	if err != nil {
+		log.Log.Reason(err).Errorf("failed to check the IPv4 address of interface: %s", b.podInterfaceName)
+		return nil, err
+	}
+	if hasIPv4 {
+		return nil, nil
+	}
+	return []string{"--ipv6-only"}, nil
+}
+
+// generateAddressArgs keeps the addresses the guest was given on the migration source,
+// so that its network configuration remains valid after the passt process is re-created on the target.
+// passt accepts a single address per IP family.
+func (b *PasstLibvirtSpecGenerator) generateAddressArgs() []string {
+	if !b.isMigrationTarget() {
+		return nil
+	}
+	ifaceStatus := vmispec.LookupInterfaceStatusByName(b.vmi.Status.Interfaces, b.vmiSpecIface.Name)
+	if ifaceStatus == nil {
+		return nil
+	}
+
+	var args []string
+	var hasIPv4, hasIPv6 bool
+	for _, ip := range ifaceStatus.IPs {
+		if !hasIPv4 && netutils.IsIPv4String(ip) {
+			hasIPv4 = true
+			args = append(args, "--address", ip)
+		} else if !hasIPv6 && netutils.IsIPv6String(ip) {
+			hasIPv6 = true
+			args = append(args, "--address", ip)
+		}
+	}
+	return args
+}
+
+// isMigrationTarget returns whether the launcher pod is the target of an ongoing migration of the VMI
+func (b *PasstLibvirtSpecGenerator) isMigrationTarget() bool {
+	migrationState := b.vmi.Status.MigrationState
+	return b.podName != "" && migrationState != nil && !migrationState.Completed && migrationState.TargetPod == b.podName
+}
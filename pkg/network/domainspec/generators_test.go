@@ -153,6 +153,7 @@ var _ = Describe("Pod Network", func() {
 			})
 		})
 		Context("Passt plug", func() {
+			const podIfaceName = "eth0"
 			var specGenerator *PasstLibvirtSpecGenerator
 
 			getPorts := func(specGenerator *PasstLibvirtSpecGenerator) string {
@@ -170,7 +171,7 @@ var _ = Describe("Pod Network", func() {
 
 			It("Should forward all ports if ports are not specified in spec.interfaces", func() {
 				specGenerator = NewPasstLibvirtSpecGenerator(
-					createPasstInterface(), nil, api2.NewMinimalVMI("passtVmi"))
+					createPasstInterface(), nil, podIfaceName, api2.NewMinimalVMI("passtVmi"), mockNetwork)
 				Expect(getPorts(specGenerator)).To(Equal("-t all -u all"))
 			})
 
@@ -178,7 +179,7 @@ var _ = Describe("Pod Network", func() {
 				passtIface := createPasstInterface()
 				passtIface.Ports = []v1.Port{{Port: 1}, {Protocol: "UdP", Port: 2}, {Protocol: "UDP", Port: 3}, {Protocol: "tcp", Port: 4}}
 				specGenerator = NewPasstLibvirtSpecGenerator(
-					passtIface, nil, api2.NewMinimalVMI("passtVmi"))
+					passtIface, nil, podIfaceName, api2.NewMinimalVMI("passtVmi"), mockNetwork)
 				Expect(getPorts(specGenerator)).To(Equal("-t 1,4 -u 2,3"))
 			})
 
@@ -186,7 +187,7 @@ var _ = Describe("Pod Network", func() {
 				passtIface := createPasstInterface()
 				passtIface.Ports = []v1.Port{{Protocol: "TCP", Port: 1}, {Protocol: "TCP", Port: 4}}
 				specGenerator = NewPasstLibvirtSpecGenerator(
-					passtIface, nil, api2.NewMinimalVMI("passtVmi"))
+					passtIface, nil, podIfaceName, api2.NewMinimalVMI("passtVmi"), mockNetwork)
 				Expect(getPorts(specGenerator)).To(Equal("-t 1,4"))
 			})
 
@@ -194,7 +195,7 @@ var _ = Describe("Pod Network", func() {
 				passtIface := createPasstInterface()
 				passtIface.Ports = []v1.Port{{Protocol: "UDP", Port: 2}, {Protocol: "UDP", Port: 3}}
 				specGenerator = NewPasstLibvirtSpecGenerator(
-					passtIface, nil, api2.NewMinimalVMI("passtVmi"))
+					passtIface, nil, podIfaceName, api2.NewMinimalVMI("passtVmi"), mockNetwork)
 				Expect(getPorts(specGenerator)).To(Equal("-u 2,3"))
 			})
 
@@ -205,9 +206,64 @@ var _ = Describe("Pod Network", func() {
 					istio.ISTIO_INJECT_ANNOTATION: "true",
 				}
 				specGenerator = NewPasstLibvirtSpecGenerator(
-					passtIface, nil, istioVmi)
+					passtIface, nil, podIfaceName, istioVmi, mockNetwork)
 				Expect(getPorts(specGenerator)).To(Equal("-t ~15000,~15001,~15004,~15006,~15008,~15009,~15020,~15021,~15053,~15090 -u all"))
 			})
+
+			It("Should forward all ports when requested even if ports are specified", func() {
+				passtIface := createPasstInterface()
+				passtIface.Passt.PortForwarding = v1.PasstPortForwardingAll
+				passtIface.Ports = []v1.Port{{Protocol: "TCP", Port: 1}}
+				specGenerator = NewPasstLibvirtSpecGenerator(
+					passtIface, nil, podIfaceName, api2.NewMinimalVMI("passtVmi"), mockNetwork)
+				Expect(getPorts(specGenerator)).To(Equal("-t all -u all"))
+			})
+
+			DescribeTable("Should restrict passt to IPv6 only when the pod interface has no IPv4 address",
+				func(hasIPv4 bool, expectedArgs []string) {
+					mockNetwork.EXPECT().HasIPv4GlobalUnicastAddress(podIfaceName).Return(hasIPv4, nil)
+					specGenerator = NewPasstLibvirtSpecGenerator(
+						createPasstInterface(), nil, podIfaceName, api2.NewMinimalVMI("passtVmi"), mockNetwork)
+					Expect(specGenerator.generateIPFamilyArgs()).To(Equal(expectedArgs))
+				},
+				Entry("with an IPv4 address", true, nil),
+				Entry("without an IPv4 address", false, []string{"--ipv6-only"}),
+			)
+
+			It("Should keep the first guest address of each family of the migration source on the target", func() {
+				passtIface := createPasstInterface()
+				vmi := api2.NewMinimalVMI("passtVmi")
+				vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{
+					Name: passtIface.Name,
+					IPs:  []string{"10.244.0.5", "fd10:244::5", "10.244.0.6", "fd10:244::6"},
+				}}
+				vmi.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{TargetPod: "virt-launcher-target"}
+				specGenerator = NewPasstLibvirtSpecGenerator(passtIface, nil, podIfaceName, vmi, mockNetwork)
+				specGenerator.podName = "virt-launcher-target"
+				Expect(specGenerator.generateAddressArgs()).To(Equal(
+					[]string{"--address", "10.244.0.5", "--address", "fd10:244::5"}))
+			})
+
+			DescribeTable("Should not keep the guest addresses", func(podName string, migrationState *v1.VirtualMachineInstanceMigrationState) {
+				passtIface := createPasstInterface()
+				vmi := api2.NewMinimalVMI("passtVmi")
+				vmi.Status.Interfaces = []v1.VirtualMachineInstanceNetworkInterface{{
+					Name: passtIface.Name,
+					IPs:  []string{"10.244.0.5"},
+				}}
+				vmi.Status.MigrationState = migrationState
+				specGenerator = NewPasstLibvirtSpecGenerator(passtIface, nil, podIfaceName, vmi, mockNetwork)
+				specGenerator.podName = podName
+				Expect(specGenerator.generateAddressArgs()).To(BeEmpty())
+			},
+				Entry("without a migration", "virt-launcher-target", nil),
+				Entry("on the migration source", "virt-launcher-source",
+					&v1.VirtualMachineInstanceMigrationState{TargetPod: "virt-launcher-target"}),
+				Entry("once the migration completed", "virt-launcher-target",
+					&v1.VirtualMachineInstanceMigrationState{TargetPod: "virt-launcher-target", Completed: true}),
+				Entry("when the pod name is unknown", "",
+					&v1.VirtualMachineInstanceMigrationState{}),
+			)
 		})
 	})
 })
@@ -50,6 +50,7 @@ const (
 	allowForwarding             = "1"
 	LibvirtUserAndGroupId       = "0"
 	allowRouteLocalNet          = "1"
+	nftBridgeFamily             = "bridge"
 )
 
 type IPVersion int
@@ -87,6 +88,9 @@ type NetworkHandler interface {
 	NftablesNewChain(ipVersion IPVersion, table, chain string) error
 	NftablesNewTable(ipVersion IPVersion, name string) error
 	NftablesAppendRule(ipVersion IPVersion, table, chain string, rulespec ...string) error
+	NftablesNewBridgeTable(name string) error
+	NftablesNewBridgeChain(table, chain string) error
+	NftablesAppendBridgeRule(table, chain string, rulespec ...string) error
 	CheckNftables() error
 	GetNFTIPString(ipVersion IPVersion) string
 	CreateTapDevice(tapName string, queueNumber uint32, launcherPID int, mtu int, tapOwner string) error
@@ -249,6 +253,35 @@ func (h *NetworkUtilsHandler) NftablesAppendRule(ipVersion IPVersion, table, cha
 	return nil
 }
 
+func (h *NetworkUtilsHandler) NftablesNewBridgeTable(name string) error {
+	output, err := exec.Command("nft", "add", "table", nftBridgeFamily, name).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s, error: %s", string(output), err.Error())
+	}
+
+	return nil
+}
+
+func (h *NetworkUtilsHandler) NftablesNewBridgeChain(table, chain string) error {
+	output, err := exec.Command("nft", "add", "chain", nftBridgeFamily, table, chain).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%s, error: %s", string(output), err.Error())
+	}
+
+	return nil
+}
+
+func (h *NetworkUtilsHandler) NftablesAppendBridgeRule(table, chain string, rulespec ...string) error {
+	cmd := append([]string{"add", "rule", nftBridgeFamily, table, chain}, rulespec...)
+	// #nosec No risk for attacket injection. CMD variables are predefined strings
+	output, err := exec.Command("nft", cmd...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to apped new nfrule error %s", string(output))
+	}
+
+	return nil
+}
+
 func (h *NetworkUtilsHandler) GetNFTIPString(ipVersion IPVersion) string {
 	if ipVersion == IPv6 {
 		return "ip6"
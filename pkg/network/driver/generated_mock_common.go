@@ -318,6 +318,41 @@ func (_mr *_MockNetworkHandlerRecorder) NftablesAppendRule(arg0, arg1, arg2 inte
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesAppendRule", _s...)
 }
 
+func (_m *MockNetworkHandler) NftablesNewBridgeTable(name string) error {
+	ret := _m.ctrl.Call(_m, "NftablesNewBridgeTable", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) NftablesNewBridgeTable(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesNewBridgeTable", arg0)
+}
+
+func (_m *MockNetworkHandler) NftablesNewBridgeChain(table string, chain string) error {
+	ret := _m.ctrl.Call(_m, "NftablesNewBridgeChain", table, chain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) NftablesNewBridgeChain(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesNewBridgeChain", arg0, arg1)
+}
+
+func (_m *MockNetworkHandler) NftablesAppendBridgeRule(table string, chain string, rulespec ...string) error {
+	_s := []interface{}{table, chain}
+	for _, _x := range rulespec {
+		_s = append(_s, _x)
+	}
+	ret := _m.ctrl.Call(_m, "NftablesAppendBridgeRule", _s...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockNetworkHandlerRecorder) NftablesAppendBridgeRule(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	_s := append([]interface{}{arg0, arg1}, arg2...)
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NftablesAppendBridgeRule", _s...)
+}
+
 func (_m *MockNetworkHandler) CheckNftables() error {
 	ret := _m.ctrl.Call(_m, "CheckNftables")
 	ret0, _ := ret[0].(error)
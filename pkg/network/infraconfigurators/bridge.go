@@ -143,6 +143,13 @@ func (b *BridgePodNetworkConfigurator) PreparePodNetworkInterface() error {
 		return err
 	}
 
+	if isSpoofCheckEnabled(b.vmiSpecIface) {
+		if err := createSpoofCheckRules(b.handler, b.tapDeviceName, b.spoofCheckAddresses()); err != nil {
+			log.Log.Reason(err).Errorf("failed to create spoof check rules for tap device %s", b.tapDeviceName)
+			return err
+		}
+	}
+
 	if err := b.handler.LinkSetUp(b.podNicLink); err != nil {
 		log.Log.Reason(err).Errorf("failed to bring link up for interface: %s", b.podNicLink.Attrs().Name)
 		return err
@@ -162,6 +169,17 @@ func (b *BridgePodNetworkConfigurator) GenerateNonRecoverableDomainIfaceSpec() *
 	}
 }
 
+func (b *BridgePodNetworkConfigurator) spoofCheckAddresses() spoofCheckAddresses {
+	var addresses spoofCheckAddresses
+	if b.vmMac != nil {
+		addresses.mac = *b.vmMac
+	}
+	if b.ipamEnabled {
+		addresses.ipv4 = b.podIfaceIP.IP
+	}
+	return addresses
+}
+
 func (b *BridgePodNetworkConfigurator) learnInterfaceRoutes() error {
 	routes, err := b.handler.RouteList(b.podNicLink, netlink.FAMILY_V4)
 	if err != nil {
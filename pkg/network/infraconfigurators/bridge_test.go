@@ -27,6 +27,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"k8s.io/utils/pointer"
+
 	"kubevirt.io/client-go/api"
 
 	"github.com/vishvananda/netlink"
@@ -252,6 +254,32 @@ var _ = Describe("Bridge infrastructure configurator", func() {
 				Expect(bridgeConfigurator.PreparePodNetworkInterface()).To(Succeed())
 			})
 
+			It("network preparation with spoof check succeeds", func() {
+				iface.SpoofCheck = pointer.Bool(true)
+				vmMac, _ := net.ParseMAC("02:00:00:00:00:01")
+				bridgeConfigurator := newMockedBridgeConfiguratorForPreparePhase(
+					vmi,
+					iface,
+					handler,
+					bridgeIfaceName,
+					launcherPID,
+					podLink,
+					podIP,
+					withOriginalPodLinkDown(podLink),
+					withPodPrimaryLinkSwapped(podLink, podLinkAfterNameChange, dummySwap, podIP),
+					withARPIgnore(),
+					withCreatedInPodBridge(inPodBridge, bridgeIPAddr),
+					withSwitchedPodLinkMac(podLinkAfterNameChange, inPodBridge),
+					withLinkAsBridgePort(inPodBridge, podLinkAfterNameChange),
+					withCreatedTapDevice(tapDeviceName, bridgeIfaceName, launcherPID, mtu, queueCount),
+					withDisabledTxOffloadChecksum(bridgeIfaceName),
+					withSpoofCheckRules(tapDeviceName, spoofCheckAddresses{mac: vmMac, ipv4: podIP.IP}),
+					withLinkLearningOff(podLinkAfterNameChange),
+					withLinkUp(podLinkAfterNameChange))
+				bridgeConfigurator.vmMac = &vmMac
+				Expect(bridgeConfigurator.PreparePodNetworkInterface()).To(Succeed())
+			})
+
 			It("network preparation fails when setting the link down errors", func() {
 				const errorString = "failed to set link down"
 				bridgeConfigurator := newMockedBridgeConfiguratorForPreparePhase(
@@ -749,6 +777,20 @@ func withSwitchedPodLinkMac(link netlink.Link, bridge *netlink.Bridge) Option {
 	}
 }
 
+func withSpoofCheckRules(tapName string, addresses spoofCheckAddresses) Option {
+	return func(handler *netdriver.MockNetworkHandler) {
+		handler.EXPECT().NftablesNewBridgeTable(spoofCheckTable)
+		handler.EXPECT().NftablesNewBridgeChain(spoofCheckTable, spoofCheckChainSpec)
+		for _, rule := range spoofCheckRules(tapName, addresses) {
+			args := []interface{}{}
+			for _, arg := range rule {
+				args = append(args, arg)
+			}
+			handler.EXPECT().NftablesAppendBridgeRule(spoofCheckTable, spoofCheckChain, args...)
+		}
+	}
+}
+
 func withLinkUp(link netlink.Link) Option {
 	return func(handler *netdriver.MockNetworkHandler) {
 		handler.EXPECT().LinkSetUp(link)
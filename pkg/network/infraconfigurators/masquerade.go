@@ -129,6 +129,17 @@ func (b *MasqueradePodNetworkConfigurator) PreparePodNetworkInterface() error {
 		return err
 	}
 
+	if isSpoofCheckEnabled(b.vmiSpecIface) {
+		addresses, err := b.spoofCheckAddresses()
+		if err != nil {
+			return err
+		}
+		if err := createSpoofCheckRules(b.handler, tapDeviceName, addresses); err != nil {
+			log.Log.Reason(err).Errorf("failed to create spoof check rules for tap device %s", tapDeviceName)
+			return err
+		}
+	}
+
 	ipv4Enabled, err := b.handler.HasIPv4GlobalUnicastAddress(b.podNicLink.Attrs().Name)
 	if err != nil {
 		log.Log.Reason(err).Errorf(ipVerifyFailFmt, "4", b.podNicLink.Attrs().Name)
@@ -167,6 +178,26 @@ func (b *MasqueradePodNetworkConfigurator) GenerateNonRecoverableDomainIfaceSpec
 	return nil
 }
 
+// spoofCheckAddresses returns the addresses the guest is expected to use. The MAC address is only enforced when it
+// is set on the VMI interface, otherwise it is chosen by libvirt and is unknown at this stage.
+func (b *MasqueradePodNetworkConfigurator) spoofCheckAddresses() (spoofCheckAddresses, error) {
+	var addresses spoofCheckAddresses
+	mac, err := virtnetlink.RetrieveMacAddressFromVMISpecIface(b.vmiSpecIface)
+	if err != nil {
+		return addresses, err
+	}
+	if mac != nil {
+		addresses.mac = *mac
+	}
+	if b.vmGatewayAddr != nil {
+		addresses.ipv4 = b.vmIPv4Addr.IP
+	}
+	if b.vmGatewayIpv6Addr != nil {
+		addresses.ipv6 = b.vmIPv6Addr.IP
+	}
+	return addresses, nil
+}
+
 func (b *MasqueradePodNetworkConfigurator) createBridge() error {
 	mac, err := net.ParseMAC(link.StaticMasqueradeBridgeMAC)
 	if err != nil {
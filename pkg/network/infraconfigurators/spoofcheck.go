@@ -0,0 +1,102 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package infraconfigurators
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+)
+
+const (
+	spoofCheckTable = "kubevirt_spoofcheck"
+	spoofCheckChain = "prerouting"
+	// Filter frames as early as possible, before they are forwarded by the in-pod bridge.
+	spoofCheckChainSpec = "prerouting { type filter hook prerouting priority -300; }"
+
+	unspecifiedIPv4Addr = "0.0.0.0"
+	unspecifiedIPv6Addr = "::"
+	linkLocalIPv6Net    = "fe80::/10"
+)
+
+// spoofCheckAddresses holds the addresses the guest is allowed to use as source on a tap device.
+// A nil address means the corresponding check is skipped.
+type spoofCheckAddresses struct {
+	mac  net.HardwareAddr
+	ipv4 net.IP
+	ipv6 net.IP
+}
+
+func isSpoofCheckEnabled(iface *v1.Interface) bool {
+	return iface.SpoofCheck != nil && *iface.SpoofCheck
+}
+
+// createSpoofCheckRules installs bridge family nftables rules dropping the frames coming from the tap device
+// whose source MAC, ARP sender or source IP addresses differ from the ones assigned to the guest interface.
+// Unspecified and link local addresses remain allowed so that DHCP, duplicate address detection and neighbour
+// discovery keep working.
+func createSpoofCheckRules(handler netdriver.NetworkHandler, tapName string, addresses spoofCheckAddresses) error {
+	if err := handler.NftablesNewBridgeTable(spoofCheckTable); err != nil {
+		return err
+	}
+	if err := handler.NftablesNewBridgeChain(spoofCheckTable, spoofCheckChainSpec); err != nil {
+		return err
+	}
+
+	for _, rule := range spoofCheckRules(tapName, addresses) {
+		if err := handler.NftablesAppendBridgeRule(spoofCheckTable, spoofCheckChain, rule...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func spoofCheckRules(tapName string, addresses spoofCheckAddresses) [][]string {
+	var rules [][]string
+	if addresses.mac != nil {
+		mac := addresses.mac.String()
+		rules = append(rules,
+			[]string{"iifname", tapName, "ether", "saddr", "!=", mac, "counter", "drop"},
+			[]string{"iifname", tapName, "ether", "type", "arp", "arp", "saddr", "ether", "!=", mac, "counter", "drop"},
+		)
+	}
+	if addresses.ipv4 != nil {
+		allowed := nftSet(unspecifiedIPv4Addr, addresses.ipv4.String())
+		rules = append(rules,
+			[]string{"iifname", tapName, "ether", "type", "arp", "arp", "saddr", "ip", "!=", allowed, "counter", "drop"},
+			[]string{"iifname", tapName, "ether", "type", "ip", "ip", "saddr", "!=", allowed, "counter", "drop"},
+		)
+	}
+	if addresses.ipv6 != nil {
+		allowed := nftSet(unspecifiedIPv6Addr, linkLocalIPv6Net, addresses.ipv6.String())
+		rules = append(rules,
+			[]string{"iifname", tapName, "ether", "type", "ip6", "ip6", "saddr", "!=", allowed, "counter", "drop"},
+		)
+	}
+	return rules
+}
+
+func nftSet(elements ...string) string {
+	return fmt.Sprintf(strFmt, strings.Join(elements, ", "))
+}
@@ -0,0 +1,93 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package infraconfigurators
+
+import (
+	"fmt"
+	"net"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	netdriver "kubevirt.io/kubevirt/pkg/network/driver"
+)
+
+var _ = Describe("Spoof check", func() {
+	const tapName = "tap0"
+
+	var (
+		mac  net.HardwareAddr
+		ipv4 net.IP
+		ipv6 net.IP
+	)
+
+	BeforeEach(func() {
+		var err error
+		mac, err = net.ParseMAC("02:00:00:00:00:01")
+		Expect(err).ToNot(HaveOccurred())
+		ipv4 = net.ParseIP("10.0.2.2")
+		ipv6 = net.ParseIP("fd10:0:2::2")
+	})
+
+	It("should only filter the MAC address when the IP addresses are unknown", func() {
+		Expect(spoofCheckRules(tapName, spoofCheckAddresses{mac: mac})).To(Equal([][]string{
+			{"iifname", tapName, "ether", "saddr", "!=", "02:00:00:00:00:01", "counter", "drop"},
+			{"iifname", tapName, "ether", "type", "arp", "arp", "saddr", "ether", "!=", "02:00:00:00:00:01", "counter", "drop"},
+		}))
+	})
+
+	It("should only filter the IP addresses when the MAC address is unknown", func() {
+		Expect(spoofCheckRules(tapName, spoofCheckAddresses{ipv4: ipv4, ipv6: ipv6})).To(Equal([][]string{
+			{"iifname", tapName, "ether", "type", "arp", "arp", "saddr", "ip", "!=", "{ 0.0.0.0, 10.0.2.2 }", "counter", "drop"},
+			{"iifname", tapName, "ether", "type", "ip", "ip", "saddr", "!=", "{ 0.0.0.0, 10.0.2.2 }", "counter", "drop"},
+			{"iifname", tapName, "ether", "type", "ip6", "ip6", "saddr", "!=", "{ ::, fe80::/10, fd10:0:2::2 }", "counter", "drop"},
+		}))
+	})
+
+	Context("rules creation", func() {
+		var (
+			ctrl    *gomock.Controller
+			handler *netdriver.MockNetworkHandler
+		)
+
+		BeforeEach(func() {
+			ctrl = gomock.NewController(GinkgoT())
+			handler = netdriver.NewMockNetworkHandler(ctrl)
+		})
+
+		It("should create the table, the chain and a rule per filtered address", func() {
+			handler.EXPECT().NftablesNewBridgeTable(spoofCheckTable).Return(nil)
+			handler.EXPECT().NftablesNewBridgeChain(spoofCheckTable, spoofCheckChainSpec).Return(nil)
+			handler.EXPECT().NftablesAppendBridgeRule(spoofCheckTable, spoofCheckChain, gomock.Any()).Return(nil).Times(4)
+
+			Expect(createSpoofCheckRules(handler, tapName, spoofCheckAddresses{mac: mac, ipv4: ipv4})).To(Succeed())
+		})
+
+		It("should fail when a rule cannot be appended", func() {
+			const errorString = "failed to append rule"
+			handler.EXPECT().NftablesNewBridgeTable(spoofCheckTable).Return(nil)
+			handler.EXPECT().NftablesNewBridgeChain(spoofCheckTable, spoofCheckChainSpec).Return(nil)
+			handler.EXPECT().NftablesAppendBridgeRule(spoofCheckTable, spoofCheckChain, gomock.Any()).Return(fmt.Errorf(errorString))
+
+			Expect(createSpoofCheckRules(handler, tapName, spoofCheckAddresses{mac: mac})).To(MatchError(errorString))
+		})
+	})
+})
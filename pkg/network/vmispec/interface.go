@@ -20,6 +20,8 @@
 package vmispec
 
 import (
+	"k8s.io/apimachinery/pkg/api/equality"
+
 	v1 "kubevirt.io/api/core/v1"
 )
 
@@ -70,6 +72,15 @@ func IsPodNetworkWithBridgeBindingInterface(networks []v1.Network, ifaces []v1.I
 	return true
 }
 
+func IsPodNetworkWithPasstBindingInterface(networks []v1.Network, ifaces []v1.Interface) bool {
+	if podNetwork := LookupPodNetwork(networks); podNetwork != nil {
+		if podInterface := LookupInterfaceByNetwork(ifaces, podNetwork); podInterface != nil {
+			return podInterface.Passt != nil
+		}
+	}
+	return false
+}
+
 func PopInterfaceByNetwork(statusIfaces []v1.VirtualMachineInstanceNetworkInterface, network *v1.Network) (*v1.VirtualMachineInstanceNetworkInterface, []v1.VirtualMachineInstanceNetworkInterface) {
 	if network == nil {
 		return nil, statusIfaces
@@ -156,3 +167,28 @@ func FilterStatusInterfacesByNames(interfaces []v1.VirtualMachineInstanceNetwork
 
 	return filtered
 }
+
+// ApplyLiveUpdatableInterfaceFields returns a copy of the interfaces where the fields which can be changed on a
+// running VMI (the link state and the bandwidth limits) are taken from the matching desired interfaces.
+// Matching by the interface 'Name' attribute. The second return value reports whether any interface was changed.
+func ApplyLiveUpdatableInterfaceFields(interfaces, desiredInterfaces []v1.Interface) ([]v1.Interface, bool) {
+	desiredIfacesByName := IndexInterfaceSpecByName(desiredInterfaces)
+
+	changed := false
+	updatedIfaces := make([]v1.Interface, 0, len(interfaces))
+	for _, iface := range interfaces {
+		updatedIface := *iface.DeepCopy()
+		if desiredIface, exists := desiredIfacesByName[iface.Name]; exists {
+			if updatedIface.State != desiredIface.State {
+				updatedIface.State = desiredIface.State
+				changed = true
+			}
+			if !equality.Semantic.DeepEqual(updatedIface.Bandwidth, desiredIface.Bandwidth) {
+				updatedIface.Bandwidth = desiredIface.Bandwidth.DeepCopy()
+				changed = true
+			}
+		}
+		updatedIfaces = append(updatedIfaces, updatedIface)
+	}
+	return updatedIfaces, changed
+}
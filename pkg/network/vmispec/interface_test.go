@@ -23,6 +23,8 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "kubevirt.io/api/core/v1"
 
 	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
@@ -49,6 +51,20 @@ var _ = Describe("VMI network spec", func() {
 			ifaces := []v1.Interface{interfaceWithBridgeBinding(podNet0)}
 			Expect(netvmispec.IsPodNetworkWithMasqueradeBindingInterface(networks, ifaces)).To(BeFalse())
 		})
+
+		It("is used by a passt interface", func() {
+			ifaces := []v1.Interface{{
+				Name:                   podNet0,
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Passt: &v1.InterfacePasst{}},
+			}}
+			Expect(netvmispec.IsPodNetworkWithPasstBindingInterface(networks, ifaces)).To(BeTrue())
+		})
+
+		It("is not used by a passt interface", func() {
+			ifaces := []v1.Interface{interfaceWithMasqueradeBinding(podNet0)}
+			Expect(netvmispec.IsPodNetworkWithPasstBindingInterface(networks, ifaces)).To(BeFalse())
+			Expect(netvmispec.IsPodNetworkWithPasstBindingInterface([]v1.Network{}, ifaces)).To(BeFalse())
+		})
 	})
 
 	Context("SR-IOV", func() {
@@ -153,6 +169,33 @@ var _ = Describe("VMI network spec", func() {
 		expectedInterfaces := vmiStatusInterfaces(names...)
 		Expect(netvmispec.FilterStatusInterfacesByNames(statusInterfaces, names)).To(Equal(expectedInterfaces))
 	})
+
+	Context("live updatable interface fields", func() {
+		bandwidth := &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse("1Mi")}}
+
+		It("should report no change when the interfaces match", func() {
+			ifaces := []v1.Interface{interfaceWithBridgeBinding(iface1), interfaceWithBridgeBinding(iface2)}
+			updatedIfaces, changed := netvmispec.ApplyLiveUpdatableInterfaceFields(ifaces, ifaces)
+			Expect(changed).To(BeFalse())
+			Expect(updatedIfaces).To(Equal(ifaces))
+		})
+
+		It("should apply the link state and bandwidth of the desired interfaces", func() {
+			ifaces := []v1.Interface{interfaceWithBridgeBinding(iface1), interfaceWithBridgeBinding(iface2)}
+			desiredIface := interfaceWithBridgeBinding(iface2)
+			desiredIface.State = v1.InterfaceStateLinkDown
+			desiredIface.Bandwidth = bandwidth
+			desiredIface.MacAddress = "02:00:00:00:00:01"
+
+			updatedIfaces, changed := netvmispec.ApplyLiveUpdatableInterfaceFields(ifaces, []v1.Interface{desiredIface})
+			Expect(changed).To(BeTrue())
+
+			expectedIface := interfaceWithBridgeBinding(iface2)
+			expectedIface.State = v1.InterfaceStateLinkDown
+			expectedIface.Bandwidth = bandwidth
+			Expect(updatedIfaces).To(Equal([]v1.Interface{interfaceWithBridgeBinding(iface1), expectedIface}))
+		})
+	})
 })
 
 func podNetwork(name string) v1.Network {
@@ -21,6 +21,7 @@ package filter
 
 import (
 	"net"
+	"net/url"
 
 	restful "github.com/emicklei/go-restful"
 
@@ -41,9 +42,23 @@ func RequestLoggingFilter() restful.FilterFunction {
 			With("remoteAddress", remoteAddr).
 			With("username", username).
 			With("method", req.Request.Method).
-			With("url", req.Request.URL.RequestURI()).
+			With("url", loggedRequestURI(req.Request.URL)).
 			With("proto", req.Request.Proto).
 			With("statusCode", resp.StatusCode()).
 			Log("contentLength", resp.ContentLength())
 	}
 }
+
+// tokenQueryParameter authenticates the requests of web clients without cluster credentials, it must not be logged
+const tokenQueryParameter = "token"
+
+func loggedRequestURI(u *url.URL) string {
+	query := u.Query()
+	if !query.Has(tokenQueryParameter) {
+		return u.RequestURI()
+	}
+	query.Set(tokenQueryParameter, "redacted")
+	redacted := *u
+	redacted.RawQuery = query.Encode()
+	return redacted.RequestURI()
+}
@@ -23,26 +23,40 @@ import (
 	"context"
 	"fmt"
 
-	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
-
-	"k8s.io/apimachinery/pkg/api/errors"
-
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
 	corev1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
 	PVCPrefix = "persistent-state-for-"
 	PVCSize   = "10Mi"
+
+	// PVCVMILabel holds the name of the VMI whose state is kept on a backend storage PVC
+	PVCVMILabel = "kubevirt.io/persistent-state-for"
+	// PVCMigrationAnnotation holds the UID of the migration a backend storage PVC was provisioned for,
+	// until the migration succeeds
+	PVCMigrationAnnotation = "kubevirt.io/persistent-state-migration"
 )
 
 func PVCForVMI(vmi *corev1.VirtualMachineInstance) string {
 	return PVCPrefix + vmi.Name
 }
 
+// MigrationTargetPVCName returns the name of the backend storage PVC provisioned for the target of the migration.
+func MigrationTargetPVCName(vmi *corev1.VirtualMachineInstance, migrationUID types.UID) string {
+	return fmt.Sprintf("%s-%s", PVCForVMI(vmi), migrationUID)
+}
+
 func HasPersistentTPMDevice(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
 	if vmiSpec.Domain.Devices.TPM != nil &&
 		vmiSpec.Domain.Devices.TPM.Persistent != nil &&
@@ -53,34 +67,73 @@ func HasPersistentTPMDevice(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
 	return false
 }
 
+func HasPersistentEFI(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
+	if vmiSpec.Domain.Firmware != nil &&
+		vmiSpec.Domain.Firmware.Bootloader != nil &&
+		vmiSpec.Domain.Firmware.Bootloader.EFI != nil &&
+		vmiSpec.Domain.Firmware.Bootloader.EFI.Persistent != nil &&
+		*vmiSpec.Domain.Firmware.Bootloader.EFI.Persistent {
+		return true
+	}
+
+	return false
+}
+
+func IsBackendStorageNeeded(vmiSpec *corev1.VirtualMachineInstanceSpec) bool {
+	return HasPersistentTPMDevice(vmiSpec) || HasPersistentEFI(vmiSpec)
+}
+
 func isBackendStorageNeededForVMI(vmi *corev1.VirtualMachineInstance) bool {
-	return HasPersistentTPMDevice(&vmi.Spec)
+	return IsBackendStorageNeeded(&vmi.Spec)
 }
 
 func IsBackendStorageNeededForVM(vm *corev1.VirtualMachine) bool {
 	if vm.Spec.Template == nil {
 		return false
 	}
-	return HasPersistentTPMDevice(&vm.Spec.Template.Spec)
+	return IsBackendStorageNeeded(&vm.Spec.Template.Spec)
 }
 
-func CreateIfNeeded(vmi *corev1.VirtualMachineInstance, clusterConfig *virtconfig.ClusterConfig, client kubecli.KubevirtClient) error {
-	if !isBackendStorageNeededForVMI(vmi) {
-		return nil
+// IsMigrationTargetPVCNeeded returns true when the backend storage of the VMI cannot be shared
+// between the source and the target of a migration.
+func IsMigrationTargetPVCNeeded(vmi *corev1.VirtualMachineInstance, clusterConfig *virtconfig.ClusterConfig) bool {
+	return isBackendStorageNeededForVMI(vmi) && clusterConfig.GetVMStateStorageAccessMode() == v1.ReadWriteOnce
+}
+
+func isMigrationTargetPVC(pvc *v1.PersistentVolumeClaim) bool {
+	_, exists := pvc.Annotations[PVCMigrationAnnotation]
+	return exists
+}
+
+// CurrentPVCName returns the name of the backend storage PVC holding the state of the VMI,
+// ignoring the PVCs provisioned for migrations which did not succeed yet.
+func CurrentPVCName(vmi *corev1.VirtualMachineInstance, pvcStore cache.Store) string {
+	if pvcStore == nil {
+		return PVCForVMI(vmi)
 	}
 
-	_, err := client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), PVCForVMI(vmi), metav1.GetOptions{})
-	if err == nil {
-		return nil
+	var current *v1.PersistentVolumeClaim
+	for _, obj := range pvcStore.List() {
+		pvc := obj.(*v1.PersistentVolumeClaim)
+		if pvc.Namespace != vmi.Namespace || pvc.Labels[PVCVMILabel] != vmi.Name ||
+			pvc.DeletionTimestamp != nil || isMigrationTargetPVC(pvc) {
+			continue
+		}
+		if current == nil || current.CreationTimestamp.Before(&pvc.CreationTimestamp) {
+			current = pvc
+		}
 	}
-	if !errors.IsNotFound(err) {
-		return err
+	if current == nil {
+		return PVCForVMI(vmi)
 	}
+	return current.Name
+}
 
+func newPVC(vmi *corev1.VirtualMachineInstance, name string, clusterConfig *virtconfig.ClusterConfig) (*v1.PersistentVolumeClaim, error) {
 	modeFile := v1.PersistentVolumeFilesystem
 	storageClass := clusterConfig.GetVMStateStorageClass()
 	if storageClass == "" {
-		return fmt.Errorf("backend VM storage requires a backend storage class defined in the custom resource")
+		return nil, fmt.Errorf("backend VM storage requires a backend storage class defined in the custom resource")
 	}
 	ownerReferences := vmi.OwnerReferences
 	if len(vmi.OwnerReferences) == 0 {
@@ -92,19 +145,51 @@ func CreateIfNeeded(vmi *corev1.VirtualMachineInstance, clusterConfig *virtconfi
 			*metav1.NewControllerRef(vmi, corev1.VirtualMachineInstanceGroupVersionKind),
 		}
 	}
-	pvc := &v1.PersistentVolumeClaim{
+	return &v1.PersistentVolumeClaim{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:            PVCForVMI(vmi),
+			Name:            name,
+			Labels:          map[string]string{PVCVMILabel: vmi.Name},
 			OwnerReferences: ownerReferences,
 		},
 		Spec: v1.PersistentVolumeClaimSpec{
-			AccessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			AccessModes: []v1.PersistentVolumeAccessMode{clusterConfig.GetVMStateStorageAccessMode()},
 			Resources: v1.ResourceRequirements{
 				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(PVCSize)},
 			},
 			StorageClassName: &storageClass,
 			VolumeMode:       &modeFile,
 		},
+	}, nil
+}
+
+func CreateIfNeeded(vmi *corev1.VirtualMachineInstance, clusterConfig *virtconfig.ClusterConfig, client kubecli.KubevirtClient) error {
+	if !isBackendStorageNeededForVMI(vmi) {
+		return nil
+	}
+
+	pvcs, err := client.CoreV1().PersistentVolumeClaims(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", PVCVMILabel, vmi.Name),
+	})
+	if err != nil {
+		return err
+	}
+	for i := range pvcs.Items {
+		if !isMigrationTargetPVC(&pvcs.Items[i]) {
+			return nil
+		}
+	}
+
+	_, err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Get(context.Background(), PVCForVMI(vmi), metav1.GetOptions{})
+	if err == nil {
+		return nil
+	}
+	if !errors.IsNotFound(err) {
+		return err
+	}
+
+	pvc, err := newPVC(vmi, PVCForVMI(vmi), clusterConfig)
+	if err != nil {
+		return err
 	}
 
 	_, err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
@@ -114,3 +199,81 @@ func CreateIfNeeded(vmi *corev1.VirtualMachineInstance, clusterConfig *virtconfi
 
 	return err
 }
+
+// CreateForMigrationTarget provisions the backend storage PVC the migration target keeps the VMI state on,
+// and returns its name. The state itself is transferred by the migration.
+func CreateForMigrationTarget(vmi *corev1.VirtualMachineInstance, migrationUID types.UID, clusterConfig *virtconfig.ClusterConfig, client kubecli.KubevirtClient) (string, error) {
+	pvc, err := newPVC(vmi, MigrationTargetPVCName(vmi, migrationUID), clusterConfig)
+	if err != nil {
+		return "", err
+	}
+	pvc.Annotations = map[string]string{PVCMigrationAnnotation: string(migrationUID)}
+
+	_, err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Create(context.Background(), pvc, metav1.CreateOptions{})
+	if err != nil && !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+	return pvc.Name, nil
+}
+
+// MigrationSucceeded makes the backend storage PVC of the migration target the current one,
+// and deletes the PVCs the VMI state was previously kept on.
+func MigrationSucceeded(vmi *corev1.VirtualMachineInstance, migrationUID types.UID, pvcStore cache.Store, client kubecli.KubevirtClient) error {
+	targetName := MigrationTargetPVCName(vmi, migrationUID)
+	obj, exists, err := pvcStore.GetByKey(fmt.Sprintf("%s/%s", vmi.Namespace, targetName))
+	if err != nil || !exists {
+		return err
+	}
+
+	targetPVC := obj.(*v1.PersistentVolumeClaim)
+	if targetPVC.DeletionTimestamp != nil {
+		return nil
+	}
+
+	if !isMigrationTargetPVC(targetPVC) {
+		// The PVC may have been superseded by a later migration already
+		if CurrentPVCName(vmi, pvcStore) != targetName {
+			return nil
+		}
+	} else {
+		patchBytes := []byte(fmt.Sprintf(`[{"op": "remove", "path": "/metadata/annotations/%s"}]`, patch.EscapeJSONPointer(PVCMigrationAnnotation)))
+		_, err := client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Patch(context.Background(), targetName, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, obj := range pvcStore.List() {
+		pvc := obj.(*v1.PersistentVolumeClaim)
+		if pvc.Namespace != vmi.Namespace || pvc.Name == targetName || pvc.DeletionTimestamp != nil || isMigrationTargetPVC(pvc) {
+			continue
+		}
+		if pvc.Labels[PVCVMILabel] != vmi.Name && pvc.Name != PVCForVMI(vmi) {
+			continue
+		}
+		err := client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Delete(context.Background(), pvc.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// MigrationFailed deletes the backend storage PVC provisioned for the target of the migration.
+func MigrationFailed(vmi *corev1.VirtualMachineInstance, migrationUID types.UID, pvcStore cache.Store, client kubecli.KubevirtClient) error {
+	targetName := MigrationTargetPVCName(vmi, migrationUID)
+	obj, exists, err := pvcStore.GetByKey(fmt.Sprintf("%s/%s", vmi.Namespace, targetName))
+	if err != nil || !exists {
+		return err
+	}
+	pvc := obj.(*v1.PersistentVolumeClaim)
+	if pvc.DeletionTimestamp != nil || !isMigrationTargetPVC(pvc) {
+		return nil
+	}
+
+	err = client.CoreV1().PersistentVolumeClaims(vmi.Namespace).Delete(context.Background(), targetName, metav1.DeleteOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
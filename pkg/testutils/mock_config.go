@@ -137,3 +137,21 @@ func AddPrometheusRuleAPI(crdInformer cache.SharedIndexInformer) {
 func RemovePrometheusRuleAPI(crdInformer cache.SharedIndexInformer) {
 	crdInformer.GetStore().Replace(nil, "")
 }
+
+func AddDNSEndpointAPI(crdInformer cache.SharedIndexInformer) {
+	crdInformer.GetStore().Add(&extv1.CustomResourceDefinition{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dnsendpoints.externaldns.k8s.io",
+		},
+		Spec: extv1.CustomResourceDefinitionSpec{
+			Group: "externaldns.k8s.io",
+			Names: extv1.CustomResourceDefinitionNames{
+				Kind: "DNSEndpoint",
+			},
+		},
+	})
+}
+
+func RemoveDNSEndpointAPI(crdInformer cache.SharedIndexInformer) {
+	crdInformer.GetStore().Replace(nil, "")
+}
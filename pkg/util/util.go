@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -100,6 +101,21 @@ func IsPasstVMI(vmi *v1.VirtualMachineInstance) bool {
 	return false
 }
 
+// Check if the passt interface forwards all the ports to the guest
+func IsPasstForwardingAllPorts(iface *v1.Interface) bool {
+	if iface.Passt == nil {
+		return false
+	}
+	switch iface.Passt.PortForwarding {
+	case v1.PasstPortForwardingAll:
+		return true
+	case v1.PasstPortForwardingList:
+		return false
+	default:
+		return len(iface.Ports) == 0
+	}
+}
+
 // Check if a VMI spec requests AMD SEV
 func IsSEVVMI(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.LaunchSecurity != nil && vmi.Spec.Domain.LaunchSecurity.SEV != nil
@@ -143,6 +159,36 @@ func IsAutoAttachVSOCK(vmi *v1.VirtualMachineInstance) bool {
 	return vmi.Spec.Domain.Devices.AutoattachVSOCK != nil && *vmi.Spec.Domain.Devices.AutoattachVSOCK
 }
 
+// GuestConsoleLogContainerName is the container of the virt-launcher pod streaming the serial console log
+const GuestConsoleLogContainerName = "guest-console-log"
+
+// IsAutoAttachSerialConsole returns true if the default serial console is attached to the VMI
+func IsAutoAttachSerialConsole(vmi *v1.VirtualMachineInstance) bool {
+	return vmi.Spec.Domain.Devices.AutoattachSerialConsole == nil || *vmi.Spec.Domain.Devices.AutoattachSerialConsole
+}
+
+// IsSerialConsoleLogEnabled returns true if the output of the default serial console is logged
+func IsSerialConsoleLogEnabled(vmi *v1.VirtualMachineInstance) bool {
+	return IsAutoAttachSerialConsole(vmi) &&
+		(vmi.Spec.Domain.Devices.LogSerialConsole == nil || *vmi.Spec.Domain.Devices.LogSerialConsole)
+}
+
+// SerialConsoleLogPath returns the path of the file the serial console output is logged to
+func SerialConsoleLogPath(vmi *v1.VirtualMachineInstance) string {
+	return filepath.Join(VirtPrivateDir, string(vmi.UID), "virt-serial0-log")
+}
+
+// SerialPortSocketName returns the name of the unix socket backing the named additional serial port of the VMI.
+// The socket of the serial console is virt-serial0, the additional ports follow in the order of the spec.
+func SerialPortSocketName(vmi *v1.VirtualMachineInstance, name string) (string, bool) {
+	for i, port := range vmi.Spec.Domain.Devices.SerialPorts {
+		if port.Name == name {
+			return fmt.Sprintf("virt-serial%d", i+1), true
+		}
+	}
+	return "", false
+}
+
 // UseSoftwareEmulationForDevice determines whether to fallback to software emulation for the given device.
 // This happens when the given device doesn't exist, and software emulation is enabled.
 func UseSoftwareEmulationForDevice(devicePath string, allowEmulation bool) (bool, error) {
@@ -211,6 +211,8 @@ func (app *virtAPIApp) composeSubresources() {
 
 	var subwss []*restful.WebService
 
+	subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig, app.certmanager)
+
 	for _, version := range v1.SubresourceGroupVersions {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
@@ -220,8 +222,6 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Doc(fmt.Sprintf("KubeVirt \"%s\" Subresource API.", version.Version))
 		subws.Path(definitions.GroupVersionBasePath(version))
 
-		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
-
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
 			Reads(v1.RestartOptions{}).
@@ -323,13 +323,15 @@ func (app *virtAPIApp) composeSubresources() {
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("console")).
 			To(subresourceApp.ConsoleRequestHandler).
-			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.SerialPortParam(subws)).
+			Param(definitions.ReadOnlyParam(subws)).Param(definitions.ExclusiveParam(subws)).
 			Operation(version.Version + "Console").
 			Doc("Open a websocket connection to a serial console on the specified VirtualMachineInstance."))
 
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc")).
 			To(subresourceApp.VNCRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.ReadOnlyParam(subws)).Param(definitions.ExclusiveParam(subws)).
 			Operation(version.Version + "VNC").
 			Doc("Open a websocket connection to connect to VNC on the specified VirtualMachineInstance."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc/screenshot")).
@@ -337,6 +339,17 @@ func (app *virtAPIApp) composeSubresources() {
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.MoveCursorParam(subws)).
 			Operation(version.Version + "VNCScreenshot").
 			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("vnc/token")).
+			To(subresourceApp.VNCTokenRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.ReadOnlyParam(subws)).Param(definitions.ExpirationSecondsParam(subws)).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"VNCToken").
+			Doc("Get a short-lived token to connect to VNC on the specified VirtualMachineInstance through the VNC websocket endpoint of virt-api, without other credentials.").
+			Writes(v1.VNCToken{}).
+			Returns(http.StatusOK, "OK", v1.VNCToken{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("usbredir")).
 			To(subresourceApp.USBRedirRequestHandler).
 			Param(definitions.NamespaceParam(subws)).
@@ -447,6 +460,75 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("domainstats")).
+			To(subresourceApp.DomainStats).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"Domainstats").
+			Doc("Get the CPU, memory, disk and network statistics of the domain of a running VirtualMachineInstance").
+			Writes(v1.VirtualMachineInstanceDomainStats{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceDomainStats{}))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecRequestHandler).
+			Reads(v1.GuestExecOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"GuestExec").
+			Doc("Run a command in the guest via guest agent").
+			Writes(v1.GuestExecResult{}).
+			Returns(http.StatusOK, "OK", v1.GuestExecResult{}).
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileDownloadRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestFilePathParam(subws)).
+			Produces("application/octet-stream").
+			Operation(version.Version+"GuestFileDownload").
+			Doc("Download a file from the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile")).
+			To(subresourceApp.GuestFileUploadRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestFilePathParam(subws)).
+			Param(definitions.GuestFilePermissionsParam(subws)).
+			Param(definitions.GuestFileOwnerParam(subws)).
+			Consumes("application/octet-stream").
+			Operation(version.Version+"GuestFileUpload").
+			Doc("Upload a file into the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addsshkey")).
+			To(subresourceApp.AddSSHKeyRequestHandler).
+			Reads(v1.AddSSHKeyOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"AddSSHKey").
+			Doc("Authorize an ephemeral SSH public key for a user of the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("removesshkey")).
+			To(subresourceApp.RemoveSSHKeyRequestHandler).
+			Reads(v1.RemoveSSHKeyOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"RemoveSSHKey").
+			Doc("Remove an ephemeral SSH public key from the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Reads(v1.AddVolumeOptions{}).
@@ -518,6 +600,42 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addhostdevice")).
+			To(subresourceApp.VMIAddHostDeviceRequestHandler).
+			Reads(v1.AddHostDeviceOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-addhostdevice").
+			Doc("Add a host device to a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("removehostdevice")).
+			To(subresourceApp.VMIRemoveHostDeviceRequestHandler).
+			Reads(v1.RemoveHostDeviceOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-removehostdevice").
+			Doc("Removes a hot-attached host device from a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addhostdevice")).
+			To(subresourceApp.VMAddHostDeviceRequestHandler).
+			Reads(v1.AddHostDeviceOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-addhostdevice").
+			Doc("Add a host device to a running Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("removehostdevice")).
+			To(subresourceApp.VMRemoveHostDeviceRequestHandler).
+			Reads(v1.RemoveHostDeviceOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vm-removehostdevice").
+			Doc("Removes a hot-attached host device from a running Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		// Return empty api resource list.
 		// K8s expects to be able to retrieve a resource list for each aggregated
 		// app in order to discover what resources it provides. Without returning
@@ -592,6 +710,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/addinterface",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/addhostdevice",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/removehostdevice",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
@@ -604,6 +730,26 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/domainstats",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestfile",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/addsshkey",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/removesshkey",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -616,6 +762,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/addinterface",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/addhostdevice",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/removehostdevice",
+						Namespaced: true,
+					},
 				}
 
 				response.WriteAsJson(list)
@@ -653,6 +807,10 @@ func (app *virtAPIApp) composeSubresources() {
 		Returns(http.StatusNotFound, httpStatusNotFoundMessage, ""))
 	ws.Route(ws.GET("/healthz").To(healthz.KubeConnectionHealthzFuncFactory(app.clusterConfig, apiHealthVersion)).Doc("Health endpoint"))
 
+	ws.Route(ws.GET("/vnc").To(subresourceApp.VNCWebsocketRequestHandler).
+		Param(definitions.TokenParam(ws)).Param(definitions.ExclusiveParam(ws)).
+		Doc("Open a websocket connection to connect to VNC on the VirtualMachineInstance of a token, for web clients without cluster credentials."))
+
 	componentProfiler := profiler.NewProfileManager(app.clusterConfig)
 
 	ws.Route(ws.GET("/start-profiler").To(componentProfiler.HandleStartProfiler).Doc("start profiler endpoint"))
@@ -33,6 +33,8 @@ import (
 	"kubevirt.io/api/migrations"
 
 	migrationsv1 "kubevirt.io/api/migrations/v1alpha1"
+	"kubevirt.io/api/node"
+	nodev1alpha1 "kubevirt.io/api/node/v1alpha1"
 
 	restful "github.com/emicklei/go-restful"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -64,6 +66,7 @@ func ComposeAPIDefinitions() []*restful.WebService {
 		migrationPoliciesApiServiceDefinitions,
 		poolApiServiceDefinitions,
 		vmCloneDefinitions,
+		nodeCapabilitiesDefinitions,
 	} {
 		result = append(result, f()...)
 	}
@@ -273,6 +276,26 @@ func vmCloneDefinitions() []*restful.WebService {
 	return []*restful.WebService{ws, ws2}
 }
 
+func nodeCapabilitiesDefinitions() []*restful.WebService {
+	ncGVR := nodev1alpha1.SchemeGroupVersion.WithResource(node.ResourceNodeCapabilitiesPlural)
+
+	ws, err := groupVersionProxyBase(schema.GroupVersion{Group: nodev1alpha1.SchemeGroupVersion.Group, Version: nodev1alpha1.SchemeGroupVersion.Version})
+	if err != nil {
+		panic(err)
+	}
+
+	ws, err = genericClusterResourceProxy(ws, ncGVR, &nodev1alpha1.NodeCapabilities{}, nodev1alpha1.NodeCapabilitiesKind.Kind, &nodev1alpha1.NodeCapabilitiesList{})
+	if err != nil {
+		panic(err)
+	}
+
+	ws2, err := resourceProxyAutodiscovery(ncGVR)
+	if err != nil {
+		panic(err)
+	}
+	return []*restful.WebService{ws, ws2}
+}
+
 func groupVersionProxyBase(gv schema.GroupVersion) (*restful.WebService, error) {
 	ws := new(restful.WebService)
 	ws.Doc("The KubeVirt API, a virtual machine management.")
@@ -568,6 +591,16 @@ const (
 	NamespaceParamName  = "namespace"
 	NameParamName       = "name"
 	MoveCursorParamName = "moveCursor"
+	SerialPortParamName = "serialPort"
+	ReadOnlyParamName   = "readOnly"
+	ExclusiveParamName  = "exclusive"
+
+	ExpirationSecondsParamName = "expirationSeconds"
+	TokenParamName             = "token"
+
+	GuestFilePathParamName        = "path"
+	GuestFilePermissionsParamName = "permissions"
+	GuestFileOwnerParamName       = "owner"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -582,6 +615,38 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func SerialPortParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(SerialPortParamName, "Name of the additional serial port to connect to, instead of the serial console")
+}
+
+func ReadOnlyParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(ReadOnlyParamName, "Observe the console without sending any input to it").DataType("boolean").DefaultValue("false")
+}
+
+func ExclusiveParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(ExclusiveParamName, "Fail if the console is held by another client, instead of taking it over").DataType("boolean").DefaultValue("false")
+}
+
+func ExpirationSecondsParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(ExpirationSecondsParamName, "How long the token is valid for, between 10 seconds and 1 hour").DataType("integer").DefaultValue("300")
+}
+
+func TokenParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(TokenParamName, "Token returned by the vnc/token subresource of the VirtualMachineInstance").Required(true)
+}
+
+func GuestFilePathParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestFilePathParamName, "Absolute path of the file in the guest").Required(true)
+}
+
+func GuestFilePermissionsParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestFilePermissionsParamName, "Permissions of the uploaded file in octal, e.g. 0644")
+}
+
+func GuestFileOwnerParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestFileOwnerParamName, "Owner of the uploaded file as user[:group]")
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
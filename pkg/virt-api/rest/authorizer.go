@@ -41,6 +41,8 @@ const (
 	userHeader            = "X-Remote-User"
 	groupHeader           = "X-Remote-Group"
 	userExtraHeaderPrefix = "X-Remote-Extra-"
+
+	userAttribute = "kubevirt.io/user"
 )
 
 var noAuthEndpoints = map[string]struct{}{
@@ -68,6 +70,8 @@ var noAuthEndpoints = map[string]struct{}{
 	"/apis/subresources.kubevirt.io/v1alpha3/start-cluster-profiler": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/stop-cluster-profiler":  {},
 	"/apis/subresources.kubevirt.io/v1alpha3/dump-cluster-profiler":  {},
+	// the VNC websocket endpoint authenticates its requests with the token issued by the vnc/token subresource
+	"/vnc": {},
 }
 
 type VirtApiAuthorizor interface {
@@ -338,6 +342,8 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	}
 
 	if result.Status.Allowed {
+		// Let the handlers know which user they serve
+		req.SetAttribute(userAttribute, r.Spec.User)
 		return true, "", nil
 	}
 
@@ -43,8 +43,7 @@ var _ = Describe("Authorizer", func() {
 
 		app := authorizor{}
 		BeforeEach(func() {
-			req = &restful.Request{}
-			req.Request = &http.Request{}
+			req = restful.NewRequest(&http.Request{})
 			req.Request.URL = &url.URL{}
 			req.Request.Header = make(map[string][]string)
 			req.Request.Header[userHeader] = []string{"user"}
@@ -120,6 +119,7 @@ var _ = Describe("Authorizer", func() {
 					allowed, _, err := app.Authorize(req)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(allowed).To(BeTrue())
+					Expect(req.Attribute(userAttribute)).To(Equal("user"))
 				})
 
 				It("should not allow user if auth check fails", func() {
@@ -228,6 +228,7 @@ var _ = Describe("Authorizer", func() {
 				Entry("start profiler", "/start-profiler"),
 				Entry("stop profiler", "/stop-profiler"),
 				Entry("dump profiler", "/dump-profiler"),
+				Entry("vnc websocket", "/vnc"),
 				// Subresources v1
 				Entry("subresource v1 groupversion", "/apis/subresources.kubevirt.io/v1"),
 				Entry("subresource v1 version", "/apis/subresources.kubevirt.io/v1/version"),
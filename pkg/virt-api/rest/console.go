@@ -2,6 +2,8 @@ package rest
 
 import (
 	"fmt"
+	"net/url"
+	"strconv"
 
 	restful "github.com/emicklei/go-restful"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -11,23 +13,58 @@ import (
 	"kubevirt.io/client-go/log"
 
 	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/api"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
 func (app *SubresourceAPIApp) ConsoleRequestHandler(request *restful.Request, response *restful.Response) {
 	activeConnectionMetric := apimetrics.NewActiveConsoleConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
 
+	session, statusErr := consoleSessionFromRequest(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	serialPort := request.QueryParameter(definitions.SerialPortParamName)
+	if serialPort != "" {
+		streamer := NewRawStreamer(
+			app.FetchVirtualMachineInstance,
+			func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+				return validateVMIForSerialPort(vmi, serialPort)
+			},
+			app.virtHandlerDialer(session.resolver(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+				return conn.SerialPortURI(vmi, serialPort)
+			})),
+		)
+		streamer.Handle(request, response)
+		return
+	}
+
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
 		validateVMIForConsole,
-		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		app.virtHandlerDialer(session.resolver(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.ConsoleURI(vmi)
-		}),
+		})),
 	)
 
 	streamer.Handle(request, response)
 }
 
+func validateVMIForSerialPort(vmi *v1.VirtualMachineInstance, serialPort string) *errors.StatusError {
+	if _, exists := util.SerialPortSocketName(vmi, serialPort); !exists {
+		err := fmt.Errorf("No serial port named %s is present.", serialPort)
+		log.Log.Object(vmi).Reason(err).Error("Can't establish a serial port connection.")
+		return errors.NewBadRequest(err.Error())
+	}
+	if vmi.Status.Phase == v1.Failed {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is in failed status"))
+	}
+	return nil
+}
+
 func validateVMIForConsole(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 	if vmi.Spec.Domain.Devices.AutoattachSerialConsole != nil && *vmi.Spec.Domain.Devices.AutoattachSerialConsole == false {
 		err := fmt.Errorf("No serial consoles are present.")
@@ -39,3 +76,62 @@ func validateVMIForConsole(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 	}
 	return nil
 }
+
+// consoleSession are the options of a client joining the session of a console, passed on to virt-handler
+type consoleSession struct {
+	readOnly  bool
+	exclusive bool
+	user      string
+}
+
+func consoleSessionFromRequest(request *restful.Request) (*consoleSession, *errors.StatusError) {
+	session := &consoleSession{}
+	if user, ok := request.Attribute(userAttribute).(string); ok {
+		session.user = user
+	}
+	var statusErr *errors.StatusError
+	if session.readOnly, statusErr = boolQueryParameter(request, definitions.ReadOnlyParamName); statusErr != nil {
+		return nil, statusErr
+	}
+	if session.exclusive, statusErr = boolQueryParameter(request, definitions.ExclusiveParamName); statusErr != nil {
+		return nil, statusErr
+	}
+	if session.readOnly && session.exclusive {
+		return nil, errors.NewBadRequest("a read-only client can't get exclusive access to the console")
+	}
+	return session, nil
+}
+
+func boolQueryParameter(request *restful.Request, name string) (bool, *errors.StatusError) {
+	value := request.QueryParameter(name)
+	if value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, errors.NewBadRequest(fmt.Sprintf("invalid %s parameter %q", name, value))
+	}
+	return parsed, nil
+}
+
+// resolver adds the options of the session to the URL of the console on virt-handler
+func (s *consoleSession) resolver(getURL URLResolver) URLResolver {
+	return func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		consoleURL, err := getURL(vmi, conn)
+		if err != nil {
+			return "", err
+		}
+		parsed, err := url.Parse(consoleURL)
+		if err != nil {
+			return "", err
+		}
+		query := parsed.Query()
+		query.Set(definitions.ReadOnlyParamName, strconv.FormatBool(s.readOnly))
+		query.Set(definitions.ExclusiveParamName, strconv.FormatBool(s.exclusive))
+		if s.user != "" {
+			query.Set("user", s.user)
+		}
+		parsed.RawQuery = query.Encode()
+		return parsed.String(), nil
+	}
+}
@@ -2,7 +2,10 @@ package rest
 
 import (
 	"fmt"
+	"io"
 	"net"
+	"net/http"
+	"strings"
 
 	"github.com/gorilla/websocket"
 
@@ -30,8 +33,13 @@ func (h handlerDial) Dial(vmi *v1.VirtualMachineInstance) (*websocket.Conn, *err
 	if statusError != nil {
 		return nil, statusError
 	}
-	conn, _, err := kubecli.Dial(url, h.app.handlerTLSConfiguration)
+	conn, resp, err := kubecli.Dial(url, h.app.handlerTLSConfiguration)
 	if err != nil {
+		// The console is held by another client
+		if resp != nil && resp.StatusCode == http.StatusConflict {
+			reason, _ := io.ReadAll(resp.Body)
+			return nil, errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("%s", strings.TrimSpace(string(reason))))
+		}
 		return nil, errors.NewInternalError(fmt.Errorf("dialing virt-handler: %w", err))
 	}
 	return conn, nil
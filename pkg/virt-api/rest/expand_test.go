@@ -57,7 +57,7 @@ var _ = Describe("Instancetype expansion subresources", func() {
 
 		instancetypeMethods = testutils.NewMockInstancetypeMethods()
 
-		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil, nil)
 		app.instancetypeMethods = instancetypeMethods
 
 		request = restful.NewRequest(&http.Request{})
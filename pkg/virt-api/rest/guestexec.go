@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	restful "github.com/emicklei/go-restful"
+	v12 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+)
+
+const (
+	// MaxGuestExecStdinBytes bounds the stdin passed to a command, the guest agent gets it in a single message
+	MaxGuestExecStdinBytes = 1024 * 1024
+	// DefaultGuestExecTimeoutSeconds is used when the request doesn't set a timeout
+	DefaultGuestExecTimeoutSeconds = 30
+	// MaxGuestExecTimeoutSeconds keeps the request within the default request timeout of the kube-apiserver
+	MaxGuestExecTimeoutSeconds = 50
+
+	// guestExecRequestLimit leaves room for the base64 encoding of stdin and the command
+	guestExecRequestLimit = 2 * MaxGuestExecStdinBytes
+	// guestExecConnectionMargin is added to the command timeout for the request to virt-handler
+	guestExecConnectionMargin = 10 * time.Second
+)
+
+// GuestExecRequestHandler runs a command in the guest through the guest agent, and returns its exit code and output
+func (app *SubresourceAPIApp) GuestExecRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, the command is expected as the request body"), response)
+		return
+	}
+	defer request.Request.Body.Close()
+	opts := &v1.GuestExecOptions{}
+	err := yaml.NewYAMLOrJSONDecoder(io.LimitReader(request.Request.Body, guestExecRequestLimit), 1024).Decode(opts)
+	if err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if statusErr := validateGuestExecOptions(opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if opts.TimeoutSeconds == 0 {
+		opts.TimeoutSeconds = DefaultGuestExecTimeoutSeconds
+	}
+
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validateVMIForGuestExec)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	// The command can outlive the default timeout of the virt-handler client
+	httpClient := *app.handlerHttpClient
+	httpClient.Timeout = time.Duration(opts.TimeoutSeconds)*time.Second + guestExecConnectionMargin
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, &httpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	url, err := conn.GuestExecURI(vmi)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	resp, err := conn.PutWithResponse(url, io.NopCloser(bytes.NewReader(body)))
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to run the command in the guest")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	result := &v1.GuestExecResult{}
+	if err := json.Unmarshal([]byte(resp), result); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("error unmarshalling guestexec response")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if err := response.WriteHeaderAndJson(http.StatusOK, result, restful.MIME_JSON); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func validateGuestExecOptions(opts *v1.GuestExecOptions) *errors.StatusError {
+	if len(opts.Command) == 0 || opts.Command[0] == "" {
+		return errors.NewBadRequest("GuestExecOptions requires a command")
+	}
+	if len(opts.Stdin) > MaxGuestExecStdinBytes {
+		return errors.NewBadRequest(fmt.Sprintf("stdin can't exceed %d bytes", MaxGuestExecStdinBytes))
+	}
+	if opts.TimeoutSeconds < 0 || opts.TimeoutSeconds > MaxGuestExecTimeoutSeconds {
+		return errors.NewBadRequest(fmt.Sprintf("timeoutSeconds must be between 1 and %d", MaxGuestExecTimeoutSeconds))
+	}
+	return nil
+}
+
+func validateVMIForGuestExec(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if condManager.HasConditionWithStatus(vmi, v1.VirtualMachineInstancePaused, v12.ConditionTrue) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf("VMI is paused"))
+	}
+	if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	restful "github.com/emicklei/go-restful"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const guestFileErrorBodyLimit = 4096
+
+var (
+	guestFileWindowsPathRegex = regexp.MustCompile(`^[a-zA-Z]:[\\/]`)
+	guestFilePermissionsRegex = regexp.MustCompile(`^[0-7]{3,4}$`)
+	// The owner is passed to chown in the guest, it can't start with a dash
+	guestFileOwnerRegex = regexp.MustCompile(`^[a-zA-Z0-9_.][a-zA-Z0-9_.-]*(:[a-zA-Z0-9_.][a-zA-Z0-9_.-]*)?$`)
+)
+
+// GuestFileDownloadRequestHandler streams a file out of the guest through the guest agent
+func (app *SubresourceAPIApp) GuestFileDownloadRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	opts := guestFileOptionsFromRequest(request)
+	if opts.Permissions != "" || opts.Owner != "" {
+		writeError(errors.NewBadRequest("permissions and owner only apply to uploads"), response)
+		return
+	}
+	if statusErr := validateGuestFileOptions(opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validateVMIForGuestExec)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	resp, statusErr := app.guestFileRequest(request, vmi, http.MethodGet, opts, nil)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	defer resp.Body.Close()
+
+	response.AddHeader("Content-Type", "application/octet-stream")
+	response.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(response, resp.Body); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to download guest file %s", opts.Path)
+		// The status was already sent, the client has to see the transfer failing
+		panic(http.ErrAbortHandler)
+	}
+}
+
+// GuestFileUploadRequestHandler streams the request body into a file of the guest through the guest agent
+func (app *SubresourceAPIApp) GuestFileUploadRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, the file content is expected as the request body"), response)
+		return
+	}
+	defer request.Request.Body.Close()
+
+	opts := guestFileOptionsFromRequest(request)
+	if statusErr := validateGuestFileOptions(opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validateVMIForGuestExec)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	resp, statusErr := app.guestFileRequest(request, vmi, http.MethodPut, opts, request.Request.Body)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	resp.Body.Close()
+
+	response.WriteHeader(http.StatusOK)
+}
+
+// guestFileRequest forwards the transfer to virt-handler, the caller has to close the body of the returned response
+func (app *SubresourceAPIApp) guestFileRequest(request *restful.Request, vmi *v1.VirtualMachineInstance, method string, opts *v1.GuestFileOptions, body io.Reader) (*http.Response, *errors.StatusError) {
+	// Transfers take as long as the file needs, they are bound by the client request instead
+	httpClient := *app.handlerHttpClient
+	httpClient.Timeout = 0
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, &httpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	uri, err := conn.GuestFileURI(vmi)
+	if err != nil {
+		return nil, errors.NewBadRequest(err.Error())
+	}
+
+	query := url.Values{}
+	query.Set(definitions.GuestFilePathParamName, opts.Path)
+	if opts.Permissions != "" {
+		query.Set(definitions.GuestFilePermissionsParamName, opts.Permissions)
+	}
+	if opts.Owner != "" {
+		query.Set(definitions.GuestFileOwnerParamName, opts.Owner)
+	}
+
+	req, err := http.NewRequestWithContext(request.Request.Context(), method, uri+"?"+query.Encode(), body)
+	if err != nil {
+		return nil, errors.NewInternalError(err)
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to transfer the guest file")
+		return nil, errors.NewInternalError(err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		defer resp.Body.Close()
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, guestFileErrorBodyLimit))
+		return nil, errors.NewInternalError(fmt.Errorf("unexpected return code %d (%s): %s", resp.StatusCode, resp.Status, strings.TrimSpace(string(msg))))
+	}
+	return resp, nil
+}
+
+func guestFileOptionsFromRequest(request *restful.Request) *v1.GuestFileOptions {
+	return &v1.GuestFileOptions{
+		Path:        request.QueryParameter(definitions.GuestFilePathParamName),
+		Permissions: request.QueryParameter(definitions.GuestFilePermissionsParamName),
+		Owner:       request.QueryParameter(definitions.GuestFileOwnerParamName),
+	}
+}
+
+func validateGuestFileOptions(opts *v1.GuestFileOptions) *errors.StatusError {
+	if !strings.HasPrefix(opts.Path, "/") && !guestFileWindowsPathRegex.MatchString(opts.Path) {
+		return errors.NewBadRequest("path must be an absolute path in the guest")
+	}
+	if opts.Permissions != "" && !guestFilePermissionsRegex.MatchString(opts.Permissions) {
+		return errors.NewBadRequest("permissions must be in octal, e.g. 0644")
+	}
+	if opts.Owner != "" && !guestFileOwnerRegex.MatchString(opts.Owner) {
+		return errors.NewBadRequest("owner must be in the form user[:group]")
+	}
+	return nil
+}
@@ -0,0 +1,274 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	goerrors "errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+// hostDeviceRequest computes the desired host devices of a VM or VMI spec out of the current ones.
+type hostDeviceRequest func(hostDevices []v1.HostDevice) ([]v1.HostDevice, error)
+
+func addHostDeviceRequest(opts *v1.AddHostDeviceOptions) hostDeviceRequest {
+	return func(hostDevices []v1.HostDevice) ([]v1.HostDevice, error) {
+		for _, hostDevice := range hostDevices {
+			if hostDevice.Name == opts.Name {
+				return nil, fmt.Errorf("Unable to add host device [%s] because host device with that name already exists", opts.Name)
+			}
+		}
+		newHostDevices := append([]v1.HostDevice{}, hostDevices...)
+		return append(newHostDevices, v1.HostDevice{
+			Name:         opts.Name,
+			DeviceName:   opts.DeviceName,
+			Hotpluggable: true,
+		}), nil
+	}
+}
+
+func removeHostDeviceRequest(opts *v1.RemoveHostDeviceOptions) hostDeviceRequest {
+	return func(hostDevices []v1.HostDevice) ([]v1.HostDevice, error) {
+		newHostDevices := []v1.HostDevice{}
+		found := false
+		for _, hostDevice := range hostDevices {
+			if hostDevice.Name != opts.Name {
+				newHostDevices = append(newHostDevices, hostDevice)
+				continue
+			}
+			if !hostDevice.Hotpluggable {
+				return nil, fmt.Errorf("Unable to remove host device [%s] because it is not hotpluggable", opts.Name)
+			}
+			found = true
+		}
+		if !found {
+			return nil, fmt.Errorf("Unable to remove host device [%s] because it does not exist", opts.Name)
+		}
+		return newHostDevices, nil
+	}
+}
+
+func generateHostDevicesPatch(path string, oldHostDevices, newHostDevices []v1.HostDevice) (string, error) {
+	oldJSON, err := json.Marshal(oldHostDevices)
+	if err != nil {
+		return "", err
+	}
+	newJSON, err := json.Marshal(newHostDevices)
+	if err != nil {
+		return "", err
+	}
+
+	test := fmt.Sprintf(`{ "op": "test", "path": %q, "value": %s}`, path, string(oldJSON))
+	update := fmt.Sprintf(`{ "op": "add", "path": %q, "value": %s}`, path, string(newJSON))
+	return fmt.Sprintf("[%s, %s]", test, update), nil
+}
+
+func decodeHostDeviceOptions(request *restful.Request, opts interface{}) *errors.StatusError {
+	if request.Request.Body == nil {
+		return errors.NewBadRequest("Request with no body, a host device name is expected as the request body")
+	}
+	defer request.Request.Body.Close()
+	err := yaml.NewYAMLOrJSONDecoder(request.Request.Body, 1024).Decode(opts)
+	switch err {
+	case io.EOF, nil:
+		return nil
+	default:
+		return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+	}
+}
+
+func hostDeviceDryRunOption(dryRun []string) []string {
+	if len(dryRun) > 0 && dryRun[0] == k8smetav1.DryRunAll {
+		return dryRun
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) addHostDeviceRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugHostDevicesEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf("Unable to Add Host Device because the %s feature gate is not enabled.", virtconfig.HotplugHostDevicesGate)), response)
+		return
+	}
+
+	opts := &v1.AddHostDeviceOptions{}
+	if err := decodeHostDeviceOptions(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("AddHostDeviceOptions requires name to be set"), response)
+		return
+	} else if opts.DeviceName == "" {
+		writeError(errors.NewBadRequest("AddHostDeviceOptions requires deviceName to be set"), response)
+		return
+	}
+
+	app.hostDevicePatch(name, namespace, addHostDeviceRequest(opts), hostDeviceDryRunOption(opts.DryRun), ephemeral, response)
+}
+
+func (app *SubresourceAPIApp) removeHostDeviceRequestHandler(request *restful.Request, response *restful.Response, ephemeral bool) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if !app.clusterConfig.HotplugHostDevicesEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf("Unable to Remove Host Device because the %s feature gate is not enabled.", virtconfig.HotplugHostDevicesGate)), response)
+		return
+	}
+
+	opts := &v1.RemoveHostDeviceOptions{}
+	if err := decodeHostDeviceOptions(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if opts.Name == "" {
+		writeError(errors.NewBadRequest("RemoveHostDeviceOptions requires name to be set"), response)
+		return
+	}
+
+	app.hostDevicePatch(name, namespace, removeHostDeviceRequest(opts), hostDeviceDryRunOption(opts.DryRun), ephemeral, response)
+}
+
+func (app *SubresourceAPIApp) hostDevicePatch(name, namespace string, hostDeviceRequest hostDeviceRequest, dryRun []string, ephemeral bool, response *restful.Response) {
+	// patch the VMI only if ephemeral, else make the change permanent on the VM and hotplug it on its running VMI.
+	if ephemeral {
+		vmi, statErr := app.FetchVirtualMachineInstance(namespace, name)
+		if statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+		if !vmi.IsRunning() {
+			writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+			return
+		}
+		if statErr := app.vmiHostDevicePatch(vmi, hostDeviceRequest, dryRun); statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+	} else {
+		if statErr := app.vmHostDevicePatch(name, namespace, hostDeviceRequest, dryRun); statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) vmiHostDevicePatch(vmi *v1.VirtualMachineInstance, hostDeviceRequest hostDeviceRequest, dryRun []string) *errors.StatusError {
+	oldHostDevices := vmi.Spec.Domain.Devices.HostDevices
+	newHostDevices, err := hostDeviceRequest(oldHostDevices)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, err)
+	}
+
+	patch, err := generateHostDevicesPatch("/spec/domain/devices/hostDevices", oldHostDevices, newHostDevices)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, err)
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", patch)
+	if _, err := app.virtCli.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte(patch), &k8smetav1.PatchOptions{DryRun: dryRun}); err != nil {
+		log.Log.Object(vmi).V(1).Errorf("unable to patch vmi: %v", err)
+		var statusError *errors.StatusError
+		if errors.IsInvalid(err) && goerrors.As(err, &statusError) {
+			return statusError
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vmi: %v", err))
+	}
+	return nil
+}
+
+func (app *SubresourceAPIApp) vmHostDevicePatch(name, namespace string, hostDeviceRequest hostDeviceRequest, dryRun []string) *errors.StatusError {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return statErr
+	}
+
+	oldHostDevices := vm.Spec.Template.Spec.Domain.Devices.HostDevices
+	newHostDevices, err := hostDeviceRequest(oldHostDevices)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	patch, err := generateHostDevicesPatch("/spec/template/spec/domain/devices/hostDevices", oldHostDevices, newHostDevices)
+	if err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, patch)
+	if _, err := app.virtCli.VirtualMachine(vm.Namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, []byte(patch), &k8smetav1.PatchOptions{DryRun: dryRun}); err != nil {
+		log.Log.Object(vm).V(1).Errorf("unable to patch vm: %v", err)
+		var statusError *errors.StatusError
+		if errors.IsInvalid(err) && goerrors.As(err, &statusError) {
+			return statusError
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vm: %v", err))
+	}
+
+	vmi, err := app.virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, &k8smetav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.NewInternalError(fmt.Errorf("unable to retrieve vmi [%s]: %v", name, err))
+	}
+	if !vmi.IsRunning() {
+		// the VMI picks up the host devices of the VM template on its next start
+		return nil
+	}
+	return app.vmiHostDevicePatch(vmi, hostDeviceRequest, dryRun)
+}
+
+// VMAddHostDeviceRequestHandler handles the subresource for hot plugging a host device.
+func (app *SubresourceAPIApp) VMAddHostDeviceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.addHostDeviceRequestHandler(request, response, false)
+}
+
+// VMRemoveHostDeviceRequestHandler handles the subresource for hot unplugging a host device.
+func (app *SubresourceAPIApp) VMRemoveHostDeviceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.removeHostDeviceRequestHandler(request, response, false)
+}
+
+// VMIAddHostDeviceRequestHandler handles the subresource for hot plugging a host device.
+func (app *SubresourceAPIApp) VMIAddHostDeviceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.addHostDeviceRequestHandler(request, response, true)
+}
+
+// VMIRemoveHostDeviceRequestHandler handles the subresource for hot unplugging a host device.
+func (app *SubresourceAPIApp) VMIRemoveHostDeviceRequestHandler(request *restful.Request, response *restful.Response) {
+	app.removeHostDeviceRequestHandler(request, response, true)
+}
@@ -0,0 +1,256 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Host Device Hotplug Subresource", func() {
+	const (
+		vmName             = "testvm"
+		existingHostDevice = "gpu1"
+		hostDeviceToAdd    = "gpu2"
+		deviceName         = "nvidia.com/GP102GL_Tesla_P40"
+	)
+
+	var (
+		request    *restful.Request
+		response   *restful.Response
+		recorder   *httptest.ResponseRecorder
+		vmClient   *kubecli.MockVirtualMachineInterface
+		vmiClient  *kubecli.MockVirtualMachineInstanceInterface
+		app        *SubresourceAPIApp
+		kvInformer = func() *v1.KubeVirt {
+			return &v1.KubeVirt{
+				ObjectMeta: k8smetav1.ObjectMeta{
+					Name:      "kubevirt",
+					Namespace: "kubevirt",
+				},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{virtconfig.HostDevicesGate, virtconfig.HotplugHostDevicesGate},
+						},
+					},
+				},
+				Status: v1.KubeVirtStatus{
+					Phase: v1.KubeVirtPhaseDeploying,
+				},
+			}
+		}
+	)
+
+	newBody := func(opts interface{}) io.ReadCloser {
+		optsJSON, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJSON)}
+	}
+
+	newVM := func(hostDevices ...v1.HostDevice) *v1.VirtualMachine {
+		vm := newMinimalVM(vmName)
+		vm.Namespace = k8smetav1.NamespaceDefault
+		vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+		vm.Spec.Template.Spec.Domain.Devices.HostDevices = hostDevices
+		return vm
+	}
+
+	newRunningVMI := func(hostDevices ...v1.HostDevice) *v1.VirtualMachineInstance {
+		vmi := api.NewMinimalVMI(vmName)
+		vmi.Namespace = k8smetav1.NamespaceDefault
+		vmi.Status.Phase = v1.Running
+		vmi.Spec.Domain.Devices.HostDevices = hostDevices
+		return vmi
+	}
+
+	expectPatch := func(patches *[]string) func(_ context.Context, _ string, _ types.PatchType, patch []byte, _ *k8smetav1.PatchOptions, _ ...string) {
+		return func(_ context.Context, _ string, _ types.PatchType, patch []byte, _ *k8smetav1.PatchOptions, _ ...string) {
+			*patches = append(*patches, string(patch))
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(kvInformer())
+		app = &SubresourceAPIApp{virtCli: virtClient, clusterConfig: config}
+
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = vmName
+		request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+	})
+
+	Context("addhostdevice", func() {
+		It("should add a hotpluggable host device to the VM and its running VMI", func() {
+			request.Request.Body = newBody(&v1.AddHostDeviceOptions{Name: hostDeviceToAdd, DeviceName: deviceName})
+			existing := v1.HostDevice{Name: existingHostDevice, DeviceName: deviceName}
+			var vmPatches, vmiPatches []string
+
+			vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVM(existing), nil)
+			vmClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).
+				Do(expectPatch(&vmPatches)).Return(nil, nil)
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newRunningVMI(existing), nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).
+				Do(expectPatch(&vmiPatches)).Return(nil, nil)
+
+			app.VMAddHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+
+			const devices = `[{"name":"gpu1","deviceName":"nvidia.com/GP102GL_Tesla_P40"}]`
+			const newDevices = `[{"name":"gpu1","deviceName":"nvidia.com/GP102GL_Tesla_P40"},{"name":"gpu2","deviceName":"nvidia.com/GP102GL_Tesla_P40","hotpluggable":true}]`
+			Expect(vmPatches).To(ConsistOf(
+				`[{ "op": "test", "path": "/spec/template/spec/domain/devices/hostDevices", "value": ` + devices + `}, ` +
+					`{ "op": "add", "path": "/spec/template/spec/domain/devices/hostDevices", "value": ` + newDevices + `}]`,
+			))
+			Expect(vmiPatches).To(ConsistOf(
+				`[{ "op": "test", "path": "/spec/domain/devices/hostDevices", "value": ` + devices + `}, ` +
+					`{ "op": "add", "path": "/spec/domain/devices/hostDevices", "value": ` + newDevices + `}]`,
+			))
+		})
+
+		It("should only add the host device to the VM if it is not running", func() {
+			request.Request.Body = newBody(&v1.AddHostDeviceOptions{Name: hostDeviceToAdd, DeviceName: deviceName})
+
+			vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVM(), nil)
+			vmClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).Return(nil, nil)
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(nil, k8serrors.NewNotFound(v1.Resource("virtualmachineinstance"), vmName))
+
+			app.VMAddHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should add a hotpluggable host device only to the VMI", func() {
+			request.Request.Body = newBody(&v1.AddHostDeviceOptions{Name: hostDeviceToAdd, DeviceName: deviceName})
+
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newRunningVMI(), nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).Return(nil, nil)
+
+			app.VMIAddHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("should fail if the VMI is not running", func() {
+			request.Request.Body = newBody(&v1.AddHostDeviceOptions{Name: hostDeviceToAdd, DeviceName: deviceName})
+			vmi := newRunningVMI()
+			vmi.Status.Phase = v1.Scheduled
+
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(vmi, nil)
+
+			app.VMIAddHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+
+		It("should fail if a host device with the same name exists", func() {
+			request.Request.Body = newBody(&v1.AddHostDeviceOptions{Name: existingHostDevice, DeviceName: deviceName})
+
+			vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).
+				Return(newVM(v1.HostDevice{Name: existingHostDevice, DeviceName: deviceName}), nil)
+
+			app.VMAddHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+
+		DescribeTable("should reject invalid requests", func(opts *v1.AddHostDeviceOptions) {
+			request.Request.Body = newBody(opts)
+
+			app.VMAddHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		},
+			Entry("without a name", &v1.AddHostDeviceOptions{DeviceName: deviceName}),
+			Entry("without a device name", &v1.AddHostDeviceOptions{Name: hostDeviceToAdd}),
+		)
+
+		It("should reject requests if the feature gate is not enabled", func() {
+			kv := kvInformer()
+			kv.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{virtconfig.HostDevicesGate}
+			app.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKV(kv)
+			request.Request.Body = newBody(&v1.AddHostDeviceOptions{Name: hostDeviceToAdd, DeviceName: deviceName})
+
+			app.VMAddHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Context("removehostdevice", func() {
+		It("should remove a hotpluggable host device from the VM and its running VMI", func() {
+			request.Request.Body = newBody(&v1.RemoveHostDeviceOptions{Name: hostDeviceToAdd})
+			existing := v1.HostDevice{Name: existingHostDevice, DeviceName: deviceName}
+			hotplugged := v1.HostDevice{Name: hostDeviceToAdd, DeviceName: deviceName, Hotpluggable: true}
+			var vmiPatches []string
+
+			vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVM(existing, hotplugged), nil)
+			vmClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).Return(nil, nil)
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newRunningVMI(existing, hotplugged), nil)
+			vmiClient.EXPECT().Patch(context.Background(), vmName, types.JSONPatchType, gomock.Any(), &k8smetav1.PatchOptions{}).
+				Do(expectPatch(&vmiPatches)).Return(nil, nil)
+
+			app.VMRemoveHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			Expect(vmiPatches).To(HaveLen(1))
+			Expect(vmiPatches[0]).To(HaveSuffix(`{ "op": "add", "path": "/spec/domain/devices/hostDevices", "value": [{"name":"gpu1","deviceName":"nvidia.com/GP102GL_Tesla_P40"}]}]`))
+		})
+
+		It("should fail to remove a host device which is not hotpluggable", func() {
+			request.Request.Body = newBody(&v1.RemoveHostDeviceOptions{Name: existingHostDevice})
+
+			vmiClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).
+				Return(newRunningVMI(v1.HostDevice{Name: existingHostDevice, DeviceName: deviceName}), nil)
+
+			app.VMIRemoveHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+
+		It("should fail to remove a host device which does not exist", func() {
+			request.Request.Body = newBody(&v1.RemoveHostDeviceOptions{Name: hostDeviceToAdd})
+
+			vmClient.EXPECT().Get(context.Background(), vmName, &k8smetav1.GetOptions{}).Return(newVM(), nil)
+
+			app.VMRemoveHostDeviceRequestHandler(request, response)
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+	})
+})
@@ -0,0 +1,154 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+
+	restful "github.com/emicklei/go-restful"
+	"golang.org/x/crypto/ssh"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+)
+
+const (
+	// DefaultSSHKeyTTLSeconds is used when the addsshkey request doesn't set a TTL
+	DefaultSSHKeyTTLSeconds = 120
+	MinSSHKeyTTLSeconds     = 10
+	MaxSSHKeyTTLSeconds     = 3600
+
+	sshKeyRequestLimit = 64 * 1024
+)
+
+// guestUserRegex matches the portable user names of POSIX systems, the user is passed as is to the guest agent
+var guestUserRegex = regexp.MustCompile(`^[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,31}$`)
+
+// AddSSHKeyRequestHandler authorizes an ephemeral SSH public key for a user of the guest through the guest agent.
+// The key is removed from the guest once its TTL elapsed.
+func (app *SubresourceAPIApp) AddSSHKeyRequestHandler(request *restful.Request, response *restful.Response) {
+	opts := &v1.AddSSHKeyOptions{}
+	if statusErr := decodeSSHKeyOptions(request, opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	publicKey, statusErr := validateSSHKey(opts.User, opts.PublicKey)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	opts.PublicKey = publicKey
+	if opts.TTLSeconds == 0 {
+		opts.TTLSeconds = DefaultSSHKeyTTLSeconds
+	}
+	if opts.TTLSeconds < MinSSHKeyTTLSeconds || opts.TTLSeconds > MaxSSHKeyTTLSeconds {
+		writeError(errors.NewBadRequest(fmt.Sprintf("ttlSeconds must be between %d and %d", MinSSHKeyTTLSeconds, MaxSSHKeyTTLSeconds)), response)
+		return
+	}
+
+	app.putSSHKeyRequest(request, response, opts, func(conn kubecli.VirtHandlerConn, vmi *v1.VirtualMachineInstance) (string, error) {
+		return conn.AddSSHKeyURI(vmi)
+	})
+}
+
+// RemoveSSHKeyRequestHandler removes an ephemeral SSH public key from the guest before its TTL elapsed
+func (app *SubresourceAPIApp) RemoveSSHKeyRequestHandler(request *restful.Request, response *restful.Response) {
+	opts := &v1.RemoveSSHKeyOptions{}
+	if statusErr := decodeSSHKeyOptions(request, opts); statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	publicKey, statusErr := validateSSHKey(opts.User, opts.PublicKey)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	opts.PublicKey = publicKey
+
+	app.putSSHKeyRequest(request, response, opts, func(conn kubecli.VirtHandlerConn, vmi *v1.VirtualMachineInstance) (string, error) {
+		return conn.RemoveSSHKeyURI(vmi)
+	})
+}
+
+func (app *SubresourceAPIApp) putSSHKeyRequest(request *restful.Request, response *restful.Response, opts interface{}, uriFunc func(kubecli.VirtHandlerConn, *v1.VirtualMachineInstance) (string, error)) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validateVMIForGuestExec)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, app.handlerHttpClient).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	url, err := uriFunc(conn, vmi)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	body, err := json.Marshal(opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	if err := conn.Put(url, io.NopCloser(bytes.NewReader(body))); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to update the ephemeral SSH keys of the guest")
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func decodeSSHKeyOptions(request *restful.Request, opts interface{}) *errors.StatusError {
+	if request.Request.Body == nil {
+		return errors.NewBadRequest("Request with no body, the user and the public key are expected as the request body")
+	}
+	defer request.Request.Body.Close()
+	err := yaml.NewYAMLOrJSONDecoder(io.LimitReader(request.Request.Body, sshKeyRequestLimit), 1024).Decode(opts)
+	if err != nil {
+		return errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err))
+	}
+	return nil
+}
+
+// validateSSHKey validates the user and the public key, and returns the key without its options and comment
+func validateSSHKey(user string, publicKey string) (string, *errors.StatusError) {
+	if !guestUserRegex.MatchString(user) {
+		return "", errors.NewBadRequest(fmt.Sprintf("invalid user %q", user))
+	}
+	key, _, _, rest, err := ssh.ParseAuthorizedKey([]byte(publicKey))
+	if err != nil {
+		return "", errors.NewBadRequest(fmt.Sprintf("invalid public key: %v", err))
+	}
+	if len(bytes.TrimSpace(rest)) > 0 {
+		return "", errors.NewBadRequest("a single public key is expected")
+	}
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))), nil
+}
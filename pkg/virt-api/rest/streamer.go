@@ -17,6 +17,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 )
 
+// binaryStreamProtocolName is the websocket subprotocol of web clients streaming binary messages
+const binaryStreamProtocolName = "binary"
+
 type vmiFetcher func(namespace, name string) (*v1.VirtualMachineInstance, *errors.StatusError)
 type validator func(vmi *v1.VirtualMachineInstance) *errors.StatusError
 type streamFunc func(clientConn *websocket.Conn, serverConn net.Conn, result chan<- streamFuncResult)
@@ -73,6 +76,11 @@ func NewWebsocketStreamer(fetch vmiFetcher, validate validator, dial dialer) *St
 func (s *Streamer) Handle(request *restful.Request, response *restful.Response) error {
 	namespace := request.PathParameter(definitions.NamespaceParamName)
 	name := request.PathParameter(definitions.NameParamName)
+	return s.HandleVMI(namespace, name, request, response)
+}
+
+// HandleVMI streams between the client and the VMI, for requests which don't name the VMI in their path
+func (s *Streamer) HandleVMI(namespace, name string, request *restful.Request, response *restful.Response) error {
 	serverConn, statusErr := s.dialer.DialUnderlying(namespace, name)
 
 	if statusErr != nil {
@@ -116,6 +124,8 @@ const streamTimeout = 10 * time.Second
 func clientConnectionUpgrade(request *restful.Request, response *restful.Response) (*websocket.Conn, error) {
 	upgrader := kubecli.NewUpgrader()
 	upgrader.HandshakeTimeout = streamTimeout
+	// Web VNC clients like noVNC ask for the binary subprotocol
+	upgrader.Subprotocols = append(upgrader.Subprotocols, binaryStreamProtocolName)
 	clientSocket, err := upgrader.Upgrade(response.ResponseWriter, request.Request, nil)
 	if err != nil {
 		return nil, err
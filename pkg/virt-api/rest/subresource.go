@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/json"
 	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/util/certificate"
 
 	"kubevirt.io/kubevirt/pkg/util/status"
 
@@ -85,9 +86,10 @@ type SubresourceAPIApp struct {
 	clusterConfig           *virtconfig.ClusterConfig
 	instancetypeMethods     instancetype.Methods
 	handlerHttpClient       *http.Client
+	vncTokens               *vncTokenSigner
 }
 
-func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig) *SubresourceAPIApp {
+func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int, tlsConfiguration *tls.Config, clusterConfig *virtconfig.ClusterConfig, certManager certificate.Manager) *SubresourceAPIApp {
 	// When this method is called from tools/openapispec.go when running 'make generate',
 	// the virtCli is nil, and accessing GeneratedKubeVirtClient() would cause nil dereference.
 	var instancetypeMethods instancetype.Methods
@@ -112,6 +114,7 @@ func NewSubresourceAPIApp(virtCli kubecli.KubevirtClient, consoleServerPort int,
 		clusterConfig:           clusterConfig,
 		instancetypeMethods:     instancetypeMethods,
 		handlerHttpClient:       httpClient,
+		vncTokens:               newVNCTokenSigner(certManager),
 	}
 }
 
@@ -958,6 +961,21 @@ func (app *SubresourceAPIApp) FilesystemList(request *restful.Request, response
 	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceFileSystemList{})
 }
 
+// DomainStats handles the subresource for providing the statistics of the domain of a VMI
+func (app *SubresourceAPIApp) DomainStats(request *restful.Request, response *restful.Response) {
+	validate := func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+		if vmi == nil || vmi.Status.Phase != v1.Running {
+			return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+		}
+		return nil
+	}
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.DomainStatsURI(vmi)
+	}
+
+	app.httpGetRequestHandler(request, response, validate, getURL, v1.VirtualMachineInstanceDomainStats{})
+}
+
 func generateVMVolumeRequestPatch(vm *v1.VirtualMachine, volumeRequest *v1.VirtualMachineVolumeRequest) (string, error) {
 	vmCopy := vm.DeepCopy()
 
@@ -22,6 +22,8 @@ package rest
 import (
 	"bytes"
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"crypto/tls"
 	"encoding/json"
 	"flag"
@@ -29,12 +31,14 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/golang/mock/gomock"
+	"golang.org/x/crypto/ssh"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
@@ -428,6 +432,38 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 				ExpectStatusErrorWithCode(recorder, http.StatusConflict)
 			})
 
+			It("should not give a read-only client exclusive access to the console", func() {
+				request.PathParameters()["name"] = testVMIName
+				request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+				request.Request.URL = &url.URL{RawQuery: "readOnly=true&exclusive=true"}
+
+				app.ConsoleRequestHandler(request, response)
+				ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+			})
+
+			It("should pass the options of the session and the user to virt-handler", func() {
+				request.Request.URL = &url.URL{RawQuery: "readOnly=true"}
+				request.SetAttribute(userAttribute, "alice")
+
+				session, statusErr := consoleSessionFromRequest(request)
+				Expect(statusErr).ToNot(HaveOccurred())
+				resolve := session.resolver(func(_ *v1.VirtualMachineInstance, _ kubecli.VirtHandlerConn) (string, error) {
+					return "https://127.0.0.1:8186/v1/namespaces/default/virtualmachineinstances/testvmi/console?serialPort=debug", nil
+				})
+				consoleURL, err := resolve(api.NewMinimalVMI(testVMIName), nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				parsed, err := url.Parse(consoleURL)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(parsed.Path).To(Equal("/v1/namespaces/default/virtualmachineinstances/testvmi/console"))
+				Expect(parsed.Query()).To(Equal(url.Values{
+					"serialPort": {"debug"},
+					"readOnly":   {"true"},
+					"exclusive":  {"false"},
+					"user":       {"alice"},
+				}))
+			})
+
 		})
 
 		Context("restart", func() {
@@ -1785,6 +1821,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for Filesystem", app.FilesystemList),
+			Entry("for DomainStats", app.DomainStats),
 		)
 
 		DescribeTable("should fail when the VMI is not running", func(fn subRes) {
@@ -1804,6 +1841,7 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			Entry("for GuestOSInfo", app.GuestOSInfo),
 			Entry("for UserList", app.UserList),
 			Entry("for FilesystemList", app.FilesystemList),
+			Entry("for DomainStats", app.DomainStats),
 		)
 
 		DescribeTable("should fail when VMI does not have agent connected", func(fn subRes) {
@@ -2031,6 +2069,249 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 		})
 	})
 
+	Context("GuestExec", func() {
+		guestExecBody := func(opts *v1.GuestExecOptions) io.ReadCloser {
+			body, err := json.Marshal(opts)
+			Expect(err).ToNot(HaveOccurred())
+			return io.NopCloser(bytes.NewReader(body))
+		}
+
+		It("Should run a command in a running VMI with the guest agent connected", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+					ghttp.VerifyJSONRepresenting(&v1.GuestExecOptions{
+						Command:        []string{"cat"},
+						Stdin:          []byte("in"),
+						TimeoutSeconds: DefaultGuestExecTimeoutSeconds,
+					}),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, &v1.GuestExecResult{ExitCode: 1, Stdout: []byte("in"), StdoutTruncated: true}),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			request.Request.Body = guestExecBody(&v1.GuestExecOptions{Command: []string{"cat"}, Stdin: []byte("in")})
+
+			app.GuestExecRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := &v1.GuestExecResult{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+			Expect(result.ExitCode).To(BeEquivalentTo(1))
+			Expect(result.Stdout).To(Equal([]byte("in")))
+			Expect(result.StdoutTruncated).To(BeTrue())
+		})
+
+		It("Should fail without the guest agent connected", func() {
+			expectVMI(Running, UnPaused)
+			request.Request.Body = guestExecBody(&v1.GuestExecOptions{Command: []string{"ls"}})
+
+			app.GuestExecRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		DescribeTable("Should reject invalid options", func(opts *v1.GuestExecOptions) {
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			request.Request.Body = guestExecBody(opts)
+
+			app.GuestExecRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("without a command", &v1.GuestExecOptions{}),
+			Entry("with a too large stdin", &v1.GuestExecOptions{Command: []string{"cat"}, Stdin: make([]byte, MaxGuestExecStdinBytes+1)}),
+			Entry("with a too long timeout", &v1.GuestExecOptions{Command: []string{"ls"}, TimeoutSeconds: MaxGuestExecTimeoutSeconds + 1}),
+		)
+	})
+
+	Context("SSH keys", func() {
+		var publicKey string
+
+		BeforeEach(func() {
+			key, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			sshKey, err := ssh.NewPublicKey(key)
+			Expect(err).ToNot(HaveOccurred())
+			publicKey = strings.TrimSpace(string(ssh.MarshalAuthorizedKey(sshKey)))
+		})
+
+		sshKeyJSON := func(opts interface{}) []byte {
+			body, err := json.Marshal(opts)
+			Expect(err).ToNot(HaveOccurred())
+			return body
+		}
+		sshKeyBody := func(opts interface{}) io.ReadCloser {
+			return io.NopCloser(bytes.NewReader(sshKeyJSON(opts)))
+		}
+
+		It("Should add a key without its comment for the default TTL", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/addsshkey"),
+					ghttp.VerifyBody(sshKeyJSON(&v1.AddSSHKeyOptions{
+						User:       "jdoe",
+						PublicKey:  publicKey,
+						TTLSeconds: DefaultSSHKeyTTLSeconds,
+					})),
+					ghttp.RespondWith(http.StatusAccepted, ""),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			request.Request.Body = sshKeyBody(&v1.AddSSHKeyOptions{User: "jdoe", PublicKey: publicKey + " jdoe@laptop"})
+
+			app.AddSSHKeyRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("Should remove a key", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/removesshkey"),
+					ghttp.VerifyBody(sshKeyJSON(&v1.RemoveSSHKeyOptions{User: "jdoe", PublicKey: publicKey})),
+					ghttp.RespondWith(http.StatusAccepted, ""),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			request.Request.Body = sshKeyBody(&v1.RemoveSSHKeyOptions{User: "jdoe", PublicKey: publicKey})
+
+			app.RemoveSSHKeyRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+		})
+
+		It("Should fail without the guest agent connected", func() {
+			expectVMI(Running, UnPaused)
+			request.Request.Body = sshKeyBody(&v1.AddSSHKeyOptions{User: "jdoe", PublicKey: publicKey})
+
+			app.AddSSHKeyRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		DescribeTable("Should reject invalid options", func(opts *v1.AddSSHKeyOptions) {
+			if opts.PublicKey == "valid" {
+				opts.PublicKey = publicKey
+			}
+			request.Request.Body = sshKeyBody(opts)
+
+			app.AddSSHKeyRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("without a user", &v1.AddSSHKeyOptions{PublicKey: "valid"}),
+			Entry("with a user which isn't a plain name", &v1.AddSSHKeyOptions{User: `jdoe", "root`, PublicKey: "valid"}),
+			Entry("with an invalid public key", &v1.AddSSHKeyOptions{User: "jdoe", PublicKey: "ssh-ed25519 invalid"}),
+			Entry("with a too short TTL", &v1.AddSSHKeyOptions{User: "jdoe", PublicKey: "valid", TTLSeconds: MinSSHKeyTTLSeconds - 1}),
+			Entry("with a too long TTL", &v1.AddSSHKeyOptions{User: "jdoe", PublicKey: "valid", TTLSeconds: MaxSSHKeyTTLSeconds + 1}),
+		)
+	})
+
+	Context("GuestFile", func() {
+		guestFileQuery := func(opts *v1.GuestFileOptions) *url.URL {
+			query := url.Values{}
+			query.Set("path", opts.Path)
+			if opts.Permissions != "" {
+				query.Set("permissions", opts.Permissions)
+			}
+			if opts.Owner != "" {
+				query.Set("owner", opts.Owner)
+			}
+			return &url.URL{RawQuery: query.Encode()}
+		}
+
+		It("Should download a file from a running VMI with the guest agent connected", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile", "path=%2Fetc%2Fhostname"),
+					ghttp.RespondWith(http.StatusOK, "content"),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			request.Request.URL = guestFileQuery(&v1.GuestFileOptions{Path: "/etc/hostname"})
+
+			app.GuestFileDownloadRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(Equal("content"))
+		})
+
+		It("Should upload a file into a running VMI with its permissions and owner", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile", "owner=fedora&path=%2Ftmp%2Ffile&permissions=0600"),
+					ghttp.VerifyContentType("application/octet-stream"),
+					ghttp.VerifyBody([]byte("content")),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			request.Request.URL = guestFileQuery(&v1.GuestFileOptions{Path: "/tmp/file", Permissions: "0600", Owner: "fedora"})
+			request.Request.Body = io.NopCloser(strings.NewReader("content"))
+
+			app.GuestFileUploadRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+		})
+
+		It("Should return the error of the transfer", func() {
+			backend.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile"),
+					ghttp.RespondWith(http.StatusInternalServerError, "No such file or directory"),
+				),
+			)
+			expectVMI(Running, UnPaused, guestAgentConnected)
+			request.Request.URL = guestFileQuery(&v1.GuestFileOptions{Path: "/missing"})
+
+			app.GuestFileDownloadRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusInternalServerError)
+			Expect(statusErr.Error()).To(ContainSubstring("No such file or directory"))
+		})
+
+		It("Should fail without the guest agent connected", func() {
+			expectVMI(Running, UnPaused)
+			request.Request.URL = guestFileQuery(&v1.GuestFileOptions{Path: "/etc/hostname"})
+
+			app.GuestFileDownloadRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusConflict)
+		})
+
+		DescribeTable("Should reject invalid options", func(opts *v1.GuestFileOptions) {
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			request.Request.URL = guestFileQuery(opts)
+			request.Request.Body = io.NopCloser(strings.NewReader("content"))
+
+			app.GuestFileUploadRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("without a path", &v1.GuestFileOptions{}),
+			Entry("with a relative path", &v1.GuestFileOptions{Path: "tmp/file"}),
+			Entry("with non octal permissions", &v1.GuestFileOptions{Path: "/tmp/file", Permissions: "u+rw"}),
+			Entry("with an owner looking like an option", &v1.GuestFileOptions{Path: "/tmp/file", Owner: "--reference=/etc/shadow"}),
+			Entry("with a group looking like an option", &v1.GuestFileOptions{Path: "/tmp/file", Owner: "fedora:-x"}),
+		)
+
+		It("Should accept Windows paths", func() {
+			Expect(validateGuestFileOptions(&v1.GuestFileOptions{Path: `C:\Windows\Temp\file`})).To(BeNil())
+		})
+
+		It("Should reject permissions and owner for downloads", func() {
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			request.Request.URL = guestFileQuery(&v1.GuestFileOptions{Path: "/etc/hostname", Permissions: "0600"})
+
+			app.GuestFileDownloadRequestHandler(request, response)
+
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+	})
+
 	Context("SoftReboot", func() {
 		It("Should soft reboot a running VMI", func() {
 			backend.AppendHandlers(
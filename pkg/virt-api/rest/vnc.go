@@ -26,12 +26,18 @@ func (app *SubresourceAPIApp) VNCRequestHandler(request *restful.Request, respon
 	activeConnectionMetric := apimetrics.NewActiveVNCConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
 
+	session, statusErr := consoleSessionFromRequest(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
 	streamer := NewRawStreamer(
 		app.FetchVirtualMachineInstance,
 		validateVMIForVNC,
-		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		app.virtHandlerDialer(session.resolver(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.VNCURI(vmi)
-		}),
+		})),
 	)
 
 	streamer.Handle(request, response)
@@ -44,16 +50,22 @@ func (app *SubresourceAPIApp) VNCScreenshotRequestHandler(request *restful.Reque
 	activeConnectionMetric := apimetrics.NewActiveVNCConnection(request.PathParameter("namespace"), request.PathParameter("name"))
 	defer activeConnectionMetric.Dec()
 
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	name := request.PathParameter(definitions.NameParamName)
+	moveCursor := request.QueryParameter(definitions.MoveCursorParamName)
+
+	// Taking the screenshot doesn't disturb the client holding the console, and it isn't taken over to move the cursor
+	session := &consoleSession{readOnly: moveCursor != "true", exclusive: moveCursor == "true"}
+	if user, ok := request.Attribute(userAttribute).(string); ok {
+		session.user = user
+	}
 	dialer := NewDirectDialer(
 		app.FetchVirtualMachineInstance,
 		validateVMIForVNC,
-		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		app.virtHandlerDialer(session.resolver(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 			return conn.VNCURI(vmi)
-		}),
+		})),
 	)
-	namespace := request.PathParameter(definitions.NamespaceParamName)
-	name := request.PathParameter(definitions.NameParamName)
-	moveCursor := request.QueryParameter(definitions.MoveCursorParamName)
 
 	nc, statusErr := dialer.Dial(namespace, name)
 	if statusErr != nil {
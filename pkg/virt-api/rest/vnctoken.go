@@ -0,0 +1,244 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	restful "github.com/emicklei/go-restful"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/certificate"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const (
+	DefaultVNCTokenExpirationSeconds = 300
+	MinVNCTokenExpirationSeconds     = 10
+	MaxVNCTokenExpirationSeconds     = 3600
+
+	// vncTokenKeyContext separates the key of the tokens from other uses of the private key of virt-api
+	vncTokenKeyContext = "kubevirt.io/vnc-token"
+)
+
+// vncTokenClaims are what a VNC token gives access to
+type vncTokenClaims struct {
+	Namespace string    `json:"namespace"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	// User requested the token, the VNC session is reported as held by them
+	User     string `json:"user,omitempty"`
+	ReadOnly bool   `json:"readOnly,omitempty"`
+	// Expires is the Unix time after which the token is refused
+	Expires int64 `json:"expires"`
+}
+
+// vncTokenSigner signs the VNC tokens with a key derived from the private key of virt-api, which all its replicas share.
+// Rotating the certificate of virt-api invalidates the tokens issued before.
+type vncTokenSigner struct {
+	certManager certificate.Manager
+	now         func() time.Time
+}
+
+func newVNCTokenSigner(certManager certificate.Manager) *vncTokenSigner {
+	return &vncTokenSigner{
+		certManager: certManager,
+		now:         time.Now,
+	}
+}
+
+func (s *vncTokenSigner) key() ([]byte, error) {
+	if s.certManager == nil || s.certManager.Current() == nil {
+		return nil, fmt.Errorf("the certificate of virt-api is not loaded")
+	}
+	privateKey, err := x509.MarshalPKCS8PrivateKey(s.certManager.Current().PrivateKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal the private key of virt-api: %v", err)
+	}
+	mac := hmac.New(sha256.New, privateKey)
+	mac.Write([]byte(vncTokenKeyContext))
+	return mac.Sum(nil), nil
+}
+
+func (s *vncTokenSigner) signature(payload string) ([]byte, error) {
+	key, err := s.key()
+	if err != nil {
+		return nil, err
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil), nil
+}
+
+func (s *vncTokenSigner) sign(claims *vncTokenClaims) (string, error) {
+	data, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	signature, err := s.signature(payload)
+	if err != nil {
+		return "", err
+	}
+	return payload + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func (s *vncTokenSigner) verify(token string) (*vncTokenClaims, error) {
+	payload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, fmt.Errorf("malformed VNC token")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, fmt.Errorf("malformed VNC token")
+	}
+	expected, err := s.signature(payload)
+	if err != nil {
+		return nil, err
+	}
+	if !hmac.Equal(signature, expected) {
+		return nil, fmt.Errorf("invalid VNC token")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, fmt.Errorf("malformed VNC token")
+	}
+	claims := &vncTokenClaims{}
+	if err := json.Unmarshal(data, claims); err != nil {
+		return nil, fmt.Errorf("malformed VNC token")
+	}
+	if s.now().Unix() >= claims.Expires {
+		return nil, fmt.Errorf("the VNC token expired")
+	}
+	return claims, nil
+}
+
+func vncTokenExpiration(request *restful.Request) (time.Duration, *errors.StatusError) {
+	value := request.QueryParameter(definitions.ExpirationSecondsParamName)
+	if value == "" {
+		return DefaultVNCTokenExpirationSeconds * time.Second, nil
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < MinVNCTokenExpirationSeconds || seconds > MaxVNCTokenExpirationSeconds {
+		return 0, errors.NewBadRequest(fmt.Sprintf("%s must be between %d and %d", definitions.ExpirationSecondsParamName, MinVNCTokenExpirationSeconds, MaxVNCTokenExpirationSeconds))
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
+// VNCTokenRequestHandler issues a short-lived token giving access to the VNC display of the VMI through VNCWebsocketRequestHandler
+func (app *SubresourceAPIApp) VNCTokenRequestHandler(request *restful.Request, response *restful.Response) {
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	name := request.PathParameter(definitions.NameParamName)
+
+	readOnly, statusErr := boolQueryParameter(request, definitions.ReadOnlyParamName)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	expiration, statusErr := vncTokenExpiration(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validateVMIForVNC)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	expires := app.vncTokens.now().Add(expiration)
+	claims := &vncTokenClaims{
+		Namespace: namespace,
+		Name:      name,
+		UID:       vmi.UID,
+		ReadOnly:  readOnly,
+		Expires:   expires.Unix(),
+	}
+	if user, ok := request.Attribute(userAttribute).(string); ok {
+		claims.User = user
+	}
+	token, err := app.vncTokens.sign(claims)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	response.WriteEntity(&v1.VNCToken{
+		Token:               token,
+		ExpirationTimestamp: metav1.NewTime(time.Unix(claims.Expires, 0)),
+	})
+}
+
+// VNCWebsocketRequestHandler opens a websocket connection to the VNC display of the VMI a token was issued for.
+// It is meant for web clients without cluster credentials, which connect to virt-api directly: the token
+// authenticates the request instead of the kube-apiserver.
+func (app *SubresourceAPIApp) VNCWebsocketRequestHandler(request *restful.Request, response *restful.Response) {
+	claims, err := app.vncTokens.verify(request.QueryParameter(definitions.TokenParamName))
+	if err != nil {
+		writeError(errors.NewUnauthorized(err.Error()), response)
+		return
+	}
+
+	activeConnectionMetric := apimetrics.NewActiveVNCConnection(claims.Namespace, claims.Name)
+	defer activeConnectionMetric.Dec()
+
+	exclusive, statusErr := boolQueryParameter(request, definitions.ExclusiveParamName)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+	if claims.ReadOnly && exclusive {
+		writeError(errors.NewBadRequest("a read-only VNC token can't get exclusive access to the console"), response)
+		return
+	}
+	session := &consoleSession{readOnly: claims.ReadOnly, exclusive: exclusive, user: claims.User}
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		func(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+			// The token is only valid for the VMI it was issued for, not for another one reusing its name
+			if vmi.UID != claims.UID {
+				return errors.NewNotFound(v1.Resource("virtualmachineinstance"), claims.Name)
+			}
+			return validateVMIForVNC(vmi)
+		},
+		app.virtHandlerDialer(session.resolver(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.VNCURI(vmi)
+		})),
+	)
+
+	streamer.HandleVMI(claims.Namespace, claims.Name, request, response)
+}
@@ -0,0 +1,255 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+	"kubevirt.io/client-go/kubecli"
+)
+
+type fakeCertManager struct {
+	cert *tls.Certificate
+}
+
+func (f *fakeCertManager) Start()                    {}
+func (f *fakeCertManager) Stop()                     {}
+func (f *fakeCertManager) ServerHealthy() bool       { return true }
+func (f *fakeCertManager) Current() *tls.Certificate { return f.cert }
+
+func newFakeCertManager() *fakeCertManager {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	return &fakeCertManager{cert: &tls.Certificate{PrivateKey: key}}
+}
+
+var _ = Describe("VNC tokens", func() {
+	const vmiUID = types.UID("1234")
+
+	var (
+		now    time.Time
+		signer *vncTokenSigner
+	)
+
+	BeforeEach(func() {
+		now = time.Unix(1700000000, 0)
+		signer = newVNCTokenSigner(newFakeCertManager())
+		signer.now = func() time.Time { return now }
+	})
+
+	newClaims := func() *vncTokenClaims {
+		return &vncTokenClaims{
+			Namespace: k8smetav1.NamespaceDefault,
+			Name:      testVMIName,
+			UID:       vmiUID,
+			User:      "alice",
+			Expires:   now.Add(time.Minute).Unix(),
+		}
+	}
+
+	Context("signer", func() {
+		It("should verify the tokens it signed", func() {
+			token, err := signer.sign(newClaims())
+			Expect(err).ToNot(HaveOccurred())
+
+			claims, err := signer.verify(token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims).To(Equal(newClaims()))
+		})
+
+		It("should refuse an expired token", func() {
+			token, err := signer.sign(newClaims())
+			Expect(err).ToNot(HaveOccurred())
+
+			now = now.Add(time.Minute)
+			_, err = signer.verify(token)
+			Expect(err).To(MatchError("the VNC token expired"))
+		})
+
+		It("should refuse a token with altered claims", func() {
+			token, err := signer.sign(newClaims())
+			Expect(err).ToNot(HaveOccurred())
+			_, signature, _ := strings.Cut(token, ".")
+
+			claims := newClaims()
+			claims.Name = "other"
+			other, err := signer.sign(claims)
+			Expect(err).ToNot(HaveOccurred())
+			payload, _, _ := strings.Cut(other, ".")
+
+			_, err = signer.verify(payload + "." + signature)
+			Expect(err).To(MatchError("invalid VNC token"))
+		})
+
+		It("should refuse a token signed with another key", func() {
+			token, err := newVNCTokenSigner(newFakeCertManager()).sign(newClaims())
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = signer.verify(token)
+			Expect(err).To(MatchError("invalid VNC token"))
+		})
+
+		DescribeTable("should refuse a malformed token", func(token string) {
+			_, err := signer.verify(token)
+			Expect(err).To(HaveOccurred())
+		},
+			Entry("empty", ""),
+			Entry("without signature", "payload"),
+			Entry("with an invalid signature encoding", "payload.!!!"),
+		)
+
+		It("should fail without the certificate of virt-api", func() {
+			_, err := newVNCTokenSigner(&fakeCertManager{}).sign(newClaims())
+			Expect(err).To(MatchError(ContainSubstring("not loaded")))
+		})
+	})
+
+	Context("subresources", func() {
+		var (
+			app       *SubresourceAPIApp
+			vmiClient *kubecli.MockVirtualMachineInstanceInterface
+			recorder  *httptest.ResponseRecorder
+			response  *restful.Response
+		)
+
+		BeforeEach(func() {
+			ctrl := gomock.NewController(GinkgoT())
+			virtClient := kubecli.NewMockKubevirtClient(ctrl)
+			vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+			virtClient.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+			app = &SubresourceAPIApp{virtCli: virtClient, vncTokens: signer}
+			recorder = httptest.NewRecorder()
+			response = restful.NewResponse(recorder)
+			response.SetRequestAccepts(restful.MIME_JSON)
+		})
+
+		newRequest := func(query url.Values) *restful.Request {
+			request := restful.NewRequest(&http.Request{URL: &url.URL{RawQuery: query.Encode()}})
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = k8smetav1.NamespaceDefault
+			return request
+		}
+
+		expectVMI := func(uid types.UID) *v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMI(testVMIName)
+			vmi.UID = uid
+			vmi.Status.Phase = v1.Running
+			vmiClient.EXPECT().Get(context.Background(), testVMIName, &k8smetav1.GetOptions{}).Return(vmi, nil)
+			return vmi
+		}
+
+		It("should issue a token for the VMI", func() {
+			expectVMI(vmiUID)
+			request := newRequest(url.Values{"readOnly": {"true"}, "expirationSeconds": {"60"}})
+			request.SetAttribute(userAttribute, "alice")
+
+			app.VNCTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			token := &v1.VNCToken{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), token)).To(Succeed())
+			Expect(token.ExpirationTimestamp.Time.Equal(now.Add(time.Minute))).To(BeTrue())
+
+			claims, err := signer.verify(token.Token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims).To(Equal(&vncTokenClaims{
+				Namespace: k8smetav1.NamespaceDefault,
+				Name:      testVMIName,
+				UID:       vmiUID,
+				User:      "alice",
+				ReadOnly:  true,
+				Expires:   now.Add(time.Minute).Unix(),
+			}))
+		})
+
+		It("should issue a token valid for 5 minutes by default", func() {
+			expectVMI(vmiUID)
+
+			app.VNCTokenRequestHandler(newRequest(url.Values{}), response)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			token := &v1.VNCToken{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), token)).To(Succeed())
+			Expect(token.ExpirationTimestamp.Time.Equal(now.Add(5 * time.Minute))).To(BeTrue())
+		})
+
+		DescribeTable("should refuse to issue a token", func(expirationSeconds string) {
+			app.VNCTokenRequestHandler(newRequest(url.Values{"expirationSeconds": {expirationSeconds}}), response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("valid for less than 10 seconds", "5"),
+			Entry("valid for more than an hour", "3601"),
+			Entry("with an invalid expiration", "soon"),
+		)
+
+		It("should refuse to issue a token for a VMI without graphics", func() {
+			vmi := expectVMI(vmiUID)
+			autoattach := false
+			vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = &autoattach
+
+			app.VNCTokenRequestHandler(newRequest(url.Values{}), response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should refuse a websocket connection with an invalid token", func() {
+			app.VNCWebsocketRequestHandler(newRequest(url.Values{"token": {"invalid"}}), response)
+			ExpectStatusErrorWithCode(recorder, http.StatusUnauthorized)
+		})
+
+		It("should not give a read-only token exclusive access to the console", func() {
+			claims := newClaims()
+			claims.ReadOnly = true
+			token, err := signer.sign(claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			app.VNCWebsocketRequestHandler(newRequest(url.Values{"token": {token}, "exclusive": {"true"}}), response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should refuse a websocket connection to another VMI reusing the name of the token's", func() {
+			expectVMI("5678")
+			token, err := signer.sign(newClaims())
+			Expect(err).ToNot(HaveOccurred())
+
+			app.VNCWebsocketRequestHandler(newRequest(url.Values{"token": {token}}), response)
+			ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+		})
+	})
+})
@@ -49,6 +49,7 @@ func SetDefaultVirtualMachineInstance(clusterConfig *virtconfig.ClusterConfig, v
 	v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 	setDefaultHypervFeatureDependencies(&vmi.Spec)
 	setDefaultCPUArch(clusterConfig, &vmi.Spec)
+	setClusterCPUBaseline(clusterConfig, &vmi.Spec)
 	return nil
 }
 
@@ -212,6 +213,30 @@ func setDefaultCPUModel(clusterConfig *virtconfig.ClusterConfig, spec *v1.Virtua
 	}
 }
 
+// setClusterCPUBaseline replaces the cluster-baseline CPU model of a VMI with the CPU model and features
+// supported by all the nodes. VMs keep the cluster-baseline model, to get the current baseline on every start.
+func setClusterCPUBaseline(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
+	if spec.Domain.CPU == nil || spec.Domain.CPU.Model != v1.CPUModeClusterBaseline || !clusterConfig.ClusterCPUBaselineEnabled() {
+		return
+	}
+	baseline := clusterConfig.GetClusterCPUBaseline()
+	if baseline == nil || baseline.Model == "" {
+		// the model is left as is, and the VMI is rejected by the validating webhook
+		return
+	}
+
+	spec.Domain.CPU.Model = baseline.Model
+	requested := map[string]struct{}{}
+	for _, feature := range spec.Domain.CPU.Features {
+		requested[feature.Name] = struct{}{}
+	}
+	for _, feature := range baseline.Features {
+		if _, exists := requested[feature]; !exists {
+			spec.Domain.CPU.Features = append(spec.Domain.CPU.Features, v1.CPUFeature{Name: feature, Policy: "require"})
+		}
+	}
+}
+
 func setDefaultArchitecture(clusterConfig *virtconfig.ClusterConfig, spec *v1.VirtualMachineInstanceSpec) {
 	if spec.Architecture == "" {
 		spec.Architecture = clusterConfig.GetDefaultArchitecture()
@@ -245,6 +245,46 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Entry("on arm64", "arm64", v1.CPUModeHostPassthrough),
 	)
 
+	Context("with the cluster-baseline CPU model", func() {
+		updateKubeVirt := func(baseline *v1.CPUBaselineStatus) {
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, &v1.KubeVirt{
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: []string{virtconfig.ClusterCPUBaselineGate},
+						},
+					},
+				},
+				Status: v1.KubeVirtStatus{CPUBaseline: baseline},
+			})
+		}
+
+		BeforeEach(func() {
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Model:    v1.CPUModeClusterBaseline,
+				Features: []v1.CPUFeature{{Name: "pcid", Policy: "disable"}},
+			}
+		})
+
+		It("should replace it with the model and features of the cluster CPU baseline", func() {
+			updateKubeVirt(&v1.CPUBaselineStatus{Model: "Haswell-noTSX", Features: []string{"aes", "pcid"}, Nodes: 2})
+
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit("amd64")
+			Expect(vmiSpec.Domain.CPU.Model).To(Equal("Haswell-noTSX"))
+			Expect(vmiSpec.Domain.CPU.Features).To(ConsistOf(
+				v1.CPUFeature{Name: "pcid", Policy: "disable"},
+				v1.CPUFeature{Name: "aes", Policy: "require"},
+			))
+		})
+
+		It("should keep it if the cluster CPU baseline has no model", func() {
+			updateKubeVirt(&v1.CPUBaselineStatus{Nodes: 2})
+
+			_, vmiSpec, _ := getMetaSpecStatusFromAdmit("amd64")
+			Expect(vmiSpec.Domain.CPU.Model).To(Equal(v1.CPUModeClusterBaseline))
+		})
+	})
+
 	DescribeTable("it should", func(given []v1.Volume, expected []v1.Volume) {
 		vmi.Spec.Volumes = given
 		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
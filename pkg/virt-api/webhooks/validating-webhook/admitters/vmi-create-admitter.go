@@ -110,6 +110,8 @@ func (admitter *VMICreateAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 	// We only want to validate that volumes are mapped to disks or filesystems during VMI admittance, thus this logic is seperated from the above call that is shared with the VM admitter.
 	causes = append(causes, validateVirtualMachineInstanceSpecVolumeDisks(k8sfield.NewPath("spec"), &vmi.Spec)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMandatoryFields(k8sfield.NewPath("spec"), &vmi.Spec)...)
+	// The cluster-baseline CPU model of a VMI is replaced by the mutating webhook, VMs keep it.
+	causes = append(causes, validateClusterCPUBaselineResolved(k8sfield.NewPath("spec"), &vmi.Spec, admitter.ClusterConfig)...)
 	causes = append(causes, ValidateVirtualMachineInstanceMetadata(k8sfield.NewPath("metadata"), &vmi.ObjectMeta, admitter.ClusterConfig, accountName)...)
 	// In a future, yet undecided, release either libvirt or QEMU are going to check the hyperv dependencies, so we can get rid of this code.
 	causes = append(causes, webhooks.ValidateVirtualMachineInstanceHypervFeatureDependencies(k8sfield.NewPath("spec"), &vmi.Spec)...)
@@ -156,6 +158,7 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateNUMA(field, spec, config)...)
 	causes = append(causes, validateCPUIsolatorThread(field, spec)...)
 	causes = append(causes, validateCPUFeaturePolicies(field, spec)...)
+	causes = append(causes, validateClusterCPUBaseline(field, spec, config)...)
 	causes = append(causes, validateStartStrategy(field, spec)...)
 	causes = append(causes, validateRealtime(field, spec, !root)...)
 	causes = append(causes, validateSpecAffinity(field, spec)...)
@@ -220,6 +223,8 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateFilesystemsWithVirtIOFSEnabled(field, spec, config)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validateVideoDevice(field.Child("domain", "devices", "video"), spec)...)
+	causes = append(causes, validateSerialPorts(field.Child("domain", "devices", "serialPorts"), spec)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
@@ -285,6 +290,10 @@ func validateNetworksMatchInterfaces(field *k8sfield.Path, spec *v1.VirtualMachi
 		causes = append(causes, validateMacAddress(field, iface, idx)...)
 		causes = append(causes, validateInterfaceBootOrder(field, iface, idx, bootOrderMap)...)
 		causes = append(causes, validateInterfacePciAddress(field, iface, idx)...)
+		causes = append(causes, validateInterfaceSpoofCheck(field, iface, idx, config)...)
+		causes = append(causes, validateInterfaceState(field, iface, idx)...)
+		causes = append(causes, validatePasstPortForwarding(field, iface, idx)...)
+		causes = append(causes, validateInterfaceBandwidth(field, iface, idx)...)
 
 		newCauses, newDone := validateDHCPExtraOptions(field, iface)
 		causes = append(causes, newCauses...)
@@ -390,6 +399,126 @@ func validateInterfacePciAddress(field *k8sfield.Path, iface v1.Interface, idx i
 	return causes
 }
 
+func validateInterfaceSpoofCheck(field *k8sfield.Path, iface v1.Interface, idx int, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if iface.SpoofCheck == nil || !*iface.SpoofCheck {
+		return causes
+	}
+	if !config.InterfaceSpoofCheckEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled", virtconfig.InterfaceSpoofCheckGate),
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("spoofCheck").String(),
+		})
+	} else if iface.Bridge == nil && iface.Masquerade == nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "spoofCheck is only supported with the bridge and masquerade bindings",
+			Field:   field.Child("domain", "devices", "interfaces").Index(idx).Child("spoofCheck").String(),
+		})
+	}
+	return causes
+}
+
+func validatePasstPortForwarding(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.Passt == nil {
+		return causes
+	}
+	portForwardingField := field.Child("domain", "devices", "interfaces").Index(idx).Child("passt", "portForwarding").String()
+	switch iface.Passt.PortForwarding {
+	case "":
+	case v1.PasstPortForwardingAll:
+		if len(iface.Ports) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("ports must not be specified when port forwarding is %s", v1.PasstPortForwardingAll),
+				Field:   portForwardingField,
+			})
+		}
+	case v1.PasstPortForwardingList:
+		if len(iface.Ports) == 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("ports must be specified when port forwarding is %s", v1.PasstPortForwardingList),
+				Field:   portForwardingField,
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("port forwarding %q is not supported, it must be one of: %s, %s", iface.Passt.PortForwarding, v1.PasstPortForwardingAll, v1.PasstPortForwardingList),
+			Field:   portForwardingField,
+		})
+	}
+	return causes
+}
+
+func validateInterfaceState(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	stateField := field.Child("domain", "devices", "interfaces").Index(idx).Child("state").String()
+	switch iface.State {
+	case "":
+	case v1.InterfaceStateLinkUp, v1.InterfaceStateLinkDown:
+		if iface.SRIOV != nil {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: "state is not supported with the SR-IOV binding",
+				Field:   stateField,
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("state %q is not supported, it must be one of: %s, %s", iface.State, v1.InterfaceStateLinkUp, v1.InterfaceStateLinkDown),
+			Field:   stateField,
+		})
+	}
+	return causes
+}
+
+func validateInterfaceBandwidth(field *k8sfield.Path, iface v1.Interface, idx int) (causes []metav1.StatusCause) {
+	if iface.Bandwidth == nil {
+		return causes
+	}
+	bandwidthField := field.Child("domain", "devices", "interfaces").Index(idx).Child("bandwidth")
+	if iface.Bridge == nil && iface.Masquerade == nil && iface.Macvtap == nil {
+		return append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "bandwidth is only supported with the bridge, masquerade and macvtap bindings",
+			Field:   bandwidthField.String(),
+		})
+	}
+	causes = append(causes, validateBandwidthLimits(bandwidthField.Child("inbound"), iface.Bandwidth.Inbound)...)
+	causes = append(causes, validateBandwidthLimits(bandwidthField.Child("outbound"), iface.Bandwidth.Outbound)...)
+	return causes
+}
+
+func validateBandwidthLimits(field *k8sfield.Path, limits *v1.BandwidthLimits) (causes []metav1.StatusCause) {
+	if limits == nil {
+		return causes
+	}
+	if limits.Average.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", field.Child("average").String()),
+			Field:   field.Child("average").String(),
+		})
+	}
+	if limits.Peak != nil && limits.Peak.Cmp(limits.Average) < 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must not be lower than the average rate", field.Child("peak").String()),
+			Field:   field.Child("peak").String(),
+		})
+	}
+	if limits.Burst != nil && limits.Burst.Sign() <= 0 {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be greater than 0", field.Child("burst").String()),
+			Field:   field.Child("burst").String(),
+		})
+	}
+	return causes
+}
+
 func validateInterfaceBootOrder(field *k8sfield.Path, iface v1.Interface, idx int, bootOrderMap map[uint]bool) (causes []metav1.StatusCause) {
 	if iface.BootOrder != nil {
 		order := *iface.BootOrder
@@ -853,6 +982,18 @@ func validateHostDevicesWithPassthroughEnabled(field *k8sfield.Path, spec *v1.Vi
 			Field:   field.Child("HostDevices").String(),
 		})
 	}
+	if config.HotplugHostDevicesEnabled() {
+		return causes
+	}
+	for idx, hostDevice := range spec.Domain.Devices.HostDevices {
+		if hostDevice.Hotpluggable {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config, host device %s can't be hotpluggable", virtconfig.HotplugHostDevicesGate, hostDevice.Name),
+				Field:   field.Child("domain", "devices", "hostDevices").Index(idx).Child("hotpluggable").String(),
+			})
+		}
+	}
 	return causes
 }
 
@@ -878,6 +1019,94 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validateVideoDevice(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	video := spec.Domain.Devices.Video
+	if video == nil {
+		return causes
+	}
+	if spec.Domain.Devices.AutoattachGraphicsDevice != nil && !*spec.Domain.Devices.AutoattachGraphicsDevice {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires the graphics device to be attached", field.String()),
+			Field:   field.String(),
+		})
+	}
+	switch video.Type {
+	case "", v1.VideoTypeVirtio:
+	case v1.VideoTypeBochs, v1.VideoTypeVGA:
+		if spec.Architecture == "arm64" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s %s is not supported on arm64, only virtio is", field.Child("type").String(), video.Type),
+				Field:   field.Child("type").String(),
+			})
+		}
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s %s is not supported. Options: 'virtio', 'bochs' or 'vga'", field.Child("type").String(), video.Type),
+			Field:   field.Child("type").String(),
+		})
+	}
+	if video.Resolution != nil && (video.Resolution.Width == 0 || video.Resolution.Height == 0) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must have a width and a height greater than 0", field.Child("resolution").String()),
+			Field:   field.Child("resolution").String(),
+		})
+	}
+	return causes
+}
+
+// maxISASerialPorts is the number of ISA serial ports QEMU emulates on x86, including the serial console
+const maxISASerialPorts = 4
+
+func validateSerialPorts(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
+	isaSerialPorts := 0
+	if spec.Domain.Devices.AutoattachSerialConsole == nil || *spec.Domain.Devices.AutoattachSerialConsole {
+		isaSerialPorts++
+	}
+	names := map[string]struct{}{}
+	for i, port := range spec.Domain.Devices.SerialPorts {
+		portField := field.Index(i)
+		if errs := validation.IsDNS1123Label(port.Name); len(errs) > 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid serial port name: %s", portField.Child("name").String(), strings.Join(errs, ", ")),
+				Field:   portField.Child("name").String(),
+			})
+		}
+		if _, exists := names[port.Name]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s %s is already used by another serial port", portField.Child("name").String(), port.Name),
+				Field:   portField.Child("name").String(),
+			})
+		}
+		names[port.Name] = struct{}{}
+
+		switch port.Type {
+		case "", v1.SerialPortTypeVirtio:
+		case v1.SerialPortTypeSerial:
+			isaSerialPorts++
+			if isaSerialPorts > maxISASerialPorts {
+				causes = append(causes, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("%s exceeds the %d serial ports supported, including the serial console", portField.String(), maxISASerialPorts),
+					Field:   portField.Child("type").String(),
+				})
+			}
+		default:
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Message: fmt.Sprintf("%s %s is not supported. Options: 'serial' or 'virtio'", portField.Child("type").String(), port.Type),
+				Field:   portField.Child("type").String(),
+			})
+		}
+	}
+	return causes
+}
+
 func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
 	launchSecurity := spec.Domain.LaunchSecurity
 	if launchSecurity != nil && !config.WorkloadEncryptionSEVEnabled() {
@@ -1096,6 +1325,28 @@ func validateCPUFeaturePolicies(field *k8sfield.Path, spec *v1.VirtualMachineIns
 	return causes
 }
 
+func validateClusterCPUBaseline(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if spec.Domain.CPU != nil && spec.Domain.CPU.Model == v1.CPUModeClusterBaseline && !config.ClusterCPUBaselineEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s CPU model requires the %s feature gate", v1.CPUModeClusterBaseline, virtconfig.ClusterCPUBaselineGate),
+			Field:   field.Child("domain", "cpu", "model").String(),
+		})
+	}
+	return causes
+}
+
+func validateClusterCPUBaselineResolved(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	if spec.Domain.CPU != nil && spec.Domain.CPU.Model == v1.CPUModeClusterBaseline && config.ClusterCPUBaselineEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "the nodes of the cluster have no CPU model in common, or the cluster CPU baseline is not computed yet",
+			Field:   field.Child("domain", "cpu", "model").String(),
+		})
+	}
+	return causes
+}
+
 func validateCPUIsolatorThread(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.CPU != nil && spec.Domain.CPU.IsolateEmulatorThread && !spec.Domain.CPU.DedicatedCPUPlacement {
 		causes = append(causes, metav1.StatusCause{
@@ -1152,9 +1403,116 @@ func validateNUMA(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, con
 			})
 		}
 	}
+	if spec.Domain.CPU != nil && spec.Domain.CPU.NUMA != nil && len(spec.Domain.CPU.NUMA.Cells) > 0 {
+		causes = append(causes, validateNUMACells(field.Child("domain", "cpu", "numa"), spec, config)...)
+	}
+	return causes
+}
+
+// maxNUMACellsVCPUs bounds the expansion of the vCPU sets of the NUMA cells
+const maxNUMACellsVCPUs = 1024
+
+func validateNUMACells(numaField *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
+	field := numaField.Child("cells")
+	invalid := func(field *k8sfield.Path, format string, a ...interface{}) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf(format, a...),
+			Field:   field.String(),
+		})
+	}
+
+	if !config.NUMAEnabled() {
+		invalid(field, "NUMA feature gate is not enabled in kubevirt-config, invalid entry %s", field.String())
+	}
+	if spec.Domain.CPU.NUMA.GuestMappingPassthrough != nil {
+		invalid(field, "%s and %s are mutually exclusive", field.String(), numaField.Child("guestMappingPassthrough").String())
+		return causes
+	}
+
+	var pageSize *resource.Quantity
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Hugepages != nil {
+		if quantity, err := resource.ParseQuantity(spec.Domain.Memory.Hugepages.PageSize); err == nil && quantity.Value() > 0 {
+			pageSize = &quantity
+		}
+	}
+
+	cells := spec.Domain.CPU.NUMA.Cells
+	vcpus := map[int]struct{}{}
+	cpuSetsValid := true
+	totalMemory := resource.NewQuantity(0, resource.BinarySI)
+	for i, cell := range cells {
+		cellField := field.Index(i)
+		if cell.ID != uint32(i) {
+			invalid(cellField.Child("id"), "%s must be %d, the cells must be numbered in order from 0", cellField.Child("id").String(), i)
+		}
+
+		cpus, err := hwutil.ParseCPUSetLine(cell.CPUSet, maxNUMACellsVCPUs)
+		if err != nil || len(cpus) == 0 {
+			invalid(cellField.Child("cpuSet"), "%s is not a valid vCPU set: %q", cellField.Child("cpuSet").String(), cell.CPUSet)
+			cpuSetsValid = false
+		}
+		for _, cpu := range cpus {
+			if _, exists := vcpus[cpu]; exists {
+				invalid(cellField.Child("cpuSet"), "vCPU %d of %s is already assigned to another cell", cpu, cellField.Child("cpuSet").String())
+			}
+			vcpus[cpu] = struct{}{}
+		}
+
+		if cell.Memory.Sign() <= 0 {
+			invalid(cellField.Child("memory"), "%s must be greater than 0", cellField.Child("memory").String())
+		} else if pageSize != nil && cell.Memory.Value()%pageSize.Value() != 0 {
+			invalid(cellField.Child("memory"), "%s must be a multiple of the hugepage size %s", cellField.Child("memory").String(), pageSize.String())
+		}
+		totalMemory.Add(cell.Memory)
+
+		for j, distance := range cell.Distances {
+			if int(distance.CellID) >= len(cells) {
+				distanceField := cellField.Child("distances").Index(j).Child("cellID")
+				invalid(distanceField, "%s refers to the unknown cell %d", distanceField.String(), distance.CellID)
+			}
+		}
+
+		if cell.HostNodeSet != "" {
+			if _, err := hwutil.ParseCPUSetLine(cell.HostNodeSet, maxNUMACellsVCPUs); err != nil {
+				invalid(cellField.Child("hostNodeSet"), "%s is not a valid host NUMA node set: %q", cellField.Child("hostNodeSet").String(), cell.HostNodeSet)
+			}
+		}
+	}
+
+	// The coverage of the vCPUs can only be checked once all the sets are valid
+	if cpuSetsValid {
+		for cpu := range vcpus {
+			if cpu < 0 || cpu >= len(vcpus) {
+				invalid(field, "the vCPUs of %s must cover the range 0-%d without gaps", field.String(), len(vcpus)-1)
+				break
+			}
+		}
+		if requested := hwutil.GetNumberOfVCPUs(spec.Domain.CPU); requested > 0 && requested != int64(len(vcpus)) {
+			invalid(field, "the cells of %s hold %d vCPUs, while %d are requested", field.String(), len(vcpus), requested)
+		}
+	}
+
+	if guestMemory := numaGuestMemory(spec); guestMemory != nil && guestMemory.Cmp(*totalMemory) != 0 {
+		invalid(field, "the memory of the cells of %s adds up to %s, while the guest has %s", field.String(), totalMemory.String(), guestMemory.String())
+	}
 	return causes
 }
 
+// numaGuestMemory returns the memory of the guest, if known
+func numaGuestMemory(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return spec.Domain.Memory.Guest
+	}
+	if memory, exists := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; exists {
+		return &memory
+	}
+	if memory, exists := spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; exists {
+		return &memory
+	}
+	return nil
+}
+
 func validateThreadCountOnDedicatedCPUPlacement(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec) (causes []metav1.StatusCause) {
 	if spec.Domain.CPU != nil && spec.Domain.CPU.Threads > 2 {
 		causes = append(causes, metav1.StatusCause{
@@ -2643,11 +3001,11 @@ func validatePersistentReservation(field *k8sfield.Path, spec *v1.VirtualMachine
 }
 
 func validatePersistentState(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) (causes []metav1.StatusCause) {
-	if !backendstorage.HasPersistentTPMDevice(spec) {
+	if config.VMPersistentStateEnabled() {
 		return
 	}
 
-	if !config.VMPersistentStateEnabled() {
+	if backendstorage.HasPersistentTPMDevice(spec) {
 		causes = append(causes, metav1.StatusCause{
 			Type:    metav1.CauseTypeFieldValueInvalid,
 			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMPersistentState),
@@ -2655,5 +3013,13 @@ func validatePersistentState(field *k8sfield.Path, spec *v1.VirtualMachineInstan
 		})
 	}
 
+	if backendstorage.HasPersistentEFI(spec) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", virtconfig.VMPersistentState),
+			Field:   field.Child("domain", "firmware", "bootloader", "efi", "persistent").String(),
+		})
+	}
+
 	return
 }
@@ -139,6 +139,32 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		Expect(resp.Result.Message).To(ContainSubstring("no memory requested"))
 	})
 
+	DescribeTable("should validate the cluster-baseline CPU model", func(featureGate string, expectedMessage string) {
+		if featureGate != "" {
+			enableFeatureGate(featureGate)
+		}
+		vmi := api.NewMinimalVMI("testvmi")
+		vmi.Spec.Domain.CPU = &v1.CPU{Model: v1.CPUModeClusterBaseline}
+		vmiBytes, _ := json.Marshal(&vmi)
+
+		ar := &admissionv1.AdmissionReview{
+			Request: &admissionv1.AdmissionRequest{
+				Resource: webhooks.VirtualMachineInstanceGroupVersionResource,
+				Object: runtime.RawExtension{
+					Raw: vmiBytes,
+				},
+			},
+		}
+		resp := vmiCreateAdmitter.Admit(ar)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Field).To(Equal("spec.domain.cpu.model"))
+		Expect(resp.Result.Details.Causes[0].Message).To(ContainSubstring(expectedMessage))
+	},
+		Entry("without the feature gate", "", "requires the ClusterCPUBaseline feature gate"),
+		Entry("if it was not replaced by the cluster CPU baseline", virtconfig.ClusterCPUBaselineGate, "no CPU model in common"),
+	)
+
 	It("should allow Clock without Timer", func() {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Domain.Clock = &v1.Clock{
@@ -625,18 +651,21 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				Expect(causes).To(BeEmpty())
 			}
 		})
-		It("should reject unsupported audio devices", func() {
+		DescribeTable("should reject unsupported audio devices", func(model string) {
 			vmi := api.NewMinimalVMI("testvmi")
 
 			vmi.Spec.Domain.Devices.Sound = &v1.SoundDevice{
 				Name:  "audio-device",
-				Model: "aNotSupportedDevice",
+				Model: model,
 			}
 
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(Equal("fake.Sound"))
-		})
+		},
+			Entry("with an unknown model", "aNotSupportedDevice"),
+			Entry("with virtio, which the libvirt and QEMU of virt-launcher don't support", "virtio"),
+		)
 		It("should reject audio devices without name fields", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
@@ -648,6 +677,49 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(Equal("fake.Sound"))
 		})
+		It("should accept a video device and additional serial ports", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Video = &v1.VideoDevice{
+				Type:       v1.VideoTypeVirtio,
+				Resolution: &v1.VideoResolution{Width: 1920, Height: 1080},
+			}
+			vmi.Spec.Domain.Devices.SerialPorts = []v1.SerialPort{
+				{Name: "debug", Type: v1.SerialPortTypeSerial},
+				{Name: "logs"},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		DescribeTable("should reject invalid video devices", func(video *v1.VideoDevice, architecture string, autoattachGraphics *bool, field string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Architecture = architecture
+			vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = autoattachGraphics
+			vmi.Spec.Domain.Devices.Video = video
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(ContainElement(HaveField("Field", field)))
+		},
+			Entry("with an unknown type", &v1.VideoDevice{Type: "cirrus"}, "", nil, "fake.domain.devices.video.type"),
+			Entry("with vga on arm64", &v1.VideoDevice{Type: v1.VideoTypeVGA}, "arm64", nil, "fake.domain.devices.video.type"),
+			Entry("with an empty resolution", &v1.VideoDevice{Resolution: &v1.VideoResolution{Width: 1920}}, "", nil, "fake.domain.devices.video.resolution"),
+			Entry("without graphics device", &v1.VideoDevice{Type: v1.VideoTypeVirtio}, "", pointer.Bool(false), "fake.domain.devices.video"),
+		)
+		DescribeTable("should reject invalid serial ports", func(serialPorts []v1.SerialPort, field string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.SerialPorts = serialPorts
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+		},
+			Entry("with an invalid name", []v1.SerialPort{{Name: "Debug_Port"}}, "fake.domain.devices.serialPorts[0].name"),
+			Entry("with a duplicate name", []v1.SerialPort{{Name: "debug"}, {Name: "debug", Type: v1.SerialPortTypeSerial}}, "fake.domain.devices.serialPorts[1].name"),
+			Entry("with an unknown type", []v1.SerialPort{{Name: "debug", Type: "usb"}}, "fake.domain.devices.serialPorts[0].type"),
+			Entry("with too many ISA serial ports", []v1.SerialPort{
+				{Name: "port1", Type: v1.SerialPortTypeSerial},
+				{Name: "port2", Type: v1.SerialPortTypeSerial},
+				{Name: "port3", Type: v1.SerialPortTypeSerial},
+				{Name: "port4", Type: v1.SerialPortTypeSerial},
+			}, "fake.domain.devices.serialPorts[3].type"),
+		)
 		It("should reject volume with missing disk / file system", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
@@ -1555,6 +1627,145 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vm.Spec, config)
 			Expect(causes).To(HaveLen(1))
 		})
+		DescribeTable("should validate the passt port forwarding mode", func(portForwarding v1.PasstPortForwarding, ports []v1.Port, expectedCauses int) {
+			enableFeatureGate(virtconfig.PasstGate)
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+				Name: "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{
+					Passt: &v1.InterfacePasst{PortForwarding: portForwarding},
+				},
+				Ports: ports,
+			}}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			for _, cause := range causes {
+				Expect(cause.Field).To(Equal("fake.domain.devices.interfaces[0].passt.portForwarding"))
+			}
+		},
+			Entry("accept All without ports", v1.PasstPortForwardingAll, nil, 0),
+			Entry("accept List with ports", v1.PasstPortForwardingList, []v1.Port{{Port: 80}}, 0),
+			Entry("reject All with ports", v1.PasstPortForwardingAll, []v1.Port{{Port: 80}}, 1),
+			Entry("reject List without ports", v1.PasstPortForwardingList, nil, 1),
+			Entry("reject an unknown mode", v1.PasstPortForwarding("Some"), nil, 1),
+		)
+		Context("with spoof check", func() {
+			newVMIWithSpoofCheck := func(bindingMethod v1.InterfaceBindingMethod) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+					Name:                   "default",
+					InterfaceBindingMethod: bindingMethod,
+					SpoofCheck:             pointer.Bool(true),
+				}}
+				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+				return vmi
+			}
+
+			It("should reject an interface requesting spoof check when the feature gate is disabled", func() {
+				vmi := newVMIWithSpoofCheck(v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}})
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].spoofCheck"))
+			})
+
+			DescribeTable("should accept spoof check", func(bindingMethod v1.InterfaceBindingMethod) {
+				enableFeatureGate(virtconfig.InterfaceSpoofCheckGate)
+				vmi := newVMIWithSpoofCheck(bindingMethod)
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			},
+				Entry("on a bridge interface", v1.InterfaceBindingMethod{Bridge: &v1.InterfaceBridge{}}),
+				Entry("on a masquerade interface", v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}),
+			)
+
+			It("should reject spoof check on a slirp interface", func() {
+				kvConfig := kv.DeepCopy()
+				kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = []string{virtconfig.InterfaceSpoofCheckGate}
+				kvConfig.Spec.Configuration.NetworkConfiguration = &v1.NetworkConfiguration{
+					PermitSlirpInterface: pointer.Bool(true),
+				}
+				testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+				vmi := newVMIWithSpoofCheck(v1.InterfaceBindingMethod{Slirp: &v1.InterfaceSlirp{}})
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Message).To(Equal("spoofCheck is only supported with the bridge and masquerade bindings"))
+			})
+		})
+		Context("with link state and bandwidth", func() {
+			newVMIWithInterface := func(iface v1.Interface) *v1.VirtualMachineInstance {
+				vmi := api.NewMinimalVMI("testvm")
+				iface.Name = "default"
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{iface}
+				vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+				return vmi
+			}
+			masquerade := v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}}
+
+			DescribeTable("should accept the link state", func(state v1.InterfaceState) {
+				vmi := newVMIWithInterface(v1.Interface{InterfaceBindingMethod: masquerade, State: state})
+
+				Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			},
+				Entry("up", v1.InterfaceStateLinkUp),
+				Entry("down", v1.InterfaceStateLinkDown),
+			)
+
+			It("should reject an unknown link state", func() {
+				vmi := newVMIWithInterface(v1.Interface{InterfaceBindingMethod: masquerade, State: "unplugged"})
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].state"))
+			})
+
+			It("should accept valid bandwidth limits", func() {
+				peak := resource.MustParse("2Mi")
+				vmi := newVMIWithInterface(v1.Interface{
+					InterfaceBindingMethod: masquerade,
+					Bandwidth: &v1.InterfaceBandwidth{
+						Inbound:  &v1.BandwidthLimits{Average: resource.MustParse("1Mi"), Peak: &peak},
+						Outbound: &v1.BandwidthLimits{Average: resource.MustParse("1Mi")},
+					},
+				})
+
+				Expect(ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)).To(BeEmpty())
+			})
+
+			It("should reject a peak rate lower than the average and a zero average", func() {
+				peak := resource.MustParse("1Ki")
+				vmi := newVMIWithInterface(v1.Interface{
+					InterfaceBindingMethod: masquerade,
+					Bandwidth: &v1.InterfaceBandwidth{
+						Inbound:  &v1.BandwidthLimits{Average: resource.MustParse("1Mi"), Peak: &peak},
+						Outbound: &v1.BandwidthLimits{},
+					},
+				})
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(2))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].bandwidth.inbound.peak"))
+				Expect(causes[1].Field).To(Equal("fake.domain.devices.interfaces[0].bandwidth.outbound.average"))
+			})
+
+			It("should reject bandwidth limits on a slirp interface", func() {
+				enableSlirpInterface()
+				vmi := newVMIWithInterface(v1.Interface{
+					InterfaceBindingMethod: v1.InterfaceBindingMethod{Slirp: &v1.InterfaceSlirp{}},
+					Bandwidth: &v1.InterfaceBandwidth{
+						Inbound: &v1.BandwidthLimits{Average: resource.MustParse("1Mi")},
+					},
+				})
+
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.interfaces[0].bandwidth"))
+			})
+		})
 		It("should accept networks with a pod network source and slirp interface with port", func() {
 			enableSlirpInterface()
 			vm := api.NewMinimalVMI("testvm")
@@ -2226,6 +2437,31 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 			Expect(causes).To(BeEmpty())
 		})
+		DescribeTable("should validate hotpluggable host devices against the HotplugHostDevices feature gate", func(featureGates []string, expectedCauses int) {
+			kvConfig := kv.DeepCopy()
+			kvConfig.Spec.Configuration.DeveloperConfiguration.FeatureGates = featureGates
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kvConfig)
+			vmi := api.NewMinimalVMI("testvm")
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
+				{
+					Name:       "hostdev1",
+					DeviceName: "example.org/deadbeef",
+				},
+				{
+					Name:         "hostdev2",
+					DeviceName:   "example.org/deadbeef",
+					Hotpluggable: true,
+				},
+			}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(expectedCauses))
+			if expectedCauses > 0 {
+				Expect(causes[0].Field).To(Equal("fake.domain.devices.hostDevices[1].hotpluggable"))
+			}
+		},
+			Entry("should reject without the feature gate", []string{virtconfig.HostDevicesGate}, 1),
+			Entry("should accept with the feature gate", []string{virtconfig.HostDevicesGate, virtconfig.HotplugHostDevicesGate}, 0),
+		)
 		DescribeTable("Should accept valid DNSPolicy and DNSConfig",
 			func(dnsPolicy k8sv1.DNSPolicy, dnsConfig *k8sv1.PodDNSConfig) {
 				vmi := api.NewMinimalVMI("testvmi")
@@ -2424,6 +2660,79 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with explicit NUMA cells", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Cores: 4,
+				NUMA: &v1.NUMA{Cells: []v1.NUMACell{
+					{ID: 0, CPUSet: "0-1", Memory: resource.MustParse("1Gi"), Distances: []v1.NUMADistance{{CellID: 1, Value: 20}}, HostNodeSet: "0"},
+					{ID: 1, CPUSet: "2,3", Memory: resource.MustParse("1Gi")},
+				}},
+			}
+			vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{
+				k8sv1.ResourceMemory: resource.MustParse("2Gi"),
+			}
+			enableFeatureGate(virtconfig.NUMAFeatureGate)
+		})
+		It("should accept cells covering all the vCPUs and the memory without dedicated CPUs", func() {
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		It("should reject cells without the NUMA feature gate", func() {
+			disableFeatureGates()
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.cpu.numa.cells"))
+			Expect(causes[0].Message).To(ContainSubstring("NUMA feature gate"))
+		})
+		DescribeTable("should reject", func(mutate func(vmi *v1.VirtualMachineInstance), field, message string) {
+			mutate(vmi)
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(field))
+			Expect(causes[0].Message).To(ContainSubstring(message))
+		},
+			Entry("cells combined with NUMA passthrough", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.NUMA.GuestMappingPassthrough = &v1.NUMAGuestMappingPassthrough{}
+				vmi.Spec.Domain.CPU.DedicatedCPUPlacement = true
+				vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "2Mi"}}
+				vmi.Spec.Domain.Resources.Limits = k8sv1.ResourceList{k8sv1.ResourceCPU: resource.MustParse("4")}
+			}, "fake.domain.cpu.numa.cells", "mutually exclusive"),
+			Entry("cells numbered out of order", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.NUMA.Cells[1].ID = 2
+			}, "fake.domain.cpu.numa.cells[1].id", "must be 1"),
+			Entry("an invalid vCPU set", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.NUMA.Cells[1].CPUSet = "2-a"
+			}, "fake.domain.cpu.numa.cells[1].cpuSet", "not a valid vCPU set"),
+			Entry("overlapping vCPU sets", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.Cores = 3
+				vmi.Spec.Domain.CPU.NUMA.Cells[1].CPUSet = "1-2"
+			}, "fake.domain.cpu.numa.cells[1].cpuSet", "already assigned"),
+			Entry("vCPU sets with gaps", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.NUMA.Cells[1].CPUSet = "2,4"
+			}, "fake.domain.cpu.numa.cells", "without gaps"),
+			Entry("vCPU sets not matching the topology", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.Cores = 8
+			}, "fake.domain.cpu.numa.cells", "while 8 are requested"),
+			Entry("memory not matching the guest memory", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.NUMA.Cells[1].Memory = resource.MustParse("512Mi")
+			}, "fake.domain.cpu.numa.cells", "while the guest has 2Gi"),
+			Entry("memory which is not a multiple of the hugepage size", func(vmi *v1.VirtualMachineInstance) {
+				guest := resource.MustParse("1536Mi")
+				vmi.Spec.Domain.Memory = &v1.Memory{Hugepages: &v1.Hugepages{PageSize: "1Gi"}, Guest: &guest}
+				vmi.Spec.Domain.CPU.NUMA.Cells[1].Memory = resource.MustParse("512Mi")
+			}, "fake.domain.cpu.numa.cells[1].memory", "multiple of the hugepage size"),
+			Entry("a distance to an unknown cell", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.NUMA.Cells[0].Distances[0].CellID = 2
+			}, "fake.domain.cpu.numa.cells[0].distances[0].cellID", "unknown cell 2"),
+			Entry("an invalid host node set", func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.CPU.NUMA.Cells[0].HostNodeSet = "a"
+			}, "fake.domain.cpu.numa.cells[0].hostNodeSet", "not a valid host NUMA node set"),
+		)
+	})
+
 	Context("with cpu pinning", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
@@ -4610,6 +4919,11 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		addPersistentTPM := func(vmi *v1.VirtualMachineInstance) {
 			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{Persistent: pointer.BoolPtr(true)}
 		}
+		addPersistentEFI := func(vmi *v1.VirtualMachineInstance) {
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{EFI: &v1.EFI{SecureBoot: pointer.BoolPtr(false), Persistent: pointer.BoolPtr(true)}},
+			}
+		}
 		BeforeEach(func() {
 			vmi = api.NewMinimalVMI("testvmi")
 			enableFeatureGate(virtconfig.VMPersistentState)
@@ -4624,6 +4938,11 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
 				Expect(causes).To(BeEmpty())
 			})
+			It("should accept vmi with persistent EFI defined", func() {
+				addPersistentEFI(vmi)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(BeEmpty())
+			})
 		})
 		Context("feature gate disabled", func() {
 			It("should reject when the feature gate is disabled", func() {
@@ -4634,6 +4953,14 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 				Expect(causes[0].Field).To(ContainSubstring("domain.devices.tpm.persistent"))
 				Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", virtconfig.VMPersistentState)))
 			})
+			It("should reject persistent EFI when the feature gate is disabled", func() {
+				disableFeatureGates()
+				addPersistentEFI(vmi)
+				causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+				Expect(causes).To(HaveLen(1))
+				Expect(causes[0].Field).To(ContainSubstring("domain.firmware.bootloader.efi.persistent"))
+				Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", virtconfig.VMPersistentState)))
+			})
 		})
 	})
 
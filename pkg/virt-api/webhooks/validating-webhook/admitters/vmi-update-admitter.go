@@ -58,6 +58,15 @@ func (admitter *VMIUpdateAdmitter) Admit(ar *admissionv1.AdmissionReview) *admis
 			if hotplugResponse != nil {
 				return hotplugResponse
 			}
+			if hostDeviceResponse := admitHostDeviceHotplug(newVMI.Spec.Domain.Devices.HostDevices, oldVMI.Spec.Domain.Devices.HostDevices, admitter.ClusterConfig.GetPermittedHostDevices()); hostDeviceResponse != nil {
+				return hostDeviceResponse
+			}
+			if gpuResponse := admitGPUHotplug(newVMI.Spec.Domain.Devices.GPUs, oldVMI.Spec.Domain.Devices.GPUs); gpuResponse != nil {
+				return gpuResponse
+			}
+			if interfacesResponse := admitInterfacesUpdate(newVMI.Spec.Domain.Devices.Interfaces, oldVMI.Spec.Domain.Devices.Interfaces); interfacesResponse != nil {
+				return interfacesResponse
+			}
 		} else {
 			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
 				{
@@ -232,6 +241,97 @@ func verifyPermanentVolumes(newPermanentVolumeMap, oldPermanentVolumeMap map[str
 	return nil
 }
 
+// admitHostDeviceHotplug ensures that only hotpluggable host devices are added or removed, that they are not USB devices,
+// and that existing host devices are not modified.
+func admitHostDeviceHotplug(newHostDevices, oldHostDevices []v1.HostDevice, permittedHostDevices *v1.PermittedHostDevices) *admissionv1.AdmissionResponse {
+	usbResources := make(map[string]bool)
+	if permittedHostDevices != nil {
+		for _, usbHostDevice := range permittedHostDevices.USBHostDevices {
+			usbResources[usbHostDevice.ResourceName] = true
+		}
+	}
+	oldHostDeviceMap := make(map[string]v1.HostDevice)
+	for _, hostDevice := range oldHostDevices {
+		oldHostDeviceMap[hostDevice.Name] = hostDevice
+	}
+	newHostDeviceMap := make(map[string]v1.HostDevice)
+	for _, hostDevice := range newHostDevices {
+		newHostDeviceMap[hostDevice.Name] = hostDevice
+		oldHostDevice, exists := oldHostDeviceMap[hostDevice.Name]
+		if !exists && !hostDevice.Hotpluggable {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("host device %s can't be added to a running VMI without being hotpluggable", hostDevice.Name),
+				},
+			})
+		}
+		if !exists && usbResources[hostDevice.DeviceName] {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Message: fmt.Sprintf("host device %s is a USB device, USB devices can't be hotplugged", hostDevice.Name),
+				},
+			})
+		}
+		if exists && !equality.Semantic.DeepEqual(hostDevice, oldHostDevice) {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("host device %s, changed", hostDevice.Name),
+				},
+			})
+		}
+	}
+	for _, hostDevice := range oldHostDevices {
+		if _, exists := newHostDeviceMap[hostDevice.Name]; !exists && !hostDevice.Hotpluggable {
+			return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+				{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Message: fmt.Sprintf("permanent host device %s, removed", hostDevice.Name),
+				},
+			})
+		}
+	}
+	return nil
+}
+
+// admitGPUHotplug rejects any change of the GPUs, only host devices can be hotplugged.
+func admitGPUHotplug(newGPUs, oldGPUs []v1.GPU) *admissionv1.AdmissionResponse {
+	if equality.Semantic.DeepEqual(newGPUs, oldGPUs) {
+		return nil
+	}
+	return webhookutils.ToAdmissionResponse([]metav1.StatusCause{
+		{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: "GPUs can't be hotplugged, a GPU can be hotplugged as a hotpluggable host device instead",
+		},
+	})
+}
+
+// admitInterfacesUpdate validates the link state and the bandwidth of the interfaces updated on a running VMI
+func admitInterfacesUpdate(newIfaces, oldIfaces []v1.Interface) *admissionv1.AdmissionResponse {
+	oldIfacesByName := map[string]v1.Interface{}
+	for _, iface := range oldIfaces {
+		oldIfacesByName[iface.Name] = iface
+	}
+
+	field := k8sfield.NewPath("spec")
+	var causes []metav1.StatusCause
+	for idx, iface := range newIfaces {
+		oldIface, exists := oldIfacesByName[iface.Name]
+		if exists && iface.State == oldIface.State && equality.Semantic.DeepEqual(iface.Bandwidth, oldIface.Bandwidth) {
+			continue
+		}
+		causes = append(causes, validateInterfaceState(field, iface, idx)...)
+		causes = append(causes, validateInterfaceBandwidth(field, iface, idx)...)
+	}
+	if len(causes) > 0 {
+		return webhookutils.ToAdmissionResponse(causes)
+	}
+	return nil
+}
+
 func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
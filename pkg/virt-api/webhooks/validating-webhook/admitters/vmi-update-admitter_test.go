@@ -29,6 +29,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	authv1 "k8s.io/api/authentication/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
@@ -532,6 +533,108 @@ var _ = Describe("Validating VMIUpdate Admitter", func() {
 			makeExpected("number of disks (1) does not equal the number of volumes (2)", "")),
 	)
 
+	DescribeTable("Should admit or deny host device changes", func(newHostDevices, oldHostDevices []v1.HostDevice, expectedMessage string) {
+		permittedHostDevices := &v1.PermittedHostDevices{
+			USBHostDevices: []v1.USBHostDevice{{ResourceName: "example.org/dongle"}},
+		}
+		resp := admitHostDeviceHotplug(newHostDevices, oldHostDevices, permittedHostDevices)
+		if expectedMessage == "" {
+			Expect(resp).To(BeNil())
+		} else {
+			Expect(resp).ToNot(BeNil())
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Message).To(Equal(expectedMessage))
+		}
+	},
+		Entry("with an added hotpluggable host device",
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/deadbeef"}, {Name: "hotplugged", DeviceName: "example.org/deadbeef", Hotpluggable: true}},
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/deadbeef"}},
+			""),
+		Entry("with a removed hotpluggable host device",
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/deadbeef"}},
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/deadbeef"}, {Name: "hotplugged", DeviceName: "example.org/deadbeef", Hotpluggable: true}},
+			""),
+		Entry("with an added permanent host device",
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/deadbeef"}},
+			nil,
+			"host device permanent can't be added to a running VMI without being hotpluggable"),
+		Entry("with a removed permanent host device",
+			nil,
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/deadbeef"}},
+			"permanent host device permanent, removed"),
+		Entry("with a changed hotpluggable host device",
+			[]v1.HostDevice{{Name: "hotplugged", DeviceName: "example.org/other", Hotpluggable: true}},
+			[]v1.HostDevice{{Name: "hotplugged", DeviceName: "example.org/deadbeef", Hotpluggable: true}},
+			"host device hotplugged, changed"),
+		Entry("with an added hotpluggable USB host device",
+			[]v1.HostDevice{{Name: "hotplugged", DeviceName: "example.org/dongle", Hotpluggable: true}},
+			nil,
+			"host device hotplugged is a USB device, USB devices can't be hotplugged"),
+		Entry("with a permanent USB host device left untouched",
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/dongle"}, {Name: "hotplugged", DeviceName: "example.org/deadbeef", Hotpluggable: true}},
+			[]v1.HostDevice{{Name: "permanent", DeviceName: "example.org/dongle"}},
+			""),
+	)
+
+	DescribeTable("Should deny GPU changes", func(newGPUs, oldGPUs []v1.GPU) {
+		resp := admitGPUHotplug(newGPUs, oldGPUs)
+		Expect(resp).ToNot(BeNil())
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Details.Causes).To(HaveLen(1))
+		Expect(resp.Result.Details.Causes[0].Message).To(Equal("GPUs can't be hotplugged, a GPU can be hotplugged as a hotpluggable host device instead"))
+	},
+		Entry("with an added GPU",
+			[]v1.GPU{{Name: "gpu1", DeviceName: "example.org/gpu"}, {Name: "gpu2", DeviceName: "example.org/gpu"}},
+			[]v1.GPU{{Name: "gpu1", DeviceName: "example.org/gpu"}}),
+		Entry("with a removed GPU",
+			nil,
+			[]v1.GPU{{Name: "gpu1", DeviceName: "example.org/gpu"}}),
+		Entry("with a changed GPU",
+			[]v1.GPU{{Name: "gpu1", DeviceName: "example.org/other"}},
+			[]v1.GPU{{Name: "gpu1", DeviceName: "example.org/gpu"}}),
+	)
+
+	Context("with live updated interfaces", func() {
+		masquerade := func(state v1.InterfaceState, bandwidth *v1.InterfaceBandwidth) v1.Interface {
+			return v1.Interface{
+				Name:                   "default",
+				InterfaceBindingMethod: v1.InterfaceBindingMethod{Masquerade: &v1.InterfaceMasquerade{}},
+				State:                  state,
+				Bandwidth:              bandwidth,
+			}
+		}
+		inbound := func(average string) *v1.InterfaceBandwidth {
+			return &v1.InterfaceBandwidth{Inbound: &v1.BandwidthLimits{Average: resource.MustParse(average)}}
+		}
+
+		DescribeTable("should admit", func(newIface, oldIface v1.Interface) {
+			Expect(admitInterfacesUpdate([]v1.Interface{newIface}, []v1.Interface{oldIface})).To(BeNil())
+		},
+			Entry("a link state change", masquerade(v1.InterfaceStateLinkDown, nil), masquerade("", nil)),
+			Entry("a bandwidth change", masquerade("", inbound("2M")), masquerade("", inbound("1M"))),
+			Entry("an unchanged interface", masquerade("", inbound("1M")), masquerade("", inbound("1M"))),
+		)
+
+		DescribeTable("should reject", func(newIface, oldIface v1.Interface, expectedField string) {
+			resp := admitInterfacesUpdate([]v1.Interface{newIface}, []v1.Interface{oldIface})
+			Expect(resp).ToNot(BeNil())
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Details.Causes).To(HaveLen(1))
+			Expect(resp.Result.Details.Causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("an unknown link state", masquerade("sleeping", nil), masquerade("", nil),
+				"spec.domain.devices.interfaces[0].state"),
+			Entry("a bandwidth without average", masquerade("", inbound("0")), masquerade("", inbound("1M")),
+				"spec.domain.devices.interfaces[0].bandwidth.inbound.average"),
+		)
+	})
+
+	It("Should admit unchanged GPUs", func() {
+		gpus := []v1.GPU{{Name: "gpu1", DeviceName: "example.org/gpu"}}
+		Expect(admitGPUHotplug(gpus, gpus)).To(BeNil())
+	})
+
 	DescribeTable("Admit or deny based on user", func(user string, expected types.GomegaMatcher) {
 		vmi := api.NewMinimalVMI("testvmi")
 		vmi.Spec.Volumes = makeVolumes(1)
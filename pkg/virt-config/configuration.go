@@ -127,11 +127,15 @@ func isPrometheusRules(crd *extv1.CustomResourceDefinition) bool {
 	return crd.Spec.Names.Kind == "PrometheusRule"
 }
 
+func isDNSEndpoint(crd *extv1.CustomResourceDefinition) bool {
+	return crd.Spec.Group == "externaldns.k8s.io" && crd.Spec.Names.Kind == "DNSEndpoint"
+}
+
 func (c *ClusterConfig) crdAddedDeleted(obj interface{}) {
 	go c.GetConfig()
 	crd := obj.(*extv1.CustomResourceDefinition)
 	if !isDataVolumeCrd(crd) && !isDataSourceCrd(crd) &&
-		!isServiceMonitor(crd) && !isPrometheusRules(crd) {
+		!isServiceMonitor(crd) && !isPrometheusRules(crd) && !isDNSEndpoint(crd) {
 		return
 	}
 
@@ -442,6 +446,21 @@ func (c *ClusterConfig) HasPrometheusRuleAPI() bool {
 	return false
 }
 
+func (c *ClusterConfig) HasDNSEndpointAPI() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	objects := c.crdInformer.GetStore().List()
+	for _, obj := range objects {
+		if crd, ok := obj.(*extv1.CustomResourceDefinition); ok && crd.DeletionTimestamp == nil {
+			if isDNSEndpoint(crd) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func parseNodeSelectors(str string) (map[string]string, error) {
 	nodeSelectors := make(map[string]string)
 	for _, s := range strings.Split(strings.TrimSpace(str), "\n") {
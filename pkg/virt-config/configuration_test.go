@@ -557,6 +557,60 @@ var _ = Describe("test configuration", func() {
 			[]string{"nvidia-223", "nvidia-229"}),
 	)
 
+	DescribeTable("mdev configuration with rebalancing", func(featureGates []string, annotations map[string]string, expectedResult []string) {
+		node := &kubev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "testNode",
+				Labels:      map[string]string{"testLabel1": "true"},
+				Annotations: annotations,
+			},
+		}
+		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				ResourceVersion: rand.String(10),
+				Name:            "kubevirt",
+				Namespace:       "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+					MediatedDevicesConfiguration: &v1.MediatedDevicesConfiguration{
+						NodeMediatedDeviceTypes: []v1.NodeMediatedDeviceTypesConfig{
+							{
+								NodeSelector:        map[string]string{"testLabel1": "true"},
+								MediatedDeviceTypes: []string{"nvidia-223"},
+							},
+						},
+						Rebalancing: &v1.MediatedDevicesRebalancing{},
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		})
+		Expect(clusterConfig.GetDesiredMDEVTypes(node)).Should(ConsistOf(expectedResult))
+	},
+		Entry("should use the types assigned by the rebalancer",
+			[]string{virtconfig.MediatedDevicesRebalancingGate},
+			map[string]string{v1.MediatedDeviceTypesAnnotation: "nvidia-229, nvidia-230"},
+			[]string{"nvidia-229", "nvidia-230"}),
+		Entry("should configure no types if the rebalancer cleared the node",
+			[]string{virtconfig.MediatedDevicesRebalancingGate},
+			map[string]string{v1.MediatedDeviceTypesAnnotation: ""},
+			[]string{}),
+		Entry("should use the static configuration on nodes the rebalancer did not touch",
+			[]string{virtconfig.MediatedDevicesRebalancingGate},
+			nil,
+			[]string{"nvidia-223"}),
+		Entry("should ignore the assigned types if the feature gate is disabled",
+			nil,
+			map[string]string{v1.MediatedDeviceTypesAnnotation: "nvidia-229"},
+			[]string{"nvidia-223"}),
+	)
+
 	DescribeTable("when kubevirt CR holds config", func(value v1.KubeVirtConfiguration, getPart func(*v1.KubeVirtConfiguration) interface{}, result string) {
 		clusterConfig, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
 			ObjectMeta: metav1.ObjectMeta{
@@ -68,6 +68,22 @@ const (
 	// VMPersistentState enables persisting backend state files of VMs, such as the contents of the vTPM
 	VMPersistentState = "VMPersistentState"
 	Multiarchitecture = "MultiArchitecture"
+	// InterfaceSpoofCheckGate enables dropping guest traffic with spoofed source addresses on bridge and masquerade interfaces
+	InterfaceSpoofCheckGate = "InterfaceSpoofCheck"
+	// GuestEndpointsGate enables publishing the guest agent reported addresses of VMIs as the EndpointSlices of annotated Services
+	GuestEndpointsGate = "GuestEndpoints"
+	// SecondaryNetworkDNSGate enables publishing DNS records for the guest agent reported IPs of secondary networks
+	SecondaryNetworkDNSGate = "SecondaryNetworkDNS"
+	// HotplugHostDevicesGate enables hot-attaching and detaching host devices to and from running VMIs
+	HotplugHostDevicesGate = "HotplugHostDevices"
+	// MediatedDevicesRebalancingGate enables reconfiguring idle GPUs to the mediated device types requested by pending VMIs
+	MediatedDevicesRebalancingGate = "MediatedDevicesRebalancing"
+	// NodeCapabilitiesGate enables publishing the virtualization capabilities of every node as a NodeCapabilities object
+	NodeCapabilitiesGate = "NodeCapabilities"
+	// ClusterCPUBaselineGate enables computing the newest CPU model supported by all the nodes, and the cluster-baseline CPU model
+	ClusterCPUBaselineGate = "ClusterCPUBaseline"
+	// SerialConsoleLogGate enables logging the serial console of VMIs, streamed by a guest-console-log container of the virt-launcher pod
+	SerialConsoleLogGate = "SerialConsoleLog"
 )
 
 var deprecatedFeatureGates = [...]string{
@@ -225,3 +241,35 @@ func (config *ClusterConfig) VMPersistentStateEnabled() bool {
 func (config *ClusterConfig) MultiArchitectureEnabled() bool {
 	return config.isFeatureGateEnabled(Multiarchitecture)
 }
+
+func (config *ClusterConfig) InterfaceSpoofCheckEnabled() bool {
+	return config.isFeatureGateEnabled(InterfaceSpoofCheckGate)
+}
+
+func (config *ClusterConfig) GuestEndpointsEnabled() bool {
+	return config.isFeatureGateEnabled(GuestEndpointsGate)
+}
+
+func (config *ClusterConfig) SecondaryNetworkDNSEnabled() bool {
+	return config.isFeatureGateEnabled(SecondaryNetworkDNSGate)
+}
+
+func (config *ClusterConfig) HotplugHostDevicesEnabled() bool {
+	return config.isFeatureGateEnabled(HotplugHostDevicesGate)
+}
+
+func (config *ClusterConfig) MediatedDevicesRebalancingEnabled() bool {
+	return config.isFeatureGateEnabled(MediatedDevicesRebalancingGate)
+}
+
+func (config *ClusterConfig) NodeCapabilitiesEnabled() bool {
+	return config.isFeatureGateEnabled(NodeCapabilitiesGate)
+}
+
+func (config *ClusterConfig) ClusterCPUBaselineEnabled() bool {
+	return config.isFeatureGateEnabled(ClusterCPUBaselineGate)
+}
+
+func (config *ClusterConfig) SerialConsoleLogEnabled() bool {
+	return config.isFeatureGateEnabled(SerialConsoleLogGate)
+}
@@ -25,6 +25,7 @@ package virtconfig
 
 import (
 	"fmt"
+	"strings"
 
 	"kubevirt.io/client-go/log"
 
@@ -307,6 +308,12 @@ func (c *ClusterConfig) GetDesiredMDEVTypes(node *k8sv1.Node) []string {
 	if mdevTypesConf == nil {
 		return []string{}
 	}
+	if c.MediatedDevicesRebalancingEnabled() && mdevTypesConf.Rebalancing != nil {
+		// the types assigned by the rebalancer take precedence over the static configuration
+		if rebalancedTypes, exists := node.Annotations[v1.MediatedDeviceTypesAnnotation]; exists {
+			return ParseMDEVTypesList(rebalancedTypes)
+		}
+	}
 	nodeMdevConf := mdevTypesConf.NodeMediatedDeviceTypes
 	if nodeMdevConf != nil {
 		mdevTypesMap := make(map[string]struct{})
@@ -337,6 +344,17 @@ func (c *ClusterConfig) GetDesiredMDEVTypes(node *k8sv1.Node) []string {
 	return mdevTypesConf.MediatedDeviceTypes
 }
 
+// ParseMDEVTypesList splits the comma separated list of mediated device types of a node annotation.
+func ParseMDEVTypesList(mdevTypes string) []string {
+	mdevTypesList := []string{}
+	for _, mdevType := range strings.Split(mdevTypes, ",") {
+		if mdevType = strings.TrimSpace(mdevType); mdevType != "" {
+			mdevTypesList = append(mdevTypesList, mdevType)
+		}
+	}
+	return mdevTypesList
+}
+
 type virtComponent int
 
 const (
@@ -423,3 +441,25 @@ func (c *ClusterConfig) GetDeveloperConfigurationUseEmulation() bool {
 func (c *ClusterConfig) GetVMStateStorageClass() string {
 	return c.GetConfig().VMStateStorageClass
 }
+
+func (c *ClusterConfig) GetVMStateStorageAccessMode() k8sv1.PersistentVolumeAccessMode {
+	if accessMode := c.GetConfig().VMStateStorageAccessMode; accessMode != "" {
+		return accessMode
+	}
+	return k8sv1.ReadWriteMany
+}
+
+func (c *ClusterConfig) GetCPUBaselineNodeSelector() map[string]string {
+	if cpuBaseline := c.GetConfig().CPUBaseline; cpuBaseline != nil {
+		return cpuBaseline.NodeSelector
+	}
+	return nil
+}
+
+// GetClusterCPUBaseline returns the cluster CPU baseline computed by virt-controller, or nil if it is not available yet
+func (c *ClusterConfig) GetClusterCPUBaseline() *v1.CPUBaselineStatus {
+	if kv := c.GetConfigFromKubeVirtCR(); kv != nil {
+		return kv.Status.CPUBaseline
+	}
+	return nil
+}
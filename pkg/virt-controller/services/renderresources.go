@@ -241,6 +241,10 @@ func WithHostDevices(hostDevices []v1.HostDevice) ResourceRendererOption {
 	return func(renderer *ResourceRenderer) {
 		resources := renderer.ResourceRequirements()
 		for _, hostDev := range hostDevices {
+			// hotpluggable host devices are requested by their attachment pods
+			if hostDev.Hotpluggable {
+				continue
+			}
 			requestResource(&resources, hostDev.DeviceName)
 		}
 		copyResources(resources.Limits, renderer.calculatedLimits)
@@ -371,8 +375,8 @@ func GetMemoryOverhead(vmi *v1.VirtualMachineInstance, cpuArch string, additiona
 
 	// Additional overhead for each interface with Passt binding, that forwards all ports.
 	// More information can be found here: https://bugs.passt.top/show_bug.cgi?id=20
-	for _, net := range vmi.Spec.Domain.Devices.Interfaces {
-		if net.Passt != nil && len(net.Ports) == 0 {
+	for i := range vmi.Spec.Domain.Devices.Interfaces {
+		if util.IsPasstForwardingAllPorts(&vmi.Spec.Domain.Devices.Interfaces[i]) {
 			overhead.Add(resource.MustParse("800Mi"))
 		}
 	}
@@ -494,6 +498,9 @@ func validatePermittedHostDevices(spec *v1.VirtualMachineInstanceSpec, config *v
 		for _, dev := range hostDevs.MediatedDevices {
 			supportedHostDevicesMap[dev.ResourceName] = true
 		}
+		for _, dev := range hostDevs.USBHostDevices {
+			supportedHostDevicesMap[dev.ResourceName] = true
+		}
 		for _, hostDev := range spec.Domain.Devices.GPUs {
 			if _, exist := supportedHostDevicesMap[hostDev.DeviceName]; !exist {
 				errors = append(errors, fmt.Sprintf("GPU %s is not permitted in permittedHostDevices configuration", hostDev.DeviceName))
@@ -549,6 +556,32 @@ func sidecarResources(vmi *v1.VirtualMachineInstance, config *virtconfig.Cluster
 	return resources
 }
 
+func guestConsoleLogResources(vmi *v1.VirtualMachineInstance, config *virtconfig.ClusterConfig) k8sv1.ResourceRequirements {
+	resources := k8sv1.ResourceRequirements{
+		Requests: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("5m"),
+			k8sv1.ResourceMemory: resource.MustParse("35M"),
+		},
+		Limits: k8sv1.ResourceList{
+			k8sv1.ResourceCPU:    resource.MustParse("15m"),
+			k8sv1.ResourceMemory: resource.MustParse("60M"),
+		},
+	}
+	for _, name := range []k8sv1.ResourceName{k8sv1.ResourceCPU, k8sv1.ResourceMemory} {
+		if req := config.GetSupportContainerRequest(v1.GuestConsoleLog, name); req != nil {
+			resources.Requests[name] = *req
+		}
+		if lim := config.GetSupportContainerLimit(v1.GuestConsoleLog, name); lim != nil {
+			resources.Limits[name] = *lim
+		}
+	}
+	if vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed() {
+		resources.Requests[k8sv1.ResourceCPU] = resources.Limits[k8sv1.ResourceCPU]
+		resources.Requests[k8sv1.ResourceMemory] = resources.Limits[k8sv1.ResourceMemory]
+	}
+	return resources
+}
+
 func initContainerResourceRequirementsForVMI(vmi *v1.VirtualMachineInstance, containerType v1.SupportContainerType, config *virtconfig.ClusterConfig) k8sv1.ResourceRequirements {
 	if vmi.IsCPUDedicated() || vmi.WantsToHaveQOSGuaranteed() {
 		return k8sv1.ResourceRequirements{
@@ -19,6 +19,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/sriov"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
@@ -332,11 +333,10 @@ func withAccessCredentials(accessCredentials []v1.AccessCredential) VolumeRender
 	}
 }
 
-func withTPM(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+func withTPM(vmi *v1.VirtualMachineInstance, pvcName string) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if backendstorage.HasPersistentTPMDevice(&vmi.Spec) {
 			volumeName := vmi.Name + "-tpm"
-			pvcName := backendstorage.PVCForVMI(vmi)
 			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
 				Name: volumeName,
 				VolumeSource: k8sv1.VolumeSource{
@@ -352,22 +352,7 @@ func withTPM(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
 			if util.IsNonRootVMI(vmi) {
 				// For non-root VMIs, the TPM state lives under /var/run/kubevirt-private/libvirt/qemu/swtpm
 				// To persist it, we need the persistent PVC to be mounted under that location.
-				// /var/run/kubevirt-private is an emptyDir, and k8s would automatically create the right sub-directories under it.
-				// However, the sub-directories would get created as root:<fsGroup>, with a mode like 0755 (drwxr-xr-x), preventing write access to them.
-				// Depending on the storage class used, the SELinux label of the sub-directories can also be problematic (like nfs_t for nfs-csi).
-				// Creating emptydirs for each intermediate directory (+ setting fsGroup to 107) solves both issues.
-				// The only viable alternative would be to use an init container to `mkdir -p /var/run/kubevirt-private/libvirt/qemu/swtpm`,
-				//   but init containers are expensive, and emptyDirs were deemed to be the least undesirable approach.
-				renderer.podVolumes = append(renderer.podVolumes,
-					emptyDirVolume("private-libvirt"),
-					emptyDirVolume("private-libvirt-qemu"))
-				renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
-					Name:      "private-libvirt",
-					MountPath: filepath.Join(util.VirtPrivateDir, "libvirt"),
-				}, k8sv1.VolumeMount{
-					Name:      "private-libvirt-qemu",
-					MountPath: filepath.Join(util.VirtPrivateDir, "libvirt", "qemu"),
-				})
+				withPrivateLibvirtQemuDirs(renderer)
 				swtpmPath = filepath.Join(util.VirtPrivateDir, "libvirt", "qemu", "swtpm")
 				localCaPath = filepath.Join(util.VirtPrivateDir, "var", "lib", "swtpm-localca")
 			}
@@ -387,6 +372,63 @@ func withTPM(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
 	}
 }
 
+// withPrivateLibvirtQemuDirs makes /var/run/kubevirt-private/libvirt/qemu writable for non-root VMIs, so
+// that the backend storage can be mounted below it.
+// /var/run/kubevirt-private is an emptyDir, and k8s would automatically create the right sub-directories under it.
+// However, the sub-directories would get created as root:<fsGroup>, with a mode like 0755 (drwxr-xr-x), preventing write access to them.
+// Depending on the storage class used, the SELinux label of the sub-directories can also be problematic (like nfs_t for nfs-csi).
+// Creating emptydirs for each intermediate directory (+ setting fsGroup to 107) solves both issues.
+// The only viable alternative would be to use an init container to `mkdir -p /var/run/kubevirt-private/libvirt/qemu/<dir>`,
+// but init containers are expensive, and emptyDirs were deemed to be the least undesirable approach.
+func withPrivateLibvirtQemuDirs(renderer *VolumeRenderer) {
+	for _, volume := range renderer.podVolumes {
+		if volume.Name == "private-libvirt-qemu" {
+			// Already added for another backend storage mount
+			return
+		}
+	}
+	renderer.podVolumes = append(renderer.podVolumes,
+		emptyDirVolume("private-libvirt"),
+		emptyDirVolume("private-libvirt-qemu"))
+	renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+		Name:      "private-libvirt",
+		MountPath: filepath.Join(util.VirtPrivateDir, "libvirt"),
+	}, k8sv1.VolumeMount{
+		Name:      "private-libvirt-qemu",
+		MountPath: filepath.Join(util.VirtPrivateDir, "libvirt", "qemu"),
+	})
+}
+
+func withEFINVRAM(vmi *v1.VirtualMachineInstance, pvcName string) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		if backendstorage.HasPersistentEFI(&vmi.Spec) {
+			volumeName := vmi.Name + "-nvram"
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name: volumeName,
+				VolumeSource: k8sv1.VolumeSource{
+					PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+						ClaimName: pvcName,
+						ReadOnly:  false,
+					},
+				},
+			})
+			nvramPath := efi.PersistentNVRAMDir
+			if util.IsNonRootVMI(vmi) {
+				// For non-root VMIs, the NVRAM lives under /var/run/kubevirt-private/libvirt/qemu/nvram, like the TPM state
+				withPrivateLibvirtQemuDirs(renderer)
+				nvramPath = efi.NonRootPersistentNVRAMDir
+			}
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      volumeName,
+				ReadOnly:  false,
+				MountPath: nvramPath,
+				SubPath:   "nvram",
+			})
+		}
+		return nil
+	}
+}
+
 func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if len(hookSidecars) != 0 {
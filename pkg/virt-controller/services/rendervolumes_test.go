@@ -9,8 +9,11 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/pointer"
 
 	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
 )
 
 var _ = Describe("Container spec renderer", func() {
@@ -334,6 +337,96 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.VolumeDevices()).To(BeEmpty())
 		})
 	})
+
+	Context("with persistent EFI option", func() {
+		BeforeEach(func() {
+			vmi := &v1.VirtualMachineInstance{}
+			vmi.Name = "testvmi"
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{EFI: &v1.EFI{Persistent: pointer.Bool(true)}},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withEFINVRAM(vmi, "persistent-state-for-testvmi"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should feature the default mount points plus the NVRAM backend storage mount", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      "testvmi-nvram",
+						MountPath: "/var/run/kubevirt-private/nvram",
+						SubPath:   "nvram",
+					})))
+		})
+
+		It("should feature the default volumes plus the backend storage volume", func() {
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "testvmi-nvram",
+						VolumeSource: k8sv1.VolumeSource{
+							PersistentVolumeClaim: &k8sv1.PersistentVolumeClaimVolumeSource{
+								ClaimName: "persistent-state-for-testvmi",
+							},
+						},
+					})))
+		})
+	})
+
+	Context("with persistent EFI option on a non-root VMI", func() {
+		var vmi *v1.VirtualMachineInstance
+
+		BeforeEach(func() {
+			vmi = &v1.VirtualMachineInstance{}
+			vmi.Name = "testvmi"
+			vmi.Status.RuntimeUser = util.NonRootUID
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{EFI: &v1.EFI{Persistent: pointer.Bool(true)}},
+			}
+		})
+
+		privateLibvirtMounts := []k8sv1.VolumeMount{
+			{Name: "private-libvirt", MountPath: "/var/run/kubevirt-private/libvirt"},
+			{Name: "private-libvirt-qemu", MountPath: "/var/run/kubevirt-private/libvirt/qemu"},
+		}
+
+		It("should mount the NVRAM backend storage below writable emptyDirs", func() {
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withEFINVRAM(vmi, "persistent-state-for-testvmi"))
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					append(defaultVolumeMounts(), privateLibvirtMounts...),
+					k8sv1.VolumeMount{
+						Name:      "testvmi-nvram",
+						MountPath: "/var/run/kubevirt-private/libvirt/qemu/nvram",
+						SubPath:   "nvram",
+					})))
+			Expect(vsr.Volumes()).To(ContainElements(emptyDirVolume("private-libvirt"), emptyDirVolume("private-libvirt-qemu")))
+		})
+
+		It("should share the emptyDirs with the persistent TPM", func() {
+			vmi.Spec.Domain.Devices.TPM = &v1.TPMDevice{Persistent: pointer.Bool(true)}
+
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir,
+				withTPM(vmi, "persistent-state-for-testvmi"), withEFINVRAM(vmi, "persistent-state-for-testvmi"))
+			Expect(err).NotTo(HaveOccurred())
+
+			volumeNames := map[string]int{}
+			for _, volume := range vsr.Volumes() {
+				volumeNames[volume.Name]++
+			}
+			Expect(volumeNames).To(HaveKeyWithValue("private-libvirt", 1))
+			Expect(volumeNames).To(HaveKeyWithValue("private-libvirt-qemu", 1))
+			Expect(vsr.Mounts()).To(ContainElements(privateLibvirtMounts))
+		})
+	})
 })
 
 func vmiDiskPath(volumeName string) string {
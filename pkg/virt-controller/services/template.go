@@ -22,6 +22,7 @@ package services
 import (
 	"fmt"
 	"math/rand"
+	"path/filepath"
 	"strconv"
 	"strings"
 
@@ -43,6 +44,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/hooks"
 	"kubevirt.io/kubevirt/pkg/network/istio"
 	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
@@ -53,13 +55,15 @@ import (
 )
 
 const (
-	containerDisks   = "container-disks"
-	hotplugDisks     = "hotplug-disks"
-	hookSidecarSocks = "hook-sidecar-sockets"
-	varRun           = "/var/run"
-	virtBinDir       = "virt-bin-share-dir"
-	hotplugDisk      = "hotplug-disk"
-	virtExporter     = "virt-exporter"
+	containerDisks     = "container-disks"
+	hotplugDisks       = "hotplug-disks"
+	hookSidecarSocks   = "hook-sidecar-sockets"
+	varRun             = "/var/run"
+	virtBinDir         = "virt-bin-share-dir"
+	hotplugDisk        = "hotplug-disk"
+	hotplugHostDevices = "hotplug-host-devices"
+	hotplugHostDevice  = "hotplug-host-device"
+	virtExporter       = "virt-exporter"
 )
 
 const KvmDevice = "devices.kubevirt.io/kvm"
@@ -131,6 +135,7 @@ type TemplateService interface {
 	RenderLaunchManifest(vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error)
 	RenderHotplugAttachmentPodTemplate(volume []*v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, claimMap map[string]*k8sv1.PersistentVolumeClaim, tempPod bool) (*k8sv1.Pod, error)
 	RenderHotplugAttachmentTriggerPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool, tempPod bool) (*k8sv1.Pod, error)
+	RenderHotplugHostDeviceAttachmentPodTemplate(hostDevice *v1.HostDevice, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error)
 	RenderLaunchManifestNoVm(*v1.VirtualMachineInstance) (*k8sv1.Pod, error)
 	RenderExporterManifest(vmExport *exportv1.VirtualMachineExport, namePrefix string) *k8sv1.Pod
 	GetLauncherImage() string
@@ -456,6 +461,10 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 				sidecarContainerName(i), vmi, sidecarResources(vmi, t.clusterConfig), requestedHookSidecar, userId).Render(requestedHookSidecar.Command))
 	}
 
+	if t.clusterConfig.SerialConsoleLogEnabled() && util.IsSerialConsoleLogEnabled(vmi) && !tempPod {
+		containers = append(containers, t.newSerialConsoleLogContainerRenderer(vmi, userId).Render([]string{"/usr/bin/virt-tail"}))
+	}
+
 	podAnnotations, err := generatePodAnnotations(vmi)
 	if err != nil {
 		return nil, err
@@ -626,6 +635,24 @@ func newSidecarContainerRenderer(sidecarName string, vmiSpec *v1.VirtualMachineI
 		sidecarOpts...)
 }
 
+func (t *templateService) newSerialConsoleLogContainerRenderer(vmi *v1.VirtualMachineInstance, userId int64) *ContainerSpecRenderer {
+	opts := []Option{
+		WithResourceRequirements(guestConsoleLogResources(vmi, t.clusterConfig)),
+		WithVolumeMounts(
+			// virtlogd writes the log into the private directory of the compute container
+			k8sv1.VolumeMount{Name: "private", MountPath: util.VirtPrivateDir, ReadOnly: true},
+			// virt-tail follows the log until the socket of virt-launcher is gone
+			k8sv1.VolumeMount{Name: "sockets", MountPath: filepath.Join(t.virtShareDir, "sockets"), ReadOnly: true},
+		),
+		WithArgs([]string{"--logfile", util.SerialConsoleLogPath(vmi)}),
+		WithNoCapabilities(),
+	}
+	if util.IsNonRootVMI(vmi) {
+		opts = append(opts, WithNonRoot(userId))
+	}
+	return NewContainerSpecRenderer(util.GuestConsoleLogContainerName, t.launcherImage, t.clusterConfig.GetImagePullPolicy(), opts...)
+}
+
 func (t *templateService) newInitContainerRenderer(vmiSpec *v1.VirtualMachineInstance, initContainerVolumeMount k8sv1.VolumeMount, initContainerResources k8sv1.ResourceRequirements, userId int64) *ContainerSpecRenderer {
 	const containerDisk = "container-disk-binary"
 	cpInitContainerOpts := []Option{
@@ -674,11 +701,13 @@ func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstanc
 }
 
 func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, namespace string, requestedHookSidecarList hooks.HookSidecarList) (*VolumeRenderer, error) {
+	backendStoragePVCName := backendstorage.CurrentPVCName(vmi, t.persistentVolumeClaimStore)
 	volumeOpts := []VolumeRendererOption{
 		withVMIConfigVolumes(vmi.Spec.Domain.Devices.Disks, vmi.Spec.Volumes),
 		withVMIVolumes(t.persistentVolumeClaimStore, vmi.Spec.Volumes, vmi.Status.VolumeStatus),
 		withAccessCredentials(vmi.Spec.AccessCredentials),
-		withTPM(vmi),
+		withTPM(vmi, backendStoragePVCName),
+		withEFINVRAM(vmi, backendStoragePVCName),
 	}
 	if len(requestedHookSidecarList) != 0 {
 		volumeOpts = append(volumeOpts, withSidecarVolumes(requestedHookSidecarList))
@@ -871,6 +900,93 @@ func (t *templateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volum
 	return pod, nil
 }
 
+// RenderHotplugHostDeviceAttachmentPodTemplate renders a pod which requests the resource of a hotpluggable
+// host device on the node of the owner pod. The device plugin allocates the device to this pod, and
+// virt-handler passes it on to the virt-launcher pod of the VMI.
+func (t *templateService) RenderHotplugHostDeviceAttachmentPodTemplate(hostDevice *v1.HostDevice, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
+	zero := int64(0)
+	runUser := int64(util.NonRootUID)
+	command := []string{"/bin/sh", "-c", "/usr/bin/container-disk --copy-path /path/hp"}
+
+	resources := hotplugContainerResourceRequirementsForVMI(vmi, t.clusterConfig)
+	requestResource(&resources, hostDevice.DeviceName)
+
+	pod := &k8sv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "hp-hostdev-",
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(ownerPod, schema.GroupVersionKind{
+					Group:   k8sv1.SchemeGroupVersion.Group,
+					Version: k8sv1.SchemeGroupVersion.Version,
+					Kind:    "Pod",
+				}),
+			},
+			Labels: map[string]string{
+				v1.AppLabel: hotplugHostDevice,
+			},
+			Annotations: map[string]string{
+				v1.HotplugHostDeviceAnnotation: hostDevice.Name,
+			},
+		},
+		Spec: k8sv1.PodSpec{
+			Containers: []k8sv1.Container{
+				{
+					Name:      hotplugHostDevice,
+					Image:     t.launcherImage,
+					Command:   command,
+					Resources: resources,
+					SecurityContext: &k8sv1.SecurityContext{
+						AllowPrivilegeEscalation: pointer.Bool(false),
+						RunAsNonRoot:             pointer.Bool(true),
+						RunAsUser:                &runUser,
+						SeccompProfile: &k8sv1.SeccompProfile{
+							Type: k8sv1.SeccompProfileTypeRuntimeDefault,
+						},
+						Capabilities: &k8sv1.Capabilities{
+							Drop: []k8sv1.Capability{"ALL"},
+						},
+						SELinuxOptions: &k8sv1.SELinuxOptions{
+							Type:  t.clusterConfig.GetSELinuxLauncherType(),
+							Level: "s0",
+						},
+					},
+					VolumeMounts: []k8sv1.VolumeMount{
+						{
+							Name:      hotplugHostDevices,
+							MountPath: "/path",
+						},
+					},
+				},
+			},
+			Affinity: &k8sv1.Affinity{
+				NodeAffinity: &k8sv1.NodeAffinity{
+					RequiredDuringSchedulingIgnoredDuringExecution: &k8sv1.NodeSelector{
+						NodeSelectorTerms: []k8sv1.NodeSelectorTerm{
+							{
+								MatchExpressions: []k8sv1.NodeSelectorRequirement{
+									{
+										Key:      "kubernetes.io/hostname",
+										Operator: k8sv1.NodeSelectorOpIn,
+										Values:   []string{ownerPod.Spec.NodeName},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			Volumes:                       []k8sv1.Volume{emptyDirVolume(hotplugHostDevices)},
+			TerminationGracePeriodSeconds: &zero,
+		},
+	}
+
+	if err := matchSELinuxLevelOfVMI(pod, vmi); err != nil {
+		return nil, err
+	}
+
+	return pod, nil
+}
+
 func (t *templateService) RenderHotplugAttachmentTriggerPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool, tempPod bool) (*k8sv1.Pod, error) {
 	zero := int64(0)
 	runUser := int64(util.NonRootUID)
@@ -457,6 +457,69 @@ var _ = Describe("Template", func() {
 				Entry("on ppc64le", "ppc64le", "/usr/share/OVMF"),
 			)
 		})
+		Context("with the serial console log", func() {
+			newVMI := func() *v1.VirtualMachineInstance {
+				return &v1.VirtualMachineInstance{
+					ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default", UID: "1234"},
+					Status:     v1.VirtualMachineInstanceStatus{RuntimeUser: uint64(nonRootUser)},
+				}
+			}
+
+			It("should stream the log from a non-root guest-console-log container", func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+				enableFeatureGate(virtconfig.SerialConsoleLogGate)
+
+				pod, err := svc.RenderLaunchManifest(newVMI())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers).To(HaveLen(2))
+				container := pod.Spec.Containers[1]
+				Expect(container.Name).To(Equal("guest-console-log"))
+				Expect(container.Image).To(Equal("kubevirt/virt-launcher"))
+				Expect(container.Command).To(Equal([]string{"/usr/bin/virt-tail"}))
+				Expect(container.Args).To(Equal([]string{"--logfile", "/var/run/kubevirt-private/1234/virt-serial0-log"}))
+				Expect(container.VolumeMounts).To(ConsistOf(
+					kubev1.VolumeMount{Name: "private", MountPath: "/var/run/kubevirt-private", ReadOnly: true},
+					kubev1.VolumeMount{Name: "sockets", MountPath: "/var/run/kubevirt/sockets", ReadOnly: true},
+				))
+				Expect(*container.SecurityContext.RunAsUser).To(Equal(nonRootUser))
+				Expect(container.SecurityContext.Capabilities.Drop).To(ConsistOf(kubev1.Capability("ALL")))
+			})
+
+			It("should use guaranteed resources for dedicated CPUs", func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+				enableFeatureGate(virtconfig.SerialConsoleLogGate)
+				vmi := newVMI()
+				vmi.Spec.Domain.CPU = &v1.CPU{DedicatedCPUPlacement: true}
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				resources := pod.Spec.Containers[1].Resources
+				Expect(resources.Requests).To(Equal(resources.Limits))
+			})
+
+			It("should not be streamed when the feature gate is disabled", func() {
+				config, kvInformer, svc = configFactory(defaultArch)
+
+				pod, err := svc.RenderLaunchManifest(newVMI())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+			})
+
+			DescribeTable("should not be streamed", func(devices v1.Devices) {
+				config, kvInformer, svc = configFactory(defaultArch)
+				enableFeatureGate(virtconfig.SerialConsoleLogGate)
+				vmi := newVMI()
+				vmi.Spec.Domain.Devices = devices
+
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pod.Spec.Containers).To(HaveLen(1))
+			},
+				Entry("when disabled", v1.Devices{LogSerialConsole: pointer.Bool(false)}),
+				Entry("without the serial console", v1.Devices{AutoattachSerialConsole: pointer.Bool(false)}),
+			)
+		})
+
 		Context("with SELinux types", func() {
 			It("should be nil if no SELinux type is specified and none is needed", func() {
 				config, kvInformer, svc = configFactory(defaultArch)
@@ -3924,6 +3987,41 @@ var _ = Describe("Template", func() {
 			Entry("when volume is a filesystem", false),
 		)
 
+		It("should request the device resource and point to the node of the owner pod when rendering hotplug host device pods", func() {
+			const deviceName = "nvidia.com/GP102GL_Tesla_P40"
+			config, kvInformer, svc = configFactory(defaultArch)
+			vmi := api.NewMinimalVMI("fake-vmi")
+			ownerPod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+			ownerPod.Spec.NodeName = "node01"
+
+			vmi.Status.SelinuxContext = "test_u:test_r:test_t:s0"
+			pod, err := svc.RenderHotplugHostDeviceAttachmentPodTemplate(&v1.HostDevice{Name: "gpu1", DeviceName: deviceName, Hotpluggable: true}, ownerPod, vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Annotations).To(HaveKeyWithValue(v1.HotplugHostDeviceAnnotation, "gpu1"))
+			deviceLimit := pod.Spec.Containers[0].Resources.Limits[kubev1.ResourceName(deviceName)]
+			Expect(deviceLimit.Value()).To(Equal(int64(1)))
+			deviceRequest := pod.Spec.Containers[0].Resources.Requests[kubev1.ResourceName(deviceName)]
+			Expect(deviceRequest.Value()).To(Equal(int64(1)))
+			Expect(pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms[0].MatchExpressions[0].Values).To(ConsistOf("node01"))
+			Expect(pod.Spec.Containers[0].SecurityContext.SELinuxOptions.Level).To(Equal("s0"))
+		})
+
+		It("should not request hotpluggable host devices in the launcher pod", func() {
+			config, kvInformer, svc = configFactory(defaultArch)
+			vmi := api.NewMinimalVMI("fake-vmi")
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
+				{Name: "gpu1", DeviceName: "vendor.com/gpu_name"},
+				{Name: "gpu2", DeviceName: "vendor.com/hotplug_gpu_name", Hotpluggable: true},
+			}
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(pod.Spec.Containers[0].Resources.Limits).To(HaveKey(kubev1.ResourceName("vendor.com/gpu_name")))
+			Expect(pod.Spec.Containers[0].Resources.Limits).ToNot(HaveKey(kubev1.ResourceName("vendor.com/hotplug_gpu_name")))
+		})
+
 		It("Should run as non-root except compute", func() {
 			vmi := newMinimalWithContainerDisk("ranom")
 
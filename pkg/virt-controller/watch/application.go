@@ -83,8 +83,12 @@ import (
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/cpubaseline"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/guestendpoints"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/mdevrebalancer"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/networkdns"
 	workloadupdater "kubevirt.io/kubevirt/pkg/virt-controller/watch/workload-updater"
 )
 
@@ -161,6 +165,9 @@ type VirtControllerApp struct {
 
 	pdbInformer cache.SharedIndexInformer
 
+	serviceInformer            cache.SharedIndexInformer
+	guestEndpointSliceInformer cache.SharedIndexInformer
+
 	persistentVolumeClaimCache    cache.Store
 	persistentVolumeClaimInformer cache.SharedIndexInformer
 
@@ -184,6 +191,10 @@ type VirtControllerApp struct {
 
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
+	mdevRebalancer *mdevrebalancer.MediatedDevicesRebalancer
+
+	cpuBaselineCalculator *cpubaseline.Calculator
+
 	caExportConfigMapInformer    cache.SharedIndexInformer
 	exportRouteConfigMapInformer cache.SharedInformer
 	exportServiceInformer        cache.SharedIndexInformer
@@ -214,43 +225,52 @@ type VirtControllerApp struct {
 
 	LeaderElection leaderelectionconfig.Configuration
 
-	launcherImage              string
-	exporterImage              string
-	launcherQemuTimeout        int
-	imagePullSecret            string
-	virtShareDir               string
-	virtLibDir                 string
-	ephemeralDiskDir           string
-	containerDiskDir           string
-	hotplugDiskDir             string
-	readyChan                  chan bool
-	kubevirtNamespace          string
-	host                       string
-	evacuationController       *evacuation.EvacuationController
-	disruptionBudgetController *disruptionbudget.DisruptionBudgetController
+	launcherImage                 string
+	exporterImage                 string
+	launcherQemuTimeout           int
+	imagePullSecret               string
+	virtShareDir                  string
+	virtLibDir                    string
+	ephemeralDiskDir              string
+	containerDiskDir              string
+	hotplugDiskDir                string
+	readyChan                     chan bool
+	kubevirtNamespace             string
+	host                          string
+	evacuationController          *evacuation.EvacuationController
+	disruptionBudgetController    *disruptionbudget.DisruptionBudgetController
+	guestEndpointsController      *guestendpoints.GuestEndpointsController
+	secondaryNetworkDNSController *networkdns.SecondaryNetworkDNSController
+	dnsEndpointInformer           cache.SharedIndexInformer
 
 	ctx context.Context
 
 	// indicates if controllers were started with or without CDI/DataVolume support
 	hasCDI bool
+	// indicates if controllers were started with or without the ExternalDNS DNSEndpoint API
+	hasDNSEndpoint bool
+	// indicates if controllers were started with or without the GuestEndpoints feature gate
+	hasGuestEndpoints bool
 	// the channel used to trigger re-initialization.
 	reInitChan chan string
 
 	// number of threads for each controller
-	nodeControllerThreads             int
-	vmiControllerThreads              int
-	rsControllerThreads               int
-	poolControllerThreads             int
-	vmControllerThreads               int
-	migrationControllerThreads        int
-	evacuationControllerThreads       int
-	disruptionBudgetControllerThreads int
-	launcherSubGid                    int64
-	exportControllerThreads           int
-	snapshotControllerThreads         int
-	restoreControllerThreads          int
-	snapshotControllerResyncPeriod    time.Duration
-	cloneControllerThreads            int
+	nodeControllerThreads                int
+	vmiControllerThreads                 int
+	rsControllerThreads                  int
+	poolControllerThreads                int
+	vmControllerThreads                  int
+	migrationControllerThreads           int
+	evacuationControllerThreads          int
+	disruptionBudgetControllerThreads    int
+	guestEndpointsControllerThreads      int
+	secondaryNetworkDNSControllerThreads int
+	launcherSubGid                       int64
+	exportControllerThreads              int
+	snapshotControllerThreads            int
+	restoreControllerThreads             int
+	snapshotControllerResyncPeriod       time.Duration
+	cloneControllerThreads               int
 
 	caConfigMapName          string
 	promCertFilePath         string
@@ -335,6 +355,8 @@ func Execute() {
 
 	app.reInitChan = make(chan string, 10)
 	app.hasCDI = app.clusterConfig.HasDataVolumeAPI()
+	app.hasDNSEndpoint = app.clusterConfig.HasDNSEndpointAPI()
+	app.hasGuestEndpoints = app.clusterConfig.GuestEndpointsEnabled()
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeRateLimiter)
@@ -398,6 +420,23 @@ func Execute() {
 		log.Log.Infof("CDI not detected, DataVolume integration disabled")
 	}
 
+	if app.hasGuestEndpoints {
+		app.serviceInformer = app.informerFactory.Service()
+		app.guestEndpointSliceInformer = app.informerFactory.GuestEndpointSlice()
+	} else {
+		// Don't watch all the Services of the cluster when the guest endpoints controller has nothing to do
+		app.serviceInformer = app.informerFactory.DummyService()
+		app.guestEndpointSliceInformer = app.informerFactory.DummyGuestEndpointSlice()
+	}
+
+	if app.hasDNSEndpoint {
+		app.dnsEndpointInformer = app.informerFactory.DNSEndpoint()
+		log.Log.Infof("ExternalDNS detected, secondary network DNS records enabled")
+	} else {
+		app.dnsEndpointInformer = app.informerFactory.DummyDNSEndpoint()
+		log.Log.Infof("ExternalDNS not detected, secondary network DNS records disabled")
+	}
+
 	onOpenShift, err := clusterutil.IsOnOpenShift(app.clientSet)
 	if err != nil {
 		golog.Fatalf("Error determining cluster type: %v", err)
@@ -426,11 +465,15 @@ func Execute() {
 	app.initPool()
 	app.initVirtualMachines()
 	app.initDisruptionBudgetController()
+	app.initGuestEndpointsController()
+	app.initSecondaryNetworkDNSController()
 	app.initEvacuationController()
 	app.initSnapshotController()
 	app.initRestoreController()
 	app.initExportController()
 	app.initWorkloadUpdaterController()
+	app.initMediatedDevicesRebalancer()
+	app.initCPUBaselineCalculator()
 	app.initCloneController()
 	go app.Run()
 
@@ -450,6 +493,26 @@ func (vca *VirtControllerApp) configModificationCallback() {
 		}
 		vca.reInitChan <- "reinit"
 	}
+
+	newHasDNSEndpoint := vca.clusterConfig.HasDNSEndpointAPI()
+	if newHasDNSEndpoint != vca.hasDNSEndpoint {
+		if newHasDNSEndpoint {
+			log.Log.Infof("Reinitialize virt-controller, externaldns api has been introduced")
+		} else {
+			log.Log.Infof("Reinitialize virt-controller, externaldns api has been removed")
+		}
+		vca.reInitChan <- "reinit"
+	}
+
+	newHasGuestEndpoints := vca.clusterConfig.GuestEndpointsEnabled()
+	if newHasGuestEndpoints != vca.hasGuestEndpoints {
+		if newHasGuestEndpoints {
+			log.Log.Infof("Reinitialize virt-controller, guest endpoints have been enabled")
+		} else {
+			log.Log.Infof("Reinitialize virt-controller, guest endpoints have been disabled")
+		}
+		vca.reInitChan <- "reinit"
+	}
 }
 
 // Update virt-controller rate limiter
@@ -530,6 +593,8 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 
 		go vca.evacuationController.Run(vca.evacuationControllerThreads, stop)
 		go vca.disruptionBudgetController.Run(vca.disruptionBudgetControllerThreads, stop)
+		go vca.guestEndpointsController.Run(vca.guestEndpointsControllerThreads, stop)
+		go vca.secondaryNetworkDNSController.Run(vca.secondaryNetworkDNSControllerThreads, stop)
 		go vca.nodeController.Run(vca.nodeControllerThreads, stop)
 		go vca.vmiController.Run(vca.vmiControllerThreads, stop)
 		go vca.rsController.Run(vca.rsControllerThreads, stop)
@@ -552,6 +617,8 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 			}
 		}()
 		go vca.workloadUpdateController.Run(stop)
+		go vca.mdevRebalancer.Run(stop)
+		go vca.cpuBaselineCalculator.Run(stop)
 		go vca.nodeTopologyUpdater.Run(vca.nodeTopologyUpdatePeriod, stop)
 		go func() {
 			if err := vca.vmCloneController.Run(vca.cloneControllerThreads, stop); err != nil {
@@ -688,6 +755,28 @@ func (vca *VirtControllerApp) initDisruptionBudgetController() {
 
 }
 
+func (vca *VirtControllerApp) initGuestEndpointsController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "guest-endpoints-controller")
+	vca.guestEndpointsController = guestendpoints.NewGuestEndpointsController(
+		vca.serviceInformer,
+		vca.guestEndpointSliceInformer,
+		vca.vmiInformer,
+		recorder,
+		vca.clientSet,
+	)
+}
+
+func (vca *VirtControllerApp) initSecondaryNetworkDNSController() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "secondary-network-dns-controller")
+	vca.secondaryNetworkDNSController = networkdns.NewSecondaryNetworkDNSController(
+		vca.vmiInformer,
+		vca.dnsEndpointInformer,
+		recorder,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+}
+
 func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "workload-update-controller")
 	vca.workloadUpdateController = workloadupdater.NewWorkloadUpdateController(
@@ -701,6 +790,28 @@ func (vca *VirtControllerApp) initWorkloadUpdaterController() {
 		vca.clusterConfig)
 }
 
+func (vca *VirtControllerApp) initMediatedDevicesRebalancer() {
+	recorder := vca.newRecorder(k8sv1.NamespaceAll, "mdev-rebalancer")
+	vca.mdevRebalancer = mdevrebalancer.NewMediatedDevicesRebalancer(
+		vca.vmiInformer,
+		vca.nodeInformer,
+		vca.kvPodInformer,
+		vca.kubeVirtInformer,
+		recorder,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+}
+
+func (vca *VirtControllerApp) initCPUBaselineCalculator() {
+	vca.cpuBaselineCalculator = cpubaseline.NewCalculator(
+		vca.nodeInformer,
+		vca.kubeVirtInformer,
+		vca.clientSet,
+		vca.clusterConfig,
+	)
+}
+
 func (vca *VirtControllerApp) initEvacuationController() {
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "disruptionbudget-controller")
 	vca.evacuationController = evacuation.NewEvacuationController(
@@ -875,6 +986,12 @@ func (vca *VirtControllerApp) AddFlags() {
 	flag.IntVar(&vca.disruptionBudgetControllerThreads, "disruption-budget-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for disruption budget controller")
 
+	flag.IntVar(&vca.guestEndpointsControllerThreads, "guest-endpoints-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for guest endpoints controller")
+
+	flag.IntVar(&vca.secondaryNetworkDNSControllerThreads, "secondary-network-dns-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for secondary network DNS controller")
+
 	flag.Int64Var(&vca.launcherSubGid, "launcher-subgid", defaultLauncherSubGid,
 		"ID of subgroup to virt-launcher")
 
@@ -56,8 +56,10 @@ import (
 	testutils "kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/clone"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/cpubaseline"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/disruptionbudget"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/mdevrebalancer"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 )
 
@@ -224,6 +226,8 @@ var _ = Describe("Application", func() {
 			vmSnapshotContentInformer,
 			recorder,
 		)
+		app.mdevRebalancer = mdevrebalancer.NewMediatedDevicesRebalancer(vmiInformer, nodeInformer, podInformer, kvInformer, recorder, virtClient, config)
+		app.cpuBaselineCalculator = cpubaseline.NewCalculator(nodeInformer, kvInformer, virtClient, config)
 
 		app.readyChan = make(chan bool)
 
@@ -0,0 +1,253 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package cpubaseline
+
+import (
+	"sort"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/kubevirtstatus"
+)
+
+// genericCPUModels orders the x86 CPU models known to libvirt which don't belong to a vendor from the oldest to
+// the newest. They are usable on the nodes of any vendor.
+var genericCPUModels = []string{
+	"486", "pentium", "pentium2", "pentium3", "pentiumpro",
+	"qemu32", "kvm32", "cpu64-rhel5", "cpu64-rhel6", "kvm64", "qemu64",
+}
+
+// cpuModelGenerations orders the x86 CPU models known to libvirt from the oldest to the newest, per vendor as
+// reported by the cpu-vendor node label. Within a family, the models come before the variants with more features.
+var cpuModelGenerations = map[string][]string{
+	"Intel": {
+		"coreduo", "n270", "core2duo",
+		"Conroe", "Penryn",
+		"Nehalem", "Nehalem-IBRS",
+		"Westmere", "Westmere-IBRS",
+		"SandyBridge", "SandyBridge-IBRS",
+		"IvyBridge", "IvyBridge-IBRS",
+		"Haswell-noTSX", "Haswell-noTSX-IBRS", "Haswell", "Haswell-IBRS",
+		"Broadwell-noTSX", "Broadwell-noTSX-IBRS", "Broadwell", "Broadwell-IBRS",
+		"Skylake-Client", "Skylake-Client-noTSX-IBRS", "Skylake-Client-IBRS",
+		"Skylake-Server", "Skylake-Server-noTSX-IBRS", "Skylake-Server-IBRS",
+		"Cascadelake-Server-noTSX", "Cascadelake-Server",
+		"Icelake-Client-noTSX", "Icelake-Client",
+		"Icelake-Server-noTSX", "Icelake-Server",
+		"Cooperlake", "Snowridge", "SapphireRapids",
+	},
+	"AMD": {
+		"athlon", "phenom",
+		"Opteron_G1", "Opteron_G2", "Opteron_G3", "Opteron_G4", "Opteron_G5",
+		"EPYC", "EPYC-IBPB", "EPYC-Rome", "EPYC-Milan",
+	},
+	"Hygon": {
+		"Dhyana",
+	},
+}
+
+// Calculator computes the newest CPU model and the features supported by all the selected nodes,
+// from the labels set by the node-labeller, and reports them in the status of the KubeVirt CR.
+type Calculator struct {
+	*kubevirtstatus.Controller
+	nodeInformer  cache.SharedIndexInformer
+	clusterConfig *virtconfig.ClusterConfig
+}
+
+func NewCalculator(
+	nodeInformer cache.SharedIndexInformer,
+	kubeVirtInformer cache.SharedIndexInformer,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) *Calculator {
+	c := &Calculator{
+		nodeInformer:  nodeInformer,
+		clusterConfig: clusterConfig,
+	}
+	c.Controller = kubevirtstatus.NewController("cluster CPU baseline", "virt-controller-cpu-baseline", kubeVirtInformer, clientset, c.execute)
+
+	c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueNode,
+		DeleteFunc: c.enqueueNode,
+		UpdateFunc: func(old, curr interface{}) {
+			if nodeLabelsChanged(old, curr) {
+				c.enqueueNode(curr)
+			}
+		},
+	})
+
+	return c
+}
+
+func nodeLabelsChanged(old, curr interface{}) bool {
+	oldNode, ok := old.(*k8sv1.Node)
+	if !ok {
+		return true
+	}
+	currNode, ok := curr.(*k8sv1.Node)
+	if !ok {
+		return true
+	}
+	return oldNode.Spec.Unschedulable != currNode.Spec.Unschedulable || !equality.Semantic.DeepEqual(oldNode.Labels, currNode.Labels)
+}
+
+func (c *Calculator) enqueueNode(obj interface{}) {
+	if !c.clusterConfig.ClusterCPUBaselineEnabled() {
+		return
+	}
+	c.Enqueue(obj)
+}
+
+// Run runs the passed in Calculator.
+func (c *Calculator) Run(stopCh <-chan struct{}) {
+	c.Controller.Run(stopCh, c.nodeInformer.HasSynced)
+}
+
+func (c *Calculator) execute(_ string, kv *virtv1.KubeVirt) error {
+	const path = "/status/cpuBaseline"
+
+	if !c.clusterConfig.ClusterCPUBaselineEnabled() {
+		return c.PatchStatus(kv, path, kv.Status.CPUBaseline, (*virtv1.CPUBaselineStatus)(nil))
+	}
+
+	var nodes []*k8sv1.Node
+	for _, obj := range c.nodeInformer.GetStore().List() {
+		nodes = append(nodes, obj.(*k8sv1.Node))
+	}
+	return c.PatchStatus(kv, path, kv.Status.CPUBaseline, calculate(nodes, labels.SelectorFromSet(c.clusterConfig.GetCPUBaselineNodeSelector())))
+}
+
+// calculate intersects the usable CPU models and features of the schedulable nodes matching the selector.
+// Nodes without CPU model labels, e.g. not labelled yet or not x86_64, are ignored. The models of another vendor than
+// the one of the node are dropped, libvirt may report some of them as usable but they don't match the host.
+func calculate(nodes []*k8sv1.Node, nodeSelector labels.Selector) *virtv1.CPUBaselineStatus {
+	baseline := &virtv1.CPUBaselineStatus{}
+	var models, features map[string]struct{}
+	vendor := ""
+	for _, node := range nodes {
+		if node.Spec.Unschedulable || node.Labels[virtv1.NodeSchedulable] != "true" || !nodeSelector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		nodeModels := labelsWithPrefix(node, virtv1.CPUModelLabel)
+		if len(nodeModels) == 0 {
+			continue
+		}
+		nodeVendor := nodeCPUVendor(node)
+		dropOtherVendorModels(nodeModels, nodeVendor)
+		nodeFeatures := labelsWithPrefix(node, virtv1.CPUFeatureLabel)
+		if baseline.Nodes == 0 {
+			models, features, vendor = nodeModels, nodeFeatures, nodeVendor
+		} else {
+			intersect(models, nodeModels)
+			intersect(features, nodeFeatures)
+			if nodeVendor != vendor {
+				vendor = ""
+			}
+		}
+		baseline.Nodes++
+	}
+
+	baseline.Model = newestModel(models, vendor)
+	for feature := range features {
+		baseline.Features = append(baseline.Features, feature)
+	}
+	sort.Strings(baseline.Features)
+	return baseline
+}
+
+// nodeCPUVendor returns the CPU vendor of the node, or an empty string if it isn't labelled with exactly one.
+func nodeCPUVendor(node *k8sv1.Node) string {
+	vendors := labelsWithPrefix(node, virtv1.CPUModelVendorLabel)
+	if len(vendors) != 1 {
+		return ""
+	}
+	for vendor := range vendors {
+		return vendor
+	}
+	return ""
+}
+
+// dropOtherVendorModels removes the known models of the other vendors from the set.
+// Nothing is removed if the vendor is unknown.
+func dropOtherVendorModels(models map[string]struct{}, vendor string) {
+	if vendor == "" {
+		return
+	}
+	for modelVendor, generations := range cpuModelGenerations {
+		if modelVendor == vendor {
+			continue
+		}
+		for _, model := range generations {
+			delete(models, model)
+		}
+	}
+}
+
+func labelsWithPrefix(node *k8sv1.Node, prefix string) map[string]struct{} {
+	names := map[string]struct{}{}
+	for label, value := range node.Labels {
+		if value == "true" && strings.HasPrefix(label, prefix) {
+			names[strings.TrimPrefix(label, prefix)] = struct{}{}
+		}
+	}
+	return names
+}
+
+func intersect(set, other map[string]struct{}) {
+	for name := range set {
+		if _, exists := other[name]; !exists {
+			delete(set, name)
+		}
+	}
+}
+
+// newestModel returns the newest known model of the set, ranked within the models of the vendor first and then within
+// the generic ones. If the vendor is unknown, the models of all the vendors are ranked after the generic ones.
+// If no model of the set is known, the last one in alphabetical order is returned.
+func newestModel(models map[string]struct{}, vendor string) string {
+	generations, known := cpuModelGenerations[vendor]
+	if !known {
+		for _, modelVendor := range []string{"Intel", "AMD", "Hygon"} {
+			generations = append(generations, cpuModelGenerations[modelVendor]...)
+		}
+	}
+	for _, ranking := range [][]string{generations, genericCPUModels} {
+		for i := len(ranking) - 1; i >= 0; i-- {
+			if _, exists := models[ranking[i]]; exists {
+				return ranking[i]
+			}
+		}
+	}
+	newest := ""
+	for model := range models {
+		if model > newest {
+			newest = model
+		}
+	}
+	return newest
+}
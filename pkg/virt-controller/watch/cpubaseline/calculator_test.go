@@ -0,0 +1,224 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package cpubaseline_test
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"os"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/cpubaseline"
+)
+
+var _ = Describe("Cluster CPU baseline calculator", func() {
+	var (
+		kv            *v1.KubeVirt
+		nodeInformer  cache.SharedIndexInformer
+		mockQueue     *testutils.MockWorkQueue
+		calculator    *cpubaseline.Calculator
+		patchedStatus *v1.CPUBaselineStatus
+		patched       bool
+	)
+
+	initCalculator := func(cpuBaseline *v1.CPUBaselineConfiguration, featureGates ...string) {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeVirtInterface := kubecli.NewMockKubeVirtInterface(ctrl)
+		virtClient.EXPECT().KubeVirt(gomock.Any()).Return(kubeVirtInterface).AnyTimes()
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+					CPUBaseline:            cpuBaseline,
+				},
+			},
+		}
+		config, _, kubeVirtInformer := testutils.NewFakeClusterConfigUsingKV(kv)
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+
+		calculator = cpubaseline.NewCalculator(nodeInformer, kubeVirtInformer, virtClient, config)
+		mockQueue = testutils.NewMockWorkQueue(calculator.Queue)
+		calculator.Queue = mockQueue
+
+		patchedStatus = nil
+		patched = false
+		kubeVirtInterface.EXPECT().PatchStatus(kv.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions) (*v1.KubeVirt, error) {
+				patch, err := jsonpatch.DecodePatch(data)
+				Expect(err).ToNot(HaveOccurred())
+				kvJSON, err := json.Marshal(kv)
+				Expect(err).ToNot(HaveOccurred())
+				patchedJSON, err := patch.Apply(kvJSON)
+				Expect(err).ToNot(HaveOccurred())
+				patchedKV := &v1.KubeVirt{}
+				Expect(json.Unmarshal(patchedJSON, patchedKV)).To(Succeed())
+				patchedStatus = patchedKV.Status.CPUBaseline
+				patched = true
+				return patchedKV, nil
+			}).AnyTimes()
+	}
+
+	BeforeEach(func() {
+		initCalculator(nil, virtconfig.ClusterCPUBaselineGate)
+	})
+
+	addNode := func(name string, nodeLabels map[string]string, models []string, features []string) {
+		node := &k8sv1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{v1.NodeSchedulable: "true"}}}
+		for key, value := range nodeLabels {
+			node.Labels[key] = value
+		}
+		for _, model := range models {
+			node.Labels[v1.CPUModelLabel+model] = "true"
+		}
+		for _, feature := range features {
+			node.Labels[v1.CPUFeatureLabel+feature] = "true"
+		}
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+	}
+
+	execute := func() {
+		mockQueue.Add("kubevirt/kubevirt")
+		calculator.Execute()
+	}
+
+	It("should report the newest model and the features supported by all the nodes", func() {
+		addNode("node01", nil, []string{"Penryn", "Haswell-noTSX", "Skylake-Client-IBRS"}, []string{"aes", "avx2", "pcid"})
+		addNode("node02", nil, []string{"Penryn", "Haswell-noTSX", "Haswell-noTSX-IBRS"}, []string{"aes", "pcid", "ssbd"})
+
+		execute()
+
+		Expect(patchedStatus).To(Equal(&v1.CPUBaselineStatus{
+			Model:    "Haswell-noTSX",
+			Features: []string{"aes", "pcid"},
+			Nodes:    2,
+		}))
+	})
+
+	It("should ignore the nodes which are not schedulable or not labelled", func() {
+		addNode("node01", nil, []string{"Penryn", "Skylake-Client-IBRS"}, nil)
+		addNode("node02", map[string]string{v1.NodeSchedulable: "false"}, []string{"Penryn"}, nil)
+		addNode("node03", nil, nil, nil)
+
+		execute()
+
+		Expect(patchedStatus).To(Equal(&v1.CPUBaselineStatus{Model: "Skylake-Client-IBRS", Nodes: 1}))
+	})
+
+	It("should only consider the selected nodes", func() {
+		initCalculator(&v1.CPUBaselineConfiguration{
+			NodeSelector: map[string]string{"cpu-group": "new"},
+		}, virtconfig.ClusterCPUBaselineGate)
+		addNode("node01", map[string]string{"cpu-group": "new"}, []string{"Penryn", "Skylake-Client-IBRS"}, nil)
+		addNode("node02", nil, []string{"Penryn"}, nil)
+
+		execute()
+
+		Expect(patchedStatus).To(Equal(&v1.CPUBaselineStatus{Model: "Skylake-Client-IBRS", Nodes: 1}))
+	})
+
+	It("should report no model if the nodes have none in common", func() {
+		addNode("node01", nil, []string{"Skylake-Client-IBRS"}, nil)
+		addNode("node02", nil, []string{"EPYC"}, nil)
+
+		execute()
+
+		Expect(patchedStatus).To(Equal(&v1.CPUBaselineStatus{Nodes: 2}))
+	})
+
+	Context("with the usable models reported by libvirt", func() {
+		// The custom mode of these capabilities marks models of all the vendors as usable, e.g. Opteron_G1 to
+		// Opteron_G3 next to Westmere and SandyBridge.
+		usableModels := func() []string {
+			data, err := os.ReadFile("../../../virt-handler/node-labeller/testdata/domcapabilities_nosev.xml")
+			Expect(err).ToNot(HaveOccurred())
+			capabilities := struct {
+				Modes []struct {
+					Name   string `xml:"name,attr"`
+					Models []struct {
+						Name   string `xml:",chardata"`
+						Usable string `xml:"usable,attr"`
+					} `xml:"model"`
+				} `xml:"cpu>mode"`
+			}{}
+			Expect(xml.Unmarshal(data, &capabilities)).To(Succeed())
+			var models []string
+			for _, mode := range capabilities.Modes {
+				if mode.Name != "custom" {
+					continue
+				}
+				for _, model := range mode.Models {
+					if model.Usable == "yes" {
+						models = append(models, model.Name)
+					}
+				}
+			}
+			Expect(models).To(ContainElements("Opteron_G3", "SandyBridge", "EPYC-IBPB", "Dhyana"))
+			return models
+		}
+
+		DescribeTable("should rank the models within the vendor of the nodes", func(vendor, expectedModel string) {
+			addNode("node01", map[string]string{v1.CPUModelVendorLabel + vendor: "true"}, usableModels(), nil)
+
+			execute()
+
+			Expect(patchedStatus).To(Equal(&v1.CPUBaselineStatus{Model: expectedModel, Nodes: 1}))
+		},
+			Entry("on an Intel host", "Intel", "SandyBridge"),
+			Entry("on an AMD host", "AMD", "EPYC-IBPB"),
+			Entry("on a Hygon host", "Hygon", "Dhyana"),
+		)
+
+		It("should only report a generic model for nodes of different vendors", func() {
+			addNode("node01", map[string]string{v1.CPUModelVendorLabel + "Intel": "true"}, usableModels(), nil)
+			addNode("node02", map[string]string{v1.CPUModelVendorLabel + "AMD": "true"}, usableModels(), nil)
+
+			execute()
+
+			Expect(patchedStatus).To(Equal(&v1.CPUBaselineStatus{Model: "qemu64", Nodes: 2}))
+		})
+	})
+
+	It("should drop the baseline from the status when the feature gate is disabled", func() {
+		initCalculator(nil)
+		kv.Status.CPUBaseline = &v1.CPUBaselineStatus{Model: "Penryn", Nodes: 1}
+		addNode("node01", nil, []string{"Penryn"}, nil)
+
+		execute()
+
+		Expect(patched).To(BeTrue())
+		Expect(patchedStatus).To(BeNil())
+	})
+})
@@ -0,0 +1,11 @@
+package cpubaseline_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestCPUBaseline(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
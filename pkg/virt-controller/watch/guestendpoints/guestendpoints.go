@@ -0,0 +1,435 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package guestendpoints
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/pointer"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+)
+
+const (
+	// FailedSyncGuestEndpointsReason is added in an event if the EndpointSlices of a Service could not be synchronized.
+	FailedSyncGuestEndpointsReason = "FailedSyncGuestEndpoints"
+	// InvalidGuestEndpointsSelectorReason is added in an event if the VMI selector of a Service cannot be parsed.
+	InvalidGuestEndpointsSelectorReason = "InvalidGuestEndpointsSelector"
+)
+
+// GuestEndpointsController keeps the EndpointSlices of the Services annotated with
+// virtv1.GuestEndpointsNetworkAnnotation in sync with the IP addresses the guest agent
+// reports on that network, for the VMIs selected by virtv1.GuestEndpointsSelectorAnnotation.
+type GuestEndpointsController struct {
+	clientset             kubecli.KubevirtClient
+	Queue                 workqueue.RateLimitingInterface
+	serviceInformer       cache.SharedIndexInformer
+	endpointSliceInformer cache.SharedIndexInformer
+	vmiInformer           cache.SharedIndexInformer
+	recorder              record.EventRecorder
+}
+
+func NewGuestEndpointsController(
+	serviceInformer cache.SharedIndexInformer,
+	endpointSliceInformer cache.SharedIndexInformer,
+	vmiInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+) *GuestEndpointsController {
+	c := &GuestEndpointsController{
+		Queue:                 workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-guest-endpoints"),
+		clientset:             clientset,
+		serviceInformer:       serviceInformer,
+		endpointSliceInformer: endpointSliceInformer,
+		vmiInformer:           vmiInformer,
+		recorder:              recorder,
+	}
+
+	c.serviceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueService,
+		DeleteFunc: c.enqueueService,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueService(curr) },
+	})
+
+	c.endpointSliceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueEndpointSliceService,
+		DeleteFunc: c.enqueueEndpointSliceService,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueEndpointSliceService(curr) },
+	})
+
+	c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMIServices,
+		DeleteFunc: c.enqueueVMIServices,
+		UpdateFunc: func(old, curr interface{}) {
+			// A label change may remove the VMI from the Services selecting its old labels
+			c.enqueueVMIServices(old)
+			c.enqueueVMIServices(curr)
+		},
+	})
+
+	return c
+}
+
+func (c *GuestEndpointsController) enqueueService(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from service.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+func (c *GuestEndpointsController) enqueueEndpointSliceService(obj interface{}) {
+	slice, ok := obj.(*discoveryv1.EndpointSlice)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if slice, ok = tombstone.Obj.(*discoveryv1.EndpointSlice); !ok {
+			return
+		}
+	}
+	if serviceName, exists := slice.Labels[discoveryv1.LabelServiceName]; exists {
+		c.Queue.Add(controller.NamespacedKey(slice.Namespace, serviceName))
+	}
+}
+
+// enqueueVMIServices enqueues the guest endpoints Services of the VMI namespace selecting the VMI.
+func (c *GuestEndpointsController) enqueueVMIServices(obj interface{}) {
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance); !ok {
+			return
+		}
+	}
+
+	indexer := c.serviceInformer.GetIndexer()
+	keyPrefix := controller.GuestEndpointsSelectorIndexKey(vmi.Namespace, "")
+	for _, key := range indexer.ListIndexFuncValues(controller.GuestEndpointsSelectorIndex) {
+		if !strings.HasPrefix(key, keyPrefix) {
+			continue
+		}
+		selector, err := labels.Parse(strings.TrimPrefix(key, keyPrefix))
+		if err != nil || selector.Empty() || !selector.Matches(labels.Set(vmi.Labels)) {
+			continue
+		}
+		objs, err := indexer.ByIndex(controller.GuestEndpointsSelectorIndex, key)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Error("Failed to list the services selecting the VMI.")
+			return
+		}
+		for _, obj := range objs {
+			c.enqueueService(obj)
+		}
+	}
+}
+
+func isGuestEndpointsService(service *corev1.Service) bool {
+	_, exists := service.Annotations[virtv1.GuestEndpointsNetworkAnnotation]
+	return exists && len(service.Spec.Selector) == 0
+}
+
+// Run runs the passed in GuestEndpointsController.
+func (c *GuestEndpointsController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting guest endpoints controller.")
+
+	cache.WaitForCacheSync(stopCh, c.serviceInformer.HasSynced, c.endpointSliceInformer.HasSynced, c.vmiInformer.HasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping guest endpoints controller.")
+}
+
+func (c *GuestEndpointsController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *GuestEndpointsController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key.(string)); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing Service %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed Service %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *GuestEndpointsController) execute(key string) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	obj, exists, err := c.serviceInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return err
+	}
+
+	var desiredSlices []*discoveryv1.EndpointSlice
+	if exists && isGuestEndpointsService(obj.(*corev1.Service)) {
+		service := obj.(*corev1.Service)
+		selector, err := labels.Parse(service.Annotations[virtv1.GuestEndpointsSelectorAnnotation])
+		if err != nil {
+			c.recorder.Eventf(service, corev1.EventTypeWarning, InvalidGuestEndpointsSelectorReason, "Invalid VMI selector: %v", err)
+			// The service has to be updated for the situation to change
+			return nil
+		}
+		// An empty or missing selector selects no VMI rather than every VMI of the namespace
+		if !selector.Empty() {
+			vmis, err := c.listVMIs(namespace, selector)
+			if err != nil {
+				return err
+			}
+			desiredSlices = newEndpointSlices(service, vmis)
+		}
+	}
+
+	if err := c.syncEndpointSlices(namespace, name, desiredSlices); err != nil {
+		if exists {
+			c.recorder.Eventf(obj.(*corev1.Service), corev1.EventTypeWarning, FailedSyncGuestEndpointsReason, "Failed to sync the guest endpoints: %v", err)
+		}
+		return err
+	}
+	return nil
+}
+
+func (c *GuestEndpointsController) listVMIs(namespace string, selector labels.Selector) ([]*virtv1.VirtualMachineInstance, error) {
+	objs, err := c.vmiInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range objs {
+		vmi := obj.(*virtv1.VirtualMachineInstance)
+		if vmi.DeletionTimestamp == nil && !vmi.IsFinal() && selector.Matches(labels.Set(vmi.Labels)) {
+			vmis = append(vmis, vmi)
+		}
+	}
+	sort.Slice(vmis, func(i, j int) bool { return vmis[i].Name < vmis[j].Name })
+	return vmis, nil
+}
+
+// syncEndpointSlices creates, updates and deletes the EndpointSlices of the Service to match the desired ones.
+func (c *GuestEndpointsController) syncEndpointSlices(namespace, serviceName string, desiredSlices []*discoveryv1.EndpointSlice) error {
+	existingSlices := map[string]*discoveryv1.EndpointSlice{}
+	objs, err := c.endpointSliceInformer.GetIndexer().ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		return err
+	}
+	for _, obj := range objs {
+		slice := obj.(*discoveryv1.EndpointSlice)
+		if slice.Labels[discoveryv1.LabelServiceName] == serviceName {
+			existingSlices[slice.Name] = slice
+		}
+	}
+
+	client := c.clientset.DiscoveryV1().EndpointSlices(namespace)
+	for _, desired := range desiredSlices {
+		existing, exists := existingSlices[desired.Name]
+		delete(existingSlices, desired.Name)
+		if !exists {
+			if _, err := client.Create(context.Background(), desired, metav1.CreateOptions{}); err != nil {
+				return err
+			}
+			continue
+		}
+		if equality.Semantic.DeepEqual(existing.Endpoints, desired.Endpoints) &&
+			equality.Semantic.DeepEqual(existing.Ports, desired.Ports) &&
+			equality.Semantic.DeepEqual(existing.Labels, desired.Labels) {
+			continue
+		}
+		updated := existing.DeepCopy()
+		updated.Labels = desired.Labels
+		updated.Endpoints = desired.Endpoints
+		updated.Ports = desired.Ports
+		if _, err := client.Update(context.Background(), updated, metav1.UpdateOptions{}); err != nil {
+			return err
+		}
+	}
+
+	for name := range existingSlices {
+		if err := client.Delete(context.Background(), name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// newEndpointSlices returns one EndpointSlice per IP family, holding the addresses reported
+// by the guest agent on the Service network of each VMI.
+func newEndpointSlices(service *corev1.Service, vmis []*virtv1.VirtualMachineInstance) []*discoveryv1.EndpointSlice {
+	networkName := service.Annotations[virtv1.GuestEndpointsNetworkAnnotation]
+	endpointsByFamily := map[discoveryv1.AddressType][]discoveryv1.Endpoint{}
+	for _, vmi := range vmis {
+		ifaceStatus := netvmispec.LookupInterfaceStatusByName(vmi.Status.Interfaces, networkName)
+		if ifaceStatus == nil || !netvmispec.ContainsInfoSource(ifaceStatus.InfoSource, netvmispec.InfoSourceGuestAgent) {
+			continue
+		}
+		for _, ip := range interfaceIPs(ifaceStatus) {
+			addressType := discoveryv1.AddressTypeIPv4
+			if net.ParseIP(ip).To4() == nil {
+				addressType = discoveryv1.AddressTypeIPv6
+			}
+			endpointsByFamily[addressType] = append(endpointsByFamily[addressType], newEndpoint(vmi, ip))
+		}
+	}
+
+	var slices []*discoveryv1.EndpointSlice
+	for _, addressType := range []discoveryv1.AddressType{discoveryv1.AddressTypeIPv4, discoveryv1.AddressTypeIPv6} {
+		endpoints, exists := endpointsByFamily[addressType]
+		if !exists {
+			continue
+		}
+		slices = append(slices, &discoveryv1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("%s-guest-%s", service.Name, strings.ToLower(string(addressType))),
+				Namespace: service.Namespace,
+				Labels: map[string]string{
+					discoveryv1.LabelServiceName: service.Name,
+					discoveryv1.LabelManagedBy:   controller.GuestEndpointSliceManagedBy,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(service, corev1.SchemeGroupVersion.WithKind("Service")),
+				},
+			},
+			AddressType: addressType,
+			Endpoints:   endpoints,
+			Ports:       endpointPorts(service),
+		})
+	}
+	return slices
+}
+
+func interfaceIPs(ifaceStatus *virtv1.VirtualMachineInstanceNetworkInterface) []string {
+	var ips []string
+	for _, ip := range append([]string{ifaceStatus.IP}, ifaceStatus.IPs...) {
+		if parsedIP := net.ParseIP(ip); parsedIP == nil || parsedIP.IsLinkLocalUnicast() {
+			continue
+		}
+		if !containsString(ips, ip) {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func newEndpoint(vmi *virtv1.VirtualMachineInstance, ip string) discoveryv1.Endpoint {
+	endpoint := discoveryv1.Endpoint{
+		Addresses: []string{ip},
+		Conditions: discoveryv1.EndpointConditions{
+			Ready: pointer.Bool(isVMIReady(vmi)),
+		},
+		TargetRef: &corev1.ObjectReference{
+			APIVersion: virtv1.GroupVersion.String(),
+			Kind:       virtv1.VirtualMachineInstanceGroupVersionKind.Kind,
+			Namespace:  vmi.Namespace,
+			Name:       vmi.Name,
+			UID:        vmi.UID,
+		},
+	}
+	if vmi.Status.NodeName != "" {
+		endpoint.NodeName = pointer.String(vmi.Status.NodeName)
+	}
+	return endpoint
+}
+
+func isVMIReady(vmi *virtv1.VirtualMachineInstance) bool {
+	if !vmi.IsRunning() {
+		return false
+	}
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == virtv1.VirtualMachineInstanceReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// endpointPorts maps the Service ports to the guest ports, using the numeric target port when specified.
+func endpointPorts(service *corev1.Service) []discoveryv1.EndpointPort {
+	var ports []discoveryv1.EndpointPort
+	for _, servicePort := range service.Spec.Ports {
+		port := servicePort.Port
+		if servicePort.TargetPort.IntVal != 0 {
+			port = servicePort.TargetPort.IntVal
+		}
+		ports = append(ports, discoveryv1.EndpointPort{
+			Name:     pointer.String(servicePort.Name),
+			Protocol: protocolOrDefault(servicePort.Protocol),
+			Port:     pointer.Int32(port),
+		})
+	}
+	return ports
+}
+
+func protocolOrDefault(protocol corev1.Protocol) *corev1.Protocol {
+	if protocol == "" {
+		protocol = corev1.ProtocolTCP
+	}
+	return &protocol
+}
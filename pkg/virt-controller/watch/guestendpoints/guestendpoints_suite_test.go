@@ -0,0 +1,11 @@
+package guestendpoints_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestGuestEndpoints(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
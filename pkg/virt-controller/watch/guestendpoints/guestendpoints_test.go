@@ -0,0 +1,344 @@
+package guestendpoints_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	framework "k8s.io/client-go/tools/cache/testing"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	netvmispec "kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/guestendpoints"
+)
+
+var _ = Describe("Guest endpoints controller", func() {
+	const (
+		serviceName = "my-service"
+		networkName = "secondary"
+	)
+
+	var virtClient *kubecli.MockKubevirtClient
+	var kubeClient *fake.Clientset
+	var serviceInformer cache.SharedIndexInformer
+	var sliceInformer cache.SharedIndexInformer
+	var vmiInformer cache.SharedIndexInformer
+	var recorder *record.FakeRecorder
+	var mockQueue *testutils.MockWorkQueue
+	var ctrl *guestendpoints.GuestEndpointsController
+
+	BeforeEach(func() {
+		virtClient = kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		kubeClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().DiscoveryV1().Return(kubeClient.DiscoveryV1()).AnyTimes()
+
+		serviceInformer, _ = testutils.NewFakeInformerFor(&corev1.Service{})
+		Expect(serviceInformer.AddIndexers(cache.Indexers{
+			controller.GuestEndpointsSelectorIndex: controller.GuestEndpointsSelectorIndexFunc,
+		})).To(Succeed())
+		sliceInformer, _ = testutils.NewFakeInformerFor(&discoveryv1.EndpointSlice{})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		ctrl = guestendpoints.NewGuestEndpointsController(serviceInformer, sliceInformer, vmiInformer, recorder, virtClient)
+		mockQueue = testutils.NewMockWorkQueue(ctrl.Queue)
+		ctrl.Queue = mockQueue
+	})
+
+	AfterEach(func() {
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	addService := func(service *corev1.Service) {
+		Expect(serviceInformer.GetIndexer().Add(service)).To(Succeed())
+	}
+
+	addVMI := func(vmi *v1.VirtualMachineInstance) {
+		Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+	}
+
+	addSlice := func(slice *discoveryv1.EndpointSlice) {
+		Expect(sliceInformer.GetIndexer().Add(slice)).To(Succeed())
+		_, err := kubeClient.DiscoveryV1().EndpointSlices(slice.Namespace).Create(context.Background(), slice, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	execute := func() {
+		mockQueue.Add(controller.NamespacedKey(metav1.NamespaceDefault, serviceName))
+		ctrl.Execute()
+		Expect(mockQueue.Len()).To(BeZero())
+	}
+
+	listSlices := func() []discoveryv1.EndpointSlice {
+		list, err := kubeClient.DiscoveryV1().EndpointSlices(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return list.Items
+	}
+
+	It("should publish the guest agent addresses of the selected VMIs", func() {
+		addService(newService(serviceName, networkName, "app=web"))
+		addVMI(newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5", "fd10::5", "fe80::1"))
+		addVMI(newVMI("vmi2", map[string]string{"app": "db"}, networkName, "10.1.0.6"))
+
+		execute()
+
+		slices := listSlices()
+		Expect(slices).To(HaveLen(2))
+		for _, slice := range slices {
+			Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelServiceName, serviceName))
+			Expect(slice.Labels).To(HaveKeyWithValue(discoveryv1.LabelManagedBy, controller.GuestEndpointSliceManagedBy))
+			Expect(slice.OwnerReferences).To(HaveLen(1))
+			Expect(slice.Endpoints).To(HaveLen(1))
+			Expect(slice.Endpoints[0].TargetRef.Name).To(Equal("vmi1"))
+			Expect(*slice.Endpoints[0].Conditions.Ready).To(BeTrue())
+			Expect(slice.Ports).To(HaveLen(1))
+			Expect(*slice.Ports[0].Port).To(Equal(int32(8080)))
+			switch slice.AddressType {
+			case discoveryv1.AddressTypeIPv4:
+				Expect(slice.Endpoints[0].Addresses).To(ConsistOf("10.1.0.5"))
+			case discoveryv1.AddressTypeIPv6:
+				Expect(slice.Endpoints[0].Addresses).To(ConsistOf("fd10::5"))
+			default:
+				Fail("unexpected address type " + string(slice.AddressType))
+			}
+		}
+	})
+
+	It("should ignore addresses which were not reported by the guest agent", func() {
+		addService(newService(serviceName, networkName, "app=web"))
+		vmi := newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5")
+		vmi.Status.Interfaces[0].InfoSource = netvmispec.InfoSourceMultusStatus
+		addVMI(vmi)
+
+		execute()
+
+		Expect(listSlices()).To(BeEmpty())
+	})
+
+	It("should update the slice when the guest address changes", func() {
+		addService(newService(serviceName, networkName, "app=web"))
+		addVMI(newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5"))
+		execute()
+		slices := listSlices()
+		Expect(slices).To(HaveLen(1))
+		Expect(sliceInformer.GetIndexer().Add(&slices[0])).To(Succeed())
+
+		addVMI(newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.7"))
+		execute()
+
+		slices = listSlices()
+		Expect(slices).To(HaveLen(1))
+		Expect(slices[0].Endpoints[0].Addresses).To(ConsistOf("10.1.0.7"))
+	})
+
+	It("should mark the endpoints of a VMI which is not ready as not ready", func() {
+		addService(newService(serviceName, networkName, "app=web"))
+		vmi := newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5")
+		vmi.Status.Conditions = nil
+		addVMI(vmi)
+
+		execute()
+
+		slices := listSlices()
+		Expect(slices).To(HaveLen(1))
+		Expect(*slices[0].Endpoints[0].Conditions.Ready).To(BeFalse())
+	})
+
+	It("should delete the slices of a service which no longer exists", func() {
+		addSlice(newEndpointSlice(serviceName + "-guest-ipv4"))
+
+		execute()
+
+		Expect(listSlices()).To(BeEmpty())
+	})
+
+	It("should delete the slices of a service which is no longer annotated", func() {
+		service := newService(serviceName, networkName, "app=web")
+		delete(service.Annotations, v1.GuestEndpointsNetworkAnnotation)
+		addService(service)
+		addSlice(newEndpointSlice(serviceName + "-guest-ipv4"))
+
+		execute()
+
+		Expect(listSlices()).To(BeEmpty())
+	})
+
+	It("should not touch the slices of a service with a pod selector", func() {
+		service := newService(serviceName, networkName, "app=web")
+		service.Spec.Selector = map[string]string{"app": "web"}
+		addService(service)
+		addVMI(newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5"))
+
+		execute()
+
+		Expect(listSlices()).To(BeEmpty())
+	})
+
+	DescribeTable("should not select any VMI", func(selector *string) {
+		service := newService(serviceName, networkName, "")
+		if selector == nil {
+			delete(service.Annotations, v1.GuestEndpointsSelectorAnnotation)
+		} else {
+			service.Annotations[v1.GuestEndpointsSelectorAnnotation] = *selector
+		}
+		addService(service)
+		addVMI(newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5"))
+		addSlice(newEndpointSlice(serviceName + "-guest-ipv4"))
+
+		execute()
+
+		Expect(listSlices()).To(BeEmpty())
+	},
+		Entry("with an empty selector", pointer.String("")),
+		Entry("with a blank selector", pointer.String("  ")),
+		Entry("without a selector", nil),
+	)
+
+	It("should report an invalid VMI selector", func() {
+		addService(newService(serviceName, networkName, "app in (web"))
+		kubeClient.Fake.PrependReactor("*", "endpointslices", func(action testing.Action) (bool, runtime.Object, error) {
+			Expect(action).To(BeNil())
+			return true, nil, nil
+		})
+
+		execute()
+
+		testutils.ExpectEvent(recorder, guestendpoints.InvalidGuestEndpointsSelectorReason)
+	})
+
+	Context("on VMI changes", func() {
+		var vmiSource *framework.FakeControllerSource
+		var stop chan struct{}
+
+		BeforeEach(func() {
+			addService(newService(serviceName, networkName, "app=web"))
+			addService(newService("db", networkName, "app=db"))
+			podSelectorService := newService("pod-selector", networkName, "app=web")
+			podSelectorService.Spec.Selector = map[string]string{"app": "web"}
+			addService(podSelectorService)
+			otherNamespaceService := newService("other-namespace", networkName, "app=web")
+			otherNamespaceService.Namespace = "other"
+			addService(otherNamespaceService)
+
+			vmiInformer, vmiSource = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+			ctrl = guestendpoints.NewGuestEndpointsController(serviceInformer, sliceInformer, vmiInformer, recorder, virtClient)
+			mockQueue = testutils.NewMockWorkQueue(ctrl.Queue)
+			ctrl.Queue = mockQueue
+			stop = make(chan struct{})
+			go vmiInformer.Run(stop)
+			Expect(cache.WaitForCacheSync(stop, vmiInformer.HasSynced)).To(BeTrue())
+		})
+
+		AfterEach(func() {
+			close(stop)
+		})
+
+		It("should enqueue the guest endpoints services selecting the VMI", func() {
+			mockQueue.ExpectAdds(1)
+			vmiSource.Add(newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5"))
+			mockQueue.Wait()
+
+			Expect(mockQueue.Len()).To(Equal(1))
+			key, _ := mockQueue.Get()
+			Expect(key).To(Equal(controller.NamespacedKey(metav1.NamespaceDefault, serviceName)))
+		})
+
+		It("should enqueue the guest endpoints services selecting the old labels of the VMI", func() {
+			vmi := newVMI("vmi1", map[string]string{"app": "web"}, networkName, "10.1.0.5")
+			mockQueue.ExpectAdds(1)
+			vmiSource.Add(vmi)
+			mockQueue.Wait()
+			key, _ := mockQueue.Get()
+			mockQueue.Done(key)
+
+			vmi = vmi.DeepCopy()
+			vmi.Labels = map[string]string{"app": "db"}
+			mockQueue.ExpectAdds(2)
+			vmiSource.Modify(vmi)
+			mockQueue.Wait()
+
+			Expect(mockQueue.Len()).To(Equal(2))
+			first, _ := mockQueue.Get()
+			second, _ := mockQueue.Get()
+			Expect([]interface{}{first, second}).To(ConsistOf(
+				controller.NamespacedKey(metav1.NamespaceDefault, serviceName),
+				controller.NamespacedKey(metav1.NamespaceDefault, "db"),
+			))
+		})
+	})
+})
+
+func newService(name, networkName, selector string) *corev1.Service {
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+			UID:       "service-uid",
+			Annotations: map[string]string{
+				v1.GuestEndpointsNetworkAnnotation:  networkName,
+				v1.GuestEndpointsSelectorAnnotation: selector,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{
+				Name:       "http",
+				Port:       80,
+				TargetPort: intstr.FromInt(8080),
+			}},
+		},
+	}
+}
+
+func newVMI(name string, labels map[string]string, networkName string, ips ...string) *v1.VirtualMachineInstance {
+	return &v1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+			Labels:    labels,
+		},
+		Status: v1.VirtualMachineInstanceStatus{
+			Phase:    v1.Running,
+			NodeName: "node01",
+			Conditions: []v1.VirtualMachineInstanceCondition{{
+				Type:   v1.VirtualMachineInstanceReady,
+				Status: corev1.ConditionTrue,
+			}},
+			Interfaces: []v1.VirtualMachineInstanceNetworkInterface{{
+				Name:       networkName,
+				IP:         ips[0],
+				IPs:        ips,
+				InfoSource: netvmispec.InfoSourceDomainAndGA,
+			}},
+		},
+	}
+}
+
+func newEndpointSlice(name string) *discoveryv1.EndpointSlice {
+	return &discoveryv1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+			Labels: map[string]string{
+				discoveryv1.LabelServiceName: "my-service",
+				discoveryv1.LabelManagedBy:   controller.GuestEndpointSliceManagedBy,
+			},
+		},
+		AddressType: discoveryv1.AddressTypeIPv4,
+	}
+}
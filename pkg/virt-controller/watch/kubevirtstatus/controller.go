@@ -0,0 +1,179 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package kubevirtstatus
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/status"
+)
+
+const throttleInterval = 5 * time.Second
+
+// ReconcileFunc computes and patches the part of the KubeVirt CR status owned by a controller.
+type ReconcileFunc func(key string, kv *virtv1.KubeVirt) error
+
+// Controller is the scaffold of the controllers which report cluster wide state in the status of the KubeVirt CR.
+// Its queue keys off the KubeVirt install object, and changes are throttled, as any change of the watched objects
+// leads to a full reconciliation.
+type Controller struct {
+	Queue            workqueue.RateLimitingInterface
+	name             string
+	kubeVirtInformer cache.SharedIndexInformer
+	statusUpdater    *status.KVStatusUpdater
+	reconcile        ReconcileFunc
+}
+
+// NewController returns a Controller named after the part of the status it reports, e.g. "cluster CPU baseline".
+// The KubeVirt CR is always watched, so the status can be dropped once the feature is disabled.
+func NewController(name, queueName string, kubeVirtInformer cache.SharedIndexInformer, clientset kubecli.KubevirtClient, reconcile ReconcileFunc) *Controller {
+	c := &Controller{
+		Queue:            workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), queueName),
+		name:             name,
+		kubeVirtInformer: kubeVirtInformer,
+		statusUpdater:    status.NewKubeVirtStatusUpdater(clientset),
+		reconcile:        reconcile,
+	}
+
+	c.kubeVirtInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.Enqueue,
+		UpdateFunc: func(_, curr interface{}) { c.Enqueue(curr) },
+	})
+
+	return c
+}
+
+// Enqueue schedules a reconciliation of the KubeVirt CR, whatever the changed object is.
+func (c *Controller) Enqueue(_ interface{}) {
+	key, err := c.getKubeVirtKey()
+	if key == "" || err != nil {
+		return
+	}
+	c.Queue.AddAfter(key, throttleInterval)
+}
+
+func (c *Controller) getKubeVirtKey() (string, error) {
+	kvs := c.kubeVirtInformer.GetStore().List()
+	if len(kvs) > 1 {
+		return "", fmt.Errorf("more than one KubeVirt custom resource detected: %v", len(kvs))
+	}
+	if len(kvs) == 1 {
+		return controller.KeyFunc(kvs[0].(*virtv1.KubeVirt))
+	}
+	return "", nil
+}
+
+// Run runs the Controller once the KubeVirt informer and the passed in informers are synced.
+func (c *Controller) Run(stopCh <-chan struct{}, informersSynced ...cache.InformerSynced) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Infof("Starting %s controller.", c.name)
+
+	cache.WaitForCacheSync(stopCh, append(informersSynced, c.kubeVirtInformer.HasSynced)...)
+
+	// The queue keys off the KubeVirt install object, a single worker is enough.
+	go wait.Until(c.runWorker, time.Second, stopCh)
+
+	<-stopCh
+	log.Log.Infof("Stopping %s controller.", c.name)
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key.(string)); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing %s for KubeVirt %v", c.name, key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed %s for KubeVirt %v", c.name, key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.kubeVirtInformer.GetStore().GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	return c.reconcile(key, obj.(*virtv1.KubeVirt))
+}
+
+// PatchStatus sets the status field of the KubeVirt CR at path from oldValue to newValue, which are pointers.
+// The field is removed when newValue is nil, and nothing is patched if the values are equal.
+func (c *Controller) PatchStatus(kv *virtv1.KubeVirt, path string, oldValue, newValue interface{}) error {
+	if equality.Semantic.DeepEqual(oldValue, newValue) {
+		return nil
+	}
+
+	patch, err := statusPatch(path, oldValue, newValue)
+	if err != nil {
+		return err
+	}
+	if err := c.statusUpdater.PatchStatus(kv, types.JSONPatchType, patch); err != nil {
+		return fmt.Errorf("unable to patch the %s status of the KubeVirt CR: %v", c.name, err)
+	}
+	return nil
+}
+
+// statusPatch builds a JSON patch from oldValue to newValue, which tests the old value so that concurrent changes
+// of the field are not overwritten.
+func statusPatch(path string, oldValue, newValue interface{}) ([]byte, error) {
+	oldJson, err := json.Marshal(oldValue)
+	if err != nil {
+		return nil, err
+	}
+	newJson, err := json.Marshal(newValue)
+	if err != nil {
+		return nil, err
+	}
+
+	const null = "null"
+	switch {
+	case string(oldJson) == null:
+		return []byte(fmt.Sprintf(`[{ "op": "add", "path": "%s", "value": %s}]`, path, newJson)), nil
+	case string(newJson) == null:
+		return []byte(fmt.Sprintf(`[{ "op": "test", "path": "%s", "value": %s}, { "op": "remove", "path": "%s"}]`, path, oldJson, path)), nil
+	default:
+		return []byte(fmt.Sprintf(`[{ "op": "test", "path": "%s", "value": %s}, { "op": "replace", "path": "%s", "value": %s}]`, path, oldJson, path, newJson)), nil
+	}
+}
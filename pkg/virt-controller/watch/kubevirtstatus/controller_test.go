@@ -0,0 +1,44 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package kubevirtstatus
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	virtv1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("KubeVirt status patch", func() {
+	const path = "/status/cpuBaseline"
+
+	DescribeTable("should", func(oldValue, newValue *virtv1.CPUBaselineStatus, expectedPatch string) {
+		patch, err := statusPatch(path, oldValue, newValue)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(patch)).To(Equal(expectedPatch))
+	},
+		Entry("add a missing field", nil, &virtv1.CPUBaselineStatus{Nodes: 1},
+			`[{ "op": "add", "path": "/status/cpuBaseline", "value": {"nodes":1}}]`),
+		Entry("test and replace an existing field", &virtv1.CPUBaselineStatus{Nodes: 1}, &virtv1.CPUBaselineStatus{Nodes: 2},
+			`[{ "op": "test", "path": "/status/cpuBaseline", "value": {"nodes":1}}, { "op": "replace", "path": "/status/cpuBaseline", "value": {"nodes":2}}]`),
+		Entry("test and remove a dropped field", &virtv1.CPUBaselineStatus{Nodes: 1}, nil,
+			`[{ "op": "test", "path": "/status/cpuBaseline", "value": {"nodes":1}}, { "op": "remove", "path": "/status/cpuBaseline"}]`),
+	)
+})
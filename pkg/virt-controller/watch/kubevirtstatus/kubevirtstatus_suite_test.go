@@ -0,0 +1,11 @@
+package kubevirtstatus
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestKubeVirtStatus(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
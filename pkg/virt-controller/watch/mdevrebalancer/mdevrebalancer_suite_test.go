@@ -0,0 +1,11 @@
+package mdevrebalancer_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestMdevRebalancer(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
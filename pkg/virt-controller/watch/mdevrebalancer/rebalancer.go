@@ -0,0 +1,426 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package mdevrebalancer
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strings"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/kubevirtstatus"
+)
+
+const (
+	// RebalancedMediatedDeviceTypesReason is added in an event when new mediated device types are assigned to a node.
+	RebalancedMediatedDeviceTypesReason = "RebalancedMediatedDeviceTypes"
+	// FailedRebalanceMediatedDeviceTypesReason is added in an event if the mediated device types of a node could not be set.
+	FailedRebalanceMediatedDeviceTypesReason = "FailedRebalanceMediatedDeviceTypes"
+
+	resyncInterval = 1 * time.Minute
+	statusPath     = "/status/mediatedDevicesRebalancing"
+)
+
+// MediatedDevicesRebalancer reconfigures idle GPUs to the mediated device types requested by unschedulable VMIs.
+// The types of a node are handed over to virt-handler with the MediatedDeviceTypesAnnotation,
+// and the plan is reported in the status of the KubeVirt CR.
+type MediatedDevicesRebalancer struct {
+	*kubevirtstatus.Controller
+	clientset     kubecli.KubevirtClient
+	vmiInformer   cache.SharedIndexInformer
+	nodeInformer  cache.SharedIndexInformer
+	podInformer   cache.SharedIndexInformer
+	recorder      record.EventRecorder
+	clusterConfig *virtconfig.ClusterConfig
+}
+
+func NewMediatedDevicesRebalancer(
+	vmiInformer cache.SharedIndexInformer,
+	nodeInformer cache.SharedIndexInformer,
+	podInformer cache.SharedIndexInformer,
+	kubeVirtInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) *MediatedDevicesRebalancer {
+	c := &MediatedDevicesRebalancer{
+		clientset:     clientset,
+		vmiInformer:   vmiInformer,
+		nodeInformer:  nodeInformer,
+		podInformer:   podInformer,
+		recorder:      recorder,
+		clusterConfig: clusterConfig,
+	}
+	c.Controller = kubevirtstatus.NewController("mediated devices rebalancing", "virt-controller-mdev-rebalancer", kubeVirtInformer, clientset, c.execute)
+
+	c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMI,
+		DeleteFunc: c.enqueueVMI,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueVMI(curr) },
+	})
+	c.nodeInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueue,
+		UpdateFunc: func(_, curr interface{}) { c.enqueue(curr) },
+	})
+
+	return c
+}
+
+func (c *MediatedDevicesRebalancer) enabled() bool {
+	return c.clusterConfig.MediatedDevicesRebalancingEnabled() && !c.clusterConfig.MediatedDevicesHandlingDisabled()
+}
+
+func (c *MediatedDevicesRebalancer) enqueueVMI(obj interface{}) {
+	if !c.enabled() {
+		return
+	}
+	vmi, ok := obj.(*virtv1.VirtualMachineInstance)
+	if !ok {
+		tombstone, ok := obj.(cache.DeletedFinalStateUnknown)
+		if !ok {
+			return
+		}
+		if vmi, ok = tombstone.Obj.(*virtv1.VirtualMachineInstance); !ok {
+			return
+		}
+	}
+	if len(requestedTypes(vmi, mediatedDeviceTypesByResource(c.clusterConfig.GetPermittedHostDevices()))) == 0 {
+		return
+	}
+	c.enqueue(nil)
+}
+
+func (c *MediatedDevicesRebalancer) enqueue(obj interface{}) {
+	if !c.enabled() {
+		return
+	}
+	c.Enqueue(obj)
+}
+
+// Run runs the passed in MediatedDevicesRebalancer.
+func (c *MediatedDevicesRebalancer) Run(stopCh <-chan struct{}) {
+	c.Controller.Run(stopCh, c.vmiInformer.HasSynced, c.nodeInformer.HasSynced, c.podInformer.HasSynced)
+}
+
+func (c *MediatedDevicesRebalancer) execute(key string, kv *virtv1.KubeVirt) error {
+	var rebalancing *virtv1.MediatedDevicesRebalancing
+	if mdevConfig := c.clusterConfig.GetConfig().MediatedDevicesConfiguration; mdevConfig != nil && c.enabled() {
+		rebalancing = mdevConfig.Rebalancing
+	}
+	if rebalancing == nil {
+		return c.PatchStatus(kv, statusPath, kv.Status.MediatedDevicesRebalancing, (*virtv1.MediatedDevicesRebalancingStatus)(nil))
+	}
+
+	var nodes []*k8sv1.Node
+	for _, obj := range c.nodeInformer.GetStore().List() {
+		nodes = append(nodes, obj.(*k8sv1.Node))
+	}
+	var vmis []*virtv1.VirtualMachineInstance
+	for _, obj := range c.vmiInformer.GetStore().List() {
+		vmis = append(vmis, obj.(*virtv1.VirtualMachineInstance))
+	}
+	var pods []*k8sv1.Pod
+	for _, obj := range c.podInformer.GetStore().List() {
+		pods = append(pods, obj.(*k8sv1.Pod))
+	}
+
+	p := newPlanner(rebalancing, c.clusterConfig.GetPermittedHostDevices(), c.clusterConfig.GetDesiredMDEVTypes)
+	assignments, planStatus := p.plan(nodes, vmis, pods)
+
+	for _, node := range nodes {
+		mdevTypes, assigned := assignments[node.Name]
+		if !assigned {
+			continue
+		}
+		if err := c.patchNode(node, mdevTypes); err != nil {
+			c.recorder.Eventf(node, k8sv1.EventTypeWarning, FailedRebalanceMediatedDeviceTypesReason, "Failed to assign the mediated device types %s: %v", strings.Join(mdevTypes, ","), err)
+			return err
+		}
+		c.recorder.Eventf(node, k8sv1.EventTypeNormal, RebalancedMediatedDeviceTypesReason, "Assigned the mediated device types %s to run pending VMIs", strings.Join(mdevTypes, ","))
+	}
+
+	if err := c.PatchStatus(kv, statusPath, kv.Status.MediatedDevicesRebalancing, planStatus); err != nil {
+		return err
+	}
+
+	// Pending VMIs are re-evaluated periodically, as the nodes can need some time to advertise new types
+	if len(planStatus.PendingRequests) > 0 {
+		c.Queue.AddAfter(key, resyncInterval)
+	}
+	return nil
+}
+
+func (c *MediatedDevicesRebalancer) patchNode(node *k8sv1.Node, mdevTypes []string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				virtv1.MediatedDeviceTypesAnnotation: strings.Join(mdevTypes, ","),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	_, err = c.clientset.CoreV1().Nodes().Patch(context.Background(), node.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	return err
+}
+
+// mediatedDeviceTypesByResource maps the resource names of the permitted mediated devices to their type names,
+// as they are configured on the nodes.
+func mediatedDeviceTypesByResource(permittedHostDevices *virtv1.PermittedHostDevices) map[string]string {
+	mdevTypes := map[string]string{}
+	if permittedHostDevices == nil {
+		return mdevTypes
+	}
+	for _, mdev := range permittedHostDevices.MediatedDevices {
+		if mdev.ExternalResourceProvider {
+			continue
+		}
+		mdevTypes[mdev.ResourceName] = strings.TrimSpace(strings.Replace(mdev.MDEVNameSelector, " ", "_", -1))
+	}
+	return mdevTypes
+}
+
+// requestedTypes returns the mediated device types requested by the GPUs and host devices of the VMI.
+func requestedTypes(vmi *virtv1.VirtualMachineInstance, mdevTypes map[string]string) map[string]struct{} {
+	requested := map[string]struct{}{}
+	for _, gpu := range vmi.Spec.Domain.Devices.GPUs {
+		if mdevType, exists := mdevTypes[gpu.DeviceName]; exists {
+			requested[mdevType] = struct{}{}
+		}
+	}
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if mdevType, exists := mdevTypes[hostDevice.DeviceName]; exists {
+			requested[mdevType] = struct{}{}
+		}
+	}
+	return requested
+}
+
+type nodeState struct {
+	node       *k8sv1.Node
+	mdevTypes  []string
+	rebalanced bool
+	busy       bool
+	ready      bool
+}
+
+func (n *nodeState) offers(mdevType string) bool {
+	for _, t := range n.mdevTypes {
+		if t == mdevType {
+			return true
+		}
+	}
+	return false
+}
+
+type planner struct {
+	nodeSelector     labels.Selector
+	minimumNodes     map[string]int
+	resourceByType   map[string]string
+	typeByResource   map[string]string
+	desiredMDEVTypes func(node *k8sv1.Node) []string
+}
+
+func newPlanner(rebalancing *virtv1.MediatedDevicesRebalancing, permittedHostDevices *virtv1.PermittedHostDevices, desiredMDEVTypes func(node *k8sv1.Node) []string) *planner {
+	p := &planner{
+		nodeSelector:     labels.SelectorFromSet(rebalancing.NodeSelector),
+		minimumNodes:     map[string]int{},
+		resourceByType:   map[string]string{},
+		typeByResource:   mediatedDeviceTypesByResource(permittedHostDevices),
+		desiredMDEVTypes: desiredMDEVTypes,
+	}
+	for _, minimum := range rebalancing.MinimumNodesPerType {
+		p.minimumNodes[minimum.MediatedDeviceType] = minimum.Nodes
+	}
+	for resourceName, mdevType := range p.typeByResource {
+		p.resourceByType[mdevType] = resourceName
+	}
+	return p
+}
+
+// plan assigns idle nodes to the mediated device types requested by unschedulable VMIs.
+// It returns the new types of the reassigned nodes, and the resulting plan.
+func (p *planner) plan(nodes []*k8sv1.Node, vmis []*virtv1.VirtualMachineInstance, pods []*k8sv1.Pod) (map[string][]string, *virtv1.MediatedDevicesRebalancingStatus) {
+	demand := map[string]int{}
+	busyNodes := map[string]struct{}{}
+	conditionManager := controller.NewVirtualMachineInstanceConditionManager()
+	for _, vmi := range vmis {
+		requested := requestedTypes(vmi, p.typeByResource)
+		if len(requested) == 0 || vmi.IsFinal() {
+			continue
+		}
+		if vmi.Status.NodeName != "" {
+			busyNodes[vmi.Status.NodeName] = struct{}{}
+		} else if vmi.IsScheduling() && conditionManager.HasConditionWithStatusAndReason(vmi,
+			virtv1.VirtualMachineInstanceConditionType(k8sv1.PodScheduled), k8sv1.ConditionFalse, k8sv1.PodReasonUnschedulable) {
+			for mdevType := range requested {
+				demand[mdevType]++
+			}
+		}
+	}
+	// The pods are bound to their node before the VMIs report it
+	for _, pod := range pods {
+		if pod.Spec.NodeName != "" && !isPodFinal(pod) && p.requestsMediatedDevices(pod) {
+			busyNodes[pod.Spec.NodeName] = struct{}{}
+		}
+	}
+
+	var states []*nodeState
+	offering := map[string]int{}
+	provisioning := map[string]int{}
+	for _, node := range nodes {
+		if node.Spec.Unschedulable || !p.nodeSelector.Matches(labels.Set(node.Labels)) {
+			continue
+		}
+		_, rebalanced := node.Annotations[virtv1.MediatedDeviceTypesAnnotation]
+		_, busy := busyNodes[node.Name]
+		state := &nodeState{
+			node:       node,
+			mdevTypes:  p.desiredMDEVTypes(node),
+			rebalanced: rebalanced,
+			busy:       busy,
+		}
+		state.ready = p.advertisesAllTypes(node, state.mdevTypes)
+		for _, mdevType := range state.mdevTypes {
+			offering[mdevType]++
+			if rebalanced && !state.ready {
+				provisioning[mdevType]++
+			}
+		}
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool { return states[i].node.Name < states[j].node.Name })
+
+	var demandedTypes []string
+	for mdevType := range demand {
+		demandedTypes = append(demandedTypes, mdevType)
+	}
+	sort.Slice(demandedTypes, func(i, j int) bool {
+		if demand[demandedTypes[i]] != demand[demandedTypes[j]] {
+			return demand[demandedTypes[i]] > demand[demandedTypes[j]]
+		}
+		return demandedTypes[i] < demandedTypes[j]
+	})
+
+	assignments := map[string][]string{}
+	for _, mdevType := range demandedTypes {
+		// every node being reconfigured is expected to run at least one of the pending VMIs
+		for need := demand[mdevType] - provisioning[mdevType]; need > 0; need-- {
+			state := p.findIdleNode(states, mdevType, demand, offering)
+			if state == nil {
+				break
+			}
+			for _, oldType := range state.mdevTypes {
+				offering[oldType]--
+			}
+			offering[mdevType]++
+			state.mdevTypes = []string{mdevType}
+			state.rebalanced = true
+			state.ready = false
+			assignments[state.node.Name] = state.mdevTypes
+		}
+	}
+
+	planStatus := &virtv1.MediatedDevicesRebalancingStatus{}
+	for _, mdevType := range demandedTypes {
+		planStatus.PendingRequests = append(planStatus.PendingRequests, virtv1.MediatedDeviceTypeRequest{
+			MediatedDeviceType:      mdevType,
+			VirtualMachineInstances: demand[mdevType],
+		})
+	}
+	for _, state := range states {
+		if state.rebalanced {
+			planStatus.Nodes = append(planStatus.Nodes, virtv1.NodeMediatedDeviceTypesPlan{
+				NodeName:            state.node.Name,
+				MediatedDeviceTypes: state.mdevTypes,
+				Ready:               state.ready,
+			})
+		}
+	}
+	return assignments, planStatus
+}
+
+// requestsMediatedDevices returns true if a container of the pod, e.g. a virt-launcher or a host device attachment pod,
+// requests a mediated device.
+func (p *planner) requestsMediatedDevices(pod *k8sv1.Pod) bool {
+	for _, container := range pod.Spec.Containers {
+		for resourceName := range p.typeByResource {
+			if _, exists := container.Resources.Limits[k8sv1.ResourceName(resourceName)]; exists {
+				return true
+			}
+			if _, exists := container.Resources.Requests[k8sv1.ResourceName(resourceName)]; exists {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isPodFinal(pod *k8sv1.Pod) bool {
+	return pod.Status.Phase == k8sv1.PodSucceeded || pod.Status.Phase == k8sv1.PodFailed
+}
+
+// findIdleNode returns a node without VMIs using mediated devices, which can be reconfigured
+// without going below the minimum number of nodes of its current types, nor taking away a requested type.
+func (p *planner) findIdleNode(states []*nodeState, mdevType string, demand, offering map[string]int) *nodeState {
+	for _, state := range states {
+		if state.busy || state.offers(mdevType) || (state.rebalanced && !state.ready) {
+			continue
+		}
+		reconfigurable := true
+		for _, oldType := range state.mdevTypes {
+			if demand[oldType] > 0 || offering[oldType] <= p.minimumNodes[oldType] {
+				reconfigurable = false
+				break
+			}
+		}
+		if reconfigurable {
+			return state
+		}
+	}
+	return nil
+}
+
+func (p *planner) advertisesAllTypes(node *k8sv1.Node, mdevTypes []string) bool {
+	for _, mdevType := range mdevTypes {
+		resourceName, exists := p.resourceByType[mdevType]
+		if !exists {
+			continue
+		}
+		if quantity, exists := node.Status.Allocatable[k8sv1.ResourceName(resourceName)]; !exists || quantity.IsZero() {
+			return false
+		}
+	}
+	return true
+}
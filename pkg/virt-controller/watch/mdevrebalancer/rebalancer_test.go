@@ -0,0 +1,282 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package mdevrebalancer_test
+
+import (
+	"context"
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/mdevrebalancer"
+)
+
+var _ = Describe("Mediated devices rebalancer", func() {
+	const (
+		smallType     = "GRID_T4-1Q"
+		largeType     = "GRID_T4-2Q"
+		smallResource = "nvidia.com/GRID_T4-1Q"
+		largeResource = "nvidia.com/GRID_T4-2Q"
+	)
+
+	var (
+		kv                *v1.KubeVirt
+		kubeClient        *fake.Clientset
+		kubeVirtInterface *kubecli.MockKubeVirtInterface
+		vmiInformer       cache.SharedIndexInformer
+		nodeInformer      cache.SharedIndexInformer
+		podInformer       cache.SharedIndexInformer
+		recorder          *record.FakeRecorder
+		mockQueue         *testutils.MockWorkQueue
+		rebalancer        *mdevrebalancer.MediatedDevicesRebalancer
+		patchedStatus     *v1.MediatedDevicesRebalancingStatus
+	)
+
+	initRebalancer := func(rebalancing *v1.MediatedDevicesRebalancing, featureGates ...string) {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeVirtInterface = kubecli.NewMockKubeVirtInterface(ctrl)
+		virtClient.EXPECT().KubeVirt(gomock.Any()).Return(kubeVirtInterface).AnyTimes()
+		kubeClient = fake.NewSimpleClientset()
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+					PermittedHostDevices: &v1.PermittedHostDevices{
+						MediatedDevices: []v1.MediatedHostDevice{
+							{MDEVNameSelector: "GRID T4-1Q", ResourceName: smallResource},
+							{MDEVNameSelector: "GRID T4-2Q", ResourceName: largeResource},
+						},
+					},
+					MediatedDevicesConfiguration: &v1.MediatedDevicesConfiguration{
+						MediatedDeviceTypes: []string{smallType},
+						Rebalancing:         rebalancing,
+					},
+				},
+			},
+		}
+		config, _, kubeVirtInformer := testutils.NewFakeClusterConfigUsingKV(kv)
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		nodeInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+		podInformer, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		rebalancer = mdevrebalancer.NewMediatedDevicesRebalancer(vmiInformer, nodeInformer, podInformer, kubeVirtInformer, recorder, virtClient, config)
+		mockQueue = testutils.NewMockWorkQueue(rebalancer.Queue)
+		rebalancer.Queue = mockQueue
+
+		patchedStatus = nil
+		kubeVirtInterface.EXPECT().PatchStatus(kv.Name, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ string, _ types.PatchType, data []byte, _ *metav1.PatchOptions) (*v1.KubeVirt, error) {
+				patch, err := jsonpatch.DecodePatch(data)
+				Expect(err).ToNot(HaveOccurred())
+				kvJSON, err := json.Marshal(kv)
+				Expect(err).ToNot(HaveOccurred())
+				patchedJSON, err := patch.Apply(kvJSON)
+				Expect(err).ToNot(HaveOccurred())
+				patchedKV := &v1.KubeVirt{}
+				Expect(json.Unmarshal(patchedJSON, patchedKV)).To(Succeed())
+				patchedStatus = patchedKV.Status.MediatedDevicesRebalancing
+				return patchedKV, nil
+			}).AnyTimes()
+	}
+
+	BeforeEach(func() {
+		initRebalancer(&v1.MediatedDevicesRebalancing{}, virtconfig.MediatedDevicesRebalancingGate)
+	})
+
+	addNode := func(name string, allocatable k8sv1.ResourceList, annotations map[string]string) {
+		node := &k8sv1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: annotations},
+			Status:     k8sv1.NodeStatus{Allocatable: allocatable},
+		}
+		Expect(nodeInformer.GetStore().Add(node)).To(Succeed())
+		_, err := kubeClient.CoreV1().Nodes().Create(context.Background(), node, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	newVMI := func(name, resourceName string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault}}
+		vmi.Spec.Domain.Devices.GPUs = []v1.GPU{{Name: "gpu1", DeviceName: resourceName}}
+		return vmi
+	}
+
+	addPendingVMI := func(name, resourceName string) {
+		vmi := newVMI(name, resourceName)
+		vmi.Status.Phase = v1.Scheduling
+		vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{
+			Type:   v1.VirtualMachineInstanceConditionType(k8sv1.PodScheduled),
+			Status: k8sv1.ConditionFalse,
+			Reason: k8sv1.PodReasonUnschedulable,
+		}}
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	addRunningVMI := func(name, resourceName, nodeName string) {
+		vmi := newVMI(name, resourceName)
+		vmi.Status.Phase = v1.Running
+		vmi.Status.NodeName = nodeName
+		Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+	}
+
+	addBoundPod := func(name, resourceName, nodeName string) {
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: metav1.NamespaceDefault},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+				Containers: []k8sv1.Container{{
+					Name: "compute",
+					Resources: k8sv1.ResourceRequirements{
+						Limits: k8sv1.ResourceList{k8sv1.ResourceName(resourceName): resource.MustParse("1")},
+					},
+				}},
+			},
+			Status: k8sv1.PodStatus{Phase: k8sv1.PodPending},
+		}
+		Expect(podInformer.GetStore().Add(pod)).To(Succeed())
+	}
+
+	offering := func(resourceName string) k8sv1.ResourceList {
+		return k8sv1.ResourceList{k8sv1.ResourceName(resourceName): resource.MustParse("4")}
+	}
+
+	execute := func() {
+		mockQueue.Add("kubevirt/kubevirt")
+		rebalancer.Execute()
+	}
+
+	nodeAnnotations := func(name string) map[string]string {
+		node, err := kubeClient.CoreV1().Nodes().Get(context.Background(), name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return node.Annotations
+	}
+
+	It("should reconfigure an idle node to the type requested by a pending VMI", func() {
+		addNode("node01", offering(smallResource), nil)
+		addNode("node02", offering(smallResource), nil)
+		addRunningVMI("running", smallResource, "node01")
+		addPendingVMI("pending", largeResource)
+
+		execute()
+
+		Expect(nodeAnnotations("node01")).ToNot(HaveKey(v1.MediatedDeviceTypesAnnotation))
+		Expect(nodeAnnotations("node02")).To(HaveKeyWithValue(v1.MediatedDeviceTypesAnnotation, largeType))
+		testutils.ExpectEvent(recorder, mdevrebalancer.RebalancedMediatedDeviceTypesReason)
+		Expect(patchedStatus).To(Equal(&v1.MediatedDevicesRebalancingStatus{
+			PendingRequests: []v1.MediatedDeviceTypeRequest{{MediatedDeviceType: largeType, VirtualMachineInstances: 1}},
+			Nodes:           []v1.NodeMediatedDeviceTypesPlan{{NodeName: "node02", MediatedDeviceTypes: []string{largeType}}},
+		}))
+	})
+
+	It("should not reconfigure a node with a bound pod of a VMI which doesn't report its node yet", func() {
+		addNode("node01", offering(smallResource), nil)
+		addNode("node02", offering(smallResource), nil)
+		scheduled := newVMI("scheduled", smallResource)
+		scheduled.Status.Phase = v1.Scheduled
+		Expect(vmiInformer.GetStore().Add(scheduled)).To(Succeed())
+		addBoundPod("virt-launcher-scheduled", smallResource, "node01")
+		addPendingVMI("pending", largeResource)
+
+		execute()
+
+		Expect(nodeAnnotations("node01")).ToNot(HaveKey(v1.MediatedDeviceTypesAnnotation))
+		Expect(nodeAnnotations("node02")).To(HaveKeyWithValue(v1.MediatedDeviceTypesAnnotation, largeType))
+	})
+
+	It("should not reconfigure more nodes while the type is being provisioned", func() {
+		addNode("node01", nil, map[string]string{v1.MediatedDeviceTypesAnnotation: largeType})
+		addNode("node02", offering(smallResource), nil)
+		addNode("node03", offering(smallResource), nil)
+		addPendingVMI("pending", largeResource)
+
+		execute()
+
+		Expect(nodeAnnotations("node02")).ToNot(HaveKey(v1.MediatedDeviceTypesAnnotation))
+		Expect(recorder.Events).To(BeEmpty())
+		Expect(patchedStatus.Nodes).To(Equal([]v1.NodeMediatedDeviceTypesPlan{{NodeName: "node01", MediatedDeviceTypes: []string{largeType}}}))
+	})
+
+	It("should keep the minimum number of nodes per type", func() {
+		initRebalancer(&v1.MediatedDevicesRebalancing{
+			MinimumNodesPerType: []v1.MediatedDeviceTypeMinimum{{MediatedDeviceType: smallType, Nodes: 2}},
+		}, virtconfig.MediatedDevicesRebalancingGate)
+		addNode("node01", offering(smallResource), nil)
+		addNode("node02", offering(smallResource), nil)
+		addNode("node03", offering(smallResource), nil)
+		addPendingVMI("pending1", largeResource)
+		addPendingVMI("pending2", largeResource)
+
+		execute()
+
+		Expect(nodeAnnotations("node01")).To(HaveKeyWithValue(v1.MediatedDeviceTypesAnnotation, largeType))
+		for _, name := range []string{"node02", "node03"} {
+			Expect(nodeAnnotations(name)).ToNot(HaveKey(v1.MediatedDeviceTypesAnnotation))
+		}
+		testutils.ExpectEvent(recorder, mdevrebalancer.RebalancedMediatedDeviceTypesReason)
+		Expect(patchedStatus.PendingRequests).To(Equal([]v1.MediatedDeviceTypeRequest{{MediatedDeviceType: largeType, VirtualMachineInstances: 2}}))
+	})
+
+	It("should only reconfigure the selected nodes", func() {
+		initRebalancer(&v1.MediatedDevicesRebalancing{
+			NodeSelector: map[string]string{"gpu": "t4"},
+		}, virtconfig.MediatedDevicesRebalancingGate)
+		addNode("node01", offering(smallResource), nil)
+		addPendingVMI("pending", largeResource)
+
+		execute()
+
+		Expect(nodeAnnotations("node01")).ToNot(HaveKey(v1.MediatedDeviceTypesAnnotation))
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should drop the plan from the status when the feature gate is disabled", func() {
+		initRebalancer(&v1.MediatedDevicesRebalancing{})
+		kv.Status.MediatedDevicesRebalancing = &v1.MediatedDevicesRebalancingStatus{
+			PendingRequests: []v1.MediatedDeviceTypeRequest{{MediatedDeviceType: largeType, VirtualMachineInstances: 1}},
+		}
+		patchedStatus = kv.Status.MediatedDevicesRebalancing
+		addNode("node01", offering(smallResource), nil)
+		addPendingVMI("pending", largeResource)
+
+		execute()
+
+		Expect(nodeAnnotations("node01")).ToNot(HaveKey(v1.MediatedDeviceTypesAnnotation))
+		Expect(patchedStatus).To(BeNil())
+	})
+})
@@ -59,6 +59,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/controller"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
@@ -68,6 +69,8 @@ const (
 	successfulUpdatePodDisruptionBudgetReason = "SuccessfulUpdate"
 	failedUpdatePodDisruptionBudgetReason     = "FailedUpdate"
 	failedGetAttractionPodsFmt                = "failed to get attachment pods: %v"
+	failedCreateBackendStorageReason          = "FailedCreateBackendStorage"
+	failedFinalizeBackendStorageReason        = "FailedFinalizeBackendStorage"
 )
 
 // This is the timeout used when a target pod is stuck in
@@ -345,6 +348,10 @@ func (c *MigrationController) execute(key string) error {
 	}
 
 	if migration.IsFinal() {
+		err = c.finalizeBackendStorage(migration, vmi)
+		if err != nil {
+			return err
+		}
 		err = c.garbageCollectFinalizedMigrations(vmi)
 		if err != nil {
 			return err
@@ -619,6 +626,13 @@ func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineIn
 		}
 	}
 
+	if backendstorage.IsMigrationTargetPVCNeeded(vmi, c.clusterConfig) {
+		err = c.useMigrationTargetBackendStorage(migration, vmi, templatePod)
+		if err != nil {
+			return err
+		}
+	}
+
 	// This is used by the functional test to simulate failures
 	computeImageOverride, ok := migration.Annotations[virtv1.FuncTestMigrationTargetImageOverrideAnnotation]
 	if ok && computeImageOverride != "" {
@@ -651,6 +665,44 @@ func (c *MigrationController) createTargetPod(migration *virtv1.VirtualMachineIn
 	return nil
 }
 
+// useMigrationTargetBackendStorage provisions a dedicated backend storage PVC for the target pod,
+// since a ReadWriteOnce volume cannot be mounted by the source and the target at the same time.
+func (c *MigrationController) useMigrationTargetBackendStorage(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance, templatePod *k8sv1.Pod) error {
+	targetPVCName, err := backendstorage.CreateForMigrationTarget(vmi, migration.UID, c.clusterConfig, c.clientset)
+	if err != nil {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, failedCreateBackendStorageReason, "Error creating the backend storage of the migration target: %v", err)
+		return fmt.Errorf("failed to create the backend storage of the migration target: %v", err)
+	}
+
+	sourcePVCName := backendstorage.CurrentPVCName(vmi, c.pvcInformer.GetStore())
+	for i, volume := range templatePod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == sourcePVCName {
+			templatePod.Spec.Volumes[i].PersistentVolumeClaim.ClaimName = targetPVCName
+		}
+	}
+	return nil
+}
+
+// finalizeBackendStorage keeps the backend storage PVC of the side the VMI ended up running on,
+// and deletes the other one.
+func (c *MigrationController) finalizeBackendStorage(migration *virtv1.VirtualMachineInstanceMigration, vmi *virtv1.VirtualMachineInstance) error {
+	if !backendstorage.IsBackendStorageNeeded(&vmi.Spec) {
+		return nil
+	}
+
+	var err error
+	if migration.Status.Phase == virtv1.MigrationSucceeded {
+		err = backendstorage.MigrationSucceeded(vmi, migration.UID, c.pvcInformer.GetStore(), c.clientset)
+	} else {
+		err = backendstorage.MigrationFailed(vmi, migration.UID, c.pvcInformer.GetStore(), c.clientset)
+	}
+	if err != nil {
+		c.recorder.Eventf(migration, k8sv1.EventTypeWarning, failedFinalizeBackendStorageReason, "Error finalizing the backend storage: %v", err)
+		return err
+	}
+	return nil
+}
+
 func (c *MigrationController) expandPDB(pdb *policyv1.PodDisruptionBudget, vmi *virtv1.VirtualMachineInstance, vmim *virtv1.VirtualMachineInstanceMigration) error {
 	minAvailable := 2
 
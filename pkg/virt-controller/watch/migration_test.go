@@ -64,6 +64,7 @@ import (
 	fakenetworkclient "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-controller/services"
 )
@@ -1722,6 +1723,119 @@ var _ = Describe("Migration watcher", func() {
 
 	})
 
+	Context("Migration of VMI with persistent backend storage", func() {
+		const storageClass = "rwo-storage-class"
+
+		newPersistentTPMVMI := func() *virtv1.VirtualMachineInstance {
+			vmi := newVirtualMachine("testvmi", virtv1.Running)
+			vmi.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{Persistent: pointer.Bool(true)}
+			return vmi
+		}
+
+		newBackendStoragePVC := func(name string, vmi *virtv1.VirtualMachineInstance, migrationUID types.UID) *k8sv1.PersistentVolumeClaim {
+			pvc := &k8sv1.PersistentVolumeClaim{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: vmi.Namespace,
+					Labels:    map[string]string{backendstorage.PVCVMILabel: vmi.Name},
+				},
+			}
+			if migrationUID != "" {
+				pvc.Annotations = map[string]string{backendstorage.PVCMigrationAnnotation: string(migrationUID)}
+			}
+			return pvc
+		}
+
+		newFinalizedMigration := func(vmi *virtv1.VirtualMachineInstance, phase virtv1.VirtualMachineInstanceMigrationPhase) *virtv1.VirtualMachineInstanceMigration {
+			migration := newMigration("testmigration", vmi.Name, phase)
+			migration.Finalizers = []string{}
+			addMigration(migration)
+			Expect(podInformer.GetStore().Add(newSourcePodForVirtualMachine(vmi))).To(Succeed())
+			Expect(vmiInformer.GetStore().Add(vmi)).To(Succeed())
+			return migration
+		}
+
+		BeforeEach(func() {
+			initController(&virtv1.KubeVirtConfiguration{
+				VMStateStorageClass:      storageClass,
+				VMStateStorageAccessMode: k8sv1.ReadWriteOnce,
+			})
+		})
+
+		It("should create a dedicated backend storage PVC for the target pod", func() {
+			vmi := newPersistentTPMVMI()
+			migration := newMigration("testmigration", vmi.Name, virtv1.MigrationPending)
+			targetPVCName := backendstorage.MigrationTargetPVCName(vmi, migration.UID)
+
+			addMigration(migration)
+			addVirtualMachineInstance(vmi)
+
+			kubeClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				pvc := action.(testing.CreateAction).GetObject().(*k8sv1.PersistentVolumeClaim)
+				Expect(pvc.Name).To(Equal(targetPVCName))
+				Expect(pvc.Labels).To(HaveKeyWithValue(backendstorage.PVCVMILabel, vmi.Name))
+				Expect(pvc.Annotations).To(HaveKeyWithValue(backendstorage.PVCMigrationAnnotation, string(migration.UID)))
+				Expect(pvc.Spec.AccessModes).To(ConsistOf(k8sv1.ReadWriteOnce))
+				Expect(*pvc.Spec.StorageClassName).To(Equal(storageClass))
+				return true, pvc, nil
+			})
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				pod := action.(testing.CreateAction).GetObject().(*k8sv1.Pod)
+				var claimNames []string
+				for _, volume := range pod.Spec.Volumes {
+					if volume.PersistentVolumeClaim != nil {
+						claimNames = append(claimNames, volume.PersistentVolumeClaim.ClaimName)
+					}
+				}
+				Expect(claimNames).To(ConsistOf(targetPVCName))
+				return true, pod, nil
+			})
+
+			controller.Execute()
+			testutils.ExpectEvents(recorder, SuccessfulCreatePodReason)
+		})
+
+		It("should switch to the backend storage PVC of the target once the migration succeeded", func() {
+			vmi := newPersistentTPMVMI()
+			migration := newFinalizedMigration(vmi, virtv1.MigrationSucceeded)
+			targetPVCName := backendstorage.MigrationTargetPVCName(vmi, migration.UID)
+
+			Expect(pvcInformer.GetStore().Add(newBackendStoragePVC(backendstorage.PVCForVMI(vmi), vmi, ""))).To(Succeed())
+			Expect(pvcInformer.GetStore().Add(newBackendStoragePVC(targetPVCName, vmi, migration.UID))).To(Succeed())
+
+			kubeClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				patchAction := action.(testing.PatchAction)
+				Expect(patchAction.GetName()).To(Equal(targetPVCName))
+				Expect(string(patchAction.GetPatch())).To(ContainSubstring(`"op": "remove"`))
+				return true, nil, nil
+			})
+			kubeClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				Expect(action.(testing.DeleteAction).GetName()).To(Equal(backendstorage.PVCForVMI(vmi)))
+				return true, nil, nil
+			})
+
+			controller.Execute()
+			Expect(kubeClient.Actions()).To(HaveLen(2))
+		})
+
+		It("should delete the backend storage PVC of the target once the migration failed", func() {
+			vmi := newPersistentTPMVMI()
+			migration := newFinalizedMigration(vmi, virtv1.MigrationFailed)
+			targetPVCName := backendstorage.MigrationTargetPVCName(vmi, migration.UID)
+
+			Expect(pvcInformer.GetStore().Add(newBackendStoragePVC(backendstorage.PVCForVMI(vmi), vmi, ""))).To(Succeed())
+			Expect(pvcInformer.GetStore().Add(newBackendStoragePVC(targetPVCName, vmi, migration.UID))).To(Succeed())
+
+			kubeClient.Fake.PrependReactor("delete", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				Expect(action.(testing.DeleteAction).GetName()).To(Equal(targetPVCName))
+				return true, nil, nil
+			})
+
+			controller.Execute()
+			Expect(kubeClient.Actions()).To(HaveLen(1))
+		})
+	})
+
 	Context("Migration abortion before hand-off to virt-handler", func() {
 
 		var vmi *virtv1.VirtualMachineInstance
@@ -0,0 +1,332 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package networkdns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	virtv1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/dns"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+const (
+	// FailedSyncDNSEndpointReason is added in an event if the DNS records of a VMI could not be published.
+	FailedSyncDNSEndpointReason = "FailedSyncDNSEndpoint"
+	// DNSEndpointNameConflictReason is added in an event if the DNSEndpoint name of a VMI is taken by a foreign object.
+	DNSEndpointNameConflictReason = "DNSEndpointNameConflict"
+
+	dnsEndpointPrefix = "kubevirt-dns-"
+)
+
+// SecondaryNetworkDNSController publishes the `<vmi>.<network>.<namespace>` records of the
+// VMI secondary networks as ExternalDNS DNSEndpoint objects owned by the VMI.
+// The records are removed when the SecondaryNetworkDNS feature gate is disabled.
+type SecondaryNetworkDNSController struct {
+	clientset           kubecli.KubevirtClient
+	Queue               workqueue.RateLimitingInterface
+	vmiInformer         cache.SharedIndexInformer
+	dnsEndpointInformer cache.SharedIndexInformer
+	recorder            record.EventRecorder
+	clusterConfig       *virtconfig.ClusterConfig
+
+	// the feature gate and domain the records were last synced with
+	configLock sync.Mutex
+	enabled    bool
+	domain     string
+}
+
+// NewSecondaryNetworkDNSController creates the controller. The dnsEndpointInformer is expected to be
+// a dummy informer when the ExternalDNS DNSEndpoint CRD is not installed.
+func NewSecondaryNetworkDNSController(
+	vmiInformer cache.SharedIndexInformer,
+	dnsEndpointInformer cache.SharedIndexInformer,
+	recorder record.EventRecorder,
+	clientset kubecli.KubevirtClient,
+	clusterConfig *virtconfig.ClusterConfig,
+) *SecondaryNetworkDNSController {
+	c := &SecondaryNetworkDNSController{
+		Queue:               workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-controller-secondary-network-dns"),
+		clientset:           clientset,
+		vmiInformer:         vmiInformer,
+		dnsEndpointInformer: dnsEndpointInformer,
+		recorder:            recorder,
+		clusterConfig:       clusterConfig,
+		enabled:             clusterConfig.SecondaryNetworkDNSEnabled(),
+		domain:              secondaryNetworkDNSDomain(clusterConfig),
+	}
+
+	c.vmiInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueVMI,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueVMI(curr) },
+	})
+	c.dnsEndpointInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueOwner,
+		UpdateFunc: func(_, curr interface{}) { c.enqueueOwner(curr) },
+		DeleteFunc: c.enqueueOwner,
+	})
+	c.clusterConfig.SetConfigModifiedCallback(c.configModified)
+
+	return c
+}
+
+// configModified resyncs every VMI when the feature gate or the DNS domain changes,
+// publishing or garbage collecting their records.
+func (c *SecondaryNetworkDNSController) configModified() {
+	enabled := c.clusterConfig.SecondaryNetworkDNSEnabled()
+	domain := secondaryNetworkDNSDomain(c.clusterConfig)
+
+	c.configLock.Lock()
+	changed := enabled != c.enabled || domain != c.domain
+	c.enabled, c.domain = enabled, domain
+	c.configLock.Unlock()
+
+	if !changed {
+		return
+	}
+	for _, obj := range c.vmiInformer.GetStore().List() {
+		c.enqueueVMI(obj)
+	}
+}
+
+func (c *SecondaryNetworkDNSController) enqueueOwner(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	dnsEndpoint, ok := obj.(metav1.Object)
+	if !ok {
+		log.Log.Errorf("unexpected object %v in the DNSEndpoint informer", obj)
+		return
+	}
+	owner := metav1.GetControllerOf(dnsEndpoint)
+	if owner == nil || owner.Kind != virtv1.VirtualMachineInstanceGroupVersionKind.Kind {
+		return
+	}
+	c.Queue.Add(controller.NamespacedKey(dnsEndpoint.GetNamespace(), owner.Name))
+}
+
+func (c *SecondaryNetworkDNSController) enqueueVMI(obj interface{}) {
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+	if len(vmispec.FilterMultusNonDefaultNetworks(vmi.Spec.Networks)) == 0 {
+		return
+	}
+	key, err := controller.KeyFunc(vmi)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to extract key from vmi.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+// Run runs the passed in SecondaryNetworkDNSController.
+func (c *SecondaryNetworkDNSController) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting secondary network DNS controller.")
+
+	cache.WaitForCacheSync(stopCh, c.vmiInformer.HasSynced, c.dnsEndpointInformer.HasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping secondary network DNS controller.")
+}
+
+func (c *SecondaryNetworkDNSController) runWorker() {
+	for c.Execute() {
+	}
+}
+
+func (c *SecondaryNetworkDNSController) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key.(string)); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineInstance %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineInstance %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *SecondaryNetworkDNSController) execute(key string) error {
+	// virt-controller is reinitialized when the DNSEndpoint CRD is installed
+	if !c.clusterConfig.HasDNSEndpointAPI() {
+		return nil
+	}
+
+	obj, exists, err := c.vmiInformer.GetStore().GetByKey(key)
+	if err != nil {
+		return err
+	}
+	// The DNSEndpoint of a deleted VMI is garbage collected
+	if !exists {
+		return nil
+	}
+	vmi := obj.(*virtv1.VirtualMachineInstance)
+
+	// The records are removed when the feature gate is disabled
+	var records []dns.Record
+	if c.clusterConfig.SecondaryNetworkDNSEnabled() && vmi.DeletionTimestamp == nil && !vmi.IsFinal() {
+		records = dns.SecondaryNetworkRecords(vmi, secondaryNetworkDNSDomain(c.clusterConfig))
+	}
+
+	if err := c.syncDNSEndpoint(vmi, records); err != nil {
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedSyncDNSEndpointReason, "Failed to publish the secondary network DNS records: %v", err)
+		return err
+	}
+	return nil
+}
+
+func secondaryNetworkDNSDomain(clusterConfig *virtconfig.ClusterConfig) string {
+	if networkConfig := clusterConfig.GetConfig().NetworkConfiguration; networkConfig != nil {
+		return networkConfig.SecondaryNetworkDNSDomain
+	}
+	return ""
+}
+
+func (c *SecondaryNetworkDNSController) syncDNSEndpoint(vmi *virtv1.VirtualMachineInstance, records []dns.Record) error {
+	client := c.clientset.DynamicClient().Resource(controller.DNSEndpointGVR).Namespace(vmi.Namespace)
+	name := dnsEndpointPrefix + vmi.Name
+
+	var existing *unstructured.Unstructured
+	obj, exists, err := c.dnsEndpointInformer.GetStore().GetByKey(controller.NamespacedKey(vmi.Namespace, name))
+	if err != nil {
+		return err
+	}
+	if exists {
+		existing = obj.(*unstructured.Unstructured)
+		if !metav1.IsControlledBy(existing, vmi) {
+			c.recordNameConflict(vmi, name)
+			return nil
+		}
+	}
+
+	if len(records) == 0 {
+		if existing == nil {
+			return nil
+		}
+		err := client.Delete(context.Background(), name, metav1.DeleteOptions{})
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	desired := newDNSEndpoint(vmi, name, records)
+	if existing == nil {
+		_, err := client.Create(context.Background(), desired, metav1.CreateOptions{})
+		if errors.IsAlreadyExists(err) {
+			return c.handleAlreadyExists(vmi, name)
+		}
+		return ignoreMissingAPI(vmi, err)
+	}
+	if equality.Semantic.DeepEqual(existing.Object["spec"], desired.Object["spec"]) {
+		return nil
+	}
+	updated := existing.DeepCopy()
+	updated.Object["spec"] = desired.Object["spec"]
+	_, err = client.Update(context.Background(), updated, metav1.UpdateOptions{})
+	return ignoreMissingAPI(vmi, err)
+}
+
+// handleAlreadyExists tells a DNSEndpoint the informer doesn't see, because it lacks the
+// created-by label, apart from one the informer didn't catch up with yet.
+func (c *SecondaryNetworkDNSController) handleAlreadyExists(vmi *virtv1.VirtualMachineInstance, name string) error {
+	client := c.clientset.DynamicClient().Resource(controller.DNSEndpointGVR).Namespace(vmi.Namespace)
+	existing, err := client.Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !metav1.IsControlledBy(existing, vmi) {
+		c.recordNameConflict(vmi, name)
+		return nil
+	}
+	return fmt.Errorf("DNSEndpoint %s is not in the cache yet", name)
+}
+
+func (c *SecondaryNetworkDNSController) recordNameConflict(vmi *virtv1.VirtualMachineInstance, name string) {
+	c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, DNSEndpointNameConflictReason, "DNSEndpoint %s is not controlled by the VMI", name)
+}
+
+// ignoreMissingAPI drops the NotFound errors returned once the DNSEndpoint CRD is removed. Retrying
+// won't help, virt-controller is reinitialized without the DNSEndpoint informer when it notices the removal.
+func ignoreMissingAPI(vmi *virtv1.VirtualMachineInstance, err error) error {
+	if errors.IsNotFound(err) {
+		log.Log.Object(vmi).Reason(err).Warning("DNSEndpoint API not found, not publishing the secondary network DNS records")
+		return nil
+	}
+	return err
+}
+
+func newDNSEndpoint(vmi *virtv1.VirtualMachineInstance, name string, records []dns.Record) *unstructured.Unstructured {
+	var endpoints []interface{}
+	for _, record := range records {
+		var targets []interface{}
+		for _, target := range record.Targets {
+			targets = append(targets, target)
+		}
+		endpoints = append(endpoints, map[string]interface{}{
+			"dnsName":    record.DNSName,
+			"recordType": record.RecordType,
+			"targets":    targets,
+		})
+	}
+
+	dnsEndpoint := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": map[string]interface{}{
+			"endpoints": endpoints,
+		},
+	}}
+	dnsEndpoint.SetAPIVersion(controller.DNSEndpointGVR.GroupVersion().String())
+	dnsEndpoint.SetKind("DNSEndpoint")
+	dnsEndpoint.SetName(name)
+	dnsEndpoint.SetNamespace(vmi.Namespace)
+	dnsEndpoint.SetLabels(map[string]string{virtv1.CreatedByLabel: string(vmi.UID)})
+	dnsEndpoint.SetOwnerReferences([]metav1.OwnerReference{
+		*metav1.NewControllerRef(vmi, virtv1.VirtualMachineInstanceGroupVersionKind),
+	})
+	return dnsEndpoint
+}
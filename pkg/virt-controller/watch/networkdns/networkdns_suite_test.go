@@ -0,0 +1,11 @@
+package networkdns_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestNetworkDNS(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,235 @@
+package networkdns_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/network/vmispec"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/networkdns"
+)
+
+var _ = Describe("Secondary network DNS controller", func() {
+	const dnsEndpointName = "kubevirt-dns-testvmi"
+
+	var dynamicClient *fakedynamic.FakeDynamicClient
+	var vmiInformer cache.SharedIndexInformer
+	var dnsEndpointInformer cache.SharedIndexInformer
+	var recorder *record.FakeRecorder
+	var mockQueue *testutils.MockWorkQueue
+	var ctrl *networkdns.SecondaryNetworkDNSController
+
+	initController := func(featureGates ...string) {
+		virtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		dynamicClient = fakedynamic.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{controller.DNSEndpointGVR: "DNSEndpointList"})
+		virtClient.EXPECT().DynamicClient().Return(dynamicClient).AnyTimes()
+
+		config, crdInformer, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: featureGates},
+			NetworkConfiguration:   &v1.NetworkConfiguration{SecondaryNetworkDNSDomain: "vms.example.com"},
+		})
+		testutils.AddDNSEndpointAPI(crdInformer)
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		dnsEndpointInformer, _ = testutils.NewFakeInformerFor(&unstructured.Unstructured{})
+		recorder = record.NewFakeRecorder(100)
+		recorder.IncludeObject = true
+
+		ctrl = networkdns.NewSecondaryNetworkDNSController(vmiInformer, dnsEndpointInformer, recorder, virtClient, config)
+		mockQueue = testutils.NewMockWorkQueue(ctrl.Queue)
+		ctrl.Queue = mockQueue
+	}
+
+	BeforeEach(func() {
+		initController(virtconfig.SecondaryNetworkDNSGate)
+	})
+
+	AfterEach(func() {
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	// syncDNSEndpointInformer mimics the informer, which only watches the DNSEndpoints created for VMIs
+	syncDNSEndpointInformer := func() {
+		list, err := dynamicClient.Resource(controller.DNSEndpointGVR).Namespace(metav1.NamespaceAll).List(context.Background(), metav1.ListOptions{LabelSelector: v1.CreatedByLabel})
+		Expect(err).ToNot(HaveOccurred())
+		var objs []interface{}
+		for i := range list.Items {
+			objs = append(objs, &list.Items[i])
+		}
+		Expect(dnsEndpointInformer.GetStore().Replace(objs, "")).To(Succeed())
+	}
+
+	execute := func(vmi *v1.VirtualMachineInstance) {
+		Expect(vmiInformer.GetIndexer().Add(vmi)).To(Succeed())
+		syncDNSEndpointInformer()
+		mockQueue.Add(vmi.Namespace + "/" + vmi.Name)
+		ctrl.Execute()
+		Expect(mockQueue.Len()).To(BeZero())
+		Expect(mockQueue.GetRateLimitedEnqueueCount()).To(BeZero())
+	}
+
+	getDNSEndpoint := func() (*unstructured.Unstructured, error) {
+		return dynamicClient.Resource(controller.DNSEndpointGVR).Namespace(metav1.NamespaceDefault).Get(context.Background(), dnsEndpointName, metav1.GetOptions{})
+	}
+
+	dnsEndpointRecords := func() []interface{} {
+		dnsEndpoint, err := getDNSEndpoint()
+		Expect(err).ToNot(HaveOccurred())
+		endpoints, _, err := unstructured.NestedSlice(dnsEndpoint.Object, "spec", "endpoints")
+		Expect(err).ToNot(HaveOccurred())
+		return endpoints
+	}
+
+	It("should publish the guest agent reported addresses of the secondary networks", func() {
+		vmi := newVMI("192.168.1.10", "fd00::10")
+
+		execute(vmi)
+
+		dnsEndpoint, err := getDNSEndpoint()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(metav1.IsControlledBy(dnsEndpoint, vmi)).To(BeTrue())
+		Expect(dnsEndpointRecords()).To(ConsistOf(
+			map[string]interface{}{"dnsName": "testvmi.blue.default.vms.example.com", "recordType": "A", "targets": []interface{}{"192.168.1.10"}},
+			map[string]interface{}{"dnsName": "testvmi.blue.default.vms.example.com", "recordType": "AAAA", "targets": []interface{}{"fd00::10"}},
+		))
+	})
+
+	It("should update the records when the guest addresses change", func() {
+		vmi := newVMI("192.168.1.10")
+		execute(vmi)
+
+		vmi.Status.Interfaces[0].IPs = []string{"192.168.1.20"}
+		execute(vmi)
+
+		Expect(dnsEndpointRecords()).To(ConsistOf(
+			map[string]interface{}{"dnsName": "testvmi.blue.default.vms.example.com", "recordType": "A", "targets": []interface{}{"192.168.1.20"}},
+		))
+	})
+
+	It("should remove the records when the guest no longer reports addresses", func() {
+		vmi := newVMI("192.168.1.10")
+		execute(vmi)
+
+		vmi.Status.Interfaces = nil
+		execute(vmi)
+
+		_, err := getDNSEndpoint()
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should not touch a DNSEndpoint the VMI does not control", func() {
+		foreign := &unstructured.Unstructured{}
+		foreign.SetAPIVersion(controller.DNSEndpointGVR.GroupVersion().String())
+		foreign.SetKind("DNSEndpoint")
+		foreign.SetName(dnsEndpointName)
+		foreign.SetNamespace(metav1.NamespaceDefault)
+		_, err := dynamicClient.Resource(controller.DNSEndpointGVR).Namespace(metav1.NamespaceDefault).Create(context.Background(), foreign, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		execute(newVMI("192.168.1.10"))
+
+		testutils.ExpectEvent(recorder, networkdns.DNSEndpointNameConflictReason)
+		dnsEndpoint, err := getDNSEndpoint()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dnsEndpoint.Object).ToNot(HaveKey("spec"))
+	})
+
+	It("should not publish records when the feature gate is disabled", func() {
+		initController()
+
+		execute(newVMI("192.168.1.10"))
+
+		_, err := getDNSEndpoint()
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should remove the records when the feature gate is disabled", func() {
+		vmi := newVMI("192.168.1.10")
+		execute(vmi)
+		dnsEndpoint, err := getDNSEndpoint()
+		Expect(err).ToNot(HaveOccurred())
+
+		initController()
+		_, err = dynamicClient.Resource(controller.DNSEndpointGVR).Namespace(metav1.NamespaceDefault).Create(context.Background(), dnsEndpoint, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		execute(vmi)
+
+		_, err = getDNSEndpoint()
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should do nothing when the DNSEndpoint CRD is not installed", func() {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{FeatureGates: []string{virtconfig.SecondaryNetworkDNSGate}},
+		})
+		ctrl = networkdns.NewSecondaryNetworkDNSController(vmiInformer, dnsEndpointInformer, recorder, kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT())), config)
+		mockQueue = testutils.NewMockWorkQueue(ctrl.Queue)
+		ctrl.Queue = mockQueue
+
+		execute(newVMI("192.168.1.10"))
+	})
+
+	It("should not retry when the DNSEndpoint API is not found", func() {
+		dynamicClient.PrependReactor("create", "dnsendpoints", func(_ testing.Action) (bool, runtime.Object, error) {
+			return true, nil, errors.NewNotFound(controller.DNSEndpointGVR.GroupResource(), dnsEndpointName)
+		})
+
+		execute(newVMI("192.168.1.10"))
+	})
+
+	It("should retry when the created DNSEndpoint is not in the cache yet", func() {
+		vmi := newVMI("192.168.1.10")
+		execute(vmi)
+
+		Expect(dnsEndpointInformer.GetStore().Replace(nil, "")).To(Succeed())
+		vmi.Status.Interfaces[0].IPs = []string{"192.168.1.20"}
+		Expect(vmiInformer.GetIndexer().Update(vmi)).To(Succeed())
+		mockQueue.Add(vmi.Namespace + "/" + vmi.Name)
+		ctrl.Execute()
+
+		Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		testutils.ExpectEvent(recorder, networkdns.FailedSyncDNSEndpointReason)
+	})
+})
+
+func newVMI(ips ...string) *v1.VirtualMachineInstance {
+	return &v1.VirtualMachineInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "testvmi",
+			Namespace: metav1.NamespaceDefault,
+			UID:       "vmi-uid",
+		},
+		Spec: v1.VirtualMachineInstanceSpec{
+			Networks: []v1.Network{{
+				Name:          "blue",
+				NetworkSource: v1.NetworkSource{Multus: &v1.MultusNetwork{NetworkName: "blue-nad"}},
+			}},
+		},
+		Status: v1.VirtualMachineInstanceStatus{
+			Phase: v1.Running,
+			Interfaces: []v1.VirtualMachineInstanceNetworkInterface{{
+				Name:       "blue",
+				IPs:        ips,
+				InfoSource: vmispec.InfoSourceDomainAndGA,
+			}},
+		},
+	}
+}
@@ -104,6 +104,8 @@ const (
 	FailedCreateReason                 = "FailedCreate"
 	VMIFailedDeleteReason              = "FailedDelete"
 	HotPlugNetworkInterfaceErrorReason = "HotPlugNetworkInterfaceError"
+	// UpdateNetworkInterfaceErrorReason is set when the link state or bandwidth of the VMI interfaces cannot be updated
+	UpdateNetworkInterfaceErrorReason = "UpdateNetworkInterfaceError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -2521,7 +2523,7 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 		}
 	}
 
-	var ifaceHotplugError syncError
+	var ifaceHotplugError, ifaceUpdateError syncError
 	// Must check needsSync again here because a VMI can be created or
 	// deleted in the startStop function which impacts how we process
 	// hotplugged volumes and interfaces
@@ -2538,6 +2540,14 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 			}
 		}
 
+		if err := c.syncInterfacesLinkStateAndBandwidth(vmCopy, vmi); err != nil {
+			log.Log.Object(vm).Errorf("error encountered while updating the network interfaces of the VMI: %v", err)
+			ifaceUpdateError = &syncErrorImpl{
+				err:    fmt.Errorf("error encountered while updating the network interfaces of the VMI: %v", err),
+				reason: UpdateNetworkInterfaceErrorReason,
+			}
+		}
+
 		err = c.handleVolumeRequests(vmCopy, vmi)
 		if err != nil {
 			syncErr = &syncErrorImpl{fmt.Errorf("Error encountered while handling volume hotplug requests: %v", err), HotPlugVolumeErrorReason}
@@ -2559,8 +2569,8 @@ func (c *VMController) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachin
 	}
 	virtControllerVMWorkQueueTracer.StepTrace(key, "sync", trace.Field{Key: "VM Name", Value: vm.Name})
 
-	if syncErr == nil && ifaceHotplugError != nil {
-		syncErr = ifaceHotplugError
+	if syncErr == nil {
+		syncErr = joinInterfaceSyncErrors(ifaceHotplugError, ifaceUpdateError)
 	}
 	return vm, syncErr, nil
 }
@@ -2650,6 +2660,49 @@ func (c *VMController) handleInterfaceRequests(vm *virtv1.VirtualMachine, vmi *v
 	return nil
 }
 
+// syncInterfacesLinkStateAndBandwidth propagates the changes of the interfaces link state and bandwidth limits
+// from the VM template to the running VMI.
+func (c *VMController) syncInterfacesLinkStateAndBandwidth(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+		return nil
+	}
+
+	vmiIfaces := vmi.Spec.Domain.Devices.Interfaces
+	updatedIfaces, changed := netvmispec.ApplyLiveUpdatableInterfaceFields(vmiIfaces, vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+	if !changed {
+		return nil
+	}
+
+	oldIfaces, err := json.Marshal(vmiIfaces)
+	if err != nil {
+		return err
+	}
+	newIfaces, err := json.Marshal(updatedIfaces)
+	if err != nil {
+		return err
+	}
+	ops := []string{
+		fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": %s }`, string(oldIfaces)),
+		fmt.Sprintf(`{ "op": "replace", "path": "/spec/domain/devices/interfaces", "value": %s }`, string(newIfaces)),
+	}
+	_, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, controller.GeneratePatchBytes(ops), &v1.PatchOptions{})
+	return err
+}
+
+// joinInterfaceSyncErrors reports both the network interface hotplug and update errors, with the reason of the hotplug one
+func joinInterfaceSyncErrors(hotplugErr syncError, updateErr syncError) syncError {
+	if hotplugErr == nil {
+		return updateErr
+	}
+	if updateErr == nil {
+		return hotplugErr
+	}
+	return &syncErrorImpl{
+		err:    fmt.Errorf("%v, %v", hotplugErr, updateErr),
+		reason: hotplugErr.Reason(),
+	}
+}
+
 func (c *VMController) trimDoneInterfaceRequests(vm *virtv1.VirtualMachine) {
 	if len(vm.Status.InterfaceRequests) == 0 {
 		return
@@ -2438,6 +2438,68 @@ var _ = Describe("VirtualMachine", func() {
 			controller.Execute()
 		})
 
+		Context("interfaces link state and bandwidth", func() {
+			newInterface := func(state virtv1.InterfaceState) virtv1.Interface {
+				return virtv1.Interface{
+					Name:                   "default",
+					InterfaceBindingMethod: virtv1.InterfaceBindingMethod{Masquerade: &virtv1.InterfaceMasquerade{}},
+					State:                  state,
+				}
+			}
+
+			It("should patch the running VMI interfaces when the template link state changes", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{newInterface("")}
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{newInterface(virtv1.InterfaceStateLinkDown)}
+
+				oldIfaces, err := json.Marshal(vmi.Spec.Domain.Devices.Interfaces)
+				Expect(err).ToNot(HaveOccurred())
+				newIfaces, err := json.Marshal(vm.Spec.Template.Spec.Domain.Devices.Interfaces)
+				Expect(err).ToNot(HaveOccurred())
+				ops := []string{
+					fmt.Sprintf(`{ "op": "test", "path": "/spec/domain/devices/interfaces", "value": %s }`, string(oldIfaces)),
+					fmt.Sprintf(`{ "op": "replace", "path": "/spec/domain/devices/interfaces", "value": %s }`, string(newIfaces)),
+				}
+				vmiInterface.EXPECT().Patch(context.Background(), vmi.Name, types.JSONPatchType, []byte("["+strings.Join(ops, ", ")+"]"), &metav1.PatchOptions{}).Return(vmi, nil)
+
+				Expect(controller.syncInterfacesLinkStateAndBandwidth(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch the VMI when the interfaces did not change", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{newInterface(virtv1.InterfaceStateLinkDown)}
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{newInterface(virtv1.InterfaceStateLinkDown)}
+
+				vmiInterface.EXPECT().Patch(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.syncInterfacesLinkStateAndBandwidth(vm, vmi)).To(Succeed())
+			})
+
+			It("should not patch a VMI which is not running", func() {
+				vm, vmi := DefaultVirtualMachine(true)
+				vmi.Status.Phase = virtv1.Scheduling
+				vmi.Spec.Domain.Devices.Interfaces = []virtv1.Interface{newInterface("")}
+				vm.Spec.Template.Spec.Domain.Devices.Interfaces = []virtv1.Interface{newInterface(virtv1.InterfaceStateLinkDown)}
+
+				vmiInterface.EXPECT().Patch(context.Background(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+				Expect(controller.syncInterfacesLinkStateAndBandwidth(vm, vmi)).To(Succeed())
+			})
+
+			It("should report both the hotplug and the update errors of the interfaces", func() {
+				hotplugErr := &syncErrorImpl{fmt.Errorf("hotplug failed"), HotPlugNetworkInterfaceErrorReason}
+				updateErr := &syncErrorImpl{fmt.Errorf("update failed"), UpdateNetworkInterfaceErrorReason}
+
+				Expect(joinInterfaceSyncErrors(nil, nil)).To(BeNil())
+				Expect(joinInterfaceSyncErrors(nil, updateErr)).To(Equal(updateErr))
+				Expect(joinInterfaceSyncErrors(hotplugErr, nil)).To(Equal(hotplugErr))
+
+				err := joinInterfaceSyncErrors(hotplugErr, updateErr)
+				Expect(err).To(MatchError("hotplug failed, update failed"))
+				Expect(err.Reason()).To(Equal(HotPlugNetworkInterfaceErrorReason))
+			})
+		})
+
 		Context("VM memory dump", func() {
 			const (
 				testPVCName    = "testPVC"
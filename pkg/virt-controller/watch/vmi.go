@@ -710,6 +710,12 @@ func (c *VMIController) updateStatus(vmi *virtv1.VirtualMachineInstance, pod *k8
 			log.Log.Errorf("failed to update the interface status: %v", err)
 		}
 
+		if controller.VMIHasHotplugHostDevices(vmiCopy) {
+			if err := c.updateHostDeviceStatus(vmiCopy, pod); err != nil {
+				return err
+			}
+		}
+
 	case vmi.IsScheduled():
 		// Nothing here
 		break
@@ -817,6 +823,23 @@ func prepareVMIPatch(oldVMI, newVMI *virtv1.VirtualMachineInstance) ([]byte, err
 		}
 		log.Log.V(3).Object(oldVMI).Infof("Patching Volume Status")
 	}
+	if !equality.Semantic.DeepEqual(newVMI.Status.HostDeviceStatus, oldVMI.Status.HostDeviceStatus) {
+		newHostDeviceStatus, err := json.Marshal(newVMI.Status.HostDeviceStatus)
+		if err != nil {
+			return nil, err
+		}
+		oldHostDeviceStatus, err := json.Marshal(oldVMI.Status.HostDeviceStatus)
+		if err != nil {
+			return nil, err
+		}
+		if string(oldHostDeviceStatus) == "null" {
+			patchOps = append(patchOps, fmt.Sprintf(`{ "op": "add", "path": "/status/hostDeviceStatus", "value": %s }`, string(newHostDeviceStatus)))
+		} else {
+			patchOps = append(patchOps, fmt.Sprintf(`{ "op": "test", "path": "/status/hostDeviceStatus", "value": %s }`, string(oldHostDeviceStatus)))
+			patchOps = append(patchOps, fmt.Sprintf(`{ "op": "replace", "path": "/status/hostDeviceStatus", "value": %s }`, string(newHostDeviceStatus)))
+		}
+		log.Log.V(3).Object(oldVMI).Infof("Patching Host Device Status")
+	}
 	// We don't own the object anymore, so patch instead of update
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	if !vmiConditions.ConditionsEqual(oldVMI, newVMI) {
@@ -1248,6 +1271,11 @@ func (c *VMIController) sync(vmi *virtv1.VirtualMachineInstance, pod *k8sv1.Pod,
 				}
 			}
 		}
+		if pod.DeletionTimestamp == nil && vmi.IsRunning() && controller.VMIHasHotplugHostDevices(vmi) {
+			if hotplugSyncErr := c.handleHotplugHostDevices(vmi, pod); hotplugSyncErr != nil {
+				return hotplugSyncErr
+			}
+		}
 		if len(vmispec.NetworksToHotplug(vmi.Spec.Networks, vmi.Status.Interfaces)) > 0 {
 			if err := c.handleDynamicInterfaceRequests(vmi, pod); err != nil {
 				return &syncErrorImpl{
@@ -2042,7 +2070,11 @@ func (c *VMIController) deleteAllAttachmentPods(vmi *virtv1.VirtualMachineInstan
 		if err != nil {
 			return err
 		}
-		for _, attachmentPod := range attachmentPods {
+		hostDeviceAttachmentPods, err := controller.HostDeviceAttachmentPods(virtlauncherPod, c.podInformer)
+		if err != nil {
+			return err
+		}
+		for _, attachmentPod := range append(attachmentPods, hostDeviceAttachmentPods...) {
 			err := c.deleteAttachmentPodForVolume(vmi, attachmentPod)
 			if err != nil && !k8serrors.IsNotFound(err) {
 				return err
@@ -2073,6 +2105,13 @@ func (c *VMIController) deleteOrphanedAttachmentPods(vmi *virtv1.VirtualMachineI
 			// do not return; continue the cleanup...
 			continue
 		}
+		hostDeviceAttachmentPods, err := controller.HostDeviceAttachmentPods(pod, c.podInformer)
+		if err != nil {
+			log.Log.Reason(err).Errorf("failed to get host device attachment pods %s: %v", controller.PodKey(pod), err)
+			// do not return; continue the cleanup...
+			continue
+		}
+		attachmentPods = append(attachmentPods, hostDeviceAttachmentPods...)
 
 		for _, attachmentPod := range attachmentPods {
 			if err := c.deleteAttachmentPodForVolume(vmi, attachmentPod); err != nil {
@@ -2085,6 +2124,146 @@ func (c *VMIController) deleteOrphanedAttachmentPods(vmi *virtv1.VirtualMachineI
 	return nil
 }
 
+// handleHotplugHostDevices creates an attachment pod for every hotpluggable host device of the VMI, and
+// deletes the attachment pods of removed host devices once virt-handler detached them from the domain.
+func (c *VMIController) handleHotplugHostDevices(vmi *virtv1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod) syncError {
+	attachmentPods, err := controller.HostDeviceAttachmentPods(virtLauncherPod, c.podInformer)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("failed to get host device attachment pods: %v", err), FailedHotplugSyncReason}
+	}
+	attachmentPodsByHostDevice := make(map[string]*k8sv1.Pod)
+	for _, attachmentPod := range attachmentPods {
+		attachmentPodsByHostDevice[attachmentPod.Annotations[virtv1.HotplugHostDeviceAnnotation]] = attachmentPod
+	}
+
+	for i := range vmi.Spec.Domain.Devices.HostDevices {
+		hostDevice := &vmi.Spec.Domain.Devices.HostDevices[i]
+		if !hostDevice.Hotpluggable {
+			continue
+		}
+		if _, exists := attachmentPodsByHostDevice[hostDevice.Name]; exists {
+			delete(attachmentPodsByHostDevice, hostDevice.Name)
+			continue
+		}
+		if syncErr := c.createHostDeviceAttachmentPod(vmi, virtLauncherPod, hostDevice); syncErr != nil {
+			return syncErr
+		}
+	}
+
+	// The remaining attachment pods belong to host devices which were removed from the spec.
+	hostDeviceStatusMap := make(map[string]virtv1.HostDeviceStatus)
+	for _, status := range vmi.Status.HostDeviceStatus {
+		hostDeviceStatusMap[status.Name] = status
+	}
+	for name, attachmentPod := range attachmentPodsByHostDevice {
+		if status, exists := hostDeviceStatusMap[name]; exists && status.Phase != virtv1.HostDeviceDetached && status.Address != "" {
+			// still in use by the domain, wait for virt-handler to detach it
+			continue
+		}
+		if err := c.deleteAttachmentPodForVolume(vmi, attachmentPod); err != nil {
+			return &syncErrorImpl{fmt.Errorf("failed to delete host device attachment pod %s: %v", attachmentPod.Name, err), FailedHotplugSyncReason}
+		}
+	}
+	return nil
+}
+
+func (c *VMIController) createHostDeviceAttachmentPod(vmi *virtv1.VirtualMachineInstance, virtLauncherPod *k8sv1.Pod, hostDevice *virtv1.HostDevice) syncError {
+	attachmentPodTemplate, err := c.templateService.RenderHotplugHostDeviceAttachmentPodTemplate(hostDevice, virtLauncherPod, vmi)
+	if err != nil {
+		return &syncErrorImpl{fmt.Errorf("Error rendering host device attachment pod template %v", err), FailedCreatePodReason}
+	}
+	vmiKey := controller.VirtualMachineInstanceKey(vmi)
+	c.podExpectations.ExpectCreations(vmiKey, 1)
+
+	pod, err := c.clientset.CoreV1().Pods(vmi.GetNamespace()).Create(context.Background(), attachmentPodTemplate, v1.CreateOptions{})
+	if err != nil {
+		c.podExpectations.CreationObserved(vmiKey)
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, FailedCreatePodReason, "Error creating attachment pod for host device %s: %v", hostDevice.Name, err)
+		return &syncErrorImpl{fmt.Errorf("Error creating attachment pod for host device %s %v", hostDevice.Name, err), FailedCreatePodReason}
+	}
+	c.recorder.Eventf(vmi, k8sv1.EventTypeNormal, SuccessfulCreatePodReason, "Created attachment pod %s for host device %s", pod.Name, hostDevice.Name)
+	return nil
+}
+
+func (c *VMIController) updateHostDeviceStatus(vmi *virtv1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) error {
+	attachmentPods, err := controller.HostDeviceAttachmentPods(virtlauncherPod, c.podInformer)
+	if err != nil {
+		return err
+	}
+	attachmentPodsByHostDevice := make(map[string]*k8sv1.Pod)
+	for _, attachmentPod := range attachmentPods {
+		attachmentPodsByHostDevice[attachmentPod.Annotations[virtv1.HotplugHostDeviceAnnotation]] = attachmentPod
+	}
+	oldStatusMap := make(map[string]virtv1.HostDeviceStatus)
+	for _, status := range vmi.Status.HostDeviceStatus {
+		oldStatusMap[status.Name] = status
+	}
+
+	newStatus := make([]virtv1.HostDeviceStatus, 0)
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		if !hostDevice.Hotpluggable {
+			continue
+		}
+		status, exists := oldStatusMap[hostDevice.Name]
+		if !exists {
+			status = virtv1.HostDeviceStatus{
+				Name:  hostDevice.Name,
+				Phase: virtv1.HostDevicePending,
+			}
+		}
+		// Remove from map so I can detect existing host devices that have been removed from spec.
+		delete(oldStatusMap, hostDevice.Name)
+
+		attachmentPod := attachmentPodsByHostDevice[hostDevice.Name]
+		switch {
+		case attachmentPod == nil:
+			status.AttachPodName = ""
+			status.AttachPodUID = ""
+			if status.Address == "" {
+				status.Phase = virtv1.HostDevicePending
+				status.Message = "Waiting for the attachment pod to be created"
+			}
+		case !isPodReady(attachmentPod):
+			status.AttachPodName = attachmentPod.Name
+			if status.Address == "" {
+				status.Phase = virtv1.HostDevicePending
+				status.Message = fmt.Sprintf("Waiting for the attachment pod %s to get ready", attachmentPod.Name)
+			}
+		default:
+			status.AttachPodName = attachmentPod.Name
+			status.AttachPodUID = attachmentPod.UID
+			if status.Phase == virtv1.HostDevicePending {
+				status.Phase = virtv1.HostDeviceAttachedToNode
+				status.Message = fmt.Sprintf("Created attachment pod %s, the device is allocated on the node", attachmentPod.Name)
+			}
+		}
+		newStatus = append(newStatus, status)
+	}
+
+	for _, status := range vmi.Status.HostDeviceStatus {
+		if _, removed := oldStatusMap[status.Name]; !removed {
+			continue
+		}
+		if _, exists := attachmentPodsByHostDevice[status.Name]; !exists {
+			// the attachment pod is gone, nothing left to track
+			continue
+		}
+		if status.Address == "" {
+			status.Phase = virtv1.HostDeviceDetached
+		} else if status.Phase != virtv1.HostDeviceDetached {
+			status.Phase = virtv1.HostDeviceDetaching
+			status.Message = "Waiting for the host device to be detached from the domain"
+		}
+		newStatus = append(newStatus, status)
+	}
+
+	if len(newStatus) == 0 {
+		newStatus = nil
+	}
+	vmi.Status.HostDeviceStatus = newStatus
+	return nil
+}
+
 func (c *VMIController) updateVolumeStatus(vmi *virtv1.VirtualMachineInstance, virtlauncherPod *k8sv1.Pod) error {
 	oldStatus := vmi.Status.DeepCopy().VolumeStatus
 	oldStatusMap := make(map[string]virtv1.VolumeStatus)
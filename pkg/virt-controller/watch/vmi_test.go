@@ -2972,6 +2972,147 @@ var _ = Describe("VirtualMachineInstance watcher", func() {
 		})
 	})
 
+	Context("hotplug host device", func() {
+		const deviceName = "nvidia.com/GP102GL_Tesla_P40"
+
+		newHostDeviceAttachmentPod := func(virtlauncherPod *k8sv1.Pod, hostDeviceName string, phase k8sv1.PodPhase) *k8sv1.Pod {
+			pod := NewPodForVirtlauncher(virtlauncherPod, "hp-hostdev-"+hostDeviceName, "uid-"+hostDeviceName, phase)
+			pod.Annotations = map[string]string{virtv1.HotplugHostDeviceAnnotation: hostDeviceName}
+			return pod
+		}
+
+		newRunningVMIWithHostDevices := func(hostDevices ...virtv1.HostDevice) *virtv1.VirtualMachineInstance {
+			vmi := NewPendingVirtualMachine("testvmi")
+			vmi.Status.Phase = virtv1.Running
+			vmi.Spec.Domain.Devices.HostDevices = hostDevices
+			return vmi
+		}
+
+		It("should not return host device attachment pods as volume attachment pods", func() {
+			vmi := newRunningVMIWithHostDevices()
+			virtlauncherPod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			addVirtualMachine(vmi)
+			volumePod := NewPodForVirtlauncher(virtlauncherPod, "hp-volume", "abcd", k8sv1.PodRunning)
+			hostDevicePod := newHostDeviceAttachmentPod(virtlauncherPod, "gpu1", k8sv1.PodRunning)
+			podFeeder.Add(virtlauncherPod)
+			podFeeder.Add(volumePod)
+			podFeeder.Add(hostDevicePod)
+
+			Expect(kvcontroller.AttachmentPods(virtlauncherPod, podInformer)).To(ConsistOf(volumePod))
+			Expect(kvcontroller.HostDeviceAttachmentPods(virtlauncherPod, podInformer)).To(ConsistOf(hostDevicePod))
+		})
+
+		It("should create an attachment pod for a hotpluggable host device", func() {
+			vmi := newRunningVMIWithHostDevices(virtv1.HostDevice{Name: "gpu1", DeviceName: deviceName, Hotpluggable: true})
+			vmi.Status.SelinuxContext = "system_u:system_r:container_file_t:s0:c1,c2"
+			virtlauncherPod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			addVirtualMachine(vmi)
+			podFeeder.Add(virtlauncherPod)
+
+			kubeClient.Fake.PrependReactor("create", "pods", func(action testing.Action) (handled bool, obj k8sruntime.Object, err error) {
+				pod := action.(testing.CreateAction).GetObject().(*k8sv1.Pod)
+				Expect(pod.GenerateName).To(Equal("hp-hostdev-"))
+				Expect(pod.Annotations).To(HaveKeyWithValue(virtv1.HotplugHostDeviceAnnotation, "gpu1"))
+				return true, pod, nil
+			})
+
+			Expect(controller.handleHotplugHostDevices(vmi, virtlauncherPod)).To(BeNil())
+			testutils.ExpectEvent(recorder, SuccessfulCreatePodReason)
+		})
+
+		It("should not create an attachment pod for a host device which is not hotpluggable", func() {
+			vmi := newRunningVMIWithHostDevices(virtv1.HostDevice{Name: "gpu1", DeviceName: deviceName})
+			virtlauncherPod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			addVirtualMachine(vmi)
+			podFeeder.Add(virtlauncherPod)
+
+			Expect(controller.handleHotplugHostDevices(vmi, virtlauncherPod)).To(BeNil())
+			Expect(kubeClient.Actions()).To(BeEmpty())
+		})
+
+		DescribeTable("should handle the attachment pod of a removed host device", func(status virtv1.HostDeviceStatus, expectDeletion bool) {
+			vmi := newRunningVMIWithHostDevices()
+			vmi.Status.HostDeviceStatus = []virtv1.HostDeviceStatus{status}
+			virtlauncherPod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			attachmentPod := newHostDeviceAttachmentPod(virtlauncherPod, "gpu1", k8sv1.PodRunning)
+			addVirtualMachine(vmi)
+			podFeeder.Add(virtlauncherPod)
+			podFeeder.Add(attachmentPod)
+
+			if expectDeletion {
+				shouldExpectPodDeletion(attachmentPod)
+			}
+			Expect(controller.handleHotplugHostDevices(vmi, virtlauncherPod)).To(BeNil())
+			if expectDeletion {
+				testutils.ExpectEvent(recorder, SuccessfulDeletePodReason)
+			} else {
+				Expect(kubeClient.Actions()).To(BeEmpty())
+			}
+		},
+			Entry("keep it while the device is still attached to the domain",
+				virtv1.HostDeviceStatus{Name: "gpu1", Phase: virtv1.HostDeviceDetaching, Address: "0000:81:00.0"}, false),
+			Entry("delete it once the device is detached from the domain",
+				virtv1.HostDeviceStatus{Name: "gpu1", Phase: virtv1.HostDeviceDetached, Address: "0000:81:00.0"}, true),
+			Entry("delete it if the device was never handed over to the domain",
+				virtv1.HostDeviceStatus{Name: "gpu1", Phase: virtv1.HostDeviceAttachedToNode}, true),
+		)
+
+		DescribeTable("updateHostDeviceStatus", func(hostDevices []virtv1.HostDevice, oldStatus []virtv1.HostDeviceStatus, podPhases map[string]k8sv1.PodPhase, expectedStatus []virtv1.HostDeviceStatus) {
+			vmi := newRunningVMIWithHostDevices(hostDevices...)
+			vmi.Status.HostDeviceStatus = oldStatus
+			virtlauncherPod := NewPodForVirtualMachine(vmi, k8sv1.PodRunning)
+			for hostDeviceName, phase := range podPhases {
+				Expect(podInformer.GetIndexer().Add(newHostDeviceAttachmentPod(virtlauncherPod, hostDeviceName, phase))).To(Succeed())
+			}
+
+			Expect(controller.updateHostDeviceStatus(vmi, virtlauncherPod)).To(Succeed())
+			Expect(vmi.Status.HostDeviceStatus).To(Equal(expectedStatus))
+		},
+			Entry("should set the pending phase if the attachment pod does not exist",
+				[]virtv1.HostDevice{{Name: "gpu1", DeviceName: deviceName, Hotpluggable: true}},
+				nil,
+				nil,
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDevicePending, Message: "Waiting for the attachment pod to be created"}},
+			),
+			Entry("should ignore host devices which are not hotpluggable",
+				[]virtv1.HostDevice{{Name: "gpu1", DeviceName: deviceName}},
+				nil,
+				nil,
+				nil,
+			),
+			Entry("should keep the pending phase if the attachment pod is not ready",
+				[]virtv1.HostDevice{{Name: "gpu1", DeviceName: deviceName, Hotpluggable: true}},
+				nil,
+				map[string]k8sv1.PodPhase{"gpu1": k8sv1.PodPending},
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDevicePending, AttachPodName: "hp-hostdev-gpu1", Message: "Waiting for the attachment pod hp-hostdev-gpu1 to get ready"}},
+			),
+			Entry("should move to attached to node once the attachment pod is ready",
+				[]virtv1.HostDevice{{Name: "gpu1", DeviceName: deviceName, Hotpluggable: true}},
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDevicePending}},
+				map[string]k8sv1.PodPhase{"gpu1": k8sv1.PodRunning},
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDeviceAttachedToNode, AttachPodName: "hp-hostdev-gpu1", AttachPodUID: "uid-gpu1", Message: "Created attachment pod hp-hostdev-gpu1, the device is allocated on the node"}},
+			),
+			Entry("should keep the phase set by virt-handler",
+				[]virtv1.HostDevice{{Name: "gpu1", DeviceName: deviceName, Hotpluggable: true}},
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDeviceReady, AttachPodName: "hp-hostdev-gpu1", AttachPodUID: "uid-gpu1", Address: "0000:81:00.0"}},
+				map[string]k8sv1.PodPhase{"gpu1": k8sv1.PodRunning},
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDeviceReady, AttachPodName: "hp-hostdev-gpu1", AttachPodUID: "uid-gpu1", Address: "0000:81:00.0"}},
+			),
+			Entry("should move a removed host device to detaching",
+				nil,
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDeviceReady, AttachPodName: "hp-hostdev-gpu1", AttachPodUID: "uid-gpu1", Address: "0000:81:00.0"}},
+				map[string]k8sv1.PodPhase{"gpu1": k8sv1.PodRunning},
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDeviceDetaching, AttachPodName: "hp-hostdev-gpu1", AttachPodUID: "uid-gpu1", Address: "0000:81:00.0", Message: "Waiting for the host device to be detached from the domain"}},
+			),
+			Entry("should drop a removed host device once its attachment pod is gone",
+				nil,
+				[]virtv1.HostDeviceStatus{{Name: "gpu1", Phase: virtv1.HostDeviceDetached, AttachPodName: "hp-hostdev-gpu1", AttachPodUID: "uid-gpu1", Address: "0000:81:00.0"}},
+				nil,
+				nil,
+			),
+		)
+	})
+
 	Context("topology hints", decorators.TscFrequencies, func() {
 
 		getVmiWithInvTsc := func() *virtv1.VirtualMachineInstance {
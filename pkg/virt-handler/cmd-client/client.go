@@ -103,6 +103,13 @@ type LauncherClient interface {
 	GetUsers() (v1.VirtualMachineInstanceGuestOSUserList, error)
 	GetFilesystems() (v1.VirtualMachineInstanceFileSystemList, error)
 	Exec(string, string, []string, int32) (int, string, error)
+	GuestExec(domainName string, command string, args []string, stdin []byte, timeoutSeconds int32) (*v1.GuestExecResult, error)
+	GuestFileOpen(domainName string, path string, mode string) (int64, error)
+	GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error)
+	GuestFileWrite(domainName string, handle int64, data []byte) error
+	GuestFileClose(domainName string, handle int64) error
+	AddSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string, ttlSeconds int32) error
+	RemoveSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string) error
 	Ping() error
 	GuestPing(string, int32) error
 	Close()
@@ -120,6 +127,9 @@ const (
 	longTimeout  time.Duration = 20 * time.Second
 )
 
+// guestExecMaxResponseSize lifts the 4MiB default of gRPC for the 16MiB of stdout and of stderr the guest agent captures at most
+const guestExecMaxResponseSize = 2*16*1024*1024 + 1024*1024
+
 func SetLegacyBaseDir(baseDir string) {
 	legacyBaseDir = baseDir
 }
@@ -755,6 +765,133 @@ func (c *VirtLauncherClient) Exec(domainName, command string, args []string, tim
 	return exitCode, stdOut, err
 }
 
+// GuestExec runs the command with args and stdin on the guest and returns its exit code, stdout and stderr
+func (c *VirtLauncherClient) GuestExec(domainName string, command string, args []string, stdin []byte, timeoutSeconds int32) (*v1.GuestExecResult, error) {
+	request := &cmdv1.GuestExecRequest{
+		DomainName:     domainName,
+		Command:        command,
+		Args:           args,
+		Stdin:          stdin,
+		TimeoutSeconds: timeoutSeconds,
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		// we give the context a bit more time as the timeout should kick
+		// on the actual execution
+		time.Duration(timeoutSeconds)*time.Second+shortTimeout,
+	)
+	defer cancel()
+
+	resp, err := c.v1client.GuestExec(ctx, request, grpc.MaxCallRecvMsgSize(guestExecMaxResponseSize))
+	var response *cmdv1.Response
+	if resp != nil {
+		response = resp.Response
+	}
+	if err = handleError(err, "GuestExec", response); err != nil {
+		return nil, err
+	}
+
+	return &v1.GuestExecResult{
+		ExitCode:        resp.ExitCode,
+		Stdout:          resp.StdOut,
+		Stderr:          resp.StdErr,
+		StdoutTruncated: resp.StdOutTruncated,
+		StderrTruncated: resp.StdErrTruncated,
+	}, nil
+}
+
+func (c *VirtLauncherClient) guestFileCall(cmdName string, call func(context.Context, *cmdv1.GuestFileRequest, ...grpc.CallOption) (*cmdv1.GuestFileResponse, error), request *cmdv1.GuestFileRequest) (*cmdv1.GuestFileResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	resp, err := call(ctx, request)
+	var response *cmdv1.Response
+	if resp != nil {
+		response = resp.Response
+	}
+	if err = handleError(err, cmdName, response); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// GuestFileOpen opens the file at path in the guest with the fopen mode, and returns its handle
+func (c *VirtLauncherClient) GuestFileOpen(domainName string, path string, mode string) (int64, error) {
+	resp, err := c.guestFileCall("GuestFileOpen", c.v1client.GuestFileOpen, &cmdv1.GuestFileRequest{
+		DomainName: domainName,
+		Path:       path,
+		Mode:       mode,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return resp.Handle, nil
+}
+
+// GuestFileRead reads up to count bytes from the file handle, and reports whether the end of the file was reached
+func (c *VirtLauncherClient) GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error) {
+	resp, err := c.guestFileCall("GuestFileRead", c.v1client.GuestFileRead, &cmdv1.GuestFileRequest{
+		DomainName: domainName,
+		Handle:     handle,
+		Count:      count,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return resp.Data, resp.Eof, nil
+}
+
+func (c *VirtLauncherClient) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	_, err := c.guestFileCall("GuestFileWrite", c.v1client.GuestFileWrite, &cmdv1.GuestFileRequest{
+		DomainName: domainName,
+		Handle:     handle,
+		Data:       data,
+	})
+	return err
+}
+
+func (c *VirtLauncherClient) GuestFileClose(domainName string, handle int64) error {
+	_, err := c.guestFileCall("GuestFileClose", c.v1client.GuestFileClose, &cmdv1.GuestFileRequest{
+		DomainName: domainName,
+		Handle:     handle,
+	})
+	return err
+}
+
+func (c *VirtLauncherClient) sendSSHKeyCmd(cmdName string, call func(context.Context, *cmdv1.SSHKeyRequest, ...grpc.CallOption) (*cmdv1.Response, error), vmi *v1.VirtualMachineInstance, request *cmdv1.SSHKeyRequest) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+	request.Vmi = &cmdv1.VMI{
+		VmiJson: vmiJson,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+	response, err := call(ctx, request)
+
+	return handleError(err, cmdName, response)
+}
+
+// AddSSHKey authorizes the public key for the user in the guest for ttlSeconds
+func (c *VirtLauncherClient) AddSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string, ttlSeconds int32) error {
+	return c.sendSSHKeyCmd("AddSSHKey", c.v1client.AddSSHKey, vmi, &cmdv1.SSHKeyRequest{
+		User:       user,
+		PublicKey:  publicKey,
+		TtlSeconds: ttlSeconds,
+	})
+}
+
+// RemoveSSHKey removes a public key authorized for the user by AddSSHKey
+func (c *VirtLauncherClient) RemoveSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string) error {
+	return c.sendSSHKeyCmd("RemoveSSHKey", c.v1client.RemoveSSHKey, vmi, &cmdv1.SSHKeyRequest{
+		User:      user,
+		PublicKey: publicKey,
+	})
+}
+
 func (c *VirtLauncherClient) GuestPing(domainName string, timeoutSeconds int32) error {
 	request := &cmdv1.GuestPingRequest{
 		DomainName:     domainName,
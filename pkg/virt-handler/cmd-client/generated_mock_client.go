@@ -252,6 +252,80 @@ func (_mr *_MockLauncherClientRecorder) Exec(arg0, arg1, arg2, arg3 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Exec", arg0, arg1, arg2, arg3)
 }
 
+func (_m *MockLauncherClient) GuestExec(domainName string, command string, args []string, stdin []byte, timeoutSeconds int32) (*v1.GuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", domainName, command, args, stdin, timeoutSeconds)
+	ret0, _ := ret[0].(*v1.GuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestExec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2, arg3, arg4)
+}
+
+func (_m *MockLauncherClient) GuestFileOpen(domainName string, path string, mode string) (int64, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileOpen", domainName, path, mode)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileOpen(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileOpen", arg0, arg1, arg2)
+}
+
+func (_m *MockLauncherClient) GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", domainName, handle, count)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileRead(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2)
+}
+
+func (_m *MockLauncherClient) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", domainName, handle, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileWrite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2)
+}
+
+func (_m *MockLauncherClient) GuestFileClose(domainName string, handle int64) error {
+	ret := _m.ctrl.Call(_m, "GuestFileClose", domainName, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileClose(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileClose", arg0, arg1)
+}
+
+func (_m *MockLauncherClient) AddSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string, ttlSeconds int32) error {
+	ret := _m.ctrl.Call(_m, "AddSSHKey", vmi, user, publicKey, ttlSeconds)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) AddSSHKey(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddSSHKey", arg0, arg1, arg2, arg3)
+}
+
+func (_m *MockLauncherClient) RemoveSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string) error {
+	ret := _m.ctrl.Call(_m, "RemoveSSHKey", vmi, user, publicKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) RemoveSSHKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveSSHKey", arg0, arg1, arg2)
+}
+
 func (_m *MockLauncherClient) Ping() error {
 	ret := _m.ctrl.Call(_m, "Ping")
 	ret0, _ := ret[0].(error)
@@ -0,0 +1,11 @@
+package consolesession_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestConsoleSession(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,184 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package consolesession
+
+import (
+	"fmt"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+// Console identifies a console of a VMI
+type Console struct {
+	Type v1.VirtualMachineInstanceConsoleType
+	// SerialPort is the name of an additional serial port, empty for the serial console
+	SerialPort string
+}
+
+// Client is a connection to a console
+type Client struct {
+	session  v1.VirtualMachineInstanceConsoleSession
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+// ReadOnly returns true if the input of the client must not be sent to the console
+func (c *Client) ReadOnly() bool {
+	return c.session.ReadOnly
+}
+
+// Stopped is closed once the client was disconnected by another client taking the console over
+func (c *Client) Stopped() <-chan struct{} {
+	return c.stop
+}
+
+func (c *Client) disconnect() {
+	c.stopOnce.Do(func() {
+		close(c.stop)
+	})
+}
+
+func (c *Client) console() Console {
+	return Console{Type: c.session.Console, SerialPort: c.session.SerialPort}
+}
+
+// HeldError is returned when connecting to a console already held by another client
+type HeldError struct {
+	User string
+}
+
+func (e *HeldError) Error() string {
+	return fmt.Sprintf("the console is held by %q, connect read-only to observe it or without exclusive access to take it over", e.User)
+}
+
+// Manager tracks the clients connected to the consoles of the VMIs. Each console is held by at most one
+// client which isn't read-only, the others observe it.
+type Manager struct {
+	lock     sync.Mutex
+	clients  map[types.UID][]*Client
+	onChange func(vmi *v1.VirtualMachineInstance)
+}
+
+func NewManager() *Manager {
+	return &Manager{
+		clients: map[types.UID][]*Client{},
+	}
+}
+
+// OnChange sets a function called after a client connected to or disconnected from a console of the VMI
+func (m *Manager) OnChange(onChange func(vmi *v1.VirtualMachineInstance)) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.onChange = onChange
+}
+
+// Connect registers a new client of the console. A client which isn't read-only takes over a console held by
+// another client, and disconnects it, unless it asks for exclusive access, which fails if the console is held.
+func (m *Manager) Connect(vmi *v1.VirtualMachineInstance, console Console, user string, readOnly bool, exclusive bool) (*Client, error) {
+	client := &Client{
+		session: v1.VirtualMachineInstanceConsoleSession{
+			Console:            console.Type,
+			SerialPort:         console.SerialPort,
+			User:               user,
+			ReadOnly:           readOnly,
+			ConnectedTimestamp: metav1.Now(),
+		},
+		stop: make(chan struct{}),
+	}
+
+	onChange, err := func() (func(vmi *v1.VirtualMachineInstance), error) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+
+		if !readOnly {
+			if holder := m.holder(vmi.UID, console); holder != nil {
+				if exclusive {
+					return nil, &HeldError{User: holder.session.User}
+				}
+				holder.disconnect()
+				m.remove(vmi.UID, holder)
+			}
+		}
+		m.clients[vmi.UID] = append(m.clients[vmi.UID], client)
+		return m.onChange, nil
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	if onChange != nil {
+		onChange(vmi)
+	}
+	return client, nil
+}
+
+// Disconnect unregisters the client, once its connection is closed
+func (m *Manager) Disconnect(vmi *v1.VirtualMachineInstance, client *Client) {
+	onChange, removed := func() (func(vmi *v1.VirtualMachineInstance), bool) {
+		m.lock.Lock()
+		defer m.lock.Unlock()
+		return m.onChange, m.remove(vmi.UID, client)
+	}()
+
+	if removed && onChange != nil {
+		onChange(vmi)
+	}
+}
+
+// Sessions returns the clients connected to the consoles of the VMI, in the order they connected
+func (m *Manager) Sessions(uid types.UID) []v1.VirtualMachineInstanceConsoleSession {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	var sessions []v1.VirtualMachineInstanceConsoleSession
+	for _, client := range m.clients[uid] {
+		sessions = append(sessions, client.session)
+	}
+	return sessions
+}
+
+func (m *Manager) holder(uid types.UID, console Console) *Client {
+	for _, client := range m.clients[uid] {
+		if !client.session.ReadOnly && client.console() == console {
+			return client
+		}
+	}
+	return nil
+}
+
+func (m *Manager) remove(uid types.UID, client *Client) bool {
+	clients := m.clients[uid]
+	for i := range clients {
+		if clients[i] == client {
+			clients = append(clients[:i:i], clients[i+1:]...)
+			if len(clients) == 0 {
+				delete(m.clients, uid)
+			} else {
+				m.clients[uid] = clients
+			}
+			return true
+		}
+	}
+	return false
+}
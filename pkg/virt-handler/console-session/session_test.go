@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package consolesession_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	consolesession "kubevirt.io/kubevirt/pkg/virt-handler/console-session"
+)
+
+var _ = Describe("Console sessions", func() {
+	var (
+		manager *consolesession.Manager
+		vmi     *v1.VirtualMachineInstance
+		changes int
+	)
+
+	serial := consolesession.Console{Type: v1.SerialConsoleType}
+	vnc := consolesession.Console{Type: v1.VNCConsoleType}
+
+	BeforeEach(func() {
+		manager = consolesession.NewManager()
+		vmi = &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", UID: "1234"}}
+		changes = 0
+		manager.OnChange(func(changed *v1.VirtualMachineInstance) {
+			Expect(changed).To(Equal(vmi))
+			changes++
+		})
+	})
+
+	users := func() []string {
+		var users []string
+		for _, session := range manager.Sessions(vmi.UID) {
+			users = append(users, session.User)
+		}
+		return users
+	}
+
+	It("should let one client hold a console and others observe it", func() {
+		holder, err := manager.Connect(vmi, serial, "alice", false, false)
+		Expect(err).ToNot(HaveOccurred())
+		observer, err := manager.Connect(vmi, serial, "bob", true, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(observer.ReadOnly()).To(BeTrue())
+
+		_, err = manager.Connect(vmi, serial, "carol", false, true)
+		Expect(err).To(MatchError(&consolesession.HeldError{User: "alice"}))
+
+		Expect(manager.Sessions(vmi.UID)).To(ConsistOf(
+			MatchFields(IgnoreExtras, Fields{"Console": Equal(v1.SerialConsoleType), "User": Equal("alice"), "ReadOnly": BeFalse()}),
+			MatchFields(IgnoreExtras, Fields{"Console": Equal(v1.SerialConsoleType), "User": Equal("bob"), "ReadOnly": BeTrue()}),
+		))
+		Expect(holder.Stopped()).ToNot(BeClosed())
+		Expect(changes).To(Equal(2))
+	})
+
+	It("should disconnect the holder when another client takes the console over", func() {
+		holder, err := manager.Connect(vmi, serial, "alice", false, false)
+		Expect(err).ToNot(HaveOccurred())
+
+		newHolder, err := manager.Connect(vmi, serial, "bob", false, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(holder.Stopped()).To(BeClosed())
+		Expect(users()).To(Equal([]string{"bob"}))
+
+		// The connection of the previous holder is closed afterwards
+		manager.Disconnect(vmi, holder)
+		Expect(users()).To(Equal([]string{"bob"}))
+		Expect(changes).To(Equal(2))
+
+		manager.Disconnect(vmi, newHolder)
+		Expect(manager.Sessions(vmi.UID)).To(BeEmpty())
+		Expect(changes).To(Equal(3))
+	})
+
+	It("should hold each console separately", func() {
+		debug := consolesession.Console{Type: v1.SerialConsoleType, SerialPort: "debug"}
+		for _, console := range []consolesession.Console{serial, debug, vnc} {
+			_, err := manager.Connect(vmi, console, "alice", false, false)
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(manager.Sessions(vmi.UID)).To(HaveLen(3))
+
+		other := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "other", UID: "5678"}}
+		manager.OnChange(nil)
+		_, err := manager.Connect(other, serial, "bob", false, false)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(manager.Sessions(other.UID)).To(HaveLen(1))
+	})
+})
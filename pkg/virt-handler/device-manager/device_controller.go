@@ -236,6 +236,18 @@ func (c *DeviceController) updatePermittedHostDevicePlugins() []Device {
 			permittedDevices = append(permittedDevices, NewMediatedDevicePlugin(mdevUUIDs, mdevResourceName))
 		}
 	}
+	if len(hostDevs.USBHostDevices) != 0 {
+		for _, usbDev := range hostDevs.USBHostDevices {
+			log.Log.V(4).Infof("Permitted USB device in the cluster, selectors: %v, resourceName: %s, externalProvider: %t",
+				usbDev.Selectors,
+				usbDev.ResourceName,
+				usbDev.ExternalResourceProvider)
+		}
+		for usbResourceName, usbDevices := range discoverPermittedHostUSBDevices(hostDevs.USBHostDevices) {
+			log.Log.V(4).Infof("Discovered %d USB devices on the node for the resource: %s", len(usbDevices), usbResourceName)
+			permittedDevices = append(permittedDevices, NewUSBDevicePlugin(usbDevices, usbResourceName))
+		}
+	}
 	return permittedDevices
 }
 
@@ -0,0 +1,502 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package device_manager
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+const usbDevicePath = "/dev/bus/usb"
+
+var usbBasePath = "/sys/bus/usb/devices"
+
+type USBDevice struct {
+	vendor  string
+	product string
+	serial  string
+	busPath string
+	bus     int
+	device  int
+}
+
+// DevicePath returns the character device of the USB device, e.g. /dev/bus/usb/001/003
+func (d *USBDevice) DevicePath() string {
+	return filepath.Join(usbDevicePath, fmt.Sprintf("%03d", d.bus), fmt.Sprintf("%03d", d.device))
+}
+
+// Address returns the bus:device pair libvirt identifies the USB device with
+func (d *USBDevice) Address() string {
+	return fmt.Sprintf("%d:%d", d.bus, d.device)
+}
+
+// isSameDevice tells if other is the same physical device, its vendor, product and serial
+// don't change when it gets replugged
+func (d *USBDevice) isSameDevice(other *USBDevice) bool {
+	return d.vendor == other.vendor && d.product == other.product && d.serial == other.serial
+}
+
+type USBDevicePlugin struct {
+	devs         []*pluginapi.Device
+	server       *grpc.Server
+	socketPath   string
+	stop         <-chan struct{}
+	health       chan deviceHealth
+	resourceName string
+	done         chan struct{}
+	deviceRoot   string
+	usbDevices   map[string]*USBDevice
+	initialized  bool
+	lock         *sync.Mutex
+	deregistered chan struct{}
+}
+
+func NewUSBDevicePlugin(usbDevices []*USBDevice, resourceName string) *USBDevicePlugin {
+	serverSock := SocketPath(strings.Replace(resourceName, "/", "-", -1))
+	usbDevicesByID := make(map[string]*USBDevice)
+
+	devs := constructUSBDPIdevices(usbDevices, usbDevicesByID)
+	dpi := &USBDevicePlugin{
+		devs:         devs,
+		socketPath:   serverSock,
+		resourceName: resourceName,
+		deviceRoot:   util.HostRootMount,
+		usbDevices:   usbDevicesByID,
+		health:       make(chan deviceHealth),
+		initialized:  false,
+		lock:         &sync.Mutex{},
+	}
+	return dpi
+}
+
+// constructUSBDPIdevices identifies the USB devices by the port they are plugged into,
+// since their device number changes every time they get plugged in
+func constructUSBDPIdevices(usbDevices []*USBDevice, usbDevicesByID map[string]*USBDevice) (devs []*pluginapi.Device) {
+	for _, usbDevice := range usbDevices {
+		usbDevicesByID[usbDevice.busPath] = usbDevice
+		devs = append(devs, &pluginapi.Device{
+			ID:     usbDevice.busPath,
+			Health: pluginapi.Healthy,
+		})
+	}
+	return
+}
+
+// Start starts the device plugin
+func (dpi *USBDevicePlugin) Start(stop <-chan struct{}) (err error) {
+	logger := log.DefaultLogger()
+	dpi.stop = stop
+	dpi.done = make(chan struct{})
+	dpi.deregistered = make(chan struct{})
+
+	err = dpi.cleanup()
+	if err != nil {
+		return err
+	}
+
+	sock, err := net.Listen("unix", dpi.socketPath)
+	if err != nil {
+		return fmt.Errorf("error creating GRPC server socket: %v", err)
+	}
+
+	dpi.server = grpc.NewServer([]grpc.ServerOption{}...)
+	defer dpi.stopDevicePlugin()
+
+	pluginapi.RegisterDevicePluginServer(dpi.server, dpi)
+
+	errChan := make(chan error, 2)
+
+	go func() {
+		errChan <- dpi.server.Serve(sock)
+	}()
+
+	err = waitForGRPCServer(dpi.socketPath, connectionTimeout)
+	if err != nil {
+		return fmt.Errorf("error starting the GRPC server: %v", err)
+	}
+
+	err = dpi.register()
+	if err != nil {
+		return fmt.Errorf("error registering with device plugin manager: %v", err)
+	}
+
+	go func() {
+		errChan <- dpi.healthCheck()
+	}()
+
+	dpi.setInitialized(true)
+	logger.Infof("%s device plugin started", dpi.resourceName)
+	err = <-errChan
+
+	return err
+}
+
+func (dpi *USBDevicePlugin) ListAndWatch(_ *pluginapi.Empty, s pluginapi.DevicePlugin_ListAndWatchServer) error {
+	s.Send(&pluginapi.ListAndWatchResponse{Devices: dpi.devs})
+
+	done := false
+	for {
+		select {
+		case devHealth := <-dpi.health:
+			for _, dev := range dpi.devs {
+				if devHealth.DevId == dev.ID {
+					dev.Health = devHealth.Health
+				}
+			}
+			s.Send(&pluginapi.ListAndWatchResponse{Devices: dpi.devs})
+		case <-dpi.stop:
+			done = true
+		case <-dpi.done:
+			done = true
+		}
+		if done {
+			break
+		}
+	}
+	// Send empty list to increase the chance that the kubelet acts fast on stopped device plugins
+	// There exists no explicit way to deregister devices
+	emptyList := []*pluginapi.Device{}
+	if err := s.Send(&pluginapi.ListAndWatchResponse{Devices: emptyList}); err != nil {
+		log.DefaultLogger().Reason(err).Infof("%s device plugin failed to deregister", dpi.resourceName)
+	}
+	close(dpi.deregistered)
+	return nil
+}
+
+func (dpi *USBDevicePlugin) Allocate(_ context.Context, r *pluginapi.AllocateRequest) (*pluginapi.AllocateResponse, error) {
+	resourceNameEnvVar := util.ResourceNameToEnvVar(v1.USBResourcePrefix, dpi.resourceName)
+	resp := new(pluginapi.AllocateResponse)
+
+	for _, request := range r.ContainerRequests {
+		allocatedDevices := []string{}
+		deviceSpecs := make([]*pluginapi.DeviceSpec, 0)
+		for _, devID := range request.DevicesIDs {
+			discoveredDevice, exist := dpi.usbDevices[devID]
+			if !exist {
+				continue
+			}
+			// The device number found on discovery is stale if the device was replugged since
+			usbDevice, err := lookupUSBDevice(discoveredDevice)
+			if err != nil {
+				return nil, fmt.Errorf("failed to allocate the USB device %s: %v", devID, err)
+			}
+			allocatedDevices = append(allocatedDevices, usbDevice.Address())
+			deviceSpecs = append(deviceSpecs, &pluginapi.DeviceSpec{
+				HostPath:      usbDevice.DevicePath(),
+				ContainerPath: usbDevice.DevicePath(),
+				Permissions:   "mrw",
+			})
+		}
+		containerResponse := &pluginapi.ContainerAllocateResponse{
+			Devices: deviceSpecs,
+			Envs:    map[string]string{resourceNameEnvVar: strings.Join(allocatedDevices, ",")},
+		}
+		resp.ContainerResponses = append(resp.ContainerResponses, containerResponse)
+	}
+	return resp, nil
+}
+
+func (dpi *USBDevicePlugin) healthCheck() error {
+	logger := log.DefaultLogger()
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("failed to creating a fsnotify watcher: %v", err)
+	}
+	defer watcher.Close()
+
+	// Watch every bus directory to get notified when the devices get unplugged or replugged,
+	// since their device number, and their bus if they are moved to another port, change.
+	// This way we don't have to mount /dev from the node
+	usbDeviceRoot := filepath.Join(dpi.deviceRoot, usbDevicePath)
+	busDirs, err := filepath.Glob(filepath.Join(usbDeviceRoot, "*"))
+	if err != nil {
+		return fmt.Errorf("failed to list the USB buses: %v", err)
+	}
+	for _, busDir := range busDirs {
+		err = watcher.Add(busDir)
+		if err != nil {
+			return fmt.Errorf("failed to add the USB bus %s to the watcher: %v", busDir, err)
+		}
+	}
+
+	dirName := filepath.Dir(dpi.socketPath)
+	err = watcher.Add(dirName)
+
+	if err != nil {
+		return fmt.Errorf("failed to add the device-plugin kubelet path to the watcher: %v", err)
+	}
+	_, err = os.Stat(dpi.socketPath)
+	if err != nil {
+		return fmt.Errorf("failed to stat the device-plugin socket: %v", err)
+	}
+
+	healthByID := make(map[string]string)
+	for id := range dpi.usbDevices {
+		healthByID[id] = pluginapi.Healthy
+	}
+
+	for {
+		select {
+		case <-dpi.stop:
+			return nil
+		case err := <-watcher.Errors:
+			logger.Reason(err).Errorf("error watching devices and device plugin directory")
+		case event := <-watcher.Events:
+			logger.V(4).Infof("health Event: %v", event)
+			if filepath.Dir(filepath.Dir(event.Name)) == usbDeviceRoot {
+				if event.Op&(fsnotify.Create|fsnotify.Remove|fsnotify.Rename) == 0 {
+					continue
+				}
+				// Look the devices up again, any of them may have been plugged into a new device node
+				for id, usbDevice := range dpi.usbDevices {
+					health := dpi.usbDeviceHealth(usbDevice)
+					if health == healthByID[id] {
+						continue
+					}
+					healthByID[id] = health
+					if health == pluginapi.Healthy {
+						logger.Infof("monitored device %s of %s appeared", id, dpi.resourceName)
+					} else {
+						logger.Infof("monitored device %s of %s disappeared", id, dpi.resourceName)
+					}
+					dpi.health <- deviceHealth{
+						DevId:  id,
+						Health: health,
+					}
+				}
+			} else if event.Name == dpi.socketPath && event.Op == fsnotify.Remove {
+				logger.Infof("device socket file for device %s was removed, kubelet probably restarted.", dpi.resourceName)
+				return nil
+			}
+		}
+	}
+}
+
+// usbDeviceHealth tells if the discovered USB device is still plugged in and has a device node
+func (dpi *USBDevicePlugin) usbDeviceHealth(discoveredDevice *USBDevice) string {
+	usbDevice, err := lookupUSBDevice(discoveredDevice)
+	if err != nil {
+		return pluginapi.Unhealthy
+	}
+	if _, err := os.Stat(filepath.Join(dpi.deviceRoot, usbDevice.DevicePath())); err != nil {
+		return pluginapi.Unhealthy
+	}
+	return pluginapi.Healthy
+}
+
+func (dpi *USBDevicePlugin) GetDevicePath() string {
+	return usbDevicePath
+}
+
+func (dpi *USBDevicePlugin) GetDeviceName() string {
+	return dpi.resourceName
+}
+
+// Stop stops the gRPC server
+func (dpi *USBDevicePlugin) stopDevicePlugin() error {
+	defer func() {
+		if !IsChanClosed(dpi.done) {
+			close(dpi.done)
+		}
+	}()
+
+	// Give the device plugin one second to properly deregister
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
+	select {
+	case <-dpi.deregistered:
+	case <-ticker.C:
+	}
+
+	dpi.server.Stop()
+	dpi.setInitialized(false)
+	return dpi.cleanup()
+}
+
+// Register registers the device plugin for the given resourceName with Kubelet.
+func (dpi *USBDevicePlugin) register() error {
+	conn, err := gRPCConnect(pluginapi.KubeletSocket, connectionTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := pluginapi.NewRegistrationClient(conn)
+	reqt := &pluginapi.RegisterRequest{
+		Version:      pluginapi.Version,
+		Endpoint:     path.Base(dpi.socketPath),
+		ResourceName: dpi.resourceName,
+	}
+
+	_, err = client.Register(context.Background(), reqt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (dpi *USBDevicePlugin) cleanup() error {
+	if err := os.Remove(dpi.socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (dpi *USBDevicePlugin) GetDevicePluginOptions(_ context.Context, _ *pluginapi.Empty) (*pluginapi.DevicePluginOptions, error) {
+	options := &pluginapi.DevicePluginOptions{
+		PreStartRequired: false,
+	}
+	return options, nil
+}
+
+func (dpi *USBDevicePlugin) PreStartContainer(_ context.Context, _ *pluginapi.PreStartContainerRequest) (*pluginapi.PreStartContainerResponse, error) {
+	res := &pluginapi.PreStartContainerResponse{}
+	return res, nil
+}
+
+func (dpi *USBDevicePlugin) GetInitialized() bool {
+	dpi.lock.Lock()
+	defer dpi.lock.Unlock()
+	return dpi.initialized
+}
+
+func (dpi *USBDevicePlugin) setInitialized(initialized bool) {
+	dpi.lock.Lock()
+	dpi.initialized = initialized
+	dpi.lock.Unlock()
+}
+
+// discoverPermittedHostUSBDevices returns the USB devices of the node matching the permitted
+// USB host devices, by resource name. A device is exposed under the first resource it matches.
+func discoverPermittedHostUSBDevices(usbHostDevices []v1.USBHostDevice) map[string][]*USBDevice {
+	usbDevicesMap := make(map[string][]*USBDevice)
+	entries, err := os.ReadDir(usbBasePath)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("failed to discover USB devices")
+		return usbDevicesMap
+	}
+
+	for _, entry := range entries {
+		// USB interfaces are listed next to the devices, but lack the device attributes
+		usbDevice, err := readUSBDevice(usbBasePath, entry.Name())
+		if err != nil {
+			continue
+		}
+		for _, usbHostDevice := range usbHostDevices {
+			if matchesUSBSelectors(usbDevice, usbHostDevice.Selectors) {
+				// do not add the device if it's being provided via an external device plugin
+				if !usbHostDevice.ExternalResourceProvider {
+					usbDevicesMap[usbHostDevice.ResourceName] = append(usbDevicesMap[usbHostDevice.ResourceName], usbDevice)
+				}
+				break
+			}
+		}
+	}
+	return usbDevicesMap
+}
+
+func matchesUSBSelectors(usbDevice *USBDevice, selectors []v1.USBSelector) bool {
+	for _, selector := range selectors {
+		if strings.ToLower(selector.Vendor) == usbDevice.vendor &&
+			strings.ToLower(selector.Product) == usbDevice.product &&
+			(selector.BusPath == "" || selector.BusPath == usbDevice.busPath) {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupUSBDevice reads the current bus and device numbers of a discovered USB device, which change every time
+// it gets replugged. A device with a serial number is also found after it was moved to another port.
+func lookupUSBDevice(discoveredDevice *USBDevice) (*USBDevice, error) {
+	usbDevice, err := readUSBDevice(usbBasePath, discoveredDevice.busPath)
+	if err == nil && usbDevice.isSameDevice(discoveredDevice) {
+		return usbDevice, nil
+	}
+	if discoveredDevice.serial != "" {
+		entries, err := os.ReadDir(usbBasePath)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			usbDevice, err := readUSBDevice(usbBasePath, entry.Name())
+			if err == nil && usbDevice.isSameDevice(discoveredDevice) {
+				return usbDevice, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("the USB device %s:%s is no longer plugged into %s", discoveredDevice.vendor, discoveredDevice.product, discoveredDevice.busPath)
+}
+
+func readUSBDevice(basepath string, busPath string) (*USBDevice, error) {
+	usbDevice := &USBDevice{busPath: busPath}
+	var err error
+	if usbDevice.vendor, err = readUSBDeviceAttribute(basepath, busPath, "idVendor"); err != nil {
+		return nil, err
+	}
+	if usbDevice.product, err = readUSBDeviceAttribute(basepath, busPath, "idProduct"); err != nil {
+		return nil, err
+	}
+	if usbDevice.bus, err = readUSBDeviceNumber(basepath, busPath, "busnum"); err != nil {
+		return nil, err
+	}
+	if usbDevice.device, err = readUSBDeviceNumber(basepath, busPath, "devnum"); err != nil {
+		return nil, err
+	}
+	// Not every device has a serial number
+	usbDevice.serial, _ = readUSBDeviceAttribute(basepath, busPath, "serial")
+	return usbDevice, nil
+}
+
+func readUSBDeviceAttribute(basepath string, busPath string, attribute string) (string, error) {
+	// #nosec No risk for path injection. Reading static path of USB data
+	value, err := os.ReadFile(filepath.Join(basepath, busPath, attribute))
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(strings.TrimSpace(string(value))), nil
+}
+
+func readUSBDeviceNumber(basepath string, busPath string, attribute string) (int, error) {
+	value, err := readUSBDeviceAttribute(basepath, busPath, attribute)
+	if err != nil {
+		return 0, err
+	}
+	return strconv.Atoi(value)
+}
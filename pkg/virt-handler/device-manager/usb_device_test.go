@@ -0,0 +1,176 @@
+package device_manager
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/util"
+	pluginapi "kubevirt.io/kubevirt/pkg/virt-handler/device-manager/deviceplugin/v1beta1"
+)
+
+const (
+	fakeUSBName    = "example.org/dongle"
+	fakeUSBVendor  = "0529"
+	fakeUSBProduct = "0001"
+)
+
+var _ = Describe("USB Device", func() {
+	var originalUSBBasePath string
+
+	addFakeUSBDevice := func(busPath, vendor, product, busnum, devnum string) {
+		deviceDir := filepath.Join(usbBasePath, busPath)
+		Expect(os.MkdirAll(deviceDir, 0755)).To(Succeed())
+		for attribute, value := range map[string]string{
+			"idVendor":  vendor,
+			"idProduct": product,
+			"busnum":    busnum,
+			"devnum":    devnum,
+		} {
+			Expect(os.WriteFile(filepath.Join(deviceDir, attribute), []byte(value+"\n"), 0644)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		originalUSBBasePath = usbBasePath
+		usbBasePath = GinkgoT().TempDir()
+
+		addFakeUSBDevice("1-2", fakeUSBVendor, fakeUSBProduct, "1", "3")
+		addFakeUSBDevice("1-3.1", fakeUSBVendor, fakeUSBProduct, "1", "7")
+		addFakeUSBDevice("2-1", "0403", "6001", "2", "2")
+		// USB interfaces have no device attributes
+		Expect(os.MkdirAll(filepath.Join(usbBasePath, "1-2:1.0"), 0755)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		usbBasePath = originalUSBBasePath
+	})
+
+	It("should discover the USB devices matching the vendor and product IDs", func() {
+		devices := discoverPermittedHostUSBDevices([]v1.USBHostDevice{{
+			ResourceName: fakeUSBName,
+			Selectors:    []v1.USBSelector{{Vendor: fakeUSBVendor, Product: fakeUSBProduct}},
+		}})
+		Expect(devices).To(HaveLen(1))
+		Expect(devices[fakeUSBName]).To(ConsistOf(
+			&USBDevice{vendor: fakeUSBVendor, product: fakeUSBProduct, busPath: "1-2", bus: 1, device: 3},
+			&USBDevice{vendor: fakeUSBVendor, product: fakeUSBProduct, busPath: "1-3.1", bus: 1, device: 7},
+		))
+	})
+
+	It("should only discover the USB devices plugged into the selected port", func() {
+		devices := discoverPermittedHostUSBDevices([]v1.USBHostDevice{{
+			ResourceName: fakeUSBName,
+			Selectors:    []v1.USBSelector{{Vendor: fakeUSBVendor, Product: fakeUSBProduct, BusPath: "1-3.1"}},
+		}})
+		Expect(devices[fakeUSBName]).To(ConsistOf(
+			&USBDevice{vendor: fakeUSBVendor, product: fakeUSBProduct, busPath: "1-3.1", bus: 1, device: 7},
+		))
+	})
+
+	It("should not discover the USB devices provided by an external device plugin", func() {
+		devices := discoverPermittedHostUSBDevices([]v1.USBHostDevice{{
+			ResourceName:             fakeUSBName,
+			Selectors:                []v1.USBSelector{{Vendor: fakeUSBVendor, Product: fakeUSBProduct}},
+			ExternalResourceProvider: true,
+		}})
+		Expect(devices).To(BeEmpty())
+	})
+
+	It("should allocate the USB devices by the port they are plugged into", func() {
+		devices := discoverPermittedHostUSBDevices([]v1.USBHostDevice{{
+			ResourceName: fakeUSBName,
+			Selectors:    []v1.USBSelector{{Vendor: fakeUSBVendor, Product: fakeUSBProduct}},
+		}})
+		dpi := NewUSBDevicePlugin(devices[fakeUSBName], fakeUSBName)
+		Expect(dpi.devs).To(HaveLen(2))
+
+		resp, err := dpi.Allocate(nil, &pluginapi.AllocateRequest{
+			ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{"1-3.1"}}},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.ContainerResponses).To(HaveLen(1))
+		Expect(resp.ContainerResponses[0].Envs).To(HaveKeyWithValue(util.ResourceNameToEnvVar(v1.USBResourcePrefix, fakeUSBName), "1:7"))
+		Expect(resp.ContainerResponses[0].Devices).To(ConsistOf(&pluginapi.DeviceSpec{
+			HostPath:      "/dev/bus/usb/001/007",
+			ContainerPath: "/dev/bus/usb/001/007",
+			Permissions:   "mrw",
+		}))
+	})
+
+	Context("with a discovered USB device", func() {
+		var dpi *USBDevicePlugin
+
+		allocate := func() (*pluginapi.AllocateResponse, error) {
+			return dpi.Allocate(nil, &pluginapi.AllocateRequest{
+				ContainerRequests: []*pluginapi.ContainerAllocateRequest{{DevicesIDs: []string{"1-2"}}},
+			})
+		}
+
+		addDeviceNode := func(devicePath string) {
+			Expect(os.MkdirAll(filepath.Dir(filepath.Join(dpi.deviceRoot, devicePath)), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(dpi.deviceRoot, devicePath), nil, 0644)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			Expect(os.WriteFile(filepath.Join(usbBasePath, "1-2", "serial"), []byte("A1B2\n"), 0644)).To(Succeed())
+			devices := discoverPermittedHostUSBDevices([]v1.USBHostDevice{{
+				ResourceName: fakeUSBName,
+				Selectors:    []v1.USBSelector{{Vendor: fakeUSBVendor, Product: fakeUSBProduct, BusPath: "1-2"}},
+			}})
+			dpi = NewUSBDevicePlugin(devices[fakeUSBName], fakeUSBName)
+			dpi.deviceRoot = GinkgoT().TempDir()
+		})
+
+		It("should allocate the current device node after the device was replugged", func() {
+			addFakeUSBDevice("1-2", fakeUSBVendor, fakeUSBProduct, "1", "12")
+
+			resp, err := allocate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.ContainerResponses[0].Envs).To(HaveKeyWithValue(util.ResourceNameToEnvVar(v1.USBResourcePrefix, fakeUSBName), "1:12"))
+			Expect(resp.ContainerResponses[0].Devices).To(ConsistOf(&pluginapi.DeviceSpec{
+				HostPath:      "/dev/bus/usb/001/012",
+				ContainerPath: "/dev/bus/usb/001/012",
+				Permissions:   "mrw",
+			}))
+		})
+
+		It("should find the device by its serial number after it was moved to another port", func() {
+			Expect(os.RemoveAll(filepath.Join(usbBasePath, "1-2"))).To(Succeed())
+			addFakeUSBDevice("3-4", fakeUSBVendor, fakeUSBProduct, "3", "2")
+			Expect(os.WriteFile(filepath.Join(usbBasePath, "3-4", "serial"), []byte("A1B2\n"), 0644)).To(Succeed())
+
+			resp, err := allocate()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.ContainerResponses[0].Envs).To(HaveKeyWithValue(util.ResourceNameToEnvVar(v1.USBResourcePrefix, fakeUSBName), "3:2"))
+		})
+
+		It("should fail to allocate the device once another device is plugged into its port", func() {
+			addFakeUSBDevice("1-2", "0403", "6001", "1", "12")
+
+			_, err := allocate()
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should report the device healthy only while its current device node exists", func() {
+			discovered := dpi.usbDevices["1-2"]
+			Expect(dpi.usbDeviceHealth(discovered)).To(Equal(pluginapi.Unhealthy))
+
+			addDeviceNode("/dev/bus/usb/001/003")
+			Expect(dpi.usbDeviceHealth(discovered)).To(Equal(pluginapi.Healthy))
+
+			addFakeUSBDevice("1-2", fakeUSBVendor, fakeUSBProduct, "1", "12")
+			Expect(dpi.usbDeviceHealth(discovered)).To(Equal(pluginapi.Unhealthy))
+
+			addDeviceNode("/dev/bus/usb/001/012")
+			Expect(dpi.usbDeviceHealth(discovered)).To(Equal(pluginapi.Healthy))
+
+			Expect(os.RemoveAll(filepath.Join(usbBasePath, "1-2"))).To(Succeed())
+			Expect(dpi.usbDeviceHealth(discovered)).To(Equal(pluginapi.Unhealthy))
+		})
+	})
+})
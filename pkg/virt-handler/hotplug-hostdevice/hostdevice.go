@@ -0,0 +1,334 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package hotplug_hostdevice
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/devices"
+	"golang.org/x/sys/unix"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+//go:generate mockgen -source $GOFILE -package=$GOPACKAGE -destination=generated_mock_$GOFILE
+
+const (
+	pciBasePath  = "/sys/bus/pci/devices"
+	mdevBasePath = "/sys/bus/mdev/devices"
+
+	vfioDir           = "vfio"
+	vfioControlDevice = "vfio"
+
+	failedToCreateCgroupManagerErrTemplate = "could not create cgroup manager. err: %v"
+)
+
+var (
+	// the VFIO container device, /dev/vfio/vfio, always has the same major and minor numbers
+	vfioControlDeviceNumber = unix.Mkdev(10, 196)
+
+	socketPath = func(podUID types.UID) string {
+		return fmt.Sprintf("pods/%s/volumes/kubernetes.io~empty-dir/hotplug-host-devices/hp.sock", string(podUID))
+	}
+
+	isolationDetector = func(path string) isolation.PodIsolationDetector {
+		return isolation.NewSocketBasedIsolationDetector(path)
+	}
+
+	readEnviron = func(pid int) ([]byte, error) {
+		return os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "environ"))
+	}
+
+	iommuGroup = func(address string) (string, error) {
+		basePath := pciBasePath
+		if !isPCIAddress(address) {
+			basePath = mdevBasePath
+		}
+		iommuPath, err := os.Readlink(filepath.Join(basePath, address, "iommu_group"))
+		if err != nil {
+			return "", err
+		}
+		return filepath.Base(iommuPath), nil
+	}
+
+	vfioGroupDevice = func(group string) (uint64, error) {
+		devicePath, err := safepath.JoinAndResolveWithRelativeRoot("/proc/1/root", "dev", vfioDir, group)
+		if err != nil {
+			return 0, err
+		}
+		fileInfo, err := safepath.StatAtNoFollow(devicePath)
+		if err != nil {
+			return 0, err
+		}
+		if fileInfo.Mode()&os.ModeCharDevice == 0 {
+			return 0, fmt.Errorf("%v is not a character device", devicePath)
+		}
+		return fileInfo.Sys().(*syscall.Stat_t).Rdev, nil
+	}
+
+	mknodCommand = func(basePath *safepath.Path, deviceName string, dev uint64) error {
+		return safepath.MknodAtNoFollow(basePath, deviceName, 0600|syscall.S_IFCHR, dev)
+	}
+
+	getCgroupManager = func(vmi *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+		return cgroup.NewManagerFromVM(vmi)
+	}
+)
+
+// HostDeviceManager hands host devices allocated to attachment pods over to the virt-launcher pod of the VMI.
+type HostDeviceManager interface {
+	// Address returns the PCI address or the mediated device UUID which the device plugin allocated
+	// to the attachment pod of the host device.
+	Address(vmi *v1.VirtualMachineInstance, status *v1.HostDeviceStatus, deviceName string) (string, error)
+	// Expose makes the VFIO group of the host device available in the virt-launcher pod.
+	Expose(vmi *v1.VirtualMachineInstance, launcherRes isolation.IsolationResult, status *v1.HostDeviceStatus) error
+	// Withdraw removes the VFIO group of the host device from the virt-launcher pod, unless other devices
+	// of the same IOMMU group are still assigned to the VMI.
+	Withdraw(vmi *v1.VirtualMachineInstance, launcherRes isolation.IsolationResult, status *v1.HostDeviceStatus) error
+}
+
+type hostDeviceManager struct {
+	ownershipManager diskutils.OwnershipManagerInterface
+}
+
+// NewHostDeviceManager creates a new host device manager.
+func NewHostDeviceManager() HostDeviceManager {
+	return &hostDeviceManager{
+		ownershipManager: diskutils.DefaultOwnershipManager,
+	}
+}
+
+func (m *hostDeviceManager) Address(vmi *v1.VirtualMachineInstance, status *v1.HostDeviceStatus, deviceName string) (string, error) {
+	if status.AttachPodUID == "" {
+		return "", fmt.Errorf("host device %s has no attachment pod", status.Name)
+	}
+	res, err := isolationDetector("/path").DetectForSocket(vmi, socketPath(status.AttachPodUID))
+	if err != nil {
+		return "", err
+	}
+	environ, err := readEnviron(res.Pid())
+	if err != nil {
+		return "", err
+	}
+	for _, prefix := range []string{v1.PCIResourcePrefix, v1.MDevResourcePrefix} {
+		key := util.ResourceNameToEnvVar(prefix, deviceName) + "="
+		for _, variable := range bytes.Split(environ, []byte{0}) {
+			if value := strings.TrimPrefix(string(variable), key); value != string(variable) && value != "" {
+				// a single device is allocated to every attachment pod
+				return strings.Split(value, ",")[0], nil
+			}
+		}
+	}
+	return "", fmt.Errorf("no device of resource %s was allocated to the attachment pod of host device %s", deviceName, status.Name)
+}
+
+func (m *hostDeviceManager) Expose(vmi *v1.VirtualMachineInstance, launcherRes isolation.IsolationResult, status *v1.HostDeviceStatus) error {
+	group, err := iommuGroup(status.Address)
+	if err != nil {
+		return fmt.Errorf("failed to find the IOMMU group of host device %s: %v", status.Name, err)
+	}
+	dev, err := vfioGroupDevice(group)
+	if err != nil {
+		return err
+	}
+
+	vfioPath, err := launcherVFIODir(launcherRes)
+	if err != nil {
+		return err
+	}
+	if err := createCharDeviceFile(vfioPath, vfioControlDevice, vfioControlDeviceNumber); err != nil {
+		return err
+	}
+	if err := createCharDeviceFile(vfioPath, group, dev); err != nil {
+		return err
+	}
+
+	cgroupManager, err := getCgroupManager(vmi)
+	if err != nil {
+		return fmt.Errorf(failedToCreateCgroupManagerErrTemplate, err)
+	}
+	for _, device := range []uint64{vfioControlDeviceNumber, dev} {
+		if err := updateCharMajorMinor(device, true, cgroupManager); err != nil {
+			return err
+		}
+	}
+
+	if util.IsNonRootVMI(vmi) {
+		for _, name := range []string{vfioControlDevice, group} {
+			devicePath, err := safepath.JoinNoFollow(vfioPath, name)
+			if err != nil {
+				return err
+			}
+			if err := m.ownershipManager.SetFileOwnership(devicePath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (m *hostDeviceManager) Withdraw(vmi *v1.VirtualMachineInstance, launcherRes isolation.IsolationResult, status *v1.HostDeviceStatus) error {
+	group, err := iommuGroup(status.Address)
+	if err != nil {
+		return fmt.Errorf("failed to find the IOMMU group of host device %s: %v", status.Name, err)
+	}
+	inUse, err := groupInUse(vmi, launcherRes, status, group)
+	if err != nil {
+		return err
+	}
+	if inUse {
+		log.Log.Object(vmi).V(3).Infof("keeping the VFIO group %s of host device %s, other devices of the group are still assigned", group, status.Name)
+		return nil
+	}
+	vfioPath, err := isolation.SafeJoin(launcherRes, "dev", vfioDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	devicePath, err := safepath.JoinNoFollow(vfioPath, group)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	fileInfo, err := safepath.StatAtNoFollow(devicePath)
+	if err != nil {
+		return err
+	}
+
+	cgroupManager, err := getCgroupManager(vmi)
+	if err != nil {
+		return fmt.Errorf(failedToCreateCgroupManagerErrTemplate, err)
+	}
+	if err := updateCharMajorMinor(fileInfo.Sys().(*syscall.Stat_t).Rdev, false, cgroupManager); err != nil {
+		return err
+	}
+	return safepath.UnlinkAtNoFollow(devicePath)
+}
+
+// groupInUse tells if other host devices of the VMI belong to the IOMMU group, either hotplugged ones which are not
+// detached yet, or the ones the device plugins allocated to the virt-launcher pod.
+func groupInUse(vmi *v1.VirtualMachineInstance, launcherRes isolation.IsolationResult, withdrawn *v1.HostDeviceStatus, group string) (bool, error) {
+	var addresses []string
+	for _, status := range vmi.Status.HostDeviceStatus {
+		if status.Name != withdrawn.Name && status.Address != "" && status.Phase != v1.HostDeviceDetached {
+			addresses = append(addresses, status.Address)
+		}
+	}
+	environ, err := readEnviron(launcherRes.Pid())
+	if err != nil {
+		return false, err
+	}
+	addresses = append(addresses, allocatedAddresses(environ)...)
+
+	for _, address := range addresses {
+		addressGroup, err := iommuGroup(address)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warningf("failed to find the IOMMU group of host device %s", address)
+			continue
+		}
+		if addressGroup == group {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// allocatedAddresses returns the PCI addresses and mediated device UUIDs the device plugins passed to the process
+func allocatedAddresses(environ []byte) []string {
+	var addresses []string
+	for _, variable := range bytes.Split(environ, []byte{0}) {
+		name, value, found := strings.Cut(string(variable), "=")
+		if !found || value == "" {
+			continue
+		}
+		if strings.HasPrefix(name, v1.PCIResourcePrefix+"_") || strings.HasPrefix(name, v1.MDevResourcePrefix+"_") {
+			addresses = append(addresses, strings.Split(value, ",")...)
+		}
+	}
+	return addresses
+}
+
+func launcherVFIODir(launcherRes isolation.IsolationResult) (*safepath.Path, error) {
+	devPath, err := isolation.SafeJoin(launcherRes, "dev")
+	if err != nil {
+		return nil, err
+	}
+	if err := safepath.MkdirAtNoFollow(devPath, vfioDir, 0755); err != nil && !os.IsExist(err) {
+		return nil, err
+	}
+	return safepath.JoinNoFollow(devPath, vfioDir)
+}
+
+func createCharDeviceFile(basePath *safepath.Path, deviceName string, dev uint64) error {
+	if _, err := safepath.JoinNoFollow(basePath, deviceName); errors.Is(err, os.ErrNotExist) {
+		if err := mknodCommand(basePath, deviceName, dev); err != nil && !os.IsExist(err) {
+			return err
+		}
+		log.DefaultLogger().V(1).Infof("successfully created character device %v", deviceName)
+		return nil
+	} else {
+		return err
+	}
+}
+
+func updateCharMajorMinor(dev uint64, allow bool, manager cgroup.Manager) error {
+	deviceRule := &devices.Rule{
+		Type:        devices.CharDevice,
+		Major:       int64(unix.Major(dev)),
+		Minor:       int64(unix.Minor(dev)),
+		Permissions: "rwm",
+		Allow:       allow,
+	}
+
+	err := manager.Set(&configs.Resources{
+		Devices: []*devices.Rule{deviceRule},
+	})
+
+	if err != nil {
+		log.Log.Infof("cgroup %s had failed to set device rule. error: %v. rule: %+v", manager.GetCgroupVersion(), err, *deviceRule)
+	} else {
+		log.Log.Infof("cgroup %s device rule is set successfully. rule: %+v", manager.GetCgroupVersion(), *deviceRule)
+	}
+
+	return err
+}
+
+// isPCIAddress tells a PCI address like 0000:81:00.0 apart from a mediated device UUID.
+func isPCIAddress(address string) bool {
+	return strings.Count(address, ":") == 2 && strings.Contains(address, ".")
+}
@@ -0,0 +1,256 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package hotplug_hostdevice
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	runc_configs "github.com/opencontainers/runc/libcontainer/configs"
+	"github.com/opencontainers/runc/libcontainer/devices"
+	"golang.org/x/sys/unix"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/virt-handler/cgroup"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+var _ = Describe("HotplugHostDevice", func() {
+	var (
+		ctrl              *gomock.Controller
+		vmi               *v1.VirtualMachineInstance
+		manager           *hostDeviceManager
+		cgroupManagerMock *cgroup.MockManager
+		ownershipManager  *diskutils.MockOwnershipManagerInterface
+		launcherRes       *isolation.MockIsolationResult
+		tempDir           string
+	)
+
+	const groupDevice = uint64(243<<8 | 7)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		vmi = api.NewMinimalVMI("testvmi")
+		vmi.UID = "1234"
+
+		cgroupManagerMock = cgroup.NewMockManager(ctrl)
+		cgroupManagerMock.EXPECT().GetCgroupVersion().AnyTimes()
+		ownershipManager = diskutils.NewMockOwnershipManagerInterface(ctrl)
+		manager = &hostDeviceManager{ownershipManager: ownershipManager}
+
+		tempDir = GinkgoT().TempDir()
+		Expect(os.Mkdir(filepath.Join(tempDir, "dev"), 0755)).To(Succeed())
+		root, err := safepath.JoinAndResolveWithRelativeRoot(tempDir)
+		Expect(err).ToNot(HaveOccurred())
+		launcherRes = isolation.NewMockIsolationResult(ctrl)
+		launcherRes.EXPECT().MountRoot().Return(root, nil).AnyTimes()
+
+		origIommuGroup := iommuGroup
+		origVFIOGroupDevice := vfioGroupDevice
+		origMknodCommand := mknodCommand
+		origGetCgroupManager := getCgroupManager
+		DeferCleanup(func() {
+			iommuGroup = origIommuGroup
+			vfioGroupDevice = origVFIOGroupDevice
+			mknodCommand = origMknodCommand
+			getCgroupManager = origGetCgroupManager
+		})
+
+		iommuGroup = func(address string) (string, error) {
+			Expect(address).To(Equal("0000:81:00.0"))
+			return "7", nil
+		}
+		vfioGroupDevice = func(group string) (uint64, error) {
+			Expect(group).To(Equal("7"))
+			return groupDevice, nil
+		}
+		mknodCommand = func(basePath *safepath.Path, deviceName string, _ uint64) error {
+			return safepath.TouchAtNoFollow(basePath, deviceName, 0600)
+		}
+		getCgroupManager = func(_ *v1.VirtualMachineInstance) (cgroup.Manager, error) {
+			return cgroupManagerMock, nil
+		}
+	})
+
+	expectDeviceRules := func(allow bool, devs ...uint64) {
+		for _, dev := range devs {
+			expected := &devices.Rule{
+				Type:        devices.CharDevice,
+				Major:       int64(unix.Major(dev)),
+				Minor:       int64(unix.Minor(dev)),
+				Permissions: "rwm",
+				Allow:       allow,
+			}
+			cgroupManagerMock.EXPECT().Set(&runc_configs.Resources{Devices: []*devices.Rule{expected}}).Return(nil)
+		}
+	}
+
+	Context("Address", func() {
+		var origIsolationDetector func(string) isolation.PodIsolationDetector
+		var origReadEnviron func(int) ([]byte, error)
+
+		BeforeEach(func() {
+			origIsolationDetector = isolationDetector
+			origReadEnviron = readEnviron
+			detector := isolation.NewMockPodIsolationDetector(ctrl)
+			attachRes := isolation.NewMockIsolationResult(ctrl)
+			attachRes.EXPECT().Pid().Return(42).AnyTimes()
+			detector.EXPECT().DetectForSocket(vmi, socketPath("attach-pod")).Return(attachRes, nil).AnyTimes()
+			isolationDetector = func(_ string) isolation.PodIsolationDetector {
+				return detector
+			}
+		})
+
+		AfterEach(func() {
+			isolationDetector = origIsolationDetector
+			readEnviron = origReadEnviron
+		})
+
+		DescribeTable("should return the device allocated to the attachment pod", func(environ, expected string) {
+			readEnviron = func(pid int) ([]byte, error) {
+				Expect(pid).To(Equal(42))
+				return []byte(environ), nil
+			}
+			status := &v1.HostDeviceStatus{Name: "gpu", AttachPodUID: "attach-pod"}
+			Expect(manager.Address(vmi, status, "example.org/gpu")).To(Equal(expected))
+		},
+			Entry("with a PCI device", "PATH=/bin\x00PCI_RESOURCE_EXAMPLE_ORG_GPU=0000:81:00.0\x00", "0000:81:00.0"),
+			Entry("with a mediated device", "MDEV_PCI_RESOURCE_EXAMPLE_ORG_GPU=a2b1c2d3-0000-4000-8000-000000000001\x00", "a2b1c2d3-0000-4000-8000-000000000001"),
+		)
+
+		It("should fail if the attachment pod has no device of the resource", func() {
+			readEnviron = func(_ int) ([]byte, error) {
+				return []byte("PATH=/bin\x00"), nil
+			}
+			status := &v1.HostDeviceStatus{Name: "gpu", AttachPodUID: "attach-pod"}
+			_, err := manager.Address(vmi, status, "example.org/gpu")
+			Expect(err).To(MatchError(ContainSubstring("no device of resource example.org/gpu")))
+		})
+
+		It("should fail if the attachment pod is unknown", func() {
+			_, err := manager.Address(vmi, &v1.HostDeviceStatus{Name: "gpu"}, "example.org/gpu")
+			Expect(err).To(MatchError(ContainSubstring("has no attachment pod")))
+		})
+	})
+
+	It("should expose the VFIO group in the launcher and allow it in the cgroup", func() {
+		expectDeviceRules(true, vfioControlDeviceNumber, groupDevice)
+		status := &v1.HostDeviceStatus{Name: "gpu", Address: "0000:81:00.0"}
+		Expect(manager.Expose(vmi, launcherRes, status)).To(Succeed())
+		Expect(filepath.Join(tempDir, "dev", "vfio", "vfio")).To(BeAnExistingFile())
+		Expect(filepath.Join(tempDir, "dev", "vfio", "7")).To(BeAnExistingFile())
+	})
+
+	It("should hand the VFIO devices over to a non-root VMI", func() {
+		vmi.Annotations = map[string]string{v1.DeprecatedNonRootVMIAnnotation: ""}
+		expectDeviceRules(true, vfioControlDeviceNumber, groupDevice)
+		ownershipManager.EXPECT().SetFileOwnership(gomock.Any()).Return(nil).Times(2)
+		status := &v1.HostDeviceStatus{Name: "gpu", Address: "0000:81:00.0"}
+		Expect(manager.Expose(vmi, launcherRes, status)).To(Succeed())
+	})
+
+	Context("Withdraw", func() {
+		var origReadEnviron func(int) ([]byte, error)
+		var launcherEnviron string
+
+		BeforeEach(func() {
+			origReadEnviron = readEnviron
+			launcherEnviron = "PATH=/bin\x00"
+			launcherRes.EXPECT().Pid().Return(1).AnyTimes()
+			readEnviron = func(pid int) ([]byte, error) {
+				Expect(pid).To(Equal(1))
+				return []byte(launcherEnviron), nil
+			}
+			iommuGroup = func(address string) (string, error) {
+				groups := map[string]string{
+					"0000:81:00.0": "7",
+					"0000:81:00.1": "7",
+					"0000:82:00.0": "8",
+				}
+				Expect(groups).To(HaveKey(address))
+				return groups[address], nil
+			}
+			Expect(os.MkdirAll(filepath.Join(tempDir, "dev", "vfio"), 0755)).To(Succeed())
+			Expect(os.WriteFile(filepath.Join(tempDir, "dev", "vfio", "7"), nil, 0600)).To(Succeed())
+		})
+
+		AfterEach(func() {
+			readEnviron = origReadEnviron
+		})
+
+		It("should withdraw the VFIO group from the launcher", func() {
+			cgroupManagerMock.EXPECT().Set(gomock.Any()).Return(nil)
+			status := &v1.HostDeviceStatus{Name: "gpu", Address: "0000:81:00.0"}
+			Expect(manager.Withdraw(vmi, launcherRes, status)).To(Succeed())
+			Expect(filepath.Join(tempDir, "dev", "vfio", "7")).ToNot(BeAnExistingFile())
+		})
+
+		It("should ignore a VFIO group which was already withdrawn", func() {
+			Expect(os.Remove(filepath.Join(tempDir, "dev", "vfio", "7"))).To(Succeed())
+			status := &v1.HostDeviceStatus{Name: "gpu", Address: "0000:81:00.0"}
+			Expect(manager.Withdraw(vmi, launcherRes, status)).To(Succeed())
+		})
+
+		DescribeTable("should keep the VFIO group while another hotplugged device of the group", func(phase v1.HostDevicePhase) {
+			vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{
+				{Name: "gpu", Address: "0000:81:00.0", Phase: v1.HostDeviceDetached},
+				{Name: "audio", Address: "0000:81:00.1", Phase: phase},
+			}
+			Expect(manager.Withdraw(vmi, launcherRes, &vmi.Status.HostDeviceStatus[0])).To(Succeed())
+			Expect(filepath.Join(tempDir, "dev", "vfio", "7")).To(BeAnExistingFile())
+		},
+			Entry("is exposed", v1.HostDeviceAttachedToNode),
+			Entry("is attached", v1.HostDeviceReady),
+			Entry("is detaching", v1.HostDeviceDetaching),
+		)
+
+		It("should withdraw the VFIO group once the other hotplugged devices of the group are detached", func() {
+			vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{
+				{Name: "gpu", Address: "0000:81:00.0", Phase: v1.HostDeviceDetached},
+				{Name: "audio", Address: "0000:81:00.1", Phase: v1.HostDeviceDetached},
+				{Name: "nic", Address: "0000:82:00.0", Phase: v1.HostDeviceReady},
+			}
+			cgroupManagerMock.EXPECT().Set(gomock.Any()).Return(nil)
+			Expect(manager.Withdraw(vmi, launcherRes, &vmi.Status.HostDeviceStatus[0])).To(Succeed())
+			Expect(filepath.Join(tempDir, "dev", "vfio", "7")).ToNot(BeAnExistingFile())
+		})
+
+		It("should keep the VFIO group while a device of the group is allocated to the launcher", func() {
+			launcherEnviron = "PATH=/bin\x00PCI_RESOURCE_EXAMPLE_ORG_AUDIO=0000:82:00.0,0000:81:00.1\x00"
+			status := &v1.HostDeviceStatus{Name: "gpu", Address: "0000:81:00.0", Phase: v1.HostDeviceDetached}
+			Expect(manager.Withdraw(vmi, launcherRes, status)).To(Succeed())
+			Expect(filepath.Join(tempDir, "dev", "vfio", "7")).To(BeAnExistingFile())
+		})
+	})
+
+	DescribeTable("should tell PCI addresses apart from mediated devices", func(address string, expected bool) {
+		Expect(isPCIAddress(address)).To(Equal(expected))
+	},
+		Entry("PCI address", "0000:81:00.0", true),
+		Entry("mediated device UUID", "a2b1c2d3-0000-4000-8000-000000000001", false),
+	)
+})
@@ -14,6 +14,7 @@ type CPUSiblings []uint32
 type Capabilities struct {
 	XMLName xml.Name `xml:"capabilities"`
 	Host    Host     `xml:"host"`
+	Guests  []Guest  `xml:"guest"`
 }
 
 type Host struct {
@@ -35,6 +36,22 @@ type Counter struct {
 	Scaling   yesnobool `xml:"scaling,attr"`
 }
 
+type Guest struct {
+	OSType string    `xml:"os_type"`
+	Arch   GuestArch `xml:"arch"`
+}
+
+type GuestArch struct {
+	Name     string    `xml:"name,attr"`
+	Machines []Machine `xml:"machine"`
+}
+
+type Machine struct {
+	Name      string `xml:",chardata"`
+	Canonical string `xml:"canonical,attr"`
+	MaxCPUs   int32  `xml:"maxCpus,attr"`
+}
+
 type CPU struct {
 	ID       uint32      `xml:"id,attr"`
 	SocketID uint32      `xml:"socket_id,attr"`
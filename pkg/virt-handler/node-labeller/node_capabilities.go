@@ -0,0 +1,297 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package nodelabeller
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubevirtv1 "kubevirt.io/api/core/v1"
+	nodev1alpha1 "kubevirt.io/api/node/v1alpha1"
+)
+
+const (
+	iommuGroupsPath = "/sys/kernel/iommu_groups"
+	virshVersionTxt = "virsh_version.txt"
+
+	libvirtVersionPrefix = "Using library: libvirt "
+	qemuVersionPrefix    = "Running hypervisor: QEMU "
+)
+
+// publishNodeCapabilities creates or updates the NodeCapabilities object of the node
+func (n *NodeLabeller) publishNodeCapabilities(node *v1.Node, cpuModels []string, cpuFeatures cpuFeatures, hostCPUModel hostCPUModel) error {
+	if !n.clusterConfig.NodeCapabilitiesEnabled() {
+		return nil
+	}
+
+	status, err := n.nodeCapabilitiesStatus(cpuModels, cpuFeatures, hostCPUModel)
+	if err != nil {
+		return err
+	}
+
+	client := n.clientset.NodeCapabilities()
+	nodeCapabilities, err := client.Get(context.Background(), node.Name, metav1.GetOptions{})
+	if k8serrors.IsNotFound(err) {
+		nodeCapabilities, err = client.Create(context.Background(), &nodev1alpha1.NodeCapabilities{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: node.Name,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: v1.SchemeGroupVersion.String(),
+					Kind:       "Node",
+					Name:       node.Name,
+					UID:        node.UID,
+				}},
+			},
+		}, metav1.CreateOptions{})
+	}
+	if err != nil {
+		return err
+	}
+
+	if equality.Semantic.DeepEqual(nodeCapabilities.Status, *status) {
+		return nil
+	}
+	nodeCapabilities = nodeCapabilities.DeepCopy()
+	nodeCapabilities.Status = *status
+	_, err = client.UpdateStatus(context.Background(), nodeCapabilities, metav1.UpdateOptions{})
+	return err
+}
+
+func (n *NodeLabeller) nodeCapabilitiesStatus(cpuModels []string, cpuFeatures cpuFeatures, hostCPUModel hostCPUModel) (*nodev1alpha1.NodeCapabilitiesStatus, error) {
+	hypervisor, err := n.loadHypervisorVersions()
+	if err != nil {
+		return nil, err
+	}
+	iommuGroups, err := n.loadIOMMUGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	return &nodev1alpha1.NodeCapabilitiesStatus{
+		Hypervisor:   hypervisor,
+		CPU:          n.cpuCapabilities(cpuModels, cpuFeatures, hostCPUModel),
+		MachineTypes: n.machineTypes(),
+		NUMACells:    n.numaCells(),
+		IOMMUGroups:  iommuGroups,
+	}, nil
+}
+
+func (n *NodeLabeller) cpuCapabilities(cpuModels []string, cpuFeatures cpuFeatures, hostCPUModel hostCPUModel) *nodev1alpha1.CPUCapabilities {
+	featureLabels := make(map[string]string, len(cpuFeatures))
+	for feature := range cpuFeatures {
+		featureLabels[kubevirtv1.CPUFeatureLabel+feature] = "true"
+	}
+	models := make([]string, 0, len(cpuModels))
+	for _, model := range cpuModels {
+		if n.shouldAddCPUModelLabel(model, &hostCPUModel, featureLabels) {
+			models = append(models, model)
+		}
+	}
+	sort.Strings(models)
+
+	capabilities := &nodev1alpha1.CPUCapabilities{
+		Arch:                      n.capabilities.Host.CPU.Arch,
+		Vendor:                    n.cpuModelVendor,
+		HostModel:                 hostCPUModel.Name,
+		HostModelRequiredFeatures: sortedKeys(hostCPUModel.requiredFeatures),
+		Models:                    models,
+		Features:                  sortedKeys(cpuFeatures),
+		HypervFeatures:            append([]string{}, n.hypervFeatures.items...),
+		SEV:                       n.SEV.Supported == "yes",
+	}
+	sort.Strings(capabilities.HypervFeatures)
+
+	if counter, err := n.capabilities.GetTSCCounter(); err == nil && counter != nil {
+		capabilities.TSC = &nodev1alpha1.TSCCounter{
+			Frequency: counter.Frequency,
+			Scalable:  bool(counter.Scaling),
+		}
+	}
+	return capabilities
+}
+
+// machineTypes lists the machine types of the hvm guests with the architecture of the host
+func (n *NodeLabeller) machineTypes() []nodev1alpha1.MachineType {
+	var machineTypes []nodev1alpha1.MachineType
+	for _, guest := range n.capabilities.Guests {
+		if guest.OSType != "hvm" || guest.Arch.Name != n.capabilities.Host.CPU.Arch {
+			continue
+		}
+		for _, machine := range guest.Arch.Machines {
+			machineTypes = append(machineTypes, nodev1alpha1.MachineType{
+				Name:      machine.Name,
+				Canonical: machine.Canonical,
+				MaxCPUs:   machine.MaxCPUs,
+			})
+		}
+	}
+	sort.Slice(machineTypes, func(i, j int) bool {
+		return machineTypes[i].Name < machineTypes[j].Name
+	})
+	return machineTypes
+}
+
+func (n *NodeLabeller) numaCells() []nodev1alpha1.NUMACell {
+	var cells []nodev1alpha1.NUMACell
+	for _, cell := range n.capabilities.Host.Topology.Cells.Cell {
+		numaCell := nodev1alpha1.NUMACell{
+			ID:     cell.ID,
+			Memory: toBytes(cell.Memory.Amount, cell.Memory.Unit),
+		}
+
+		cpus := make([]uint32, 0, len(cell.Cpus.CPU))
+		for _, cpu := range cell.Cpus.CPU {
+			cpus = append(cpus, cpu.ID)
+		}
+		numaCell.CPUSet = formatCPUSet(cpus)
+
+		for _, pages := range cell.Pages {
+			pageSize := toBytes(uint64(pages.Size), pages.Unit)
+			// The regular pages are reported as well, only keep the hugepages
+			if pageSize == nil || pageSize.Value() <= int64(os.Getpagesize()) {
+				continue
+			}
+			numaCell.HugePages = append(numaCell.HugePages, nodev1alpha1.HugePages{
+				PageSize: *pageSize,
+				Count:    int64(pages.Count),
+			})
+		}
+
+		for _, sibling := range cell.Distances.Sibling {
+			numaCell.Distances = append(numaCell.Distances, nodev1alpha1.NUMADistance{
+				CellID: sibling.ID,
+				Value:  int64(sibling.Value),
+			})
+		}
+		cells = append(cells, numaCell)
+	}
+	return cells
+}
+
+// loadHypervisorVersions reads the output of virsh version, which is missing
+// if the node-labeller init container predates it
+func (n *NodeLabeller) loadHypervisorVersions() (*nodev1alpha1.HypervisorVersions, error) {
+	f, err := os.Open(filepath.Join(n.volumePath, virshVersionTxt))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	versions := &nodev1alpha1.HypervisorVersions{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if strings.HasPrefix(line, libvirtVersionPrefix) {
+			versions.LibvirtVersion = strings.TrimPrefix(line, libvirtVersionPrefix)
+		} else if strings.HasPrefix(line, qemuVersionPrefix) {
+			versions.QEMUVersion = strings.TrimPrefix(line, qemuVersionPrefix)
+		}
+	}
+	return versions, scanner.Err()
+}
+
+// loadIOMMUGroups lists the devices of every IOMMU group, there are none if the IOMMU is disabled
+func (n *NodeLabeller) loadIOMMUGroups() ([]nodev1alpha1.IOMMUGroup, error) {
+	groupDirs, err := os.ReadDir(n.iommuGroupsPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var groups []nodev1alpha1.IOMMUGroup
+	for _, groupDir := range groupDirs {
+		id, err := strconv.ParseUint(groupDir.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+		devices, err := os.ReadDir(filepath.Join(n.iommuGroupsPath, groupDir.Name(), "devices"))
+		if err != nil {
+			return nil, err
+		}
+		group := nodev1alpha1.IOMMUGroup{ID: uint32(id), Devices: []string{}}
+		for _, device := range devices {
+			group.Devices = append(group.Devices, device.Name())
+		}
+		sort.Strings(group.Devices)
+		groups = append(groups, group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].ID < groups[j].ID
+	})
+	return groups, nil
+}
+
+// toBytes converts an amount of memory reported by libvirt in the given unit, KiB by default, to a quantity of bytes.
+func toBytes(amount uint64, unit string) *resource.Quantity {
+	switch unit {
+	case "KiB", "":
+		return resource.NewQuantity(int64(amount)*1024, resource.BinarySI)
+	case "MiB":
+		return resource.NewQuantity(int64(amount)*1024*1024, resource.BinarySI)
+	case "GiB":
+		return resource.NewQuantity(int64(amount)*1024*1024*1024, resource.BinarySI)
+	}
+	return nil
+}
+
+// formatCPUSet formats CPU IDs as a cpuset list, e.g. 0-3,8
+func formatCPUSet(cpus []uint32) string {
+	sort.Slice(cpus, func(i, j int) bool { return cpus[i] < cpus[j] })
+
+	var ranges []string
+	for i := 0; i < len(cpus); {
+		j := i
+		for j+1 < len(cpus) && cpus[j+1] == cpus[j]+1 {
+			j++
+		}
+		if i == j {
+			ranges = append(ranges, fmt.Sprintf("%d", cpus[i]))
+		} else {
+			ranges = append(ranges, fmt.Sprintf("%d-%d", cpus[i], cpus[j]))
+		}
+		i = j + 1
+	}
+	return strings.Join(ranges, ",")
+}
+
+func sortedKeys(features cpuFeatures) []string {
+	keys := make([]string, 0, len(features))
+	for key := range features {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
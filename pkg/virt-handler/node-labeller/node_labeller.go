@@ -81,6 +81,7 @@ type NodeLabeller struct {
 	capabilities            *api.Capabilities
 	hostCPUModel            hostCPUModel
 	SEV                     SEVConfiguration
+	iommuGroupsPath         string
 }
 
 func NewNodeLabeller(clusterConfig *virtconfig.ClusterConfig, clientset kubecli.KubevirtClient, host, namespace string, recorder record.EventRecorder) (*NodeLabeller, error) {
@@ -99,6 +100,7 @@ func newNodeLabeller(clusterConfig *virtconfig.ClusterConfig, clientset kubecli.
 		volumePath:              volumePath,
 		domCapabilitiesFileName: "virsh_domcapabilities.xml",
 		hostCPUModel:            hostCPUModel{requiredFeatures: make(map[string]bool, 0)},
+		iommuGroupsPath:         iommuGroupsPath,
 	}
 
 	err := n.loadAll()
@@ -191,10 +193,17 @@ func (n *NodeLabeller) run() error {
 		return err
 	}
 
+	// Failing to publish the capabilities must not hold the labels back, the node is
+	// only requeued for the capabilities once it is labelled
+	capabilitiesErr := n.publishNodeCapabilities(originalNode, cpuModels, cpuFeatures, hostCPUModel)
+	if capabilitiesErr != nil {
+		n.logger.Reason(capabilitiesErr).Error("node-labeller could not publish the node capabilities")
+	}
+
 	node := originalNode.DeepCopy()
 
 	if skipNode(node) {
-		return nil
+		return capabilitiesErr
 	}
 
 	//prepare new labels
@@ -204,9 +213,10 @@ func (n *NodeLabeller) run() error {
 	//add new labels
 	n.addLabellerLabels(node, newLabels)
 
-	err = n.patchNode(originalNode, node)
-
-	return err
+	if err := n.patchNode(originalNode, node); err != nil {
+		return err
+	}
+	return capabilitiesErr
 }
 
 func skipNode(node *v1.Node) bool {
@@ -22,6 +22,10 @@
 package nodelabeller
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/golang/mock/gomock"
@@ -29,14 +33,19 @@ import (
 	. "github.com/onsi/gomega"
 	k8sv1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
 
 	kubevirtv1 "kubevirt.io/api/core/v1"
+	nodev1alpha1 "kubevirt.io/api/node/v1alpha1"
 
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
 	"kubevirt.io/client-go/kubecli"
 
 	"kubevirt.io/kubevirt/pkg/testutils"
@@ -52,6 +61,8 @@ var _ = Describe("Node-labeller ", func() {
 	var kubeClient *fake.Clientset
 	var mockQueue *testutils.MockWorkQueue
 	var config *virtconfig.ClusterConfig
+	var kv *kubevirtv1.KubeVirt
+	var kvInformer cache.SharedIndexInformer
 	var addedNode *v1.Node
 	var recorder *record.FakeRecorder
 
@@ -99,7 +110,7 @@ var _ = Describe("Node-labeller ", func() {
 
 		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
 
-		kv := &kubevirtv1.KubeVirt{
+		kv = &kubevirtv1.KubeVirt{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      "kubevirt",
 				Namespace: "kubevirt",
@@ -112,7 +123,7 @@ var _ = Describe("Node-labeller ", func() {
 			},
 		}
 
-		config, _, _ = testutils.NewFakeClusterConfigUsingKV(kv)
+		config, _, kvInformer = testutils.NewFakeClusterConfigUsingKV(kv)
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 
@@ -188,6 +199,93 @@ var _ = Describe("Node-labeller ", func() {
 		Expect(res).To(BeTrue())
 	})
 
+	Context("with the NodeCapabilities feature gate", func() {
+		var kubevirtClient *kubevirtfake.Clientset
+
+		BeforeEach(func() {
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			virtClient.EXPECT().NodeCapabilities().Return(kubevirtClient.NodeV1alpha1().NodeCapabilities()).AnyTimes()
+
+			nlController.iommuGroupsPath = GinkgoT().TempDir()
+			for group, devices := range map[string][]string{"0": {"0000:00:00.0"}, "12": {"0000:01:00.1", "0000:01:00.0"}} {
+				for _, device := range devices {
+					Expect(os.MkdirAll(filepath.Join(nlController.iommuGroupsPath, group, "devices", device), 0755)).To(Succeed())
+				}
+			}
+			addedNode.UID = types.UID("test-node-uid")
+			expectNodePatch()
+		})
+
+		enableFeatureGate := func() {
+			kv.Spec.Configuration.DeveloperConfiguration = &kubevirtv1.DeveloperConfiguration{
+				FeatureGates: []string{virtconfig.NodeCapabilitiesGate},
+			}
+			testutils.UpdateFakeKubeVirtClusterConfig(kvInformer, kv)
+		}
+
+		getNodeCapabilities := func() *nodev1alpha1.NodeCapabilities {
+			nodeCapabilities, err := kubevirtClient.NodeV1alpha1().NodeCapabilities().Get(context.Background(), "testNode", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return nodeCapabilities
+		}
+
+		It("should publish the capabilities of the node", func() {
+			enableFeatureGate()
+			Expect(nlController.execute()).To(BeTrue())
+
+			nodeCapabilities := getNodeCapabilities()
+			Expect(nodeCapabilities.OwnerReferences).To(ConsistOf(metav1.OwnerReference{
+				APIVersion: "v1",
+				Kind:       "Node",
+				Name:       "testNode",
+				UID:        "test-node-uid",
+			}))
+
+			status := nodeCapabilities.Status
+			Expect(status.Hypervisor).To(Equal(&nodev1alpha1.HypervisorVersions{LibvirtVersion: "9.0.0", QEMUVersion: "7.2.0"}))
+			Expect(status.CPU.Arch).To(Equal("x86_64"))
+			Expect(status.CPU.HostModel).To(Equal("Skylake-Client-IBRS"))
+			Expect(status.CPU.Models).To(ContainElement("Penryn"))
+			Expect(status.CPU.Models).ToNot(ContainElement("Opteron_G2"))
+			Expect(status.CPU.SEV).To(BeTrue())
+			Expect(status.MachineTypes).To(ContainElement(nodev1alpha1.MachineType{Name: "q35", Canonical: "pc-q35-5.2", MaxCPUs: 288}))
+			Expect(status.NUMACells).To(HaveLen(1))
+			Expect(status.NUMACells[0].CPUSet).To(Equal("0-7"))
+			Expect(status.NUMACells[0].HugePages).To(HaveLen(2))
+			Expect(status.NUMACells[0].HugePages[0].PageSize.Cmp(resource.MustParse("2Mi"))).To(BeZero())
+			Expect(status.NUMACells[0].HugePages[1].PageSize.Cmp(resource.MustParse("1Gi"))).To(BeZero())
+			Expect(status.IOMMUGroups).To(Equal([]nodev1alpha1.IOMMUGroup{
+				{ID: 0, Devices: []string{"0000:00:00.0"}},
+				{ID: 12, Devices: []string{"0000:01:00.0", "0000:01:00.1"}},
+			}))
+		})
+
+		It("should label the node and requeue it when the capabilities can't be published", func() {
+			enableFeatureGate()
+			kubevirtClient.Fake.PrependReactor("create", "nodecapabilities", func(_ testing.Action) (bool, runtime.Object, error) {
+				return true, nil, fmt.Errorf("create failed")
+			})
+			patched := false
+			kubeClient.Fake.PrependReactor("patch", "nodes", func(action testing.Action) (bool, runtime.Object, error) {
+				Expect(string(action.(testing.PatchAction).GetPatch())).To(ContainSubstring(kubevirtv1.HostModelCPULabel))
+				patched = true
+				return true, nil, nil
+			})
+
+			Expect(nlController.execute()).To(BeTrue())
+			Expect(patched).To(BeTrue())
+			Expect(mockQueue.GetRateLimitedEnqueueCount()).To(Equal(1))
+		})
+
+		It("should not publish the capabilities if the feature gate is disabled", func() {
+			Expect(nlController.execute()).To(BeTrue())
+
+			list, err := kubevirtClient.NodeV1alpha1().NodeCapabilities().List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(list.Items).To(BeEmpty())
+		})
+	})
+
 	AfterEach(func() {
 		close(stop)
 	})
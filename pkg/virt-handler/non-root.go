@@ -209,6 +209,51 @@ func (*VirtualMachineController) prepareVFIO(vmi *v1.VirtualMachineInstance, res
 	return nil
 }
 
+// prepareUSB hands the USB device nodes allocated to the pod over to qemu, the device plugin
+// only allows the container to access them
+func (*VirtualMachineController) prepareUSB(res isolation.IsolationResult) error {
+	usbBasePath, err := isolation.SafeJoin(res, "dev", "bus", "usb")
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var buses []os.DirEntry
+	err = usbBasePath.ExecuteNoFollow(func(safePath string) (err error) {
+		buses, err = os.ReadDir(safePath)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, bus := range buses {
+		busPath, err := safepath.JoinNoFollow(usbBasePath, bus.Name())
+		if err != nil {
+			return err
+		}
+		var devices []os.DirEntry
+		err = busPath.ExecuteNoFollow(func(safePath string) (err error) {
+			devices, err = os.ReadDir(safePath)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		for _, device := range devices {
+			devicePath, err := safepath.JoinNoFollow(busPath, device.Name())
+			if err != nil {
+				return err
+			}
+			if err := diskutils.DefaultOwnershipManager.SetFileOwnership(devicePath); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func (d *VirtualMachineController) nonRootSetup(origVMI, vmi *v1.VirtualMachineInstance) error {
 	res, err := d.podIsolationDetector.Detect(origVMI)
 	if err != nil {
@@ -223,5 +268,8 @@ func (d *VirtualMachineController) nonRootSetup(origVMI, vmi *v1.VirtualMachineI
 	if err := d.prepareVFIO(origVMI, res); err != nil {
 		return err
 	}
+	if err := d.prepareUSB(res); err != nil {
+		return err
+	}
 	return nil
 }
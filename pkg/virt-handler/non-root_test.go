@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package virthandler
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	diskutils "kubevirt.io/kubevirt/pkg/ephemeral-disk-utils"
+	"kubevirt.io/kubevirt/pkg/safepath"
+	"kubevirt.io/kubevirt/pkg/unsafepath"
+	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
+)
+
+var _ = Describe("Non-root VMI setup", func() {
+	var rootDir string
+	var res *isolation.MockIsolationResult
+	var ownershipManager *diskutils.MockOwnershipManagerInterface
+	var originalOwnershipManager diskutils.OwnershipManagerInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		rootDir = GinkgoT().TempDir()
+		root, err := safepath.JoinAndResolveWithRelativeRoot(rootDir)
+		Expect(err).ToNot(HaveOccurred())
+		res = isolation.NewMockIsolationResult(ctrl)
+		res.EXPECT().MountRoot().Return(root, nil).AnyTimes()
+
+		originalOwnershipManager = diskutils.DefaultOwnershipManager
+		ownershipManager = diskutils.NewMockOwnershipManagerInterface(ctrl)
+		diskutils.DefaultOwnershipManager = ownershipManager
+	})
+
+	AfterEach(func() {
+		diskutils.DefaultOwnershipManager = originalOwnershipManager
+	})
+
+	It("should hand the allocated USB device nodes over to qemu", func() {
+		for _, device := range []string{"001/003", "002/005"} {
+			devicePath := filepath.Join(rootDir, "dev", "bus", "usb", device)
+			Expect(os.MkdirAll(filepath.Dir(devicePath), 0755)).To(Succeed())
+			Expect(os.WriteFile(devicePath, nil, 0644)).To(Succeed())
+		}
+
+		var chowned []string
+		ownershipManager.EXPECT().SetFileOwnership(gomock.Any()).DoAndReturn(func(path *safepath.Path) error {
+			chowned = append(chowned, unsafepath.UnsafeAbsolute(path.Raw()))
+			return nil
+		}).Times(2)
+
+		Expect((&VirtualMachineController{}).prepareUSB(res)).To(Succeed())
+		Expect(chowned).To(ConsistOf(
+			filepath.Join(rootDir, "dev", "bus", "usb", "001", "003"),
+			filepath.Join(rootDir, "dev", "bus", "usb", "002", "005"),
+		))
+	})
+
+	It("should do nothing without USB devices", func() {
+		Expect((&VirtualMachineController{}).prepareUSB(res)).To(Succeed())
+	})
+})
@@ -15,13 +15,15 @@ func virtualMachineOptions(
 	capabilities *api.Capabilities,
 	disksInfo map[string]*containerdisk.DiskInfo,
 	expandDisksEnabled bool,
+	serialConsoleLogEnabled bool,
 ) *cmdv1.VirtualMachineOptions {
 	options := &cmdv1.VirtualMachineOptions{
-		MemBalloonStatsPeriod: period,
-		PreallocatedVolumes:   preallocatedVolumes,
-		Topology:              capabilitiesToTopology(capabilities),
-		DisksInfo:             disksInfoToDisksInfo(disksInfo),
-		ExpandDisksEnabled:    expandDisksEnabled,
+		MemBalloonStatsPeriod:   period,
+		PreallocatedVolumes:     preallocatedVolumes,
+		Topology:                capabilitiesToTopology(capabilities),
+		DisksInfo:               disksInfoToDisksInfo(disksInfo),
+		ExpandDisksEnabled:      expandDisksEnabled,
+		SerialConsoleLogEnabled: serialConsoleLogEnabled,
 	}
 	if smbios != nil {
 		options.VirtualMachineSMBios = &cmdv1.SMBios{
@@ -42,6 +42,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/util"
+	consolesession "kubevirt.io/kubevirt/pkg/virt-handler/console-session"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 )
 
@@ -49,10 +50,9 @@ import (
 
 type ConsoleHandler struct {
 	podIsolationDetector isolation.PodIsolationDetector
-	serialStopChans      map[types.UID](chan struct{})
-	vncStopChans         map[types.UID](chan struct{})
+	sessions             *consolesession.Manager
+	serialMuxes          map[string]*serialMux
 	serialLock           *sync.Mutex
-	vncLock              *sync.Mutex
 	vmiInformer          cache.SharedIndexInformer
 	usbredir             map[types.UID]UsbredirHandlerVMI
 	usbredirLock         *sync.Mutex
@@ -63,13 +63,12 @@ type UsbredirHandlerVMI struct {
 	stopChans map[int](chan struct{})
 }
 
-func NewConsoleHandler(podIsolationDetector isolation.PodIsolationDetector, vmiInformer cache.SharedIndexInformer, certManager certificate.Manager) *ConsoleHandler {
+func NewConsoleHandler(podIsolationDetector isolation.PodIsolationDetector, vmiInformer cache.SharedIndexInformer, certManager certificate.Manager, sessions *consolesession.Manager) *ConsoleHandler {
 	return &ConsoleHandler{
 		podIsolationDetector: podIsolationDetector,
-		serialStopChans:      make(map[types.UID](chan struct{})),
-		vncStopChans:         make(map[types.UID](chan struct{})),
+		sessions:             sessions,
+		serialMuxes:          make(map[string]*serialMux),
 		serialLock:           &sync.Mutex{},
-		vncLock:              &sync.Mutex{},
 		usbredirLock:         &sync.Mutex{},
 		vmiInformer:          vmiInformer,
 		usbredir:             make(map[types.UID]UsbredirHandlerVMI),
@@ -153,10 +152,19 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 		response.WriteError(http.StatusBadRequest, err)
 		return
 	}
-	uid := vmi.GetUID()
-	stopChn := newStopChan(uid, t.vncLock, t.vncStopChans)
-	defer deleteStopChan(uid, stopChn, t.vncLock, t.vncStopChans)
-	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn)
+	client, ok := t.connectConsoleSession(vmi, consolesession.Console{Type: v1.VNCConsoleType}, request, response)
+	if !ok {
+		return
+	}
+	defer t.sessions.Disconnect(vmi, client)
+	dial := unixSocketDialer(vmi, unixSocketPath)
+	t.stream(vmi, request, response, func() (net.Conn, error) {
+		conn, err := dial()
+		if err != nil {
+			return nil, err
+		}
+		return newRFBFilteredConn(conn, client.ReadOnly()), nil
+	}, client.Stopped())
 }
 
 func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restful.Response) {
@@ -166,16 +174,104 @@ func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restf
 		response.WriteError(code, err)
 		return
 	}
-	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-serial0")
+	socketName := "virt-serial0"
+	console := consolesession.Console{Type: v1.SerialConsoleType}
+	// Additional serial ports are shared like the serial console
+	if serialPort := request.QueryParameter("serialPort"); serialPort != "" {
+		var exists bool
+		socketName, exists = util.SerialPortSocketName(vmi, serialPort)
+		if !exists {
+			err := fmt.Errorf("no serial port named %s", serialPort)
+			log.Log.Object(vmi).Reason(err).Error("Failed finding serial port")
+			response.WriteError(http.StatusBadRequest, err)
+			return
+		}
+		console.SerialPort = serialPort
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, socketName)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for serial console")
 		response.WriteError(http.StatusBadRequest, err)
 		return
 	}
-	uid := vmi.GetUID()
-	stopCh := newStopChan(uid, t.serialLock, t.serialStopChans)
-	defer deleteStopChan(uid, stopCh, t.serialLock, t.serialStopChans)
-	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopCh)
+	client, ok := t.connectConsoleSession(vmi, console, request, response)
+	if !ok {
+		return
+	}
+	defer t.sessions.Disconnect(vmi, client)
+	muxKey := fmt.Sprintf("%s/%s", vmi.GetUID(), socketName)
+	t.stream(vmi, request, response, t.serialMuxDialer(muxKey, unixSocketDialer(vmi, unixSocketPath), client.ReadOnly()), client.Stopped())
+}
+
+// connectConsoleSession registers the client in the session of the console, and writes the error response if it can't join it
+func (t *ConsoleHandler) connectConsoleSession(vmi *v1.VirtualMachineInstance, console consolesession.Console, request *restful.Request, response *restful.Response) (*consolesession.Client, bool) {
+	readOnly, err := boolQueryParameter(request, "readOnly")
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return nil, false
+	}
+	exclusive, err := boolQueryParameter(request, "exclusive")
+	if err != nil {
+		response.WriteError(http.StatusBadRequest, err)
+		return nil, false
+	}
+	if readOnly && exclusive {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("a read-only client can't get exclusive access to the console"))
+		return nil, false
+	}
+
+	client, err := t.sessions.Connect(vmi, console, request.QueryParameter("user"), readOnly, exclusive)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Infof("Refused a connection to the %s console", console.Type)
+		response.WriteError(http.StatusConflict, err)
+		return nil, false
+	}
+	return client, true
+}
+
+func boolQueryParameter(request *restful.Request, name string) (bool, error) {
+	value := request.QueryParameter(name)
+	if value == "" {
+		return false, nil
+	}
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s parameter %q", name, value)
+	}
+	return parsed, nil
+}
+
+// serialMuxDialer connects to the serial console through the connection shared by its clients
+func (t *ConsoleHandler) serialMuxDialer(key string, dial func() (net.Conn, error), readOnly bool) func() (net.Conn, error) {
+	return func() (net.Conn, error) {
+		t.serialLock.Lock()
+		defer t.serialLock.Unlock()
+
+		if mux, exists := t.serialMuxes[key]; exists {
+			if conn, ok := mux.attach(readOnly); ok {
+				return conn, nil
+			}
+		}
+
+		conn, err := dial()
+		if err != nil {
+			return nil, err
+		}
+		mux := newSerialMux(conn)
+		t.serialMuxes[key] = mux
+		go mux.broadcast()
+		go func() {
+			<-mux.done
+			t.serialLock.Lock()
+			defer t.serialLock.Unlock()
+			if t.serialMuxes[key] == mux {
+				delete(t.serialMuxes, key)
+			}
+		}()
+
+		muxConn, _ := mux.attach(readOnly)
+		return muxConn, nil
+	}
 }
 
 func (t *ConsoleHandler) VSOCKHandler(request *restful.Request, response *restful.Response) {
@@ -242,29 +338,6 @@ func (t *ConsoleHandler) VSOCKHandler(request *restful.Request, response *restfu
 	}, make(chan struct{})) // It is legitimate and up to the guest-application to accept multiple connections.
 }
 
-func newStopChan(uid types.UID, lock *sync.Mutex, stopChans map[types.UID](chan struct{})) chan struct{} {
-	lock.Lock()
-	defer lock.Unlock()
-	// close current connection, if exists
-	if c, ok := stopChans[uid]; ok {
-		delete(stopChans, uid)
-		close(c)
-	}
-	// create a stop channel for the new connection
-	stopCh := make(chan struct{})
-	stopChans[uid] = stopCh
-	return stopCh
-}
-
-func deleteStopChan(uid types.UID, stopChn chan struct{}, lock *sync.Mutex, stopChans map[types.UID](chan struct{})) {
-	lock.Lock()
-	defer lock.Unlock()
-	// delete the stop channel from the cache if needed
-	if c, ok := stopChans[uid]; ok && c == stopChn {
-		delete(stopChans, uid)
-	}
-}
-
 func (t *ConsoleHandler) getUnixSocketPath(vmi *v1.VirtualMachineInstance, socketName string) (string, error) {
 	result, err := t.podIsolationDetector.Detect(vmi)
 	if err != nil {
@@ -292,7 +365,7 @@ func unixSocketDialer(vmi *v1.VirtualMachineInstance, unixSocketPath string) fun
 	}
 }
 
-func (t *ConsoleHandler) stream(vmi *v1.VirtualMachineInstance, request *restful.Request, response *restful.Response, dial func() (net.Conn, error), stopCh chan struct{}) {
+func (t *ConsoleHandler) stream(vmi *v1.VirtualMachineInstance, request *restful.Request, response *restful.Response, dial func() (net.Conn, error), stopCh <-chan struct{}) {
 	var upgrader = kubecli.NewUpgrader()
 	clientSocket, err := upgrader.Upgrade(response.ResponseWriter, request.Request, nil)
 	if err != nil {
@@ -0,0 +1,194 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/emicklei/go-restful"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	// guestFileChunkSize is the amount of data read or written per guest agent command,
+	// well within the limits of the guest agent messages
+	guestFileChunkSize = 1024 * 1024
+
+	guestFileAttrTimeoutSeconds = 10
+)
+
+// GuestFileDownloadHandler streams a file out of the guest through the guest agent
+func (lh *LifecycleHandler) GuestFileDownloadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	path := request.QueryParameter("path")
+	if path == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("no path in guest file request"))
+		return
+	}
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	handle, err := client.GuestFileOpen(domainName, path, "rb")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to open guest file %s", path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	defer closeGuestFile(vmi, client, domainName, handle)
+
+	written := false
+	for {
+		data, eof, err := client.GuestFileRead(domainName, handle, guestFileChunkSize)
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Errorf("Failed to read guest file %s", path)
+			if written {
+				// The status was already sent, the client has to see the transfer failing
+				panic(http.ErrAbortHandler)
+			}
+			response.WriteError(http.StatusInternalServerError, err)
+			return
+		}
+		if !written {
+			response.AddHeader("Content-Type", "application/octet-stream")
+			response.WriteHeader(http.StatusOK)
+			written = true
+		}
+		if len(data) > 0 {
+			if _, err := response.Write(data); err != nil {
+				log.Log.Object(vmi).Reason(err).Errorf("Failed to send guest file %s", path)
+				return
+			}
+			response.Flush()
+		}
+		if eof {
+			return
+		}
+	}
+}
+
+// GuestFileUploadHandler streams the request body into a file of the guest through the guest agent
+func (lh *LifecycleHandler) GuestFileUploadHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	options := &v1.GuestFileOptions{
+		Path:        request.QueryParameter("path"),
+		Permissions: request.QueryParameter("permissions"),
+		Owner:       request.QueryParameter("owner"),
+	}
+	if options.Path == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("no path in guest file request"))
+		return
+	}
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("no content in guest file request"))
+		return
+	}
+	defer request.Request.Body.Close()
+
+	domainName := api.VMINamespaceKeyFunc(vmi)
+	handle, err := client.GuestFileOpen(domainName, options.Path, "wb")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to open guest file %s", options.Path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	// The file exists once opened, restrict it before writing anything into it
+	if err := setGuestFileAttributes(client, domainName, options); err != nil {
+		closeGuestFile(vmi, client, domainName, handle)
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to set the attributes of guest file %s", options.Path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	buf := make([]byte, guestFileChunkSize)
+	for {
+		n, readErr := io.ReadFull(request.Request.Body, buf)
+		if n > 0 {
+			if err := client.GuestFileWrite(domainName, handle, buf[:n]); err != nil {
+				closeGuestFile(vmi, client, domainName, handle)
+				log.Log.Object(vmi).Reason(err).Errorf("Failed to write guest file %s", options.Path)
+				response.WriteError(http.StatusInternalServerError, err)
+				return
+			}
+		}
+		if readErr == io.EOF || readErr == io.ErrUnexpectedEOF {
+			break
+		}
+		if readErr != nil {
+			closeGuestFile(vmi, client, domainName, handle)
+			log.Log.Object(vmi).Reason(readErr).Errorf("Failed to receive guest file %s", options.Path)
+			response.WriteError(http.StatusBadRequest, readErr)
+			return
+		}
+	}
+
+	if err := client.GuestFileClose(domainName, handle); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to close guest file %s", options.Path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	response.WriteHeader(http.StatusOK)
+}
+
+func closeGuestFile(vmi *v1.VirtualMachineInstance, client cmdclient.LauncherClient, domainName string, handle int64) {
+	if err := client.GuestFileClose(domainName, handle); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to close guest file")
+	}
+}
+
+// setGuestFileAttributes relies on chown and chmod in the guest
+func setGuestFileAttributes(client cmdclient.LauncherClient, domainName string, options *v1.GuestFileOptions) error {
+	if options.Owner != "" {
+		if err := guestExecChecked(client, domainName, "chown", options.Owner, options.Path); err != nil {
+			return err
+		}
+	}
+	if options.Permissions != "" {
+		if err := guestExecChecked(client, domainName, "chmod", options.Permissions, options.Path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func guestExecChecked(client cmdclient.LauncherClient, domainName string, command string, args ...string) error {
+	result, err := client.GuestExec(domainName, command, args, nil, guestFileAttrTimeoutSeconds)
+	if err != nil {
+		return err
+	}
+	if result.ExitCode != 0 {
+		return fmt.Errorf("%s exited with code %d: %s", command, result.ExitCode, string(result.Stderr))
+	}
+	return nil
+}
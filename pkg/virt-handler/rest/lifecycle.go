@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
 
 	"github.com/emicklei/go-restful"
 
@@ -33,13 +34,24 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
+	vms "kubevirt.io/kubevirt/pkg/monitoring/domainstats"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
 const (
 	failedRetrieveVMI      = "Failed to retrieve VMI"
 	failedDetectCmdClient  = "Failed to detect cmd client"
 	failedConnectCmdClient = "Failed to connect cmd client"
+
+	// guestExecRequestLimit bounds the guestexec request body, stdin is base64 encoded in it
+	guestExecRequestLimit = 2 * 1024 * 1024
+	// defaultGuestExecTimeoutSeconds is used when the guestexec request doesn't set a timeout
+	defaultGuestExecTimeoutSeconds = 30
+	// sshKeyRequestLimit bounds the addsshkey and removesshkey request bodies
+	sshKeyRequestLimit = 64 * 1024
+	// defaultSSHKeyTTLSeconds is used when the addsshkey request doesn't set a TTL
+	defaultSSHKeyTTLSeconds = 120
 )
 
 type LifecycleHandler struct {
@@ -218,6 +230,125 @@ func (lh *LifecycleHandler) GetFilesystems(request *restful.Request, response *r
 	response.WriteEntity(fsList)
 }
 
+func (lh *LifecycleHandler) GetDomainStats(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	domStats, exists, err := client.GetDomainStats()
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to get domain stats")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	if !exists || domStats == nil {
+		response.WriteError(http.StatusNotFound, fmt.Errorf("no domain stats available for %s", vmi.Name))
+		return
+	}
+
+	response.WriteEntity(vms.Summarize(domStats, time.Now()))
+}
+
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	options := &v1.GuestExecOptions{}
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Error("No options in guestexec request")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve guestexec options"))
+		return
+	}
+
+	defer request.Request.Body.Close()
+	err = yaml.NewYAMLOrJSONDecoder(io.LimitReader(request.Request.Body, guestExecRequestLimit), 1024).Decode(options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to unmarshal guestexec options")
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal guestexec options"))
+		return
+	}
+
+	if len(options.Command) == 0 {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("no command in guestexec request"))
+		return
+	}
+	timeoutSeconds := options.TimeoutSeconds
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultGuestExecTimeoutSeconds
+	}
+
+	result, err := client.GuestExec(api.VMINamespaceKeyFunc(vmi), options.Command[0], options.Command[1:], options.Stdin, timeoutSeconds)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to run command in the guest")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(result)
+}
+
+func (lh *LifecycleHandler) AddSSHKeyHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	options := &v1.AddSSHKeyOptions{}
+	if !decodeSSHKeyOptions(vmi, "addsshkey", request, response, options) {
+		return
+	}
+	ttlSeconds := options.TTLSeconds
+	if ttlSeconds <= 0 {
+		ttlSeconds = defaultSSHKeyTTLSeconds
+	}
+
+	if err := client.AddSSHKey(vmi, options.User, options.PublicKey, ttlSeconds); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to add an ephemeral SSH key")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (lh *LifecycleHandler) RemoveSSHKeyHandler(request *restful.Request, response *restful.Response) {
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	options := &v1.RemoveSSHKeyOptions{}
+	if !decodeSSHKeyOptions(vmi, "removesshkey", request, response, options) {
+		return
+	}
+
+	if err := client.RemoveSSHKey(vmi, options.User, options.PublicKey); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to remove an ephemeral SSH key")
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func decodeSSHKeyOptions(vmi *v1.VirtualMachineInstance, subresource string, request *restful.Request, response *restful.Response, options interface{}) bool {
+	if request.Request.Body == nil {
+		log.Log.Object(vmi).Errorf("No options in %s request", subresource)
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to retrieve %s options", subresource))
+		return false
+	}
+
+	defer request.Request.Body.Close()
+	err := yaml.NewYAMLOrJSONDecoder(io.LimitReader(request.Request.Body, sshKeyRequestLimit), 1024).Decode(options)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to unmarshal %s options", subresource)
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to unmarshal %s options", subresource))
+		return false
+	}
+	return true
+}
+
 func (lh *LifecycleHandler) getVMILauncherClient(request *restful.Request, response *restful.Response) (*v1.VirtualMachineInstance, cmdclient.LauncherClient, error) {
 	vmi, code, err := getVMI(request, lh.vmiInformer)
 	if err != nil {
@@ -0,0 +1,232 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+)
+
+type rfbState int
+
+const (
+	rfbProtocolVersion rfbState = iota
+	rfbSecurityType
+	rfbVNCAuthResponse
+	rfbClientInit
+	rfbClientMessages
+	rfbPassThrough
+)
+
+const (
+	rfbProtocolVersionLength = 12
+	rfbVNCAuthResponseLength = 16
+
+	rfbSecurityNone    = 1
+	rfbSecurityVNCAuth = 2
+)
+
+// rfbClientFilter parses what a VNC client sends to the server, so that several clients can share the display.
+// The shared flag of every client is set, otherwise QEMU disconnects the other clients, and the input of
+// read-only clients is dropped.
+type rfbClientFilter struct {
+	server   io.Writer
+	readOnly bool
+
+	state   rfbState
+	pending []byte
+	// skip is the number of bytes left of a dropped message
+	skip int
+}
+
+func newRFBClientFilter(server io.Writer, readOnly bool) *rfbClientFilter {
+	return &rfbClientFilter{
+		server:   server,
+		readOnly: readOnly,
+	}
+}
+
+func (f *rfbClientFilter) Write(p []byte) (int, error) {
+	if f.state == rfbPassThrough {
+		return f.server.Write(p)
+	}
+
+	f.pending = append(f.pending, p...)
+	for len(f.pending) > 0 {
+		if f.skip > 0 {
+			n := f.skip
+			if n > len(f.pending) {
+				n = len(f.pending)
+			}
+			f.skip -= n
+			f.pending = f.pending[n:]
+			continue
+		}
+		if f.state == rfbPassThrough {
+			if _, err := f.server.Write(f.pending); err != nil {
+				return 0, err
+			}
+			f.pending = nil
+			break
+		}
+
+		length, forward, err := f.next()
+		if err != nil {
+			return 0, err
+		}
+		if length == 0 {
+			// Wait for the rest of the message
+			break
+		}
+		if !forward {
+			f.skip = length
+			continue
+		}
+		if length > len(f.pending) {
+			break
+		}
+		if _, err := f.server.Write(f.pending[:length]); err != nil {
+			return 0, err
+		}
+		f.pending = f.pending[length:]
+	}
+	return len(p), nil
+}
+
+// next returns the length of the next message, and whether to forward it to the server.
+// It returns a zero length if more data is needed to know the length.
+func (f *rfbClientFilter) next() (int, bool, error) {
+	switch f.state {
+	case rfbProtocolVersion:
+		if len(f.pending) < rfbProtocolVersionLength {
+			return 0, false, nil
+		}
+		// Version 3.3 clients don't select the security type, QEMU picks none for the socket of virt-launcher
+		if string(f.pending[:rfbProtocolVersionLength]) == "RFB 003.003\n" {
+			f.state = rfbClientInit
+		} else {
+			f.state = rfbSecurityType
+		}
+		return rfbProtocolVersionLength, true, nil
+	case rfbSecurityType:
+		switch f.pending[0] {
+		case rfbSecurityNone:
+			f.state = rfbClientInit
+		case rfbSecurityVNCAuth:
+			f.state = rfbVNCAuthResponse
+		default:
+			return 0, false, fmt.Errorf("unsupported VNC security type %d", f.pending[0])
+		}
+		return 1, true, nil
+	case rfbVNCAuthResponse:
+		if len(f.pending) < rfbVNCAuthResponseLength {
+			return 0, false, nil
+		}
+		f.state = rfbClientInit
+		return rfbVNCAuthResponseLength, true, nil
+	case rfbClientInit:
+		// Share the display with the other clients
+		f.pending[0] = 1
+		if f.readOnly {
+			f.state = rfbClientMessages
+		} else {
+			f.state = rfbPassThrough
+		}
+		return 1, true, nil
+	}
+	return f.nextClientMessage()
+}
+
+func (f *rfbClientFilter) nextClientMessage() (int, bool, error) {
+	msg := f.pending
+	switch msgType := msg[0]; msgType {
+	case 0: // SetPixelFormat
+		return 20, true, nil
+	case 2: // SetEncodings
+		if len(msg) < 4 {
+			return 0, false, nil
+		}
+		return 4 + 4*int(binary.BigEndian.Uint16(msg[2:4])), true, nil
+	case 3: // FramebufferUpdateRequest
+		return 10, true, nil
+	case 4: // KeyEvent
+		return 8, false, nil
+	case 5: // PointerEvent
+		return 6, false, nil
+	case 6: // ClientCutText
+		if len(msg) < 8 {
+			return 0, false, nil
+		}
+		// A negative length is used by the extended clipboard pseudo-encoding
+		length := int64(int32(binary.BigEndian.Uint32(msg[4:8])))
+		if length < 0 {
+			length = -length
+		}
+		return 8 + int(length), false, nil
+	case 150: // EnableContinuousUpdates
+		return 10, true, nil
+	case 248: // ClientFence
+		if len(msg) < 9 {
+			return 0, false, nil
+		}
+		return 9 + int(msg[8]), true, nil
+	case 250: // xvp, to shutdown or reboot the guest
+		return 4, false, nil
+	case 255: // QEMU extensions
+		if len(msg) < 2 {
+			return 0, false, nil
+		}
+		switch msg[1] {
+		case 0: // Extended key event
+			return 12, false, nil
+		case 1: // Audio
+			if len(msg) < 4 {
+				return 0, false, nil
+			}
+			if binary.BigEndian.Uint16(msg[2:4]) == 2 {
+				// Set the audio format
+				return 10, true, nil
+			}
+			return 4, true, nil
+		}
+		return 0, false, fmt.Errorf("unsupported QEMU VNC client message %d", msg[1])
+	default:
+		return 0, false, fmt.Errorf("unsupported VNC client message type %d", msgType)
+	}
+}
+
+// rfbFilteredConn filters what is written to the VNC server
+type rfbFilteredConn struct {
+	net.Conn
+	filter *rfbClientFilter
+}
+
+func newRFBFilteredConn(conn net.Conn, readOnly bool) net.Conn {
+	return &rfbFilteredConn{
+		Conn:   conn,
+		filter: newRFBClientFilter(conn, readOnly),
+	}
+}
+
+func (c *rfbFilteredConn) Write(p []byte) (int, error) {
+	return c.filter.Write(p)
+}
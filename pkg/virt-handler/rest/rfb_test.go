@@ -0,0 +1,109 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RFB client filter", func() {
+	const (
+		version       = "RFB 003.008\n"
+		securityNone  = "\x01"
+		exclusiveInit = "\x00"
+		sharedInit    = "\x01"
+	)
+
+	var (
+		keyEvent        = []byte{4, 1, 0, 0, 0, 0, 0, 0x61}
+		pointerEvent    = []byte{5, 1, 0, 10, 0, 20}
+		updateRequest   = []byte{3, 1, 0, 0, 0, 0, 0, 100, 0, 100}
+		setEncodings    = []byte{2, 0, 0, 2, 0, 0, 0, 0, 0, 0, 0, 7}
+		clientCutText   = append([]byte{6, 0, 0, 0, 0, 0, 0, 5}, []byte("hello")...)
+		qemuExtendedKey = []byte{255, 0, 0, 1, 0, 0, 0, 0x61, 0, 0, 0, 0x1e}
+		xvpReboot       = []byte{250, 0, 1, 3}
+	)
+
+	join := func(messages ...[]byte) []byte {
+		return bytes.Join(messages, nil)
+	}
+
+	handshake := func(clientInit string) []byte {
+		return []byte(version + securityNone + clientInit)
+	}
+
+	// writeInChunks writes the data like a client sending it in arbitrary pieces
+	writeInChunks := func(filter *rfbClientFilter, data []byte, chunkSize int) {
+		for len(data) > 0 {
+			n := chunkSize
+			if n > len(data) {
+				n = len(data)
+			}
+			written, err := filter.Write(data[:n])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(written).To(Equal(n))
+			data = data[n:]
+		}
+	}
+
+	DescribeTable("should drop the input of read-only clients", func(chunkSize int) {
+		server := &bytes.Buffer{}
+		filter := newRFBClientFilter(server, true)
+
+		writeInChunks(filter, join(handshake(exclusiveInit), setEncodings, updateRequest, keyEvent, pointerEvent,
+			clientCutText, qemuExtendedKey, xvpReboot, updateRequest), chunkSize)
+		Expect(server.Bytes()).To(Equal(join(handshake(sharedInit), setEncodings, updateRequest, updateRequest)))
+	},
+		Entry("written at once", 1024),
+		Entry("written byte by byte", 1),
+		Entry("written in uneven chunks", 7),
+	)
+
+	It("should pass the input of the client holding the display, sharing it", func() {
+		server := &bytes.Buffer{}
+		filter := newRFBClientFilter(server, false)
+
+		messages := join(updateRequest, keyEvent, pointerEvent, clientCutText)
+		writeInChunks(filter, join(handshake(exclusiveInit), messages), 5)
+		Expect(server.Bytes()).To(Equal(join(handshake(sharedInit), messages)))
+	})
+
+	It("should handle the VNC authentication and version 3.3 clients", func() {
+		server := &bytes.Buffer{}
+		filter := newRFBClientFilter(server, true)
+		authResponse := bytes.Repeat([]byte{0xaa}, rfbVNCAuthResponseLength)
+		writeInChunks(filter, join([]byte(version+"\x02"), authResponse, []byte(exclusiveInit), keyEvent), 3)
+		Expect(server.Bytes()).To(Equal(join([]byte(version+"\x02"), authResponse, []byte(sharedInit))))
+
+		server.Reset()
+		filter = newRFBClientFilter(server, true)
+		writeInChunks(filter, join([]byte("RFB 003.003\n"+exclusiveInit), keyEvent, updateRequest), 1024)
+		Expect(server.Bytes()).To(Equal(join([]byte("RFB 003.003\n"+sharedInit), updateRequest)))
+	})
+
+	It("should fail on unknown messages of read-only clients", func() {
+		filter := newRFBClientFilter(&bytes.Buffer{}, true)
+		_, err := filter.Write(join(handshake(sharedInit), []byte{42, 0, 0, 0}))
+		Expect(err).To(MatchError(ContainSubstring("unsupported VNC client message type 42")))
+	})
+})
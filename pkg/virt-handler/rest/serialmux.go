@@ -0,0 +1,158 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"io"
+	"net"
+	"sync"
+)
+
+// serialMuxClientBuffer is the number of reads of the console a client can lag behind before it is disconnected
+const serialMuxClientBuffer = 64
+
+// serialMux shares the single connection QEMU accepts to a serial console between clients. The output of the
+// console is sent to all of them, the input of the read-only clients is dropped.
+type serialMux struct {
+	conn    net.Conn
+	lock    sync.Mutex
+	clients map[*serialMuxConn]struct{}
+	closed  bool
+	done    chan struct{}
+}
+
+func newSerialMux(conn net.Conn) *serialMux {
+	return &serialMux{
+		conn:    conn,
+		clients: map[*serialMuxConn]struct{}{},
+		done:    make(chan struct{}),
+	}
+}
+
+// broadcast sends the output of the console to the clients, until the connection to the console is closed
+func (m *serialMux) broadcast() {
+	buf := make([]byte, 32*1024)
+	for {
+		n, err := m.conn.Read(buf)
+		if n > 0 {
+			data := append([]byte(nil), buf[:n]...)
+			m.lock.Lock()
+			for client := range m.clients {
+				select {
+				case client.output <- data:
+				default:
+					// Don't let a slow client hold the others back
+					m.remove(client)
+				}
+			}
+			m.lock.Unlock()
+		}
+		if err != nil {
+			m.close()
+			return
+		}
+	}
+}
+
+// attach returns a connection for a new client, or false if the connection to the console is closed
+func (m *serialMux) attach(readOnly bool) (net.Conn, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.closed {
+		return nil, false
+	}
+	client := &serialMuxConn{
+		Conn:     m.conn,
+		mux:      m,
+		output:   make(chan []byte, serialMuxClientBuffer),
+		readOnly: readOnly,
+	}
+	m.clients[client] = struct{}{}
+	return client, true
+}
+
+// detach removes the client, and closes the connection to the console after the last one
+func (m *serialMux) detach(client *serialMuxConn) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.remove(client)
+	if len(m.clients) == 0 && !m.closed {
+		m.closed = true
+		m.conn.Close()
+		close(m.done)
+	}
+}
+
+func (m *serialMux) close() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for client := range m.clients {
+		m.remove(client)
+	}
+	if !m.closed {
+		m.closed = true
+		m.conn.Close()
+		close(m.done)
+	}
+}
+
+func (m *serialMux) remove(client *serialMuxConn) {
+	if _, exists := m.clients[client]; exists {
+		delete(m.clients, client)
+		close(client.output)
+	}
+}
+
+// serialMuxConn is the connection of a client to a shared serial console
+type serialMuxConn struct {
+	net.Conn
+	mux       *serialMux
+	output    chan []byte
+	pending   []byte
+	readOnly  bool
+	closeOnce sync.Once
+}
+
+func (c *serialMuxConn) Read(p []byte) (int, error) {
+	if len(c.pending) == 0 {
+		data, ok := <-c.output
+		if !ok {
+			return 0, io.EOF
+		}
+		c.pending = data
+	}
+	n := copy(p, c.pending)
+	c.pending = c.pending[n:]
+	return n, nil
+}
+
+func (c *serialMuxConn) Write(p []byte) (int, error) {
+	if c.readOnly {
+		return len(p), nil
+	}
+	return c.Conn.Write(p)
+}
+
+func (c *serialMuxConn) Close() error {
+	c.closeOnce.Do(func() {
+		c.mux.detach(c)
+	})
+	return nil
+}
@@ -0,0 +1,107 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package rest
+
+import (
+	"io"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Serial console multiplexer", func() {
+	var (
+		console net.Conn
+		mux     *serialMux
+	)
+
+	BeforeEach(func() {
+		var muxSide net.Conn
+		console, muxSide = net.Pipe()
+		mux = newSerialMux(muxSide)
+		go mux.broadcast()
+	})
+
+	AfterEach(func() {
+		console.Close()
+	})
+
+	attach := func(readOnly bool) net.Conn {
+		conn, ok := mux.attach(readOnly)
+		Expect(ok).To(BeTrue())
+		return conn
+	}
+
+	read := func(conn net.Conn, length int) string {
+		buf := make([]byte, length)
+		_, err := io.ReadFull(conn, buf)
+		Expect(err).ToNot(HaveOccurred())
+		return string(buf)
+	}
+
+	It("should send the output of the console to all the clients", func() {
+		holder := attach(false)
+		observer := attach(true)
+
+		_, err := console.Write([]byte("login: "))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(read(holder, 7)).To(Equal("login: "))
+		Expect(read(observer, 7)).To(Equal("login: "))
+	})
+
+	It("should only send the input of the client holding the console", func() {
+		holder := attach(false)
+		observer := attach(true)
+
+		n, err := observer.Write([]byte("reboot\n"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(7))
+
+		go func() {
+			defer GinkgoRecover()
+			_, err := holder.Write([]byte("root\n"))
+			Expect(err).ToNot(HaveOccurred())
+		}()
+		Expect(read(console, 5)).To(Equal("root\n"))
+	})
+
+	It("should close the connection to the console after the last client", func() {
+		holder := attach(false)
+		observer := attach(true)
+
+		Expect(holder.Close()).To(Succeed())
+		Expect(mux.done).ToNot(BeClosed())
+		Expect(observer.Close()).To(Succeed())
+		Expect(mux.done).To(BeClosed())
+
+		_, ok := mux.attach(false)
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should disconnect the clients when the console is gone", func() {
+		observer := attach(true)
+		Expect(console.Close()).To(Succeed())
+
+		_, err := observer.Read(make([]byte, 1))
+		Expect(err).To(Equal(io.EOF))
+		Eventually(mux.done).Should(BeClosed())
+	})
+})
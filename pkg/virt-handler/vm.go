@@ -34,6 +34,7 @@ import (
 	"strings"
 	"time"
 
+	"golang.org/x/crypto/ssh"
 	"golang.org/x/sys/unix"
 	"k8s.io/apimachinery/pkg/util/errors"
 
@@ -71,9 +72,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 
+	consolesession "kubevirt.io/kubevirt/pkg/virt-handler/console-session"
 	container_disk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
 	device_manager "kubevirt.io/kubevirt/pkg/virt-handler/device-manager"
 	hotplug_volume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
+	hotplug_hostdevice "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-hostdevice"
 
 	ps "github.com/mitchellh/go-ps"
 
@@ -96,6 +99,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
 	"kubevirt.io/kubevirt/pkg/watchdog"
 )
 
@@ -192,6 +196,7 @@ func NewController(
 	migrationProxy migrationproxy.ProxyManager,
 	capabilities *nodelabellerapi.Capabilities,
 	hostCpuModel string,
+	consoleSessions *consolesession.Manager,
 ) *VirtualMachineController {
 
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "virt-handler-vm")
@@ -213,6 +218,7 @@ func NewController(
 		podIsolationDetector:        podIsolationDetector,
 		containerDiskMounter:        container_disk.NewMounter(podIsolationDetector, filepath.Join(virtPrivateDir, "container-disk-mount-state"), clusterConfig),
 		hotplugVolumeMounter:        hotplug_volume.NewVolumeMounter(filepath.Join(virtPrivateDir, "hotplug-volume-mount-state"), kubeletPodsDir),
+		hotplugHostDeviceManager:    hotplug_hostdevice.NewHostDeviceManager(),
 		clusterConfig:               clusterConfig,
 		virtLauncherFSRunDirPattern: "/proc/%d/root/var/run",
 		capabilities:                capabilities,
@@ -220,8 +226,13 @@ func NewController(
 		vmiExpectations:             controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectations()),
 		sriovHotplugExecutorPool:    executor.NewRateLimitedExecutorPool(executor.NewExponentialLimitedBackoffCreator()),
 		ioErrorRetryManager:         NewFailRetryManager("io-error-retry", 10*time.Second, 3*time.Minute, 30*time.Second),
+		consoleSessions:             consoleSessions,
 	}
 
+	consoleSessions.OnChange(func(vmi *v1.VirtualMachineInstance) {
+		c.updateFunc(nil, vmi)
+	})
+
 	vmiSourceInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc:    c.addFunc,
 		DeleteFunc: c.deleteFunc,
@@ -297,6 +308,7 @@ type VirtualMachineController struct {
 	podIsolationDetector     isolation.PodIsolationDetector
 	containerDiskMounter     container_disk.Mounter
 	hotplugVolumeMounter     hotplug_volume.VolumeMounter
+	hotplugHostDeviceManager hotplug_hostdevice.HostDeviceManager
 	clusterConfig            *virtconfig.ClusterConfig
 	sriovHotplugExecutorPool *executor.RateLimitedExecutorPool
 
@@ -310,6 +322,7 @@ type VirtualMachineController struct {
 	hostCpuModel                string
 	vmiExpectations             *controller.UIDTrackingControllerExpectations
 	ioErrorRetryManager         *FailRetryManager
+	consoleSessions             *consolesession.Manager
 }
 
 type virtLauncherCriticalSecurebootError struct {
@@ -915,6 +928,51 @@ func (d *VirtualMachineController) updateVolumeStatusesFromDomain(vmi *v1.Virtua
 	return hasHotplug
 }
 
+func (d *VirtualMachineController) updateHostDeviceStatusesFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || len(vmi.Status.HostDeviceStatus) == 0 {
+		return
+	}
+
+	attachedHostDevices := make(map[string]struct{})
+	for _, hostDevice := range domain.Spec.Devices.HostDevices {
+		if hostDevice.Alias != nil {
+			attachedHostDevices[hostDevice.Alias.GetName()] = struct{}{}
+		}
+	}
+	resourceNames := make(map[string]string)
+	for _, hostDevice := range vmi.Spec.Domain.Devices.HostDevices {
+		resourceNames[hostDevice.Name] = hostDevice.DeviceName
+	}
+
+	for i := range vmi.Status.HostDeviceStatus {
+		status := &vmi.Status.HostDeviceStatus[i]
+		_, attached := attachedHostDevices[generic.AliasPrefix+status.Name]
+		switch status.Phase {
+		case v1.HostDeviceAttachedToNode:
+			if attached {
+				status.Phase = v1.HostDeviceReady
+				status.Message = "The host device is attached to the domain"
+				continue
+			}
+			resourceName, exists := resourceNames[status.Name]
+			if status.Address != "" || !exists {
+				continue
+			}
+			address, err := d.hotplugHostDeviceManager.Address(vmi, status, resourceName)
+			if err != nil {
+				log.Log.Object(vmi).Reason(err).Errorf("failed to find the address of host device %s", status.Name)
+				continue
+			}
+			status.Address = address
+		case v1.HostDeviceDetaching:
+			if !attached {
+				status.Phase = v1.HostDeviceDetached
+				status.Message = "The host device is detached from the domain"
+			}
+		}
+	}
+}
+
 func (d *VirtualMachineController) updateGuestInfoFromDomain(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
 
 	if domain == nil {
@@ -1277,8 +1335,11 @@ func (d *VirtualMachineController) updateVMIStatus(origVMI *v1.VirtualMachineIns
 	d.setMigrationProgressStatus(vmi, domain)
 	d.updateGuestInfoFromDomain(vmi, domain)
 	d.updateVolumeStatusesFromDomain(vmi, domain)
+	d.updateHostDeviceStatusesFromDomain(vmi, domain)
 	d.updateFSFreezeStatus(vmi, domain)
 	d.updateMachineType(vmi, domain)
+	d.updateSSHHostKeys(vmi, domain)
+	vmi.Status.ConsoleSessions = d.consoleSessions.Sessions(vmi.UID)
 	err = d.netStat.UpdateStatus(vmi, domain)
 	if err != nil {
 		return err
@@ -2359,8 +2420,11 @@ func (d *VirtualMachineController) checkNetworkInterfacesForMigration(vmi *v1.Vi
 	if netvmispec.IsPodNetworkWithMasqueradeBindingInterface(vmi.Spec.Networks, ifaces) {
 		return nil
 	}
+	if netvmispec.IsPodNetworkWithPasstBindingInterface(vmi.Spec.Networks, ifaces) {
+		return nil
+	}
 
-	return fmt.Errorf("cannot migrate VMI which does not use masquerade to connect to the pod network or bridge with %s VM annotation", v1.AllowPodBridgeNetworkLiveMigrationAnnotation)
+	return fmt.Errorf("cannot migrate VMI which does not use masquerade or passt to connect to the pod network or bridge with %s VM annotation", v1.AllowPodBridgeNetworkLiveMigrationAnnotation)
 }
 
 func (d *VirtualMachineController) checkVolumesForMigration(vmi *v1.VirtualMachineInstance) (blockMigrate bool, err error) {
@@ -2685,7 +2749,7 @@ func (d *VirtualMachineController) vmUpdateHelperMigrationTarget(origVMI *v1.Vir
 		}
 	}
 
-	options := virtualMachineOptions(nil, 0, nil, d.capabilities, disksInfo, d.clusterConfig.ExpandDisksEnabled())
+	options := virtualMachineOptions(nil, 0, nil, d.capabilities, disksInfo, d.clusterConfig.ExpandDisksEnabled(), d.clusterConfig.SerialConsoleLogEnabled())
 	if err := client.SyncMigrationTarget(vmi, options); err != nil {
 		return fmt.Errorf("syncing migration target failed: %v", err)
 	}
@@ -2934,6 +2998,10 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 			return err
 		}
 
+		if err := d.hotplugHostDevices(vmi); err != nil {
+			return err
+		}
+
 		if err := d.getMemoryDump(vmi); err != nil {
 			return err
 		}
@@ -2950,7 +3018,7 @@ func (d *VirtualMachineController) vmUpdateHelperDefault(origVMI *v1.VirtualMach
 	smbios := d.clusterConfig.GetSMBIOS()
 	period := d.clusterConfig.GetMemBalloonStatsPeriod()
 
-	options := virtualMachineOptions(smbios, period, preallocatedVolumes, d.capabilities, disksInfo, d.clusterConfig.ExpandDisksEnabled())
+	options := virtualMachineOptions(smbios, period, preallocatedVolumes, d.capabilities, disksInfo, d.clusterConfig.ExpandDisksEnabled(), d.clusterConfig.SerialConsoleLogEnabled())
 
 	err = client.SyncVirtualMachine(vmi, options)
 	if err != nil {
@@ -3027,6 +3095,60 @@ func (d *VirtualMachineController) hotplugSriovInterfacesCommand(vmi *v1.Virtual
 	return nil
 }
 
+func (d *VirtualMachineController) hotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
+	const errMsgPrefix = "failed to hot-plug host devices"
+
+	if len(vmi.Status.HostDeviceStatus) == 0 {
+		return nil
+	}
+
+	launcherRes, err := d.podIsolationDetector.Detect(vmi)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	syncDevices := false
+	for i := range vmi.Status.HostDeviceStatus {
+		status := &vmi.Status.HostDeviceStatus[i]
+		switch status.Phase {
+		case v1.HostDeviceAttachedToNode:
+			if status.Address == "" {
+				continue
+			}
+			if err := d.hotplugHostDeviceManager.Expose(vmi, launcherRes, status); err != nil {
+				return fmt.Errorf("%s: %v", errMsgPrefix, err)
+			}
+			syncDevices = true
+		case v1.HostDeviceDetaching:
+			syncDevices = true
+		case v1.HostDeviceDetached:
+			if err := d.hotplugHostDeviceManager.Withdraw(vmi, launcherRes, status); err != nil {
+				return fmt.Errorf("%s: %v", errMsgPrefix, err)
+			}
+		}
+	}
+	if !syncDevices {
+		return nil
+	}
+
+	client, err := d.getVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	if err := isolation.AdjustQemuProcessMemoryLimits(d.podIsolationDetector, vmi, d.clusterConfig.GetConfig().AdditionalGuestMemoryOverheadRatio); err != nil {
+		d.recorder.Event(vmi, k8sv1.EventTypeWarning, err.Error(), err.Error())
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	log.Log.V(3).Object(vmi).Info("sending hot-plug host-devices command")
+	if err := client.HotplugHostDevices(vmi); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	return nil
+}
+
 func memoryDumpPath(volumeStatus v1.VolumeStatus) string {
 	target := hotplugdisk.GetVolumeMountDir(volumeStatus.Name)
 	dumpPath := filepath.Join(target, volumeStatus.MemoryDumpVolume.TargetFileName)
@@ -3289,7 +3411,7 @@ func (d *VirtualMachineController) reportDedicatedCPUSetForMigratingVMI(vmi *v1.
 }
 
 func (d *VirtualMachineController) reportTargetTopologyForMigratingVMI(vmi *v1.VirtualMachineInstance) error {
-	options := virtualMachineOptions(nil, 0, nil, d.capabilities, map[string]*containerdisk.DiskInfo{}, d.clusterConfig.ExpandDisksEnabled())
+	options := virtualMachineOptions(nil, 0, nil, d.capabilities, map[string]*containerdisk.DiskInfo{}, d.clusterConfig.ExpandDisksEnabled(), d.clusterConfig.SerialConsoleLogEnabled())
 	topology, err := json.Marshal(options.Topology)
 	if err != nil {
 		return err
@@ -3326,3 +3448,27 @@ func (d *VirtualMachineController) updateMachineType(vmi *v1.VirtualMachineInsta
 		vmi.Status.Machine = &v1.Machine{Type: domain.Spec.OS.Type.Machine}
 	}
 }
+
+// updateSSHHostKeys reports the host keys of the SSH server of the guest, read by virt-launcher when an ephemeral SSH key is added
+func (d *VirtualMachineController) updateSSHHostKeys(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || vmi == nil || domain.Spec.Metadata.KubeVirt.SSHHostKeys == nil {
+		return
+	}
+	var hostKeys []v1.SSHHostKey
+	for _, line := range strings.Split(domain.Spec.Metadata.KubeVirt.SSHHostKeys.Keys, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			log.Log.Object(vmi).Reason(err).Warning("Ignoring an invalid SSH host key of the guest")
+			continue
+		}
+		hostKeys = append(hostKeys, v1.SSHHostKey{
+			Type:        key.Type(),
+			PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))),
+			Fingerprint: ssh.FingerprintSHA256(key),
+		})
+	}
+	vmi.Status.SSHHostKeys = hostKeys
+}
@@ -21,6 +21,8 @@ package virthandler
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	"crypto/tls"
 	"errors"
 	"fmt"
@@ -31,6 +33,7 @@ import (
 	"sync"
 	"time"
 
+	"golang.org/x/crypto/ssh"
 	"k8s.io/utils/pointer"
 
 	"kubevirt.io/kubevirt/pkg/safepath"
@@ -47,6 +50,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util"
 	container_disk "kubevirt.io/kubevirt/pkg/virt-handler/container-disk"
 	hotplug_volume "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-disk"
+	hotplug_hostdevice "kubevirt.io/kubevirt/pkg/virt-handler/hotplug-hostdevice"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -76,9 +80,11 @@ import (
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtcache "kubevirt.io/kubevirt/pkg/virt-handler/cache"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	consolesession "kubevirt.io/kubevirt/pkg/virt-handler/console-session"
 	"kubevirt.io/kubevirt/pkg/virt-handler/isolation"
 	migrationproxy "kubevirt.io/kubevirt/pkg/virt-handler/migration-proxy"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice/generic"
 	"kubevirt.io/kubevirt/pkg/watchdog"
 )
 
@@ -103,6 +109,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 	var mockIsolationResult *isolation.MockIsolationResult
 	var mockContainerDiskMounter *container_disk.MockMounter
 	var mockHotplugVolumeMounter *hotplug_volume.MockVolumeMounter
+	var mockHotplugHostDeviceManager *hotplug_hostdevice.MockHostDeviceManager
 
 	var vmiFeeder *testutils.VirtualMachineFeeder
 	var domainFeeder *testutils.DomainFeeder
@@ -203,6 +210,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 
 		mockContainerDiskMounter = container_disk.NewMockMounter(ctrl)
 		mockHotplugVolumeMounter = hotplug_volume.NewMockVolumeMounter(ctrl)
+		mockHotplugHostDeviceManager = hotplug_hostdevice.NewMockHostDeviceManager(ctrl)
 
 		migrationProxy := migrationproxy.NewMigrationProxyManager(tlsConfig, tlsConfig, config)
 		controller = NewController(recorder,
@@ -223,8 +231,10 @@ var _ = Describe("VirtualMachineInstance", func() {
 			migrationProxy,
 			nil,
 			"",
+			consolesession.NewManager(),
 		)
 		controller.hotplugVolumeMounter = mockHotplugVolumeMounter
+		controller.hotplugHostDeviceManager = mockHotplugHostDeviceManager
 		controller.virtLauncherFSRunDirPattern = filepath.Join(shareDir, "%d")
 
 		controller.netConf = &netConfStub{}
@@ -719,6 +729,67 @@ var _ = Describe("VirtualMachineInstance", func() {
 			controller.Execute()
 		})
 
+		It("should report the SSH host keys read by virt-launcher on the VMI status", func() {
+			publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).ToNot(HaveOccurred())
+			hostKey, err := ssh.NewPublicKey(publicKey)
+			Expect(err).ToNot(HaveOccurred())
+			authorizedKey := strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey)))
+
+			vmi := api2.NewMinimalVMI("testvmi")
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.Metadata.KubeVirt.SSHHostKeys = &api.SSHHostKeysMetadata{
+				Keys: authorizedKey + " root@guest\ninvalid key\n",
+			}
+
+			controller.updateSSHHostKeys(vmi, domain)
+
+			Expect(vmi.Status.SSHHostKeys).To(ConsistOf(v1.SSHHostKey{
+				Type:        ssh.KeyAlgoED25519,
+				PublicKey:   authorizedKey,
+				Fingerprint: ssh.FingerprintSHA256(hostKey),
+			}))
+		})
+
+		It("should report the console sessions on the VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Running
+			vmi = addActivePods(vmi, podTestUUID, host)
+			vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{
+				{
+					Type:   v1.VirtualMachineInstanceIsMigratable,
+					Status: k8sv1.ConditionTrue,
+				},
+			}
+
+			mockWatchdog.CreateFile(vmi)
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+
+			vmiFeeder.Add(vmi)
+			domainFeeder.Add(domain)
+
+			_, err := controller.consoleSessions.Connect(vmi, consolesession.Console{Type: v1.VNCConsoleType}, "alice", false, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			client.EXPECT().SyncVirtualMachine(vmi, gomock.Any())
+			vmiInterface.EXPECT().Update(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, obj interface{}) (*v1.VirtualMachineInstance, error) {
+				vmi := obj.(*v1.VirtualMachineInstance)
+				Expect(vmi.Status.ConsoleSessions).To(HaveLen(1))
+				Expect(vmi.Status.ConsoleSessions[0].Console).To(Equal(v1.VNCConsoleType))
+				Expect(vmi.Status.ConsoleSessions[0].User).To(Equal("alice"))
+				Expect(vmi.Status.ConsoleSessions[0].ReadOnly).To(BeFalse())
+				return vmi, nil
+			})
+			mockHotplugVolumeMounter.EXPECT().Unmount(gomock.Any()).Return(nil)
+			mockHotplugVolumeMounter.EXPECT().Mount(gomock.Any()).Return(nil)
+
+			controller.Execute()
+		})
+
 		It("should update from Scheduled to Running, if it sees a running Domain", func() {
 			vmi := api2.NewMinimalVMI("testvmi")
 			vmi.UID = vmiTestUUID
@@ -1346,6 +1417,54 @@ var _ = Describe("VirtualMachineInstance", func() {
 			})
 		})
 
+		Context("hotplug host device status", func() {
+			var vmi *v1.VirtualMachineInstance
+			var domain *api.Domain
+
+			BeforeEach(func() {
+				vmi = api2.NewMinimalVMI("testvmi")
+				vmi.UID = vmiTestUUID
+				vmi.Status.Phase = v1.Running
+				vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "gpu", DeviceName: "example.org/gpu", Hotpluggable: true}}
+				domain = api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Running
+			})
+
+			It("should report the address of the device allocated to the attachment pod", func() {
+				vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{{Name: "gpu", Phase: v1.HostDeviceAttachedToNode, AttachPodUID: "1234"}}
+				mockHotplugHostDeviceManager.EXPECT().Address(vmi, &vmi.Status.HostDeviceStatus[0], "example.org/gpu").Return("0000:81:00.0", nil)
+				controller.updateHostDeviceStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.HostDeviceStatus[0].Phase).To(Equal(v1.HostDeviceAttachedToNode))
+				Expect(vmi.Status.HostDeviceStatus[0].Address).To(Equal("0000:81:00.0"))
+			})
+
+			It("should move to Ready once the device is attached to the domain", func() {
+				vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{{Name: "gpu", Phase: v1.HostDeviceAttachedToNode, Address: "0000:81:00.0"}}
+				domain.Spec.Devices.HostDevices = []api.HostDevice{{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + "gpu")}}
+				controller.updateHostDeviceStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.HostDeviceStatus[0].Phase).To(Equal(v1.HostDeviceReady))
+			})
+
+			It("should move to Detached once the device is gone from the domain", func() {
+				vmi.Spec.Domain.Devices.HostDevices = nil
+				vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{{Name: "gpu", Phase: v1.HostDeviceDetaching, Address: "0000:81:00.0"}}
+				controller.updateHostDeviceStatusesFromDomain(vmi, domain)
+				Expect(vmi.Status.HostDeviceStatus[0].Phase).To(Equal(v1.HostDeviceDetached))
+			})
+
+			It("should withdraw detached devices without syncing the domain", func() {
+				vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{{Name: "old", Phase: v1.HostDeviceDetached, Address: "0000:82:00.0"}}
+				mockHotplugHostDeviceManager.EXPECT().Withdraw(vmi, mockIsolationResult, &vmi.Status.HostDeviceStatus[0]).Return(nil)
+				Expect(controller.hotplugHostDevices(vmi)).To(Succeed())
+			})
+
+			It("should fail when the device can not be exposed to the virt-launcher pod", func() {
+				vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{{Name: "gpu", Phase: v1.HostDeviceAttachedToNode, Address: "0000:81:00.0"}}
+				mockHotplugHostDeviceManager.EXPECT().Expose(vmi, mockIsolationResult, &vmi.Status.HostDeviceStatus[0]).Return(fmt.Errorf("no IOMMU group"))
+				Expect(controller.hotplugHostDevices(vmi)).To(MatchError(ContainSubstring("no IOMMU group")))
+			})
+		})
+
 		Context("hotplug status events", func() {
 			It("should have hashotplug false without hotplugged volumes", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
@@ -2471,7 +2590,7 @@ var _ = Describe("VirtualMachineInstance", func() {
 			conditionManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
 			controller.updateLiveMigrationConditions(vmi, conditionManager)
 
-			testutils.ExpectEvent(recorder, fmt.Sprintf("cannot migrate VMI which does not use masquerade to connect to the pod network or bridge with %s VM annotation", v1.AllowPodBridgeNetworkLiveMigrationAnnotation))
+			testutils.ExpectEvent(recorder, fmt.Sprintf("cannot migrate VMI which does not use masquerade or passt to connect to the pod network or bridge with %s VM annotation", v1.AllowPodBridgeNetworkLiveMigrationAnnotation))
 		})
 		Context("with AllowLiveMigrationBridgePodNetwork annotation", func() {
 			It("should allow to live-migrate if the VMI use bridge to connect to the pod network", func() {
@@ -2578,6 +2697,23 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(condition.Reason).To(Equal(v1.VirtualMachineInstanceReasonPRNotMigratable))
 		})
 
+		DescribeTable("with persistent EFI should be allowed to live-migrate", func(accessMode k8sv1.PersistentVolumeAccessMode) {
+			controller.clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+				VMStateStorageAccessMode: accessMode,
+			})
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{EFI: &v1.EFI{Persistent: pointer.Bool(true)}},
+			}
+
+			condition, _ := controller.calculateLiveMigrationCondition(vmi)
+			Expect(condition.Type).To(Equal(v1.VirtualMachineInstanceIsMigratable))
+			Expect(condition.Status).To(Equal(k8sv1.ConditionTrue))
+		},
+			Entry("on ReadWriteMany backend storage", k8sv1.ReadWriteMany),
+			Entry("on ReadWriteOnce backend storage", k8sv1.ReadWriteOnce),
+		)
+
 		Context("with network configuration", func() {
 			It("should block migration for bridge binding assigned to the pod network", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
@@ -2625,6 +2761,29 @@ var _ = Describe("VirtualMachineInstance", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 
+			It("should not block migration for passt binding assigned to the pod network", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				interface_name := "interface_name"
+
+				vmi.Spec.Networks = []v1.Network{
+					{
+						Name:          interface_name,
+						NetworkSource: v1.NetworkSource{Pod: &v1.PodNetwork{}},
+					},
+				}
+				vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+					{
+						Name: interface_name,
+						InterfaceBindingMethod: v1.InterfaceBindingMethod{
+							Passt: &v1.InterfacePasst{},
+						},
+					},
+				}
+
+				err := controller.checkNetworkInterfacesForMigration(vmi)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
 			It("should not block migration for bridge binding assigned to a multus network", func() {
 				vmi := api2.NewMinimalVMI("testvmi")
 				interface_name := "interface_name"
@@ -69,6 +69,10 @@ type AccessCredentialManager struct {
 
 	domainModifyLock *sync.Mutex
 	metadataCache    *metadata.Cache
+
+	// serializes the updates of the authorized_keys files, made by the secret watcher and for the ephemeral keys
+	authorizedKeysLock sync.Mutex
+	now                func() time.Time
 }
 
 func NewManager(connection cli.Connection, domainModifyLock *sync.Mutex, metadataCache *metadata.Cache) *AccessCredentialManager {
@@ -78,6 +82,7 @@ func NewManager(connection cli.Connection, domainModifyLock *sync.Mutex, metadat
 		resyncCheckIntervalSeconds: 15,
 		domainModifyLock:           domainModifyLock,
 		metadataCache:              metadataCache,
+		now:                        time.Now,
 	}
 }
 
@@ -307,6 +312,17 @@ func (l *AccessCredentialManager) pingAgent(domName string) error {
 }
 
 func (l *AccessCredentialManager) agentWriteAuthorizedKeys(domName string, user string, desiredAuthorizedKeys string) error {
+	return l.agentUpdateAuthorizedKeys(domName, user, func(curAuthorizedKeys string) string {
+		// the ephemeral keys are kept until they expire
+		return appendAuthorizedKeys(desiredAuthorizedKeys, activeEphemeralKeys(curAuthorizedKeys, l.now()))
+	})
+}
+
+// agentUpdateAuthorizedKeys replaces the authorized_keys file of the user with what update returns for its current contents
+func (l *AccessCredentialManager) agentUpdateAuthorizedKeys(domName string, user string, update func(curAuthorizedKeys string) string) error {
+	l.authorizedKeysLock.Lock()
+	defer l.authorizedKeysLock.Unlock()
+
 	curAuthorizedKeys := ""
 	fileExists := true
 
@@ -332,6 +348,7 @@ func (l *AccessCredentialManager) agentWriteAuthorizedKeys(domName string, user
 	// ######
 	// Step 3. Write authorized_keys file if changes exist
 	// ######
+	desiredAuthorizedKeys := update(curAuthorizedKeys)
 	// only update if the updated string is not equal to the current contents on the guest.
 	if curAuthorizedKeys != desiredAuthorizedKeys {
 		err = l.writeGuestFile(desiredAuthorizedKeys, domName, filePath, fmt.Sprintf("%s:%s", uid, gid), fileExists)
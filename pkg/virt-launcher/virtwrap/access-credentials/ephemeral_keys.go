@@ -0,0 +1,194 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package accesscredentials
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/util"
+)
+
+const (
+	// ephemeralKeyMarker is the comment of the ephemeral keys in the authorized_keys files, followed by the Unix time they expire
+	ephemeralKeyMarker = "kubevirt-ephemeral-key:expires="
+	// expiryTimeOption makes sshd refuse the ephemeral keys once they expired, in the time zone of the guest
+	expiryTimeOption = "expiry-time="
+	expiryTimeFormat = "200601021504"
+)
+
+var sshHostKeyPaths = []string{
+	"/etc/ssh/ssh_host_ed25519_key.pub",
+	"/etc/ssh/ssh_host_ecdsa_key.pub",
+	"/etc/ssh/ssh_host_rsa_key.pub",
+}
+
+// AddEphemeralSSHKey authorizes the public key for the user of the guest until the ttl elapsed.
+// sshd refuses the key once it expired, the expired keys are removed from the file the next time it is updated.
+// It also reports the host keys of the SSH server of the guest, so that the client can verify it.
+func (l *AccessCredentialManager) AddEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string, ttl time.Duration) error {
+	domName := util.VMINamespaceKeyFunc(vmi)
+
+	l.reportSSHHostKeys(domName)
+
+	utcOffset, err := l.agentGetUTCOffset(domName)
+	if err != nil {
+		return fmt.Errorf("failed to get the time zone of the guest: %v", err)
+	}
+
+	expires := l.now().Add(ttl)
+	err = l.agentUpdateAuthorizedKeys(domName, user, func(curAuthorizedKeys string) string {
+		authorizedKeys := pruneEphemeralKeys(curAuthorizedKeys, l.now(), publicKey)
+		return appendAuthorizedKeys(authorizedKeys, []string{ephemeralKeyLine(publicKey, expires, utcOffset)})
+	})
+	if err != nil {
+		return fmt.Errorf("failed to authorize the ephemeral SSH key of user %s: %v", user, err)
+	}
+	return nil
+}
+
+// RemoveEphemeralSSHKey removes a public key authorized by AddEphemeralSSHKey before it expires
+func (l *AccessCredentialManager) RemoveEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string) error {
+	domName := util.VMINamespaceKeyFunc(vmi)
+
+	err := l.agentUpdateAuthorizedKeys(domName, user, func(curAuthorizedKeys string) string {
+		return pruneEphemeralKeys(curAuthorizedKeys, l.now(), publicKey)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to remove the ephemeral SSH key of user %s: %v", user, err)
+	}
+	return nil
+}
+
+// agentGetUTCOffset returns the offset of the time zone of the guest to UTC, east of UTC is positive
+func (l *AccessCredentialManager) agentGetUTCOffset(domName string) (time.Duration, error) {
+	output, err := l.virConn.QemuAgentCommand(`{"execute":"guest-get-timezone"}`, domName)
+	if err != nil {
+		return 0, err
+	}
+	timezone := struct {
+		Return struct {
+			Offset int `json:"offset"`
+		} `json:"return"`
+	}{}
+	if err := json.Unmarshal([]byte(output), &timezone); err != nil {
+		return 0, err
+	}
+	return time.Duration(timezone.Return.Offset) * time.Second, nil
+}
+
+func (l *AccessCredentialManager) reportSSHHostKeys(domName string) {
+	var keys []string
+	for _, path := range sshHostKeyPaths {
+		key, err := l.readGuestFile(domName, path)
+		if err != nil {
+			log.Log.V(4).Infof("Unable to read the SSH host key %s: %v", path, err)
+			continue
+		}
+		if key = strings.TrimSpace(key); key != "" {
+			keys = append(keys, key)
+		}
+	}
+	l.metadataCache.SSHHostKeys.Store(api.SSHHostKeysMetadata{Keys: strings.Join(keys, "\n")})
+}
+
+// ephemeralKeyLine is the authorized_keys line of an ephemeral key, the comment of the key is replaced by the marker.
+// sshd reads the expiry-time option in the time zone of the guest and to the minute, it is rounded up to the next
+// minute for the key not to be refused before the ttl elapsed.
+func ephemeralKeyLine(publicKey string, expires time.Time, utcOffset time.Duration) string {
+	expiryTime := expires.UTC().Add(utcOffset)
+	if truncated := expiryTime.Truncate(time.Minute); truncated.Before(expiryTime) {
+		expiryTime = truncated.Add(time.Minute)
+	}
+	return fmt.Sprintf(`%s"%s" %s %s%d`, expiryTimeOption, expiryTime.Format(expiryTimeFormat), keyOf(publicKey), ephemeralKeyMarker, expires.Unix())
+}
+
+// keyFields returns the fields of an authorized_keys line, without the expiry-time option of the ephemeral keys
+func keyFields(line string) []string {
+	fields := strings.Fields(line)
+	if len(fields) > 0 && strings.HasPrefix(fields[0], expiryTimeOption) {
+		fields = fields[1:]
+	}
+	return fields
+}
+
+// keyOf returns the type and the base64 encoded key of an authorized_keys line without other options
+func keyOf(line string) string {
+	fields := keyFields(line)
+	if len(fields) > 2 {
+		fields = fields[:2]
+	}
+	return strings.Join(fields, " ")
+}
+
+// ephemeralKeyExpiration returns when the key of an authorized_keys line expires, if it is an ephemeral key
+func ephemeralKeyExpiration(line string) (time.Time, bool) {
+	fields := keyFields(line)
+	if len(fields) != 3 || !strings.HasPrefix(fields[2], ephemeralKeyMarker) {
+		return time.Time{}, false
+	}
+	seconds, err := strconv.ParseInt(strings.TrimPrefix(fields[2], ephemeralKeyMarker), 10, 64)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return time.Unix(seconds, 0), true
+}
+
+// activeEphemeralKeys returns the lines of the ephemeral keys of authorizedKeys which didn't expire
+func activeEphemeralKeys(authorizedKeys string, now time.Time) []string {
+	var lines []string
+	for _, line := range strings.Split(authorizedKeys, "\n") {
+		if expires, isEphemeral := ephemeralKeyExpiration(line); isEphemeral && now.Before(expires) {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// pruneEphemeralKeys removes from authorizedKeys the ephemeral keys which expired, and the ephemeral key publicKey if set
+func pruneEphemeralKeys(authorizedKeys string, now time.Time, publicKey string) string {
+	var lines []string
+	for _, line := range strings.Split(authorizedKeys, "\n") {
+		if expires, isEphemeral := ephemeralKeyExpiration(line); isEphemeral {
+			if !now.Before(expires) || (publicKey != "" && keyOf(line) == keyOf(publicKey)) {
+				continue
+			}
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func appendAuthorizedKeys(authorizedKeys string, lines []string) string {
+	if len(lines) == 0 {
+		return authorizedKeys
+	}
+	if authorizedKeys != "" && !strings.HasSuffix(authorizedKeys, "\n") {
+		authorizedKeys += "\n"
+	}
+	return authorizedKeys + strings.Join(lines, "\n") + "\n"
+}
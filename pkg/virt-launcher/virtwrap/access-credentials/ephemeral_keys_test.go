@@ -0,0 +1,99 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package accesscredentials
+
+import (
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ephemeral SSH keys", func() {
+	const (
+		staticKey = "ssh-rsa AAAAstatic user@host"
+		key1      = "ecdsa-sha2-nistp256 AAAAkey1"
+		key2      = "ecdsa-sha2-nistp256 AAAAkey2"
+	)
+
+	now := time.Unix(1700000000, 0)
+	ephemeral := func(key string, expires time.Time) string {
+		return ephemeralKeyLine(key, expires, 0)
+	}
+
+	It("should replace the comment of the key with the expiry time and the expiration marker", func() {
+		Expect(ephemeralKeyLine(key1+" jdoe@laptop\n", now, 0)).To(Equal(
+			fmt.Sprintf(`expiry-time="202311142214" %s %s%d`, key1, ephemeralKeyMarker, now.Unix())))
+
+		Expect(keyOf(ephemeral(key1, now))).To(Equal(key1))
+		expires, isEphemeral := ephemeralKeyExpiration(ephemeral(key1, now))
+		Expect(isEphemeral).To(BeTrue())
+		Expect(expires.Equal(now)).To(BeTrue())
+	})
+
+	DescribeTable("should write the expiry time in the time zone of the guest", func(expires time.Time, utcOffset time.Duration, expiryTime string) {
+		Expect(ephemeralKeyLine(key1, expires, utcOffset)).To(HavePrefix(`expiry-time="` + expiryTime + `" `))
+	},
+		Entry("rounded up to the next minute", now, time.Duration(0), "202311142214"),
+		Entry("not rounded on a whole minute", now.Add(40*time.Second), time.Duration(0), "202311142214"),
+		Entry("east of UTC", now, 2*time.Hour, "202311150014"),
+		Entry("west of UTC", now, -5*time.Hour-30*time.Minute, "202311141644"),
+	)
+
+	DescribeTable("should not take as ephemeral", func(line string) {
+		_, isEphemeral := ephemeralKeyExpiration(line)
+		Expect(isEphemeral).To(BeFalse())
+	},
+		Entry("a key with another comment", staticKey),
+		Entry("a key without comment", key1),
+		Entry("a marker without expiration", key1+" "+ephemeralKeyMarker),
+		Entry("an empty line", ""),
+	)
+
+	It("should keep the ephemeral keys which didn't expire", func() {
+		authorizedKeys := staticKey + "\n" +
+			ephemeral(key1, now.Add(time.Minute)) + "\n" +
+			ephemeral(key2, now) + "\n"
+
+		Expect(activeEphemeralKeys(authorizedKeys, now)).To(ConsistOf(ephemeral(key1, now.Add(time.Minute))))
+	})
+
+	It("should prune the expired ephemeral keys and the removed key", func() {
+		authorizedKeys := staticKey + "\n" +
+			ephemeral(key1, now.Add(time.Minute)) + "\n" +
+			ephemeral(key2, now.Add(-time.Second)) + "\n"
+
+		Expect(pruneEphemeralKeys(authorizedKeys, now, "")).To(Equal(staticKey + "\n" + ephemeral(key1, now.Add(time.Minute)) + "\n"))
+		Expect(pruneEphemeralKeys(authorizedKeys, now, key1+" comment")).To(Equal(staticKey + "\n"))
+	})
+
+	It("should not remove a static key matching the removed key", func() {
+		Expect(pruneEphemeralKeys(key1+" jdoe@laptop\n", now, key1)).To(Equal(key1 + " jdoe@laptop\n"))
+	})
+
+	DescribeTable("should append keys", func(authorizedKeys string, expected string) {
+		Expect(appendAuthorizedKeys(authorizedKeys, []string{key1})).To(Equal(expected))
+	},
+		Entry("to an empty file", "", key1+"\n"),
+		Entry("after a last line without newline", staticKey, staticKey+"\n"+key1+"\n"),
+		Entry("after a last line with newline", staticKey+"\n", staticKey+"\n"+key1+"\n"),
+	)
+})
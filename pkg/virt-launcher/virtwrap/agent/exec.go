@@ -23,8 +23,35 @@ type execStatusReturnData struct {
 	Exited   bool   `json:"exited"`
 	ExitCode int    `json:"exitcode"`
 	OutData  string `json:"out-data"`
+	ErrData  string `json:"err-data"`
+	// The guest agent caps the captured output, it reports when it dropped the rest
+	OutTruncated bool `json:"out-truncated"`
+	ErrTruncated bool `json:"err-truncated"`
 }
 
+type guestExecCommand struct {
+	Execute   string             `json:"execute"`
+	Arguments guestExecArguments `json:"arguments"`
+}
+
+type guestExecArguments struct {
+	Path          string   `json:"path"`
+	Arg           []string `json:"arg,omitempty"`
+	InputData     string   `json:"input-data,omitempty"`
+	CaptureOutput bool     `json:"capture-output"`
+}
+
+// GuestExecResult is the outcome of a command run by the guest agent
+type GuestExecResult struct {
+	ExitCode        int
+	StdOut          []byte
+	StdErr          []byte
+	StdOutTruncated bool
+	StdErrTruncated bool
+}
+
+const guestExecStatusInterval = 250 * time.Millisecond
+
 // ExecExitCode returned at non-zero return codes
 type ExecExitCode struct {
 	ExitCode int
@@ -104,3 +131,74 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 
 	return stdOut, nil
 }
+
+// GuestExecWithInput sends the provided command, args and stdin to the guest agent for execution, and waits for it to exit.
+// Unlike GuestExec, a non-zero exit code is not an error, and the stderr is returned along with the stdout.
+func GuestExecWithInput(virConn cli.Connection, domName string, command string, args []string, stdin []byte, timeoutSeconds int32) (*GuestExecResult, error) {
+	execCmd := guestExecCommand{
+		Execute: "guest-exec",
+		Arguments: guestExecArguments{
+			Path:          command,
+			Arg:           args,
+			CaptureOutput: true,
+		},
+	}
+	if len(stdin) > 0 {
+		execCmd.Arguments.InputData = base64.StdEncoding.EncodeToString(stdin)
+	}
+	cmdExec, err := json.Marshal(execCmd)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := virConn.QemuAgentCommand(string(cmdExec), domName)
+	if err != nil {
+		return nil, err
+	}
+	execRes := &execReturn{}
+	if err := json.Unmarshal([]byte(output), execRes); err != nil {
+		return nil, err
+	}
+	if execRes.Return.Pid <= 0 {
+		return nil, fmt.Errorf("Invalid pid [%d] returned from qemu agent: %s", execRes.Return.Pid, output)
+	}
+
+	statusCheck := time.NewTicker(guestExecStatusInterval)
+	defer statusCheck.Stop()
+	checkUntil := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+
+	cmdExecStatus := fmt.Sprintf(`{"execute": "guest-exec-status", "arguments": { "pid": %d } }`, execRes.Return.Pid)
+	for {
+		output, err := virConn.QemuAgentCommand(cmdExecStatus, domName)
+		if err != nil {
+			return nil, err
+		}
+		execStatusRes := &execStatusReturn{}
+		if err := json.Unmarshal([]byte(output), execStatusRes); err != nil {
+			return nil, err
+		}
+
+		if execStatusRes.Return.Exited {
+			stdOut, err := base64.StdEncoding.DecodeString(execStatusRes.Return.OutData)
+			if err != nil {
+				return nil, err
+			}
+			stdErr, err := base64.StdEncoding.DecodeString(execStatusRes.Return.ErrData)
+			if err != nil {
+				return nil, err
+			}
+			return &GuestExecResult{
+				ExitCode:        execStatusRes.Return.ExitCode,
+				StdOut:          stdOut,
+				StdErr:          stdErr,
+				StdOutTruncated: execStatusRes.Return.OutTruncated,
+				StdErrTruncated: execStatusRes.Return.ErrTruncated,
+			}, nil
+		}
+
+		if checkUntil.Before(<-statusCheck.C) {
+			// The guest agent can't kill the command, report its pid for the caller to kill it in the guest
+			return nil, fmt.Errorf("Timed out waiting for guest pid [%d] for command [%s] to exit, it keeps running in the guest", execRes.Return.Pid, command)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+type guestFileCommand struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments"`
+}
+
+type guestFileOpenArguments struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+type guestFileHandleArguments struct {
+	Handle int64  `json:"handle"`
+	BufB64 string `json:"buf-b64,omitempty"`
+	Count  int32  `json:"count,omitempty"`
+}
+
+type fileOpenReturn struct {
+	Return int64 `json:"return"`
+}
+
+type fileReadReturn struct {
+	Return fileReadReturnData `json:"return"`
+}
+type fileReadReturnData struct {
+	Count  int    `json:"count"`
+	BufB64 string `json:"buf-b64"`
+	EOF    bool   `json:"eof"`
+}
+
+func guestFileCommandJSON(execute string, arguments interface{}) (string, error) {
+	cmd, err := json.Marshal(guestFileCommand{Execute: execute, Arguments: arguments})
+	if err != nil {
+		return "", err
+	}
+	return string(cmd), nil
+}
+
+// GuestFileOpen opens the file at path in the guest with the fopen mode, and returns its handle
+func GuestFileOpen(virConn cli.Connection, domName string, path string, mode string) (int64, error) {
+	cmd, err := guestFileCommandJSON("guest-file-open", guestFileOpenArguments{Path: path, Mode: mode})
+	if err != nil {
+		return 0, err
+	}
+	output, err := virConn.QemuAgentCommand(cmd, domName)
+	if err != nil {
+		return 0, err
+	}
+	res := &fileOpenReturn{}
+	if err := json.Unmarshal([]byte(output), res); err != nil {
+		return 0, err
+	}
+	return res.Return, nil
+}
+
+// GuestFileRead reads up to count bytes from the file handle, and reports whether the end of the file was reached
+func GuestFileRead(virConn cli.Connection, domName string, handle int64, count int32) ([]byte, bool, error) {
+	cmd, err := guestFileCommandJSON("guest-file-read", guestFileHandleArguments{Handle: handle, Count: count})
+	if err != nil {
+		return nil, false, err
+	}
+	output, err := virConn.QemuAgentCommand(cmd, domName)
+	if err != nil {
+		return nil, false, err
+	}
+	res := &fileReadReturn{}
+	if err := json.Unmarshal([]byte(output), res); err != nil {
+		return nil, false, err
+	}
+	data, err := base64.StdEncoding.DecodeString(res.Return.BufB64)
+	if err != nil {
+		return nil, false, err
+	}
+	return data, res.Return.EOF, nil
+}
+
+// GuestFileWrite writes data to the file handle
+func GuestFileWrite(virConn cli.Connection, domName string, handle int64, data []byte) error {
+	cmd, err := guestFileCommandJSON("guest-file-write", guestFileHandleArguments{Handle: handle, BufB64: base64.StdEncoding.EncodeToString(data)})
+	if err != nil {
+		return err
+	}
+	_, err = virConn.QemuAgentCommand(cmd, domName)
+	return err
+}
+
+// GuestFileClose closes the file handle
+func GuestFileClose(virConn cli.Connection, domName string, handle int64) error {
+	cmd, err := guestFileCommandJSON("guest-file-close", guestFileHandleArguments{Handle: handle})
+	if err != nil {
+		return err
+	}
+	_, err = virConn.QemuAgentCommand(cmd, domName)
+	return err
+}
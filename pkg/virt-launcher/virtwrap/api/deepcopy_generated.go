@@ -167,6 +167,16 @@ func (in *BackingStoreFormat) DeepCopy() *BackingStoreFormat {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandWidth) DeepCopyInto(out *BandWidth) {
 	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandWidthRate)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandWidthRate)
+		**out = **in
+	}
 	return
 }
 
@@ -180,6 +190,22 @@ func (in *BandWidth) DeepCopy() *BandWidth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandWidthRate) DeepCopyInto(out *BandWidthRate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandWidthRate.
+func (in *BandWidthRate) DeepCopy() *BandWidthRate {
+	if in == nil {
+		return nil
+	}
+	out := new(BandWidthRate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockIO) DeepCopyInto(out *BlockIO) {
 	*out = *in
@@ -1909,7 +1935,7 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 	if in.BandWidth != nil {
 		in, out := &in.BandWidth, &out.BandWidth
 		*out = new(BandWidth)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BootOrder != nil {
 		in, out := &in.BootOrder, &out.BootOrder
@@ -2061,6 +2087,11 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SSHHostKeys != nil {
+		in, out := &in.SSHHostKeys, &out.SSHHostKeys
+		*out = new(SSHHostKeysMetadata)
+		**out = **in
+	}
 	return
 }
 
@@ -2409,7 +2440,9 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 	if in.Cells != nil {
 		in, out := &in.Cells, &out.Cells
 		*out = make([]NUMACell, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	return
 }
@@ -2427,6 +2460,11 @@ func (in *NUMA) DeepCopy() *NUMA {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMACell) DeepCopyInto(out *NUMACell) {
 	*out = *in
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = new(NUMADistances)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2440,10 +2478,51 @@ func (in *NUMACell) DeepCopy() *NUMACell {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMADistances) DeepCopyInto(out *NUMADistances) {
+	*out = *in
+	if in.Siblings != nil {
+		in, out := &in.Siblings, &out.Siblings
+		*out = make([]NUMASibling, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMADistances.
+func (in *NUMADistances) DeepCopy() *NUMADistances {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMADistances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMASibling) DeepCopyInto(out *NUMASibling) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMASibling.
+func (in *NUMASibling) DeepCopy() *NUMASibling {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMASibling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMATune) DeepCopyInto(out *NUMATune) {
 	*out = *in
-	out.Memory = in.Memory
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(NumaTuneMemory)
+		**out = **in
+	}
 	if in.MemNodes != nil {
 		in, out := &in.MemNodes, &out.MemNodes
 		*out = make([]MemNode, len(*in))
@@ -2770,6 +2849,22 @@ func (in *SMBios) DeepCopy() *SMBios {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHHostKeysMetadata) DeepCopyInto(out *SSHHostKeysMetadata) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHHostKeysMetadata.
+func (in *SSHHostKeysMetadata) DeepCopy() *SSHHostKeysMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHHostKeysMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretSpec) DeepCopyInto(out *SecretSpec) {
 	*out = *in
@@ -2817,6 +2912,11 @@ func (in *Serial) DeepCopyInto(out *Serial) {
 		*out = new(SerialSource)
 		**out = **in
 	}
+	if in.Log != nil {
+		in, out := &in.Log, &out.Log
+		*out = new(SerialLog)
+		**out = **in
+	}
 	if in.Alias != nil {
 		in, out := &in.Alias, &out.Alias
 		*out = new(Alias)
@@ -2835,6 +2935,22 @@ func (in *Serial) DeepCopy() *Serial {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialLog) DeepCopyInto(out *SerialLog) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialLog.
+func (in *SerialLog) DeepCopy() *SerialLog {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SerialSource) DeepCopyInto(out *SerialSource) {
 	*out = *in
@@ -3152,6 +3268,11 @@ func (in *VideoModel) DeepCopyInto(out *VideoModel) {
 		*out = new(uint)
 		**out = **in
 	}
+	if in.Resolution != nil {
+		in, out := &in.Resolution, &out.Resolution
+		*out = new(VideoResolution)
+		**out = **in
+	}
 	return
 }
 
@@ -3165,6 +3286,22 @@ func (in *VideoModel) DeepCopy() *VideoModel {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoResolution) DeepCopyInto(out *VideoResolution) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoResolution.
+func (in *VideoResolution) DeepCopy() *VideoResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Watchdog) DeepCopyInto(out *Watchdog) {
 	*out = *in
@@ -99,6 +99,7 @@ const (
 
 	HostDevicePCI  = "pci"
 	HostDeviceMDev = "mdev"
+	HostDeviceUSB  = "usb"
 	AddressPCI     = "pci"
 )
 
@@ -213,8 +214,8 @@ type CPUTune struct {
 }
 
 type NUMATune struct {
-	Memory   NumaTuneMemory `xml:"memory"`
-	MemNodes []MemNode      `xml:"memnode"`
+	Memory   *NumaTuneMemory `xml:"memory,omitempty"`
+	MemNodes []MemNode       `xml:"memnode"`
 }
 
 type MemNode struct {
@@ -260,11 +261,21 @@ type NUMA struct {
 }
 
 type NUMACell struct {
-	ID           string `xml:"id,attr"`
-	CPUs         string `xml:"cpus,attr"`
-	Memory       uint64 `xml:"memory,attr,omitempty"`
-	Unit         string `xml:"unit,attr,omitempty"`
-	MemoryAccess string `xml:"memAccess,attr,omitempty"`
+	ID           string         `xml:"id,attr"`
+	CPUs         string         `xml:"cpus,attr"`
+	Memory       uint64         `xml:"memory,attr,omitempty"`
+	Unit         string         `xml:"unit,attr,omitempty"`
+	MemoryAccess string         `xml:"memAccess,attr,omitempty"`
+	Distances    *NUMADistances `xml:"distances,omitempty"`
+}
+
+type NUMADistances struct {
+	Siblings []NUMASibling `xml:"sibling"`
+}
+
+type NUMASibling struct {
+	ID    uint32 `xml:"id,attr"`
+	Value uint32 `xml:"value,attr"`
 }
 
 type CPUFeature struct {
@@ -350,6 +361,7 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	SSHHostKeys      *SSHHostKeysMetadata      `xml:"sshHostKeys,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -357,6 +369,11 @@ type AccessCredentialMetadata struct {
 	Message   string `xml:"message,omitempty"`
 }
 
+// SSHHostKeysMetadata holds the public host keys of the SSH server of the guest, one per line in the authorized_keys format
+type SSHHostKeysMetadata struct {
+	Keys string `xml:"keys,omitempty"`
+}
+
 type MemoryDumpMetadata struct {
 	FileName       string       `xml:"fileName,omitempty"`
 	StartTimestamp *metav1.Time `xml:"startTimestamp,omitempty"`
@@ -690,6 +707,7 @@ type Serial struct {
 	Type   string        `xml:"type,attr"`
 	Target *SerialTarget `xml:"target,omitempty"`
 	Source *SerialSource `xml:"source,omitempty"`
+	Log    *SerialLog    `xml:"log,omitempty"`
 	Alias  *Alias        `xml:"alias,omitempty"`
 }
 
@@ -702,6 +720,11 @@ type SerialSource struct {
 	Path string `xml:"path,attr,omitempty"`
 }
 
+type SerialLog struct {
+	File   string `xml:"file,attr,omitempty"`
+	Append string `xml:"append,attr,omitempty"`
+}
+
 // END Serial -----------------------------
 
 // BEGIN Console -----------------------------
@@ -761,6 +784,15 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthRate `xml:"inbound,omitempty"`
+	Outbound *BandWidthRate `xml:"outbound,omitempty"`
+}
+
+// BandWidthRate rates are expressed in kibibytes per second and the burst in kibibytes.
+type BandWidthRate struct {
+	Average uint64 `xml:"average,attr"`
+	Peak    uint64 `xml:"peak,attr,omitempty"`
+	Burst   uint64 `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -993,11 +1025,17 @@ type Video struct {
 }
 
 type VideoModel struct {
-	Type   string `xml:"type,attr"`
-	Heads  *uint  `xml:"heads,attr,omitempty"`
-	Ram    *uint  `xml:"ram,attr,omitempty"`
-	VRam   *uint  `xml:"vram,attr,omitempty"`
-	VGAMem *uint  `xml:"vgamem,attr,omitempty"`
+	Type       string           `xml:"type,attr"`
+	Heads      *uint            `xml:"heads,attr,omitempty"`
+	Ram        *uint            `xml:"ram,attr,omitempty"`
+	VRam       *uint            `xml:"vram,attr,omitempty"`
+	VGAMem     *uint            `xml:"vgamem,attr,omitempty"`
+	Resolution *VideoResolution `xml:"resolution,omitempty"`
+}
+
+type VideoResolution struct {
+	X uint32 `xml:"x,attr"`
+	Y uint32 `xml:"y,attr"`
 }
 
 type Graphics struct {
@@ -1027,6 +1065,7 @@ type Address struct {
 	Target     string `xml:"target,attr,omitempty"`
 	Unit       string `xml:"unit,attr,omitempty"`
 	UUID       string `xml:"uuid,attr,omitempty"`
+	Device     string `xml:"device,attr,omitempty"`
 }
 
 //END Video -------------------
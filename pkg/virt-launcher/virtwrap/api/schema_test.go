@@ -509,7 +509,7 @@ var _ = ginkgo.Describe("Schema", func() {
 					},
 				},
 				NUMATune: &NUMATune{
-					Memory: NumaTuneMemory{
+					Memory: &NumaTuneMemory{
 						Mode:    "strict",
 						NodeSet: "1-2",
 					},
@@ -545,6 +545,101 @@ func (l *Launcher) Exec(ctx context.Context, request *cmdv1.ExecRequest) (*cmdv1
 	return resp, nil
 }
 
+func (l *Launcher) GuestExec(ctx context.Context, request *cmdv1.GuestExecRequest) (*cmdv1.GuestExecResponse, error) {
+	resp := &cmdv1.GuestExecResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+
+	result, err := l.domainManager.GuestExec(request.DomainName, request.Command, request.Args, request.Stdin, request.TimeoutSeconds)
+	if err != nil {
+		resp.Response.Success = false
+		resp.Response.Message = err.Error()
+		return resp, err
+	}
+	resp.ExitCode = int32(result.ExitCode)
+	resp.StdOut = result.StdOut
+	resp.StdErr = result.StdErr
+	resp.StdOutTruncated = result.StdOutTruncated
+	resp.StdErrTruncated = result.StdErrTruncated
+
+	return resp, nil
+}
+
+func newGuestFileResponse(err error) *cmdv1.GuestFileResponse {
+	resp := &cmdv1.GuestFileResponse{
+		Response: &cmdv1.Response{
+			Success: true,
+		},
+	}
+	if err != nil {
+		resp.Response.Success = false
+		resp.Response.Message = err.Error()
+	}
+	return resp
+}
+
+func (l *Launcher) GuestFileOpen(ctx context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.GuestFileResponse, error) {
+	handle, err := l.domainManager.GuestFileOpen(request.DomainName, request.Path, request.Mode)
+	resp := newGuestFileResponse(err)
+	resp.Handle = handle
+	return resp, err
+}
+
+func (l *Launcher) GuestFileRead(ctx context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.GuestFileResponse, error) {
+	data, eof, err := l.domainManager.GuestFileRead(request.DomainName, request.Handle, request.Count)
+	resp := newGuestFileResponse(err)
+	resp.Data = data
+	resp.Eof = eof
+	return resp, err
+}
+
+func (l *Launcher) GuestFileWrite(ctx context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.GuestFileResponse, error) {
+	err := l.domainManager.GuestFileWrite(request.DomainName, request.Handle, request.Data)
+	return newGuestFileResponse(err), err
+}
+
+func (l *Launcher) GuestFileClose(ctx context.Context, request *cmdv1.GuestFileRequest) (*cmdv1.GuestFileResponse, error) {
+	err := l.domainManager.GuestFileClose(request.DomainName, request.Handle)
+	return newGuestFileResponse(err), err
+}
+
+func (l *Launcher) AddSSHKey(_ context.Context, request *cmdv1.SSHKeyRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	ttl := time.Duration(request.TtlSeconds) * time.Second
+	if err := l.domainManager.AddEphemeralSSHKey(vmi, request.User, request.PublicKey, ttl); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to add an ephemeral SSH key")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Added an ephemeral SSH key for user %s, expiring in %v", request.User, ttl)
+	return response, nil
+}
+
+func (l *Launcher) RemoveSSHKey(_ context.Context, request *cmdv1.SSHKeyRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.RemoveEphemeralSSHKey(vmi, request.User, request.PublicKey); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to remove an ephemeral SSH key")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Removed an ephemeral SSH key of user %s", request.User)
+	return response, nil
+}
+
 func (l *Launcher) GuestPing(ctx context.Context, request *cmdv1.GuestPingRequest) (*cmdv1.GuestPingResponse, error) {
 	resp := &cmdv1.GuestPingResponse{
 		Response: &cmdv1.Response{
@@ -20,6 +20,7 @@
 package cmdserver
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"os"
@@ -114,6 +115,30 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(client.UnfreezeVirtualMachine(vmi)).To(Succeed())
 		})
 
+		It("should add an ephemeral SSH key", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().AddEphemeralSSHKey(vmi, "jdoe", "ssh-ed25519 AAAA", 2*time.Minute)
+			Expect(client.AddSSHKey(vmi, "jdoe", "ssh-ed25519 AAAA", 120)).To(Succeed())
+		})
+
+		It("should remove an ephemeral SSH key", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().RemoveEphemeralSSHKey(vmi, "jdoe", "ssh-ed25519 AAAA")
+			Expect(client.RemoveSSHKey(vmi, "jdoe", "ssh-ed25519 AAAA")).To(Succeed())
+		})
+
+		It("should return guest exec output larger than the default gRPC message size", func() {
+			stdOut := bytes.Repeat([]byte("o"), 16*1024*1024)
+			stdErr := bytes.Repeat([]byte("e"), 16*1024*1024)
+			domainManager.EXPECT().GuestExec("testvmi", "cat", nil, nil, int32(10)).
+				Return(&agent.GuestExecResult{StdOut: stdOut, StdErr: stdErr}, nil)
+
+			result, err := client.GuestExec("testvmi", "cat", nil, nil, 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Stdout).To(HaveLen(len(stdOut)))
+			Expect(result.Stderr).To(HaveLen(len(stdErr)))
+		})
+
 		It("should soft reboot a vmi", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().SoftRebootVMI(vmi)
@@ -371,6 +396,60 @@ var _ = Describe("Virt remote commands", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(resp.Response.Success).To(BeTrue())
 			})
+			It("returns the exit code, stdOut and stdErr of guest exec", func() {
+				domainManager.EXPECT().GuestExec(testDomainName, testCommand, testArgs, []byte("stdIn"), testTimeoutSeconds).
+					Times(1).Return(&agent.GuestExecResult{ExitCode: 2, StdOut: []byte(testStdOut), StdErr: []byte("stdErr"), StdOutTruncated: true}, nil)
+				resp, err := server.GuestExec(context.TODO(), &cmdv1.GuestExecRequest{
+					DomainName:     testDomainName,
+					Command:        testCommand,
+					Args:           testArgs,
+					Stdin:          []byte("stdIn"),
+					TimeoutSeconds: testTimeoutSeconds,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+				Expect(resp.ExitCode).To(BeEquivalentTo(2))
+				Expect(resp.StdOut).To(Equal([]byte(testStdOut)))
+				Expect(resp.StdErr).To(Equal([]byte("stdErr")))
+				Expect(resp.StdOutTruncated).To(BeTrue())
+				Expect(resp.StdErrTruncated).To(BeFalse())
+			})
+			It("returns guest exec errors in the response", func() {
+				domainManager.EXPECT().GuestExec(testDomainName, testCommand, testArgs, nil, testTimeoutSeconds).
+					Times(1).Return(nil, testExecErr)
+				resp, err := server.GuestExec(context.TODO(), &cmdv1.GuestExecRequest{
+					DomainName:     testDomainName,
+					Command:        testCommand,
+					Args:           testArgs,
+					TimeoutSeconds: testTimeoutSeconds,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(resp.Response.Success).To(BeFalse())
+				Expect(resp.Response.Message).To(Equal(testExecErr.Error()))
+			})
+			It("returns the data read from a guest file", func() {
+				domainManager.EXPECT().GuestFileRead(testDomainName, int64(3), int32(1024)).Times(1).Return([]byte("data"), true, nil)
+				resp, err := server.GuestFileRead(context.TODO(), &cmdv1.GuestFileRequest{
+					DomainName: testDomainName,
+					Handle:     3,
+					Count:      1024,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp.Response.Success).To(BeTrue())
+				Expect(resp.Data).To(Equal([]byte("data")))
+				Expect(resp.Eof).To(BeTrue())
+			})
+			It("returns guest file errors in the response", func() {
+				domainManager.EXPECT().GuestFileOpen(testDomainName, "/tmp/file", "wb").Times(1).Return(int64(0), testExecErr)
+				resp, err := server.GuestFileOpen(context.TODO(), &cmdv1.GuestFileRequest{
+					DomainName: testDomainName,
+					Path:       "/tmp/file",
+					Mode:       "wb",
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(resp.Response.Success).To(BeFalse())
+				Expect(resp.Response.Message).To(Equal(testExecErr.Error()))
+			})
 			It("should call guest ping", func() {
 				expectGuestPing().Times(1)
 				server.GuestPing(context.TODO(), guestPingRequest())
@@ -36,6 +36,7 @@ import (
 	"strings"
 	"syscall"
 
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 
@@ -49,6 +50,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -119,6 +121,7 @@ type ConverterContext struct {
 	ExpandDisksEnabled    bool
 	UseLaunchSecurity     bool
 	FreePageReporting     bool
+	SerialConsoleLog      bool
 }
 
 func contains(volumes []string, name string) bool {
@@ -983,6 +986,79 @@ func Convert_v1_Sound_To_api_Sound(vmi *v1.VirtualMachineInstance, domainDevices
 	return
 }
 
+// Convert_v1_Video_To_api_Video replaces the default video device of the architecture with the requested one
+func Convert_v1_Video_To_api_Video(video *v1.VideoDevice, domainVideo *api.Video) {
+	if video.Type != "" && string(video.Type) != domainVideo.Model.Type {
+		var heads uint = 1
+		domainVideo.Model = api.VideoModel{
+			Type:  string(video.Type),
+			Heads: &heads,
+		}
+	}
+	if video.Resolution != nil {
+		domainVideo.Model.Resolution = &api.VideoResolution{
+			X: video.Resolution.Width,
+			Y: video.Resolution.Height,
+		}
+	}
+}
+
+// Convert_v1_SerialPorts_To_api_Devices adds the additional serial ports, backed by unix sockets.
+// ISA serial ports are numbered after the serial console, virtio-console ports are numbered by libvirt.
+func Convert_v1_SerialPorts_To_api_Devices(vmi *v1.VirtualMachineInstance, domainDevices *api.Devices) {
+	nextSerialPort := uint(len(domainDevices.Serials))
+	for _, port := range vmi.Spec.Domain.Devices.SerialPorts {
+		socketName, _ := util.SerialPortSocketName(vmi, port.Name)
+		socketPath := filepath.Join(util.VirtPrivateDir, string(vmi.ObjectMeta.UID), socketName)
+
+		if port.Type == v1.SerialPortTypeSerial {
+			serialPort := nextSerialPort
+			nextSerialPort++
+			domainDevices.Serials = append(domainDevices.Serials, api.Serial{
+				Type: "unix",
+				Target: &api.SerialTarget{
+					Port: &serialPort,
+				},
+				Source: &api.SerialSource{
+					Mode: "bind",
+					Path: socketPath,
+				},
+			})
+			continue
+		}
+
+		consoleType := "virtio"
+		domainDevices.Consoles = append(domainDevices.Consoles, api.Console{
+			Type: "unix",
+			Target: &api.ConsoleTarget{
+				Type: &consoleType,
+			},
+			Source: &api.ConsoleSource{
+				Mode: "bind",
+				Path: socketPath,
+			},
+		})
+	}
+}
+
+func needsVirtioSerialController(vmi *v1.VirtualMachineInstance) bool {
+	for _, port := range vmi.Spec.Domain.Devices.SerialPorts {
+		if port.Type != v1.SerialPortTypeSerial {
+			return true
+		}
+	}
+	return false
+}
+
+func hasVirtioSerialController(controllers []api.Controller) bool {
+	for _, controller := range controllers {
+		if controller.Type == "virtio-serial" {
+			return true
+		}
+	}
+	return false
+}
+
 func Convert_v1_Input_To_api_InputDevice(input *v1.Input, inputDevice *api.Input) error {
 	if input.Bus != v1.InputBusVirtio && input.Bus != v1.InputBusUSB && input.Bus != "" {
 		return fmt.Errorf("input contains unsupported bus %s", input.Bus)
@@ -1259,7 +1335,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 			}
 
 			domain.Spec.OS.NVRam = &api.NVRam{
-				NVRam:    filepath.Join("/tmp", domain.Spec.Name),
+				NVRam:    efi.NVRAMPath(domain.Spec.Name, backendstorage.HasPersistentEFI(&vmi.Spec), util.IsNonRootVMI(vmi)),
 				Template: c.EFIConfiguration.EFIVars,
 			}
 		}
@@ -1675,6 +1751,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 				return err
 			}
 		}
+
+		// Declare the guest NUMA topology explicitly requested by the user
+		if vmi.Spec.Domain.CPU.NUMA != nil && len(vmi.Spec.Domain.CPU.NUMA.Cells) > 0 {
+			if err := vcpu.ExplicitNUMAMapping(vmi, &domain.Spec); err != nil {
+				return err
+			}
+		}
 	}
 
 	// Make use of the tsc frequency topology hint
@@ -1695,7 +1778,7 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.CPU.Mode = v1.CPUModeHostModel
 	}
 
-	if vmi.Spec.Domain.Devices.AutoattachSerialConsole == nil || *vmi.Spec.Domain.Devices.AutoattachSerialConsole == true {
+	if util.IsAutoAttachSerialConsole(vmi) {
 		// Add mandatory console device
 		domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, api.Controller{
 			Type:   "virtio-serial",
@@ -1728,6 +1811,26 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 				},
 			},
 		}
+
+		if c.SerialConsoleLog && util.IsSerialConsoleLogEnabled(vmi) {
+			// virtlogd writes the log, and rotates it to cap its size
+			domain.Spec.Devices.Serials[0].Log = &api.SerialLog{
+				File:   util.SerialConsoleLogPath(vmi),
+				Append: "on",
+			}
+		}
+	}
+
+	if len(vmi.Spec.Domain.Devices.SerialPorts) > 0 {
+		if needsVirtioSerialController(vmi) && !hasVirtioSerialController(domain.Spec.Devices.Controllers) {
+			domain.Spec.Devices.Controllers = append(domain.Spec.Devices.Controllers, api.Controller{
+				Type:   "virtio-serial",
+				Index:  "0",
+				Model:  translateModel(c, v1.VirtIO),
+				Driver: controllerDriver,
+			})
+		}
+		Convert_v1_SerialPorts_To_api_Devices(vmi, &domain.Spec.Devices)
 	}
 
 	if vmi.Spec.Domain.Devices.AutoattachGraphicsDevice == nil || *vmi.Spec.Domain.Devices.AutoattachGraphicsDevice == true {
@@ -1771,6 +1874,9 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 				},
 			}
 		}
+		if vmi.Spec.Domain.Devices.Video != nil {
+			Convert_v1_Video_To_api_Video(vmi.Spec.Domain.Devices.Video, &domain.Spec.Devices.Video[0])
+		}
 		domain.Spec.Devices.Graphics = []api.Graphics{
 			{
 				Listen: &api.GraphicsListen{
@@ -1804,6 +1804,77 @@ var _ = Describe("Converter", func() {
 			}))
 		})
 
+		It("should replace the default video device with the requested one", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Video = &v1.VideoDevice{
+				Type:       v1.VideoTypeBochs,
+				Resolution: &v1.VideoResolution{Width: 1920, Height: 1080},
+			}
+			domain := vmiToDomain(vmi, c)
+			var heads uint = 1
+			Expect(domain.Spec.Devices.Video).To(ConsistOf(api.Video{Model: api.VideoModel{
+				Type:       "bochs",
+				Heads:      &heads,
+				Resolution: &api.VideoResolution{X: 1920, Y: 1080},
+			}}))
+		})
+
+		It("should keep the default video device when only the resolution is requested", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Video = &v1.VideoDevice{
+				Resolution: &v1.VideoResolution{Width: 1280, Height: 800},
+			}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Video).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Video[0].Model.Type).To(Equal("vga"))
+			Expect(*domain.Spec.Devices.Video[0].Model.VRam).To(Equal(uint(16384)))
+			Expect(domain.Spec.Devices.Video[0].Model.Resolution).To(Equal(&api.VideoResolution{X: 1280, Y: 800}))
+		})
+
+		It("should add the additional serial ports next to the serial console", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.SerialPorts = []v1.SerialPort{
+				{Name: "debug", Type: v1.SerialPortTypeSerial},
+				{Name: "logs"},
+			}
+			domain := vmiToDomain(vmi, c)
+
+			var consolePort, debugPort uint = 0, 1
+			Expect(domain.Spec.Devices.Serials).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Serials[0].Target.Port).To(Equal(&consolePort))
+			Expect(domain.Spec.Devices.Serials[1]).To(Equal(api.Serial{
+				Type:   "unix",
+				Target: &api.SerialTarget{Port: &debugPort},
+				Source: &api.SerialSource{Mode: "bind", Path: "/var/run/kubevirt-private/f4686d2c-6e8d-4335-b8fd-81bee22f4814/virt-serial1"},
+			}))
+
+			virtioType := "virtio"
+			Expect(domain.Spec.Devices.Consoles).To(HaveLen(2))
+			Expect(domain.Spec.Devices.Consoles[1]).To(Equal(api.Console{
+				Type:   "unix",
+				Target: &api.ConsoleTarget{Type: &virtioType},
+				Source: &api.ConsoleSource{Mode: "bind", Path: "/var/run/kubevirt-private/f4686d2c-6e8d-4335-b8fd-81bee22f4814/virt-serial2"},
+			}))
+		})
+
+		It("should add a virtio-serial controller for virtio serial ports without serial console", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.AutoattachSerialConsole = False()
+			vmi.Spec.Domain.Devices.SerialPorts = []v1.SerialPort{{Name: "logs", Type: v1.SerialPortTypeVirtio}}
+			domain := vmiToDomain(vmi, c)
+
+			Expect(domain.Spec.Devices.Serials).To(BeEmpty())
+			Expect(domain.Spec.Devices.Consoles).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Consoles[0].Source.Path).To(Equal("/var/run/kubevirt-private/f4686d2c-6e8d-4335-b8fd-81bee22f4814/virt-serial1"))
+			var virtioSerialControllers []api.Controller
+			for _, controller := range domain.Spec.Devices.Controllers {
+				if controller.Type == "virtio-serial" {
+					virtioSerialControllers = append(virtioSerialControllers, controller)
+				}
+			}
+			Expect(virtioSerialControllers).To(HaveLen(1))
+		})
+
 		It("should enable usb redirection when number of USB client devices > 0", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
@@ -2200,6 +2271,30 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces[0].BootOrder.Order).To(Equal(uint(bootOrder)))
 			Expect(domain.Spec.Devices.Interfaces[1].BootOrder).To(BeNil())
 		})
+		It("should set the interface link state and bandwidth", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			iface := v1.DefaultBridgeNetworkInterface()
+			iface.State = v1.InterfaceStateLinkDown
+			peak := resource.MustParse("2Mi")
+			iface.Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimits{Average: resource.MustParse("1Mi")},
+				Outbound: &v1.BandwidthLimits{
+					Average: resource.MustParse("1000"),
+					Peak:    &peak,
+					Burst:   resource.NewQuantity(4096, resource.BinarySI),
+				},
+			}
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{*iface}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].LinkState).To(Equal(&api.LinkState{State: "down"}))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+				Inbound:  &api.BandWidthRate{Average: 1024},
+				Outbound: &api.BandWidthRate{Average: 1, Peak: 2048, Burst: 4},
+			}))
+		})
 		It("Should create network configuration for masquerade interface", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			name1 := "Name"
@@ -2481,6 +2576,26 @@ var _ = Describe("Converter", func() {
 			Entry("and add the serial console if it is set to true", True(), 1),
 			Entry("and not add the serial console if it is set to false", False(), 0),
 		)
+
+		DescribeTable("should log the serial console", func(serialConsoleLog bool, logSerialConsole *bool, expectedLog *api.SerialLog) {
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: k8smeta.ObjectMeta{
+					Name:      "testvmi",
+					Namespace: "default",
+					UID:       "1234",
+				},
+			}
+			vmi.Spec.Domain.Devices = v1.Devices{
+				LogSerialConsole: logSerialConsole,
+			}
+			domain := vmiToDomain(&vmi, &ConverterContext{AllowEmulation: true, SerialConsoleLog: serialConsoleLog})
+			Expect(domain.Spec.Devices.Serials).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Serials[0].Log).To(Equal(expectedLog))
+		},
+			Entry("by default", true, nil, &api.SerialLog{File: "/var/run/kubevirt-private/1234/virt-serial0-log", Append: "on"}),
+			Entry("unless it is disabled", true, False(), nil),
+			Entry("unless the feature gate is disabled", false, nil, nil),
+		)
 	})
 
 	Context("IOThreads", func() {
@@ -3027,6 +3142,42 @@ var _ = Describe("Converter", func() {
 			Entry("should not use SecureBoot", False(), "OVMF_CODE.fd", "OVMF_VARS.fd"),
 			Entry("should not use SecureBoot when OVMF_CODE.fd not present", True(), "OVMF_CODE.secboot.fd", "OVMF_VARS.fd"),
 		)
+
+		It("should keep the NVRAM of a persistent EFI on the backend storage", func() {
+			c.EFIConfiguration = &EFIConfiguration{
+				EFICode: "OVMF_CODE.fd",
+				EFIVars: "OVMF_VARS.fd",
+			}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot: False(),
+						Persistent: True(),
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(path.Base(domainSpec.OS.NVRam.Template)).To(Equal("OVMF_VARS.fd"))
+			Expect(domainSpec.OS.NVRam.NVRam).To(Equal("/var/run/kubevirt-private/nvram/mynamespace_testvmi_VARS.fd"))
+		})
+
+		It("should keep the NVRAM of a persistent EFI of a non-root VMI next to its TPM state", func() {
+			c.EFIConfiguration = &EFIConfiguration{
+				EFICode: "OVMF_CODE.fd",
+				EFIVars: "OVMF_VARS.fd",
+			}
+			vmi.Status.RuntimeUser = 107
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: &v1.EFI{
+						SecureBoot: False(),
+						Persistent: True(),
+					},
+				},
+			}
+			domainSpec := vmiToDomainXMLToDomainSpec(vmi, c)
+			Expect(domainSpec.OS.NVRam.NVRam).To(Equal("/var/run/kubevirt-private/libvirt/qemu/nvram/mynamespace_testvmi_VARS.fd"))
+		})
 	})
 
 	Context("Kernel Boot", func() {
@@ -26,6 +26,8 @@ import (
 	"os"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/resource"
+
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
@@ -87,6 +89,14 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 			domainIface.ACPI = &api.ACPI{Index: uint(iface.ACPIIndex)}
 		}
 
+		if iface.State != "" {
+			domainIface.LinkState = &api.LinkState{State: string(iface.State)}
+		}
+
+		if iface.Bandwidth != nil {
+			domainIface.BandWidth = convertInterfaceBandwidth(iface.Bandwidth)
+		}
+
 		if iface.Bridge != nil || iface.Masquerade != nil {
 			// TODO:(ihar) consider abstracting interface type conversion /
 			// detection into drivers
@@ -143,6 +153,32 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, domain *api.Domain,
 	return domainInterfaces, nil
 }
 
+func convertInterfaceBandwidth(bandwidth *v1.InterfaceBandwidth) *api.BandWidth {
+	return &api.BandWidth{
+		Inbound:  convertBandwidthLimits(bandwidth.Inbound),
+		Outbound: convertBandwidthLimits(bandwidth.Outbound),
+	}
+}
+
+func convertBandwidthLimits(limits *v1.BandwidthLimits) *api.BandWidthRate {
+	if limits == nil {
+		return nil
+	}
+	rate := &api.BandWidthRate{Average: bytesToKiB(limits.Average)}
+	if limits.Peak != nil {
+		rate.Peak = bytesToKiB(*limits.Peak)
+	}
+	if limits.Burst != nil {
+		rate.Burst = bytesToKiB(*limits.Burst)
+	}
+	return rate
+}
+
+// bytesToKiB converts a quantity of bytes to kibibytes, the unit expected by libvirt, rounding up.
+func bytesToKiB(quantity resource.Quantity) uint64 {
+	return uint64((quantity.Value() + 1023) / 1024)
+}
+
 func GetInterfaceType(iface *v1.Interface) string {
 	if iface.Slirp != nil {
 		// Slirp configuration works only with e1000 or rtl8139
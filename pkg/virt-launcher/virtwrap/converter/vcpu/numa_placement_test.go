@@ -68,7 +68,7 @@ var _ = Describe("NumaPlacement", func() {
 				{VCPU: 3, CPUSet: "30"},
 			}},
 			NUMATune: &api.NUMATune{
-				Memory: api.NumaTuneMemory{Mode: "strict", NodeSet: "0,4"},
+				Memory: &api.NumaTuneMemory{Mode: "strict", NodeSet: "0,4"},
 				MemNodes: []api.MemNode{
 					{CellID: 0, Mode: "strict", NodeSet: "0"},
 					{CellID: 1, Mode: "strict", NodeSet: "4"},
@@ -154,7 +154,7 @@ var _ = Describe("NumaPlacement", func() {
 			expectedSpec.CPUTune.VCPUPin = append(expectedSpec.CPUTune.VCPUPin, api.CPUTuneVCPUPin{
 				VCPU: 4, CPUSet: "40",
 			})
-			expectedSpec.NUMATune.Memory = api.NumaTuneMemory{
+			expectedSpec.NUMATune.Memory = &api.NumaTuneMemory{
 				Mode: "strict", NodeSet: "0,4,5",
 			}
 			expectedSpec.NUMATune.MemNodes = append(expectedSpec.NUMATune.MemNodes, api.MemNode{
@@ -188,3 +188,38 @@ var _ = Describe("NumaPlacement", func() {
 		})
 	})
 })
+
+var _ = Describe("ExplicitNUMAMapping", func() {
+	var vmi *v1.VirtualMachineInstance
+	var domainSpec *api.DomainSpec
+
+	BeforeEach(func() {
+		vmi = &v1.VirtualMachineInstance{Spec: v1.VirtualMachineInstanceSpec{Domain: v1.DomainSpec{
+			CPU: &v1.CPU{NUMA: &v1.NUMA{Cells: []v1.NUMACell{
+				{ID: 0, CPUSet: "0-1", Memory: resource.MustParse("1Gi"), Distances: []v1.NUMADistance{{CellID: 0, Value: 10}, {CellID: 1, Value: 21}}},
+				{ID: 1, CPUSet: "2,3", Memory: resource.MustParse("512Mi")},
+			}}},
+		}}}
+		domainSpec = &api.DomainSpec{CPU: api.CPU{NUMA: &api.NUMA{Cells: []api.NUMACell{{ID: "0", CPUs: "0-3"}}}}}
+	})
+
+	It("should declare the requested cells", func() {
+		Expect(ExplicitNUMAMapping(vmi, domainSpec)).To(Succeed())
+		Expect(domainSpec.CPU.NUMA).To(Equal(&api.NUMA{Cells: []api.NUMACell{
+			{ID: "0", CPUs: "0-1", Memory: 1024 * 1024 * 1024, Unit: "b", Distances: &api.NUMADistances{Siblings: []api.NUMASibling{
+				{ID: 0, Value: 10},
+				{ID: 1, Value: 21},
+			}}},
+			{ID: "1", CPUs: "2,3", Memory: 512 * 1024 * 1024, Unit: "b"},
+		}}))
+		Expect(domainSpec.NUMATune).To(BeNil())
+	})
+
+	It("should only bind the memory of the cells with a host node set", func() {
+		vmi.Spec.Domain.CPU.NUMA.Cells[1].HostNodeSet = "0-1"
+		Expect(ExplicitNUMAMapping(vmi, domainSpec)).To(Succeed())
+		Expect(domainSpec.NUMATune).To(Equal(&api.NUMATune{MemNodes: []api.MemNode{
+			{CellID: 1, Mode: "strict", NodeSet: "0-1"},
+		}}))
+	})
+})
@@ -540,7 +540,7 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 
 	domain.CPU.NUMA = &api.NUMA{}
 	domain.NUMATune = &api.NUMATune{
-		Memory: api.NumaTuneMemory{
+		Memory: &api.NumaTuneMemory{
 			Mode:    "strict",
 			NodeSet: strings.Join(involvedCellIDs, ","),
 		},
@@ -611,6 +611,50 @@ func numaMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec, topolo
 	return nil
 }
 
+// ExplicitNUMAMapping declares the guest NUMA cells requested in the VMI spec, independently of any vCPU pinning,
+// and binds the memory of the cells to the requested host NUMA nodes.
+func ExplicitNUMAMapping(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec) error {
+	numa := &api.NUMA{}
+	var memNodes []api.MemNode
+	for _, cell := range vmi.Spec.Domain.CPU.NUMA.Cells {
+		memory, err := QuantityToByte(cell.Memory)
+		if err != nil {
+			return fmt.Errorf("could not convert the memory of NUMA cell %d: %v", cell.ID, err)
+		}
+		numaCell := api.NUMACell{
+			ID:     strconv.Itoa(int(cell.ID)),
+			CPUs:   cell.CPUSet,
+			Memory: memory.Value,
+			Unit:   memory.Unit,
+		}
+		if len(cell.Distances) > 0 {
+			numaCell.Distances = &api.NUMADistances{}
+			for _, distance := range cell.Distances {
+				numaCell.Distances.Siblings = append(numaCell.Distances.Siblings, api.NUMASibling{
+					ID:    distance.CellID,
+					Value: distance.Value,
+				})
+			}
+		}
+		numa.Cells = append(numa.Cells, numaCell)
+
+		if cell.HostNodeSet != "" {
+			memNodes = append(memNodes, api.MemNode{
+				CellID:  cell.ID,
+				Mode:    "strict",
+				NodeSet: cell.HostNodeSet,
+			})
+		}
+	}
+
+	domain.CPU.NUMA = numa
+	if len(memNodes) > 0 {
+		// Cells without a host node set are left to the default memory policy
+		domain.NUMATune = &api.NUMATune{MemNodes: memNodes}
+	}
+	return nil
+}
+
 func hugePagesInfo(vmi *v12.VirtualMachineInstance, domain *api.DomainSpec) (size uint64, unit string, enabled bool, err error) {
 	if domain.MemoryBacking != nil && domain.MemoryBacking.HugePages != nil {
 		if vmi.Spec.Domain.Memory.Hugepages != nil {
@@ -25,6 +25,7 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/device/hostdevice"
 )
 
@@ -35,12 +36,17 @@ const (
 )
 
 func CreateHostDevices(vmiHostDevices []v1.HostDevice) ([]api.HostDevice, error) {
-	return CreateHostDevicesFromPools(vmiHostDevices, NewPCIAddressPool(vmiHostDevices), NewMDEVAddressPool(vmiHostDevices))
+	// hotpluggable host-devices are not allocated to the virt-launcher pod,
+	// they are attached to the running domain once their attachment pod got them.
+	vmiHostDevices = filterColdplugHostDevices(vmiHostDevices)
+	return CreateHostDevicesFromPools(vmiHostDevices,
+		NewPCIAddressPool(vmiHostDevices), NewMDEVAddressPool(vmiHostDevices), NewUSBAddressPool(vmiHostDevices))
 }
 
-func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool, mdevAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
+func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool, mdevAddressPool, usbAddressPool hostdevice.AddressPooler) ([]api.HostDevice, error) {
 	pciPool := hostdevice.NewBestEffortAddressPool(pciAddressPool)
 	mdevPool := hostdevice.NewBestEffortAddressPool(mdevAddressPool)
+	usbPool := hostdevice.NewBestEffortAddressPool(usbAddressPool)
 
 	hostDevicesMetaData := createHostDevicesMetadata(vmiHostDevices)
 	pciHostDevices, err := hostdevice.CreatePCIHostDevices(hostDevicesMetaData, pciPool)
@@ -52,7 +58,13 @@ func CreateHostDevicesFromPools(vmiHostDevices []v1.HostDevice, pciAddressPool,
 		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
 	}
 
+	usbHostDevices, err := hostdevice.CreateUSBHostDevices(hostDevicesMetaData, usbPool)
+	if err != nil {
+		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
+	}
+
 	hostDevices := append(pciHostDevices, mdevHostDevices...)
+	hostDevices = append(hostDevices, usbHostDevices...)
 
 	if err := validateCreationOfAllDevices(vmiHostDevices, hostDevices); err != nil {
 		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
@@ -86,3 +98,87 @@ func validateCreationOfAllDevices(genericHostDevices []v1.HostDevice, hostDevice
 	}
 	return nil
 }
+
+func filterColdplugHostDevices(vmiHostDevices []v1.HostDevice) []v1.HostDevice {
+	var coldplugHostDevices []v1.HostDevice
+	for _, dev := range vmiHostDevices {
+		if !dev.Hotpluggable {
+			coldplugHostDevices = append(coldplugHostDevices, dev)
+		}
+	}
+	return coldplugHostDevices
+}
+
+// GetHostDevicesToAttach returns the hotpluggable host-devices which were allocated on the node
+// by their attachment pod, and are not attached to the domain yet.
+func GetHostDevicesToAttach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) ([]api.HostDevice, error) {
+	hotpluggable := map[string]struct{}{}
+	for _, dev := range vmi.Spec.Domain.Devices.HostDevices {
+		if dev.Hotpluggable {
+			hotpluggable[dev.Name] = struct{}{}
+		}
+	}
+
+	pciPool, mdevPool := statusAddressPool{}, statusAddressPool{}
+	var pciHostDevicesMetaData, mdevHostDevicesMetaData []hostdevice.HostDeviceMetaData
+	for _, status := range vmi.Status.HostDeviceStatus {
+		if _, exists := hotpluggable[status.Name]; !exists || status.Phase != v1.HostDeviceAttachedToNode || status.Address == "" {
+			continue
+		}
+		metaData := hostdevice.HostDeviceMetaData{
+			AliasPrefix:  AliasPrefix,
+			Name:         status.Name,
+			ResourceName: status.Name,
+		}
+		if _, err := device.NewPciAddressField(status.Address); err == nil {
+			pciPool[status.Name] = status.Address
+			pciHostDevicesMetaData = append(pciHostDevicesMetaData, metaData)
+		} else {
+			mdevPool[status.Name] = status.Address
+			mdevHostDevicesMetaData = append(mdevHostDevicesMetaData, metaData)
+		}
+	}
+
+	pciHostDevices, err := hostdevice.CreatePCIHostDevices(pciHostDevicesMetaData, pciPool)
+	if err != nil {
+		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
+	}
+	mdevHostDevices, err := hostdevice.CreateMDEVHostDevices(mdevHostDevicesMetaData, mdevPool, DefaultDisplayOff)
+	if err != nil {
+		return nil, fmt.Errorf(failedCreateGenericHostDevicesFmt, err)
+	}
+
+	currentAttachedHostDevices := hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix)
+	return hostdevice.DifferenceHostDevicesByAlias(append(pciHostDevices, mdevHostDevices...), currentAttachedHostDevices), nil
+}
+
+// GetHostDevicesToDetach returns the domain host-devices of the hotpluggable host-devices
+// which are being detached from the VMI.
+func GetHostDevicesToDetach(vmi *v1.VirtualMachineInstance, domainSpec *api.DomainSpec) []api.HostDevice {
+	detaching := map[string]struct{}{}
+	for _, status := range vmi.Status.HostDeviceStatus {
+		if status.Phase == v1.HostDeviceDetaching {
+			detaching[AliasPrefix+status.Name] = struct{}{}
+		}
+	}
+
+	var hostDevicesToDetach []api.HostDevice
+	for _, hostDevice := range hostdevice.FilterHostDevicesByAlias(domainSpec.Devices.HostDevices, AliasPrefix) {
+		if _, exists := detaching[hostDevice.Alias.GetName()]; exists {
+			hostDevicesToDetach = append(hostDevicesToDetach, hostDevice)
+		}
+	}
+	return hostDevicesToDetach
+}
+
+// statusAddressPool serves the address reported on the status of a hotpluggable host-device.
+type statusAddressPool map[string]string
+
+func (p statusAddressPool) Pop(name string) (string, error) {
+	address, exists := p[name]
+	if !exists {
+		return "", fmt.Errorf("no address was reported for host-device %s", name)
+	}
+	delete(p, name)
+	return address, nil
+}
@@ -58,7 +58,7 @@ var _ = Describe("Generic HostDevice", func() {
 		mdevPool := newAddressPoolStub()
 		mdevPool.AddResource(hostdevResource1, hostdevPCIAddress1)
 
-		_, err := generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool)
+		_, err := generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, newAddressPoolStub())
 		Expect(err).To(HaveOccurred())
 	})
 
@@ -89,9 +89,80 @@ var _ = Describe("Generic HostDevice", func() {
 			Model:  "vfio-pci",
 		}
 
-		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool)).
+		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, pciPool, mdevPool, newAddressPoolStub())).
 			To(Equal([]api.HostDevice{expectHostDevice0, expectHostDevice1}))
 	})
+
+	It("creates a USB device", func() {
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{DeviceName: hostdevResource0, Name: hostdevName0}}
+		usbPool := newAddressPoolStub()
+		usbPool.AddResource(hostdevResource0, "1:3")
+
+		Expect(generic.CreateHostDevicesFromPools(vmi.Spec.Domain.Devices.HostDevices, newAddressPoolStub(), newAddressPoolStub(), usbPool)).
+			To(Equal([]api.HostDevice{{
+				Alias:   api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName0),
+				Source:  api.HostDeviceSource{Address: &api.Address{Bus: "1", Device: "3"}},
+				Type:    api.HostDeviceUSB,
+				Mode:    "subsystem",
+				Managed: "no",
+			}}))
+	})
+
+	It("does not create hotpluggable devices", func() {
+		vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{DeviceName: hostdevResource0, Name: hostdevName0, Hotpluggable: true}}
+		Expect(generic.CreateHostDevices(vmi.Spec.Domain.Devices.HostDevices)).To(BeEmpty())
+	})
+
+	Context("hotplug", func() {
+		var domainSpec *api.DomainSpec
+
+		BeforeEach(func() {
+			domainSpec = &api.DomainSpec{}
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{
+				{DeviceName: hostdevResource0, Name: hostdevName0, Hotpluggable: true},
+				{DeviceName: hostdevResource1, Name: hostdevName1, Hotpluggable: true},
+			}
+		})
+
+		It("attaches the devices allocated by their attachment pod", func() {
+			vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{
+				{Name: hostdevName0, Phase: v1.HostDeviceAttachedToNode, Address: hostdevPCIAddress0},
+				{Name: hostdevName1, Phase: v1.HostDeviceAttachedToNode, Address: hostdevMDEVAddress1},
+			}
+
+			hostDevices, err := generic.GetHostDevicesToAttach(vmi, domainSpec)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hostDevices).To(HaveLen(2))
+			Expect(hostDevices[0].Alias.GetName()).To(Equal(generic.AliasPrefix + hostdevName0))
+			Expect(hostDevices[0].Type).To(Equal(api.HostDevicePCI))
+			Expect(hostDevices[1].Alias.GetName()).To(Equal(generic.AliasPrefix + hostdevName1))
+			Expect(hostDevices[1].Source.Address.UUID).To(Equal(hostdevMDEVAddress1))
+		})
+
+		It("does not attach devices which are pending or already attached", func() {
+			vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{
+				{Name: hostdevName0, Phase: v1.HostDeviceAttachedToNode, Address: hostdevPCIAddress0},
+				{Name: hostdevName1, Phase: v1.HostDevicePending},
+			}
+			domainSpec.Devices.HostDevices = []api.HostDevice{{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName0)}}
+
+			Expect(generic.GetHostDevicesToAttach(vmi, domainSpec)).To(BeEmpty())
+		})
+
+		It("detaches the devices which are being removed", func() {
+			vmi.Status.HostDeviceStatus = []v1.HostDeviceStatus{
+				{Name: hostdevName0, Phase: v1.HostDeviceReady, Address: hostdevPCIAddress0},
+				{Name: hostdevName1, Phase: v1.HostDeviceDetaching, Address: hostdevMDEVAddress1},
+			}
+			hostDevice1 := api.HostDevice{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName1)}
+			domainSpec.Devices.HostDevices = []api.HostDevice{
+				{Alias: api.NewUserDefinedAlias(generic.AliasPrefix + hostdevName0)},
+				hostDevice1,
+			}
+
+			Expect(generic.GetHostDevicesToDetach(vmi, domainSpec)).To(Equal([]api.HostDevice{hostDevice1}))
+		})
+	})
 })
 
 type stubAddressPool struct {
@@ -21,6 +21,7 @@ package hostdevice
 
 import (
 	"fmt"
+	"strings"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
@@ -58,6 +59,10 @@ func CreateMDEVHostDevices(hostDevicesData []HostDeviceMetaData, mdevAddrPool Ad
 	return createHostDevices(hostDevicesData, mdevAddrPool, createMDEVHostDevice)
 }
 
+func CreateUSBHostDevices(hostDevicesData []HostDeviceMetaData, usbAddrPool AddressPooler) ([]api.HostDevice, error) {
+	return createHostDevices(hostDevicesData, usbAddrPool, createUSBHostDevice)
+}
+
 func createHostDevices(hostDevicesData []HostDeviceMetaData, addrPool AddressPooler, createHostDev createHostDevice) ([]api.HostDevice, error) {
 	var hostDevices []api.HostDevice
 
@@ -102,6 +107,27 @@ func createPCIHostDevice(hostDeviceData HostDeviceMetaData, hostPCIAddress strin
 	return domainHostDevice, nil
 }
 
+// createUSBHostDevice creates a host USB device from its bus:device address, e.g. 1:3
+func createUSBHostDevice(hostDeviceData HostDeviceMetaData, hostUSBAddress string) (*api.HostDevice, error) {
+	addressParts := strings.Split(hostUSBAddress, ":")
+	if len(addressParts) != 2 || addressParts[0] == "" || addressParts[1] == "" {
+		return nil, fmt.Errorf("failed to create USB device for %s: invalid address %s", hostDeviceData.Name, hostUSBAddress)
+	}
+	domainHostDevice := &api.HostDevice{
+		Alias: api.NewUserDefinedAlias(hostDeviceData.AliasPrefix + hostDeviceData.Name),
+		Source: api.HostDeviceSource{
+			Address: &api.Address{
+				Bus:    addressParts[0],
+				Device: addressParts[1],
+			},
+		},
+		Type:    api.HostDeviceUSB,
+		Mode:    "subsystem",
+		Managed: "no",
+	}
+	return domainHostDevice, nil
+}
+
 func createMDEVHostDeviceWithDisplay(hostDeviceData HostDeviceMetaData, mdevUUID string) (*api.HostDevice, error) {
 	mdev, err := createMDEVHostDevice(hostDeviceData, mdevUUID)
 	if err != nil {
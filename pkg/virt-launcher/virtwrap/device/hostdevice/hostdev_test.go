@@ -69,6 +69,7 @@ var _ = Describe("HostDevice", func() {
 		},
 		Entry("PCI", hostdevice.CreatePCIHostDevices),
 		Entry("MDEV", createMDEVWithoutDisplay),
+		Entry("USB", hostdevice.CreateUSBHostDevices),
 	)
 
 	It("fails to create a device given bad host PCI address", func() {
@@ -79,6 +80,14 @@ var _ = Describe("HostDevice", func() {
 		Expect(err).To(HaveOccurred())
 	})
 
+	It("fails to create a device given bad host USB address", func() {
+		pool.AddResource(resource0, "0bad0usb0address0")
+		hostDevicesMetaData := []hostdevice.HostDeviceMetaData{{ResourceName: resource0}}
+		_, err := hostdevice.CreateUSBHostDevices(hostDevicesMetaData, pool)
+
+		Expect(err).To(HaveOccurred())
+	})
+
 	DescribeTable("fails to create a device when hook returns error",
 		func(createHostDevices createHostDevices) {
 			pool.AddResource(resource0, "0000:81:01.0")
@@ -235,6 +244,35 @@ var _ = Describe("HostDevice", func() {
 			Expect(hostDevices, err).To(Equal([]api.HostDevice{expectHostDevice1, expectHostDevice2}))
 		})
 	})
+
+	Context("USB", func() {
+		It("creates 2 USB devices that share the same resource", func() {
+			hostDevicesMetaData := []hostdevice.HostDeviceMetaData{
+				{AliasPrefix: aliasPrefix, Name: devName0, ResourceName: resourceName0},
+				{AliasPrefix: aliasPrefix, Name: devName1, ResourceName: resourceName0},
+			}
+			pool.AddResource(resourceName0, "1:3", "1:7")
+
+			hostDevices, err := hostdevice.CreateUSBHostDevices(hostDevicesMetaData, pool)
+
+			Expect(hostDevices, err).To(Equal([]api.HostDevice{
+				{
+					Alias:   newAlias(devName0),
+					Source:  api.HostDeviceSource{Address: &api.Address{Bus: "1", Device: "3"}},
+					Type:    api.HostDeviceUSB,
+					Mode:    "subsystem",
+					Managed: "no",
+				},
+				{
+					Alias:   newAlias(devName1),
+					Source:  api.HostDeviceSource{Address: &api.Address{Bus: "1", Device: "7"}},
+					Type:    api.HostDeviceUSB,
+					Mode:    "subsystem",
+					Managed: "no",
+				},
+			}))
+		})
+	})
 })
 
 func newAlias(netName string) *api.Alias {
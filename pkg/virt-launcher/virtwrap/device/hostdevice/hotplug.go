@@ -63,7 +63,7 @@ func SafelyDetachHostDevices(hostDevices []api.HostDevice, eventDetach EventRegi
 		}
 	}()
 
-	if err := detachHostDevices(dom, hostDevices); err != nil {
+	if err := DetachHostDevices(dom, hostDevices); err != nil {
 		return err
 	}
 
@@ -81,7 +81,8 @@ func FilterHostDevicesByAlias(hostDevices []api.HostDevice, prefix string) []api
 	return filteredHostDevices
 }
 
-func detachHostDevices(dom DeviceDetacher, hostDevices []api.HostDevice) error {
+// DetachHostDevices requests the detachment of the given host-devices without waiting for it to complete.
+func DetachHostDevices(dom DeviceDetacher, hostDevices []api.HostDevice) error {
 	for _, hostDev := range hostDevices {
 		devXML, err := xml.Marshal(hostDev)
 		if err != nil {
@@ -33,6 +33,11 @@ const (
 	EFIVarsSecureBoot = "OVMF_VARS.secboot.fd"
 	EFICodeSEV        = "OVMF_CODE.cc.fd"
 	EFIVarsSEV        = EFIVars
+
+	// PersistentNVRAMDir is where the backend storage of VMIs with a persistent EFI is mounted to keep their NVRAM
+	PersistentNVRAMDir = "/var/run/kubevirt-private/nvram"
+	// NonRootPersistentNVRAMDir is its counterpart for non-root VMIs, next to the persistent TPM state
+	NonRootPersistentNVRAMDir = "/var/run/kubevirt-private/libvirt/qemu/nvram"
 )
 
 type EFIEnvironment struct {
@@ -112,6 +117,18 @@ func DetectEFIEnvironment(arch, ovmfPath string) *EFIEnvironment {
 	}
 }
 
+// NVRAMPath returns the path of the NVRAM file of the domain, which survives
+// the VMI when it is stored on the backend storage.
+func NVRAMPath(domainName string, persistent, nonRoot bool) string {
+	if persistent && nonRoot {
+		return filepath.Join(NonRootPersistentNVRAMDir, domainName+"_VARS.fd")
+	}
+	if persistent {
+		return filepath.Join(PersistentNVRAMDir, domainName+"_VARS.fd")
+	}
+	return filepath.Join("/tmp", domainName)
+}
+
 func getEFIBinaryIfExists(path, binary string) string {
 	fullPath := filepath.Join(path, binary)
 	if _, err := os.Stat(fullPath); err == nil {
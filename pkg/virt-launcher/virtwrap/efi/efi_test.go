@@ -106,3 +106,17 @@ var _ = Describe("EFI environment detection", func() {
 		Expect(efiEnv.EFIVars(!secureBootEnabled, !sevEnabled)).To(Equal(varsSEV)) // same as EFIVars
 	})
 })
+
+var _ = Describe("EFI NVRAM", func() {
+	It("should be kept in a temporary directory when not persistent", func() {
+		Expect(NVRAMPath("default_testvmi", false, false)).To(Equal("/tmp/default_testvmi"))
+	})
+
+	It("should be kept on the backend storage when persistent", func() {
+		Expect(NVRAMPath("default_testvmi", true, false)).To(Equal(filepath.Join(PersistentNVRAMDir, "default_testvmi_VARS.fd")))
+	})
+
+	It("should be kept on the backend storage next to the TPM state when persistent and non-root", func() {
+		Expect(NVRAMPath("default_testvmi", true, true)).To(Equal(filepath.Join(NonRootPersistentNVRAMDir, "default_testvmi_VARS.fd")))
+	})
+})
@@ -4,11 +4,14 @@
 package virtwrap
 
 import (
+	time "time"
+
 	gomock "github.com/golang/mock/gomock"
 	v1 "kubevirt.io/api/core/v1"
 
 	v10 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	cmd_client "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	agent "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
@@ -266,6 +269,80 @@ func (_mr *_MockDomainManagerRecorder) Exec(arg0, arg1, arg2, arg3 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Exec", arg0, arg1, arg2, arg3)
 }
 
+func (_m *MockDomainManager) GuestExec(domainName string, command string, args []string, stdin []byte, timeoutSeconds int32) (*agent.GuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", domainName, command, args, stdin, timeoutSeconds)
+	ret0, _ := ret[0].(*agent.GuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestExec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2, arg3, arg4)
+}
+
+func (_m *MockDomainManager) GuestFileOpen(domainName string, path string, mode string) (int64, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileOpen", domainName, path, mode)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileOpen(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileOpen", arg0, arg1, arg2)
+}
+
+func (_m *MockDomainManager) GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", domainName, handle, count)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(bool)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileRead(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2)
+}
+
+func (_m *MockDomainManager) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", domainName, handle, data)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileWrite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2)
+}
+
+func (_m *MockDomainManager) GuestFileClose(domainName string, handle int64) error {
+	ret := _m.ctrl.Call(_m, "GuestFileClose", domainName, handle)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileClose(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileClose", arg0, arg1)
+}
+
+func (_m *MockDomainManager) AddEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string, ttl time.Duration) error {
+	ret := _m.ctrl.Call(_m, "AddEphemeralSSHKey", vmi, user, publicKey, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) AddEphemeralSSHKey(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddEphemeralSSHKey", arg0, arg1, arg2, arg3)
+}
+
+func (_m *MockDomainManager) RemoveEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string) error {
+	ret := _m.ctrl.Call(_m, "RemoveEphemeralSSHKey", vmi, user, publicKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) RemoveEphemeralSSHKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveEphemeralSSHKey", arg0, arg1, arg2)
+}
+
 func (_m *MockDomainManager) GuestPing(_param0 string) error {
 	ret := _m.ctrl.Call(_m, "GuestPing", _param0)
 	ret0, _ := ret[0].(error)
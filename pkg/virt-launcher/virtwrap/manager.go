@@ -46,6 +46,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/downwardmetrics"
 	"kubevirt.io/kubevirt/pkg/network/cache"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	cmdclient "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
@@ -125,6 +126,13 @@ type DomainManager interface {
 	InterfacesStatus() []api.InterfaceStatus
 	GetGuestOSInfo() *api.GuestOSInfo
 	Exec(string, string, []string, int32) (string, error)
+	GuestExec(domainName string, command string, args []string, stdin []byte, timeoutSeconds int32) (*agent.GuestExecResult, error)
+	GuestFileOpen(domainName string, path string, mode string) (int64, error)
+	GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error)
+	GuestFileWrite(domainName string, handle int64, data []byte) error
+	GuestFileClose(domainName string, handle int64) error
+	AddEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string, ttl time.Duration) error
+	RemoveEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string) error
 	GuestPing(string) error
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
@@ -352,7 +360,8 @@ func (l *LibvirtDomainManager) FinalizeVirtualMachineMigration(vmi *v1.VirtualMa
 	return l.finalizeMigrationTarget(vmi)
 }
 
-// HotplugHostDevices attach host-devices to running domain, currently only SRIOV host-devices are supported.
+// HotplugHostDevices attaches SRIOV and hotpluggable generic host-devices to the running domain,
+// and detaches the hotpluggable generic host-devices which are being removed.
 // This operation runs in the background, only one hotplug operation can occur at a time.
 func (l *LibvirtDomainManager) HotplugHostDevices(vmi *v1.VirtualMachineInstance) error {
 	select {
@@ -398,6 +407,19 @@ func (l *LibvirtDomainManager) hotPlugHostDevices(vmi *v1.VirtualMachineInstance
 		return fmt.Errorf("%s: %v", errMsgPrefix, hostdevice.AttachHostDevices(domain, sriovHostDevices))
 	}
 
+	genericHostDevices, err := generic.GetHostDevicesToAttach(vmi, domainSpec)
+	if err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	if err := hostdevice.AttachHostDevices(domain, genericHostDevices); err != nil {
+		return fmt.Errorf("%s: %v", errMsgPrefix, err)
+	}
+
+	if err := hostdevice.DetachHostDevices(domain, generic.GetHostDevicesToDetach(vmi, domainSpec)); err != nil {
+		return fmt.Errorf("failed to hot-unplug host-devices: %v", err)
+	}
+
 	return nil
 }
 
@@ -405,6 +427,34 @@ func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, t
 	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds)
 }
 
+func (l *LibvirtDomainManager) GuestExec(domainName string, command string, args []string, stdin []byte, timeoutSeconds int32) (*agent.GuestExecResult, error) {
+	return agent.GuestExecWithInput(l.virConn, domainName, command, args, stdin, timeoutSeconds)
+}
+
+func (l *LibvirtDomainManager) GuestFileOpen(domainName string, path string, mode string) (int64, error) {
+	return agent.GuestFileOpen(l.virConn, domainName, path, mode)
+}
+
+func (l *LibvirtDomainManager) GuestFileRead(domainName string, handle int64, count int32) ([]byte, bool, error) {
+	return agent.GuestFileRead(l.virConn, domainName, handle, count)
+}
+
+func (l *LibvirtDomainManager) GuestFileWrite(domainName string, handle int64, data []byte) error {
+	return agent.GuestFileWrite(l.virConn, domainName, handle, data)
+}
+
+func (l *LibvirtDomainManager) GuestFileClose(domainName string, handle int64) error {
+	return agent.GuestFileClose(l.virConn, domainName, handle)
+}
+
+func (l *LibvirtDomainManager) AddEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string, ttl time.Duration) error {
+	return l.credManager.AddEphemeralSSHKey(vmi, user, publicKey, ttl)
+}
+
+func (l *LibvirtDomainManager) RemoveEphemeralSSHKey(vmi *v1.VirtualMachineInstance, user string, publicKey string) error {
+	return l.credManager.RemoveEphemeralSSHKey(vmi, user, publicKey)
+}
+
 func (l *LibvirtDomainManager) GuestPing(domainName string) error {
 	pingCmd := `{"execute":"guest-ping"}`
 	_, err := l.virConn.QemuAgentCommand(pingCmd, domainName)
@@ -800,6 +850,7 @@ func (l *LibvirtDomainManager) generateConverterContext(vmi *v1.VirtualMachineIn
 
 	if options != nil {
 		c.ExpandDisksEnabled = options.ExpandDisksEnabled
+		c.SerialConsoleLog = options.SerialConsoleLogEnabled
 		if options.VirtualMachineSMBios != nil {
 			c.SMBios = options.VirtualMachineSMBios
 		}
@@ -1013,6 +1064,9 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		if err := networkInterfaceManager.hotplugVirtioInterface(vmi, &api.Domain{Spec: oldSpec}, domain); err != nil {
 			return nil, err
 		}
+		if err := networkInterfaceManager.updateInterfaces(&api.Domain{Spec: oldSpec}, domain); err != nil {
+			return nil, err
+		}
 	}
 
 	// TODO: check if VirtualMachineInstance Spec and Domain Spec are equal or if we have to sync
@@ -1592,7 +1646,12 @@ func (l *LibvirtDomainManager) DeleteVMI(vmi *v1.VirtualMachineInstance) error {
 	}
 	defer dom.Free()
 
-	err = dom.UndefineFlags(libvirt.DOMAIN_UNDEFINE_NVRAM)
+	undefineFlags := libvirt.DOMAIN_UNDEFINE_NVRAM
+	if backendstorage.HasPersistentEFI(&vmi.Spec) {
+		// The NVRAM lives on the backend storage and must survive the domain
+		undefineFlags = libvirt.DOMAIN_UNDEFINE_KEEP_NVRAM
+	}
+	err = dom.UndefineFlags(undefineFlags)
 	if err != nil {
 		log.Log.Object(vmi).Reason(err).Error("Undefining the domain failed.")
 		return err
@@ -591,6 +591,34 @@ var _ = Describe("Manager", func() {
 
 			Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
 		})
+		It("should run a command with stdin through the guest agent", func() {
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"wc","arg":["-l"],"input-data":"YQpiCg==","capture-output":true}}`, testDomainName).Return(`{"return":{"pid":7}}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute": "guest-exec-status", "arguments": { "pid": 7 } }`, testDomainName).Return(`{"return":{"exited":true,"exitcode":1,"out-data":"Mgo=","err-data":"ZXJy"}}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			result, err := manager.GuestExec(testDomainName, "wc", []string{"-l"}, []byte("a\nb\n"), 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.ExitCode).To(Equal(1))
+			Expect(string(result.StdOut)).To(Equal("2\n"))
+			Expect(string(result.StdErr)).To(Equal("err"))
+		})
+		It("should read and write guest files through the guest agent", func() {
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-file-open","arguments":{"path":"/tmp/file","mode":"rb"}}`, testDomainName).Return(`{"return":0}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-file-read","arguments":{"handle":0,"count":1024}}`, testDomainName).Return(`{"return":{"count":3,"buf-b64":"YWJj","eof":true}}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-file-write","arguments":{"handle":0,"buf-b64":"YWJj"}}`, testDomainName).Return(`{"return":{"count":3,"eof":false}}`, nil)
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-file-close","arguments":{"handle":0}}`, testDomainName).Return(`{"return":{}}`, nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache)
+
+			handle, err := manager.GuestFileOpen(testDomainName, "/tmp/file", "rb")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(handle).To(BeEquivalentTo(0))
+			data, eof, err := manager.GuestFileRead(testDomainName, handle, 1024)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal("abc"))
+			Expect(eof).To(BeTrue())
+			Expect(manager.GuestFileWrite(testDomainName, handle, []byte("abc"))).To(Succeed())
+			Expect(manager.GuestFileClose(testDomainName, handle)).To(Succeed())
+		})
 		It("should automatically unfreeze after a timeout a frozen VirtualMachineInstance", func() {
 			vmi := newVMI(testNamespace, testVmName)
 
@@ -1813,6 +1841,16 @@ var _ = Describe("Manager", func() {
 			Entry("crashed", libvirt.DOMAIN_CRASHED),
 			Entry("shutoff", libvirt.DOMAIN_SHUTOFF),
 		)
+		It("should keep the NVRAM of a VirtualMachineInstance with a persistent EFI", func() {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().UndefineFlags(libvirt.DOMAIN_UNDEFINE_KEEP_NVRAM).Return(nil)
+			manager, _ := NewLibvirtDomainManager(mockConn, "fake", "fake", nil, "/usr/share/", ephemeralDiskCreatorMock, metadataCache)
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{EFI: &v1.EFI{Persistent: pointer.Bool(true)}},
+			}
+			Expect(manager.DeleteVMI(vmi)).To(Succeed())
+		})
 		DescribeTable("should try to destroy a VirtualMachineInstance in state",
 			func(state libvirt.DomainState) {
 				mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
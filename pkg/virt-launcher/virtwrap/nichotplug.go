@@ -24,6 +24,8 @@ import (
 	"fmt"
 	"strings"
 
+	"k8s.io/apimachinery/pkg/api/equality"
+
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/log"
 
@@ -82,6 +84,45 @@ func (vim *virtIOInterfaceManager) hotplugVirtioInterface(vmi *v1.VirtualMachine
 	return nil
 }
 
+// updateInterfaces applies the link state and bandwidth changes of the updated domain interfaces
+// on the matching interfaces of the running domain.
+func (vim *virtIOInterfaceManager) updateInterfaces(currentDomain *api.Domain, updatedDomain *api.Domain) error {
+	currentIfaces := indexedDomainInterfaces(currentDomain)
+	for _, updatedIface := range updatedDomain.Spec.Devices.Interfaces {
+		currentIface, exists := currentIfaces[updatedIface.Alias.GetName()]
+		if !exists || !interfaceNeedsUpdate(currentIface, updatedIface) {
+			continue
+		}
+
+		currentIface.LinkState = &api.LinkState{State: linkState(updatedIface)}
+		currentIface.BandWidth = updatedIface.BandWidth
+		log.Log.Infof("will update interface %q: link state %q", updatedIface.Alias.GetName(), currentIface.LinkState.State)
+		ifaceXML, err := xml.Marshal(currentIface)
+		if err != nil {
+			return err
+		}
+
+		if err := vim.dom.UpdateDeviceFlags(strings.ToLower(string(ifaceXML)), affectLiveAndConfigLibvirtFlags); err != nil {
+			log.Log.Reason(err).Errorf("libvirt failed to update interface %s: %v", updatedIface.Alias.GetName(), err)
+			return err
+		}
+	}
+	return nil
+}
+
+func interfaceNeedsUpdate(currentIface, updatedIface api.Interface) bool {
+	return linkState(currentIface) != linkState(updatedIface) ||
+		!equality.Semantic.DeepEqual(currentIface.BandWidth, updatedIface.BandWidth)
+}
+
+// linkState returns the interface link state, an unset state meaning the link is up.
+func linkState(iface api.Interface) string {
+	if iface.LinkState == nil || iface.LinkState.State == "" {
+		return string(v1.InterfaceStateLinkUp)
+	}
+	return iface.LinkState.State
+}
+
 func domainInterfaceFromNetwork(domain *api.Domain, network v1.Network) *api.Interface {
 	for _, iface := range domain.Spec.Devices.Interfaces {
 		if iface.Alias.GetName() == network.Name {
@@ -139,6 +139,54 @@ var _ = Describe("nic hotplug on virt-launcher", func() {
 			libvirtClientResult{expectedError: fmt.Errorf("boom")},
 		),
 	)
+
+	Context("updateInterfaces", func() {
+		var (
+			mockDomain              *cli.MockVirDomain
+			networkInterfaceManager *virtIOInterfaceManager
+		)
+
+		BeforeEach(func() {
+			mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+			networkInterfaceManager = newVirtIOInterfaceManager(mockDomain, &fakeVMConfigurator{})
+		})
+
+		It("should not update interfaces whose link state and bandwidth did not change", func() {
+			currentDomain := dummyDomain(networkName)
+			updatedDomain := dummyDomain(networkName)
+			updatedDomain.Spec.Devices.Interfaces[0].LinkState = &api.LinkState{State: "up"}
+
+			Expect(networkInterfaceManager.updateInterfaces(currentDomain, updatedDomain)).To(Succeed())
+		})
+
+		It("should not update interfaces which are not in the domain yet", func() {
+			updatedDomain := dummyDomain(networkName)
+			updatedDomain.Spec.Devices.Interfaces[0].LinkState = &api.LinkState{State: "down"}
+
+			Expect(networkInterfaceManager.updateInterfaces(dummyDomain(), updatedDomain)).To(Succeed())
+		})
+
+		It("should update the link state and bandwidth of the changed interfaces", func() {
+			currentDomain := dummyDomain(networkName)
+			currentDomain.Spec.Devices.Interfaces[0].LinkState = &api.LinkState{State: "down"}
+			updatedDomain := dummyDomain(networkName)
+			updatedDomain.Spec.Devices.Interfaces[0].BandWidth = &api.BandWidth{Inbound: &api.BandWidthRate{Average: 1000}}
+
+			mockDomain.EXPECT().UpdateDeviceFlags(
+				`<interface type=""><source></source><bandwidth><inbound average="1000"></inbound></bandwidth><link state="up"></link><alias name="ua-n1"></alias></interface>`,
+				affectLiveAndConfigLibvirtFlags,
+			).Return(nil)
+			Expect(networkInterfaceManager.updateInterfaces(currentDomain, updatedDomain)).To(Succeed())
+		})
+
+		It("should fail when libvirt fails to update the interface", func() {
+			updatedDomain := dummyDomain(networkName)
+			updatedDomain.Spec.Devices.Interfaces[0].LinkState = &api.LinkState{State: "down"}
+
+			mockDomain.EXPECT().UpdateDeviceFlags(gomock.Any(), affectLiveAndConfigLibvirtFlags).Return(fmt.Errorf("boom"))
+			Expect(networkInterfaceManager.updateInterfaces(dummyDomain(networkName), updatedDomain)).To(MatchError("boom"))
+		})
+	})
 })
 
 type libvirtClientResult struct {
@@ -25,6 +25,8 @@ import (
 	"kubevirt.io/api/clone"
 
 	clonev1alpha1 "kubevirt.io/api/clone/v1alpha1"
+	"kubevirt.io/api/node"
+	nodev1alpha1 "kubevirt.io/api/node/v1alpha1"
 
 	"kubevirt.io/api/instancetype"
 
@@ -66,6 +68,7 @@ var (
 	VIRTUALMACHINEEXPORT             = "virtualmachineexports." + exportv1.SchemeGroupVersion.Group
 	MIGRATIONPOLICY                  = "migrationpolicies." + migrationsv1.MigrationPolicyKind.Group
 	VIRTUALMACHINECLONE              = "virtualmachineclones." + clonev1alpha1.VirtualMachineCloneKind.Group
+	NODECAPABILITIES                 = node.ResourceNodeCapabilitiesPlural + "." + nodev1alpha1.NodeCapabilitiesKind.Group
 	PreserveUnknownFieldsFalse       = false
 )
 
@@ -821,6 +824,48 @@ func NewVirtualMachineCloneCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewNodeCapabilitiesCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = NODECAPABILITIES
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group: nodev1alpha1.NodeCapabilitiesKind.Group,
+		Versions: []extv1.CustomResourceDefinitionVersion{
+			{
+				Name:    nodev1alpha1.SchemeGroupVersion.Version,
+				Served:  true,
+				Storage: true,
+			},
+		},
+		Scope: extv1.ClusterScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     node.ResourceNodeCapabilitiesPlural,
+			Singular:   node.ResourceNodeCapabilitiesSingular,
+			ShortNames: []string{"nodecaps"},
+			Kind:       nodev1alpha1.NodeCapabilitiesKind.Kind,
+		},
+	}
+	err := addFieldsToAllVersions(crd,
+		&extv1.CustomResourceSubresources{
+			Status: &extv1.CustomResourceSubresourceStatus{},
+		},
+		[]extv1.CustomResourceColumnDefinition{
+			{Name: "HostModel", Type: "string", JSONPath: ".status.cpu.hostModel"},
+			{Name: "QEMU", Type: "string", JSONPath: ".status.hypervisor.qemuVersion"},
+			{Name: "Libvirt", Type: "string", JSONPath: ".status.hypervisor.libvirtVersion"},
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 // NewKubeVirtPriorityClassCR is used for manifest generation
 func NewKubeVirtPriorityClassCR() *schedulingv1.PriorityClass {
 	return &schedulingv1.PriorityClass{
@@ -685,6 +685,17 @@ var CRDsValidation map[string]string = map[string]string{
                       type: object
                   type: object
               type: object
+            cpuBaseline:
+              description: CPUBaseline selects the nodes the cluster-baseline CPU
+                model is computed for. Requires the ClusterCPUBaseline feature gate.
+              properties:
+                nodeSelector:
+                  additionalProperties:
+                    type: string
+                  description: NodeSelector restricts the cluster CPU baseline to
+                    a group of nodes. All the schedulable nodes are selected if empty.
+                  type: object
+              type: object
             cpuModel:
               type: string
             cpuRequest:
@@ -874,6 +885,42 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                rebalancing:
+                  description: Rebalancing lets virt-controller reconfigure idle GPUs
+                    to the mediated device types requested by pending VMIs. Requires
+                    the MediatedDevicesRebalancing feature gate.
+                  properties:
+                    minimumNodesPerType:
+                      description: MinimumNodesPerType is the number of nodes which
+                        keep offering a mediated device type, even if no pending VMI
+                        requests it.
+                      items:
+                        description: MediatedDeviceTypeMinimum is the minimum number
+                          of nodes offering a mediated device type.
+                        properties:
+                          mediatedDeviceType:
+                            description: MediatedDeviceType is the name of the mediated
+                              device type
+                            type: string
+                          nodes:
+                            description: Nodes is the minimum number of nodes offering
+                              the type
+                            type: integer
+                        required:
+                        - mediatedDeviceType
+                        - nodes
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    nodeSelector:
+                      additionalProperties:
+                        type: string
+                      description: NodeSelector selects the nodes whose GPUs may be
+                        reconfigured. The selected nodes must support all the mediated
+                        device types requested by the VMIs. All nodes are selected
+                        if empty.
+                      type: object
+                  type: object
               type: object
             memBalloonStatsPeriod:
               format: int32
@@ -964,6 +1011,12 @@ var CRDsValidation map[string]string = map[string]string{
                   type: boolean
                 permitSlirpInterface:
                   type: boolean
+                secondaryNetworkDNSDomain:
+                  description: SecondaryNetworkDNSDomain is the DNS domain appended
+                    to the '<vmi>.<network>.<namespace>' records published for the
+                    secondary network interfaces of VMIs. Requires the SecondaryNetworkDNS
+                    feature gate.
+                  type: string
               type: object
             obsoleteCPUModels:
               additionalProperties:
@@ -1018,6 +1071,54 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                usbHostDevices:
+                  items:
+                    description: USBHostDevice represents host USB devices allowed
+                      for passthrough
+                    properties:
+                      externalResourceProvider:
+                        description: If true, KubeVirt will leave the allocation and
+                          monitoring to an external device plugin
+                        type: boolean
+                      resourceName:
+                        description: The name of the resource that is representing
+                          the devices. Exposed by a device plugin and requested by
+                          VMs. Typically of the form vendor.com/product_name
+                        type: string
+                      selectors:
+                        description: Selectors identify the host USB devices exposed
+                          under the resource. Every matching device can be allocated
+                          on its own.
+                        items:
+                          description: USBSelector selects host USB devices by their
+                            vendor and product IDs, and optionally by the port they
+                            are plugged into
+                          properties:
+                            busPath:
+                              description: The bus path of the port the device is
+                                plugged into, as named under /sys/bus/usb/devices,
+                                e.g. 1-2.3. Devices plugged into any port match if
+                                empty.
+                              type: string
+                            product:
+                              description: The product ID of the USB device, e.g.
+                                0001
+                              type: string
+                            vendor:
+                              description: The vendor ID of the USB device, e.g. 0529
+                              type: string
+                          required:
+                          - product
+                          - vendor
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - resourceName
+                    - selectors
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
               type: object
             seccompConfiguration:
               description: SeccompConfiguration holds Seccomp configuration for Kubevirt
@@ -1130,10 +1231,20 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             virtualMachineInstancesPerNode:
               type: integer
+            vmStateStorageAccessMode:
+              description: VMStateStorageAccessMode is the access mode of the PVCs
+                created to preserve VM state. ReadWriteMany volumes are shared by
+                the source and the target of a migration, while the state kept on
+                ReadWriteOnce volumes is transferred to a new volume on every migration.
+                Defaults to ReadWriteMany
+              enum:
+              - ReadWriteMany
+              - ReadWriteOnce
+              type: string
             vmStateStorageClass:
               description: VMStateStorageClass is the name of the storage class to
                 use for the PVCs created to preserve VM state, like TPM. The storage
-                class must support RWX in filesystem mode.
+                class must support filesystem mode, and the access mode set in VMStateStorageAccessMode.
               type: string
             webhookConfiguration:
               description: ReloadableComponentConfiguration holds all generic k8s
@@ -3138,6 +3249,26 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        cpuBaseline:
+          description: CPUBaseline reports the newest CPU model and features supported
+            by all the nodes selected for the cluster CPU baseline
+          properties:
+            features:
+              description: Features are the CPU features supported by all the nodes
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            model:
+              description: Model is the newest named CPU model supported by all the
+                nodes, empty if they have none in common
+              type: string
+            nodes:
+              description: Nodes is the number of nodes the baseline is computed for
+              type: integer
+          required:
+          - nodes
+          type: object
         defaultArchitecture:
           type: string
         generations:
@@ -3174,6 +3305,60 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        mediatedDevicesRebalancing:
+          description: MediatedDevicesRebalancing reports the plan of the mediated
+            device types rebalancing
+          properties:
+            nodes:
+              description: Nodes lists the nodes whose mediated device types were
+                set by the rebalancing
+              items:
+                description: NodeMediatedDeviceTypesPlan holds the mediated device
+                  types assigned to a node by the rebalancing.
+                properties:
+                  mediatedDeviceTypes:
+                    description: MediatedDeviceTypes are the mediated device types
+                      assigned to the node
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  nodeName:
+                    description: NodeName is the name of the node
+                    type: string
+                  ready:
+                    description: Ready tells whether the node advertises the resources
+                      of all its assigned types
+                    type: boolean
+                required:
+                - mediatedDeviceTypes
+                - nodeName
+                - ready
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            pendingRequests:
+              description: PendingRequests lists the mediated device types requested
+                by unschedulable VMIs
+              items:
+                description: MediatedDeviceTypeRequest is the number of unschedulable
+                  VMIs requesting a mediated device type.
+                properties:
+                  mediatedDeviceType:
+                    description: MediatedDeviceType is the name of the mediated device
+                      type
+                    type: string
+                  virtualMachineInstances:
+                    description: VirtualMachineInstances is the number of unschedulable
+                      VMIs requesting the type
+                    type: integer
+                required:
+                - mediatedDeviceType
+                - virtualMachineInstances
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         observedDeploymentConfig:
           type: string
         observedDeploymentID:
@@ -3257,6 +3442,186 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"nodecapabilities": `openAPIV3Schema:
+  description: NodeCapabilities reports the virtualization capabilities of a node,
+    as discovered by virt-handler. It is named after the node and is garbage collected
+    along with it.
+  properties:
+    apiVersion:
+      description: 'APIVersion defines the versioned schema of this representation
+        of an object. Servers should convert recognized schemas to the latest internal
+        value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources'
+      type: string
+    kind:
+      description: 'Kind is a string value representing the REST resource this object
+        represents. Servers may infer this from the endpoint the client submits requests
+        to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds'
+      type: string
+    metadata:
+      type: object
+    status:
+      nullable: true
+      properties:
+        cpu:
+          description: CPU holds the CPU models and features which can be provided
+            to guests
+          properties:
+            arch:
+              type: string
+            features:
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            hostModel:
+              description: HostModel is the CPU model used by guests with the host-model
+                CPU mode
+              type: string
+            hostModelRequiredFeatures:
+              description: HostModelRequiredFeatures are the features the host-model
+                CPU adds on top of the host model
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            hypervFeatures:
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            models:
+              description: Models are the usable CPU models, without the obsolete
+                ones
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            sev:
+              description: SEV is true when the node supports AMD Secure Encrypted
+                Virtualization
+              type: boolean
+            tsc:
+              properties:
+                frequency:
+                  format: int64
+                  type: integer
+                scalable:
+                  type: boolean
+              required:
+              - frequency
+              - scalable
+              type: object
+            vendor:
+              type: string
+          type: object
+        hypervisor:
+          description: Hypervisor holds the versions of the virtualization stack of
+            the node
+          properties:
+            libvirtVersion:
+              type: string
+            qemuVersion:
+              type: string
+          type: object
+        iommuGroups:
+          description: IOMMUGroups lists the PCI devices of the node by IOMMU group
+          items:
+            properties:
+              devices:
+                description: Devices are the PCI addresses of the devices of the group
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              id:
+                format: int32
+                type: integer
+            required:
+            - devices
+            - id
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        machineTypes:
+          description: MachineTypes lists the machine types supported by the emulator
+          items:
+            properties:
+              canonical:
+                description: Canonical is the versioned machine type the name is an
+                  alias of
+                type: string
+              maxCPUs:
+                format: int32
+                type: integer
+              name:
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+        numaCells:
+          description: NUMACells describes the NUMA topology of the node, along with
+            the hugepages of every cell
+          items:
+            properties:
+              cpuSet:
+                description: CPUSet lists the host CPUs of the cell in the cpuset
+                  format, e.g. 0-3,8-11
+                type: string
+              distances:
+                description: Distances to the other cells, as reported by the firmware
+                items:
+                  properties:
+                    cellID:
+                      format: int32
+                      type: integer
+                    value:
+                      format: int64
+                      type: integer
+                  required:
+                  - cellID
+                  - value
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              hugePages:
+                items:
+                  properties:
+                    count:
+                      description: Count is the number of pages of the cell, both
+                        free and in use
+                      format: int64
+                      type: integer
+                    pageSize:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                  required:
+                  - count
+                  - pageSize
+                  type: object
+                type: array
+                x-kubernetes-list-type: atomic
+              id:
+                format: int32
+                type: integer
+              memory:
+                anyOf:
+                - type: integer
+                - type: string
+                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                x-kubernetes-int-or-string: true
+            required:
+            - id
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
+      type: object
+  type: object
 `,
 	"virtualmachine": `openAPIV3Schema:
   description: VirtualMachine handles the VirtualMachines that are not running or
@@ -5038,12 +5403,77 @@ var CRDsValidation map[string]string = map[string]string{
                             List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                             It is possible to specify special cases like "host-passthrough"
                             to get the same CPU as the node and "host-model" to get
-                            CPU closest to the node one. Defaults to host-model.
+                            CPU closest to the node one. "cluster-baseline" gets the
+                            newest CPU model supported by all the nodes of the cluster,
+                            and requires the ClusterCPUBaseline feature gate. Defaults
+                            to host-model.
                           type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
                           properties:
+                            cells:
+                              description: Cells explicitly declares the guest NUMA
+                                topology, without requiring dedicated CPUs. The cells
+                                must cover all the vCPUs and the whole guest memory.
+                                Can't be combined with GuestMappingPassthrough.
+                              items:
+                                description: NUMACell declares a guest NUMA cell.
+                                properties:
+                                  cpuSet:
+                                    description: CPUSet lists the vCPUs of the cell,
+                                      in the cpuset format, e.g. 0-3,8
+                                    type: string
+                                  distances:
+                                    description: Distances to the cells, including
+                                      the cell itself. Left to the hypervisor defaults
+                                      if empty.
+                                    items:
+                                      description: NUMADistance is the distance of
+                                        a guest NUMA cell to another.
+                                      properties:
+                                        cellID:
+                                          description: CellID is the ID of the other
+                                            cell
+                                          format: int32
+                                          type: integer
+                                        value:
+                                          description: Value is the distance, 10 being
+                                            the distance of a cell to itself
+                                          format: int32
+                                          type: integer
+                                      required:
+                                      - cellID
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  hostNodeSet:
+                                    description: HostNodeSet binds the memory of the
+                                      cell to host NUMA nodes, in the cpuset format,
+                                      e.g. 0,1. The memory is not bound if empty.
+                                    type: string
+                                  id:
+                                    description: ID of the cell. The cells must be
+                                      numbered from 0, in order.
+                                    format: int32
+                                    type: integer
+                                  memory:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Memory is the amount of guest memory
+                                      of the cell. When hugepages are requested, it
+                                      must be a multiple of the page size.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - cpuSet
+                                - id
+                                - memory
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
                             guestMappingPassthrough:
                               description: GuestMappingPassthrough will create an
                                 efficient guest topology based on host CPUs exclusively
@@ -5310,6 +5740,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: DeviceName is the resource name of the
                                   host device exposed by a device plugin
                                 type: string
+                              hotpluggable:
+                                description: Hotpluggable indicates whether the device
+                                  is attached through an attachment pod, after the
+                                  VMI is started, and can be detached again while
+                                  the VMI is running.
+                                type: boolean
                               name:
                                 type: string
                               tag:
@@ -5355,6 +5791,75 @@ var CRDsValidation map[string]string = map[string]string{
                                   to the device. This value is required to be unique
                                   across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: If specified, limits the inbound and
+                                  outbound traffic of the interface. Only supported
+                                  with the bridge, masquerade and macvtap bindings.
+                                  Can be changed while the VMI is running.
+                                properties:
+                                  inbound:
+                                    description: Limits of the traffic received by
+                                      the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second
+                                          the traffic is shaped to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Amount of bytes which can be
+                                          sent at the peak rate in a single burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Maximum rate in bytes per second
+                                          at which the traffic can be sent while bursting.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Limits of the traffic sent by the
+                                      guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second
+                                          the traffic is shaped to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Amount of bytes which can be
+                                          sent at the peak rate in a single burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Maximum rate in bytes per second
+                                          at which the traffic can be sent while bursting.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               bootOrder:
                                 description: BootOrder is an integer value > 0, used
                                   to determine ordering of boot devices. Lower values
@@ -5431,6 +5936,15 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               passt:
                                 description: InterfacePasst connects to a given network.
+                                properties:
+                                  portForwarding:
+                                    description: PortForwarding selects the ports
+                                      forwarded from the pod to the guest. All forwards
+                                      every TCP and UDP port, List forwards only the
+                                      ports listed in the interface ports. Defaults
+                                      to List when the interface specifies ports,
+                                      All otherwise.
+                                    type: string
                                 type: object
                               pciAddress:
                                 description: 'If specified, the virtual network interface
@@ -5469,10 +5983,25 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: InterfaceSlirp connects to a given network
                                   using QEMU user networking mode.
                                 type: object
+                              spoofCheck:
+                                description: If set to true, frames sent by the guest
+                                  through the interface are dropped when their source
+                                  MAC address, ARP sender addresses or source IP address
+                                  do not match the ones assigned to the interface.
+                                  Only supported with the bridge and masquerade bindings.
+                                type: boolean
                               sriov:
                                 description: InterfaceSRIOV connects to a given network
                                   by passing-through an SR-IOV PCI device via vfio.
                                 type: object
+                              state:
+                                description: 'State represents the requested link
+                                  state of the interface. Setting it to down disconnects
+                                  the link as if the cable was unplugged, without
+                                  removing the interface from the guest. Can be changed
+                                  while the VMI is running. One of: up, down. Defaults
+                                  to up.'
+                                type: string
                               tag:
                                 description: If specified, the virtual network interface
                                   address and its tag will be provided to the guest
@@ -5482,6 +6011,13 @@ var CRDsValidation map[string]string = map[string]string{
                             - name
                             type: object
                           type: array
+                        logSerialConsole:
+                          description: Whether to log the auto-attached default serial
+                            console or not. The serial console output is collected
+                            to a size-capped file, and streamed by the guest-console-log
+                            container of the virt-launcher pod. Not relevant if autoattachSerialConsole
+                            is disabled. Defaults to true.
+                          type: boolean
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured
                             with a virtio bus will also enable the vhost multiqueue
@@ -5493,6 +6029,28 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        serialPorts:
+                          description: SerialPorts are additional named serial ports,
+                            next to the serial console. They can be connected to through
+                            the console subresource.
+                          items:
+                            description: SerialPort is an additional serial port of
+                              the VMI.
+                            properties:
+                              name:
+                                description: Name of the port, used to connect to
+                                  it through the console subresource.
+                                type: string
+                              type:
+                                description: Type of the port. serial emulates an
+                                  ISA serial port, virtio a virtio-console port. Defaults
+                                  to virtio.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -5522,6 +6080,31 @@ var CRDsValidation map[string]string = map[string]string{
                             like CentOS6 or RHEL6 which do not understand virtio_non_transitional
                             (virtio 1.0).
                           type: boolean
+                        video:
+                          description: Video configures the video device attached
+                            along with the graphics device. If not set, the default
+                            video device of the architecture is used.
+                          properties:
+                            resolution:
+                              description: Resolution is the preferred resolution
+                                of the display, reported to the guest.
+                              properties:
+                                height:
+                                  format: int32
+                                  type: integer
+                                width:
+                                  format: int32
+                                  type: integer
+                              required:
+                              - height
+                              - width
+                              type: object
+                            type:
+                              description: Type of the video device. One of virtio,
+                                bochs or vga. virtio is the only type supported on
+                                arm64.
+                              type: string
+                          type: object
                         watchdog:
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
@@ -5782,6 +6365,10 @@ var CRDsValidation map[string]string = map[string]string{
                             efi:
                               description: If set, EFI will be used instead of BIOS.
                               properties:
+                                persistent:
+                                  description: If set to true, Persistent will persist
+                                    the EFI NVRAM across reboots. Defaults to false
+                                  type: boolean
                                 secureBoot:
                                   description: If set, SecureBoot will be enabled
                                     and the OVMF roms will be swapped for SecureBoot-enabled
@@ -7387,6 +7974,65 @@ var CRDsValidation map[string]string = map[string]string{
             numa:
               description: NUMA allows specifying settings for the guest NUMA topology
               properties:
+                cells:
+                  description: Cells explicitly declares the guest NUMA topology,
+                    without requiring dedicated CPUs. The cells must cover all the
+                    vCPUs and the whole guest memory. Can't be combined with GuestMappingPassthrough.
+                  items:
+                    description: NUMACell declares a guest NUMA cell.
+                    properties:
+                      cpuSet:
+                        description: CPUSet lists the vCPUs of the cell, in the cpuset
+                          format, e.g. 0-3,8
+                        type: string
+                      distances:
+                        description: Distances to the cells, including the cell itself.
+                          Left to the hypervisor defaults if empty.
+                        items:
+                          description: NUMADistance is the distance of a guest NUMA
+                            cell to another.
+                          properties:
+                            cellID:
+                              description: CellID is the ID of the other cell
+                              format: int32
+                              type: integer
+                            value:
+                              description: Value is the distance, 10 being the distance
+                                of a cell to itself
+                              format: int32
+                              type: integer
+                          required:
+                          - cellID
+                          - value
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      hostNodeSet:
+                        description: HostNodeSet binds the memory of the cell to host
+                          NUMA nodes, in the cpuset format, e.g. 0,1. The memory is
+                          not bound if empty.
+                        type: string
+                      id:
+                        description: ID of the cell. The cells must be numbered from
+                          0, in order.
+                        format: int32
+                        type: integer
+                      memory:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Memory is the amount of guest memory of the cell.
+                          When hugepages are requested, it must be a multiple of the
+                          page size.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - cpuSet
+                    - id
+                    - memory
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 guestMappingPassthrough:
                   description: GuestMappingPassthrough will create an efficient guest
                     topology based on host CPUs exclusively assigned to a pod. The
@@ -7454,6 +8100,11 @@ var CRDsValidation map[string]string = map[string]string{
                 description: DeviceName is the resource name of the host device exposed
                   by a device plugin
                 type: string
+              hotpluggable:
+                description: Hotpluggable indicates whether the device is attached
+                  through an attachment pod, after the VMI is started, and can be
+                  detached again while the VMI is running.
+                type: boolean
               name:
                 type: string
               tag:
@@ -9350,12 +10001,74 @@ var CRDsValidation map[string]string = map[string]string{
                     of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough"
                     to get the same CPU as the node and "host-model" to get CPU closest
-                    to the node one. Defaults to host-model.
+                    to the node one. "cluster-baseline" gets the newest CPU model
+                    supported by all the nodes of the cluster, and requires the ClusterCPUBaseline
+                    feature gate. Defaults to host-model.
                   type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
                   properties:
+                    cells:
+                      description: Cells explicitly declares the guest NUMA topology,
+                        without requiring dedicated CPUs. The cells must cover all
+                        the vCPUs and the whole guest memory. Can't be combined with
+                        GuestMappingPassthrough.
+                      items:
+                        description: NUMACell declares a guest NUMA cell.
+                        properties:
+                          cpuSet:
+                            description: CPUSet lists the vCPUs of the cell, in the
+                              cpuset format, e.g. 0-3,8
+                            type: string
+                          distances:
+                            description: Distances to the cells, including the cell
+                              itself. Left to the hypervisor defaults if empty.
+                            items:
+                              description: NUMADistance is the distance of a guest
+                                NUMA cell to another.
+                              properties:
+                                cellID:
+                                  description: CellID is the ID of the other cell
+                                  format: int32
+                                  type: integer
+                                value:
+                                  description: Value is the distance, 10 being the
+                                    distance of a cell to itself
+                                  format: int32
+                                  type: integer
+                              required:
+                              - cellID
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          hostNodeSet:
+                            description: HostNodeSet binds the memory of the cell
+                              to host NUMA nodes, in the cpuset format, e.g. 0,1.
+                              The memory is not bound if empty.
+                            type: string
+                          id:
+                            description: ID of the cell. The cells must be numbered
+                              from 0, in order.
+                            format: int32
+                            type: integer
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory is the amount of guest memory of the
+                              cell. When hugepages are requested, it must be a multiple
+                              of the page size.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - cpuSet
+                        - id
+                        - memory
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     guestMappingPassthrough:
                       description: GuestMappingPassthrough will create an efficient
                         guest topology based on host CPUs exclusively assigned to
@@ -9606,6 +10319,11 @@ var CRDsValidation map[string]string = map[string]string{
                         description: DeviceName is the resource name of the host device
                           exposed by a device plugin
                         type: string
+                      hotpluggable:
+                        description: Hotpluggable indicates whether the device is
+                          attached through an attachment pod, after the VMI is started,
+                          and can be detached again while the VMI is running.
+                        type: boolean
                       name:
                         type: string
                       tag:
@@ -9649,6 +10367,73 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device. This value is required
                           to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: If specified, limits the inbound and outbound
+                          traffic of the interface. Only supported with the bridge,
+                          masquerade and macvtap bindings. Can be changed while the
+                          VMI is running.
+                        properties:
+                          inbound:
+                            description: Limits of the traffic received by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second the
+                                  traffic is shaped to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Amount of bytes which can be sent at
+                                  the peak rate in a single burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Maximum rate in bytes per second at which
+                                  the traffic can be sent while bursting.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Limits of the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second the
+                                  traffic is shaped to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Amount of bytes which can be sent at
+                                  the peak rate in a single burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Maximum rate in bytes per second at which
+                                  the traffic can be sent while bursting.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       bootOrder:
                         description: BootOrder is an integer value > 0, used to determine
                           ordering of boot devices. Lower values take precedence.
@@ -9722,6 +10507,14 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       passt:
                         description: InterfacePasst connects to a given network.
+                        properties:
+                          portForwarding:
+                            description: PortForwarding selects the ports forwarded
+                              from the pod to the guest. All forwards every TCP and
+                              UDP port, List forwards only the ports listed in the
+                              interface ports. Defaults to List when the interface
+                              specifies ports, All otherwise.
+                            type: string
                         type: object
                       pciAddress:
                         description: 'If specified, the virtual network interface
@@ -9759,10 +10552,24 @@ var CRDsValidation map[string]string = map[string]string{
                         description: InterfaceSlirp connects to a given network using
                           QEMU user networking mode.
                         type: object
+                      spoofCheck:
+                        description: If set to true, frames sent by the guest through
+                          the interface are dropped when their source MAC address,
+                          ARP sender addresses or source IP address do not match the
+                          ones assigned to the interface. Only supported with the
+                          bridge and masquerade bindings.
+                        type: boolean
                       sriov:
                         description: InterfaceSRIOV connects to a given network by
                           passing-through an SR-IOV PCI device via vfio.
                         type: object
+                      state:
+                        description: 'State represents the requested link state of
+                          the interface. Setting it to down disconnects the link as
+                          if the cable was unplugged, without removing the interface
+                          from the guest. Can be changed while the VMI is running.
+                          One of: up, down. Defaults to up.'
+                        type: string
                       tag:
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
@@ -9771,6 +10578,13 @@ var CRDsValidation map[string]string = map[string]string{
                     - name
                     type: object
                   type: array
+                logSerialConsole:
+                  description: Whether to log the auto-attached default serial console
+                    or not. The serial console output is collected to a size-capped
+                    file, and streamed by the guest-console-log container of the virt-launcher
+                    pod. Not relevant if autoattachSerialConsole is disabled. Defaults
+                    to true.
+                  type: boolean
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured
                     with a virtio bus will also enable the vhost multiqueue feature
@@ -9781,6 +10595,26 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                serialPorts:
+                  description: SerialPorts are additional named serial ports, next
+                    to the serial console. They can be connected to through the console
+                    subresource.
+                  items:
+                    description: SerialPort is an additional serial port of the VMI.
+                    properties:
+                      name:
+                        description: Name of the port, used to connect to it through
+                          the console subresource.
+                        type: string
+                      type:
+                        description: Type of the port. serial emulates an ISA serial
+                          port, virtio a virtio-console port. Defaults to virtio.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -9809,6 +10643,30 @@ var CRDsValidation map[string]string = map[string]string{
                     CentOS6 or RHEL6 which do not understand virtio_non_transitional
                     (virtio 1.0).
                   type: boolean
+                video:
+                  description: Video configures the video device attached along with
+                    the graphics device. If not set, the default video device of the
+                    architecture is used.
+                  properties:
+                    resolution:
+                      description: Resolution is the preferred resolution of the display,
+                        reported to the guest.
+                      properties:
+                        height:
+                          format: int32
+                          type: integer
+                        width:
+                          format: int32
+                          type: integer
+                      required:
+                      - height
+                      - width
+                      type: object
+                    type:
+                      description: Type of the video device. One of virtio, bochs
+                        or vga. virtio is the only type supported on arm64.
+                      type: string
+                  type: object
                 watchdog:
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
@@ -10045,6 +10903,10 @@ var CRDsValidation map[string]string = map[string]string{
                     efi:
                       description: If set, EFI will be used instead of BIOS.
                       properties:
+                        persistent:
+                          description: If set to true, Persistent will persist the
+                            EFI NVRAM across reboots. Defaults to false
+                          type: boolean
                         secureBoot:
                           description: If set, SecureBoot will be enabled and the
                             OVMF roms will be swapped for SecureBoot-enabled ones.
@@ -11060,6 +11922,39 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        consoleSessions:
+          description: ConsoleSessions lists the clients connected to the serial console,
+            the additional serial ports and the VNC console
+          items:
+            description: VirtualMachineInstanceConsoleSession is a client connected
+              to a console of the VMI. A console is held by at most one client which
+              isn't read-only, the others only observe it.
+            properties:
+              connectedTimestamp:
+                description: ConnectedTimestamp is when the client connected
+                format: date-time
+                type: string
+              console:
+                description: Console is the kind of console the client is connected
+                  to
+                type: string
+              readOnly:
+                description: ReadOnly is true for the observers, whose input is not
+                  sent to the console
+                type: boolean
+              serialPort:
+                description: SerialPort is the name of the additional serial port
+                  the client is connected to, empty for the serial console
+                type: string
+              user:
+                description: User is the name of the user who opened the session
+                type: string
+            required:
+            - connectedTimestamp
+            - console
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         evacuationNodeName:
           description: EvacuationNodeName is used to track the eviction process of
             a VMI. It stores the name of the node that we want to evacuate. It is
@@ -11098,6 +11993,44 @@ var CRDsValidation map[string]string = map[string]string{
               description: Version ID of the Guest OS
               type: string
           type: object
+        hostDeviceStatus:
+          description: HostDeviceStatus contains the statuses of the hot-attached
+            host devices
+          items:
+            description: HostDeviceStatus represents information about the status
+              of a host device hot-attached to the VirtualMachineInstance.
+            properties:
+              address:
+                description: Address is the host address of the allocated device,
+                  a PCI address or a mediated device UUID.
+                type: string
+              attachPodName:
+                description: AttachPodName is the name of the pod used to allocate
+                  the host device on the node.
+                type: string
+              attachPodUID:
+                description: AttachPodUID is the UID of the pod used to allocate the
+                  host device on the node.
+                type: string
+              message:
+                description: Message is a detailed message about the current hotplug
+                  host device phase
+                type: string
+              name:
+                description: Name is the name of the host device
+                type: string
+              phase:
+                description: Phase is the phase
+                type: string
+              reason:
+                description: Reason is a brief description of why we are in the current
+                  hotplug host device phase
+                type: string
+            required:
+            - name
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         interfaces:
           description: Interfaces represent the details of available network interfaces.
           items:
@@ -11348,6 +12281,31 @@ var CRDsValidation map[string]string = map[string]string{
           description: SELinuxContext is the actual SELinux context of the virt-launcher
             pod
           type: string
+        sshHostKeys:
+          description: SSHHostKeys are the public host keys of the SSH server of the
+            guest, reported by the guest agent when an ephemeral SSH key is added,
+            so that SSH clients can verify the guest
+          items:
+            description: SSHHostKey is a public host key of the SSH server of the
+              guest
+            properties:
+              fingerprint:
+                description: Fingerprint is the SHA256 fingerprint of the key, as
+                  printed by ssh-keygen -l
+                type: string
+              publicKey:
+                description: PublicKey is the key in the authorized_keys format
+                type: string
+              type:
+                description: Type is the algorithm of the key, e.g. ssh-ed25519
+                type: string
+            required:
+            - fingerprint
+            - publicKey
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-type: atomic
         topologyHints:
           properties:
             tscFrequency:
@@ -11879,12 +12837,74 @@ var CRDsValidation map[string]string = map[string]string{
                     of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                     It is possible to specify special cases like "host-passthrough"
                     to get the same CPU as the node and "host-model" to get CPU closest
-                    to the node one. Defaults to host-model.
+                    to the node one. "cluster-baseline" gets the newest CPU model
+                    supported by all the nodes of the cluster, and requires the ClusterCPUBaseline
+                    feature gate. Defaults to host-model.
                   type: string
                 numa:
                   description: NUMA allows specifying settings for the guest NUMA
                     topology
                   properties:
+                    cells:
+                      description: Cells explicitly declares the guest NUMA topology,
+                        without requiring dedicated CPUs. The cells must cover all
+                        the vCPUs and the whole guest memory. Can't be combined with
+                        GuestMappingPassthrough.
+                      items:
+                        description: NUMACell declares a guest NUMA cell.
+                        properties:
+                          cpuSet:
+                            description: CPUSet lists the vCPUs of the cell, in the
+                              cpuset format, e.g. 0-3,8
+                            type: string
+                          distances:
+                            description: Distances to the cells, including the cell
+                              itself. Left to the hypervisor defaults if empty.
+                            items:
+                              description: NUMADistance is the distance of a guest
+                                NUMA cell to another.
+                              properties:
+                                cellID:
+                                  description: CellID is the ID of the other cell
+                                  format: int32
+                                  type: integer
+                                value:
+                                  description: Value is the distance, 10 being the
+                                    distance of a cell to itself
+                                  format: int32
+                                  type: integer
+                              required:
+                              - cellID
+                              - value
+                              type: object
+                            type: array
+                            x-kubernetes-list-type: atomic
+                          hostNodeSet:
+                            description: HostNodeSet binds the memory of the cell
+                              to host NUMA nodes, in the cpuset format, e.g. 0,1.
+                              The memory is not bound if empty.
+                            type: string
+                          id:
+                            description: ID of the cell. The cells must be numbered
+                              from 0, in order.
+                            format: int32
+                            type: integer
+                          memory:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: Memory is the amount of guest memory of the
+                              cell. When hugepages are requested, it must be a multiple
+                              of the page size.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                        required:
+                        - cpuSet
+                        - id
+                        - memory
+                        type: object
+                      type: array
+                      x-kubernetes-list-type: atomic
                     guestMappingPassthrough:
                       description: GuestMappingPassthrough will create an efficient
                         guest topology based on host CPUs exclusively assigned to
@@ -12135,6 +13155,11 @@ var CRDsValidation map[string]string = map[string]string{
                         description: DeviceName is the resource name of the host device
                           exposed by a device plugin
                         type: string
+                      hotpluggable:
+                        description: Hotpluggable indicates whether the device is
+                          attached through an attachment pod, after the VMI is started,
+                          and can be detached again while the VMI is running.
+                        type: boolean
                       name:
                         type: string
                       tag:
@@ -12178,6 +13203,73 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device. This value is required
                           to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: If specified, limits the inbound and outbound
+                          traffic of the interface. Only supported with the bridge,
+                          masquerade and macvtap bindings. Can be changed while the
+                          VMI is running.
+                        properties:
+                          inbound:
+                            description: Limits of the traffic received by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second the
+                                  traffic is shaped to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Amount of bytes which can be sent at
+                                  the peak rate in a single burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Maximum rate in bytes per second at which
+                                  the traffic can be sent while bursting.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Limits of the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average rate in bytes per second the
+                                  traffic is shaped to.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Amount of bytes which can be sent at
+                                  the peak rate in a single burst.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Maximum rate in bytes per second at which
+                                  the traffic can be sent while bursting.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       bootOrder:
                         description: BootOrder is an integer value > 0, used to determine
                           ordering of boot devices. Lower values take precedence.
@@ -12251,6 +13343,14 @@ var CRDsValidation map[string]string = map[string]string{
                         type: string
                       passt:
                         description: InterfacePasst connects to a given network.
+                        properties:
+                          portForwarding:
+                            description: PortForwarding selects the ports forwarded
+                              from the pod to the guest. All forwards every TCP and
+                              UDP port, List forwards only the ports listed in the
+                              interface ports. Defaults to List when the interface
+                              specifies ports, All otherwise.
+                            type: string
                         type: object
                       pciAddress:
                         description: 'If specified, the virtual network interface
@@ -12288,10 +13388,24 @@ var CRDsValidation map[string]string = map[string]string{
                         description: InterfaceSlirp connects to a given network using
                           QEMU user networking mode.
                         type: object
+                      spoofCheck:
+                        description: If set to true, frames sent by the guest through
+                          the interface are dropped when their source MAC address,
+                          ARP sender addresses or source IP address do not match the
+                          ones assigned to the interface. Only supported with the
+                          bridge and masquerade bindings.
+                        type: boolean
                       sriov:
                         description: InterfaceSRIOV connects to a given network by
                           passing-through an SR-IOV PCI device via vfio.
                         type: object
+                      state:
+                        description: 'State represents the requested link state of
+                          the interface. Setting it to down disconnects the link as
+                          if the cable was unplugged, without removing the interface
+                          from the guest. Can be changed while the VMI is running.
+                          One of: up, down. Defaults to up.'
+                        type: string
                       tag:
                         description: If specified, the virtual network interface address
                           and its tag will be provided to the guest via config drive
@@ -12300,6 +13414,13 @@ var CRDsValidation map[string]string = map[string]string{
                     - name
                     type: object
                   type: array
+                logSerialConsole:
+                  description: Whether to log the auto-attached default serial console
+                    or not. The serial console output is collected to a size-capped
+                    file, and streamed by the guest-console-log container of the virt-launcher
+                    pod. Not relevant if autoattachSerialConsole is disabled. Defaults
+                    to true.
+                  type: boolean
                 networkInterfaceMultiqueue:
                   description: If specified, virtual network interfaces configured
                     with a virtio bus will also enable the vhost multiqueue feature
@@ -12310,6 +13431,26 @@ var CRDsValidation map[string]string = map[string]string{
                 rng:
                   description: Whether to have random number generator from host
                   type: object
+                serialPorts:
+                  description: SerialPorts are additional named serial ports, next
+                    to the serial console. They can be connected to through the console
+                    subresource.
+                  items:
+                    description: SerialPort is an additional serial port of the VMI.
+                    properties:
+                      name:
+                        description: Name of the port, used to connect to it through
+                          the console subresource.
+                        type: string
+                      type:
+                        description: Type of the port. serial emulates an ISA serial
+                          port, virtio a virtio-console port. Defaults to virtio.
+                        type: string
+                    required:
+                    - name
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 sound:
                   description: Whether to emulate a sound device.
                   properties:
@@ -12338,6 +13479,30 @@ var CRDsValidation map[string]string = map[string]string{
                     CentOS6 or RHEL6 which do not understand virtio_non_transitional
                     (virtio 1.0).
                   type: boolean
+                video:
+                  description: Video configures the video device attached along with
+                    the graphics device. If not set, the default video device of the
+                    architecture is used.
+                  properties:
+                    resolution:
+                      description: Resolution is the preferred resolution of the display,
+                        reported to the guest.
+                      properties:
+                        height:
+                          format: int32
+                          type: integer
+                        width:
+                          format: int32
+                          type: integer
+                      required:
+                      - height
+                      - width
+                      type: object
+                    type:
+                      description: Type of the video device. One of virtio, bochs
+                        or vga. virtio is the only type supported on arm64.
+                      type: string
+                  type: object
                 watchdog:
                   description: Watchdog describes a watchdog device which can be added
                     to the vmi.
@@ -12574,6 +13739,10 @@ var CRDsValidation map[string]string = map[string]string{
                     efi:
                       description: If set, EFI will be used instead of BIOS.
                       properties:
+                        persistent:
+                          description: If set to true, Persistent will persist the
+                            EFI NVRAM across reboots. Defaults to false
+                          type: boolean
                         secureBoot:
                           description: If set, SecureBoot will be enabled and the
                             OVMF roms will be swapped for SecureBoot-enabled ones.
@@ -13975,12 +15144,77 @@ var CRDsValidation map[string]string = map[string]string{
                             List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                             It is possible to specify special cases like "host-passthrough"
                             to get the same CPU as the node and "host-model" to get
-                            CPU closest to the node one. Defaults to host-model.
+                            CPU closest to the node one. "cluster-baseline" gets the
+                            newest CPU model supported by all the nodes of the cluster,
+                            and requires the ClusterCPUBaseline feature gate. Defaults
+                            to host-model.
                           type: string
                         numa:
                           description: NUMA allows specifying settings for the guest
                             NUMA topology
                           properties:
+                            cells:
+                              description: Cells explicitly declares the guest NUMA
+                                topology, without requiring dedicated CPUs. The cells
+                                must cover all the vCPUs and the whole guest memory.
+                                Can't be combined with GuestMappingPassthrough.
+                              items:
+                                description: NUMACell declares a guest NUMA cell.
+                                properties:
+                                  cpuSet:
+                                    description: CPUSet lists the vCPUs of the cell,
+                                      in the cpuset format, e.g. 0-3,8
+                                    type: string
+                                  distances:
+                                    description: Distances to the cells, including
+                                      the cell itself. Left to the hypervisor defaults
+                                      if empty.
+                                    items:
+                                      description: NUMADistance is the distance of
+                                        a guest NUMA cell to another.
+                                      properties:
+                                        cellID:
+                                          description: CellID is the ID of the other
+                                            cell
+                                          format: int32
+                                          type: integer
+                                        value:
+                                          description: Value is the distance, 10 being
+                                            the distance of a cell to itself
+                                          format: int32
+                                          type: integer
+                                      required:
+                                      - cellID
+                                      - value
+                                      type: object
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                  hostNodeSet:
+                                    description: HostNodeSet binds the memory of the
+                                      cell to host NUMA nodes, in the cpuset format,
+                                      e.g. 0,1. The memory is not bound if empty.
+                                    type: string
+                                  id:
+                                    description: ID of the cell. The cells must be
+                                      numbered from 0, in order.
+                                    format: int32
+                                    type: integer
+                                  memory:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: Memory is the amount of guest memory
+                                      of the cell. When hugepages are requested, it
+                                      must be a multiple of the page size.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                required:
+                                - cpuSet
+                                - id
+                                - memory
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
                             guestMappingPassthrough:
                               description: GuestMappingPassthrough will create an
                                 efficient guest topology based on host CPUs exclusively
@@ -14247,6 +15481,12 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: DeviceName is the resource name of the
                                   host device exposed by a device plugin
                                 type: string
+                              hotpluggable:
+                                description: Hotpluggable indicates whether the device
+                                  is attached through an attachment pod, after the
+                                  VMI is started, and can be detached again while
+                                  the VMI is running.
+                                type: boolean
                               name:
                                 type: string
                               tag:
@@ -14292,6 +15532,75 @@ var CRDsValidation map[string]string = map[string]string{
                                   to the device. This value is required to be unique
                                   across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: If specified, limits the inbound and
+                                  outbound traffic of the interface. Only supported
+                                  with the bridge, masquerade and macvtap bindings.
+                                  Can be changed while the VMI is running.
+                                properties:
+                                  inbound:
+                                    description: Limits of the traffic received by
+                                      the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second
+                                          the traffic is shaped to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Amount of bytes which can be
+                                          sent at the peak rate in a single burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Maximum rate in bytes per second
+                                          at which the traffic can be sent while bursting.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Limits of the traffic sent by the
+                                      guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average rate in bytes per second
+                                          the traffic is shaped to.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Amount of bytes which can be
+                                          sent at the peak rate in a single burst.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Maximum rate in bytes per second
+                                          at which the traffic can be sent while bursting.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               bootOrder:
                                 description: BootOrder is an integer value > 0, used
                                   to determine ordering of boot devices. Lower values
@@ -14368,6 +15677,15 @@ var CRDsValidation map[string]string = map[string]string{
                                 type: string
                               passt:
                                 description: InterfacePasst connects to a given network.
+                                properties:
+                                  portForwarding:
+                                    description: PortForwarding selects the ports
+                                      forwarded from the pod to the guest. All forwards
+                                      every TCP and UDP port, List forwards only the
+                                      ports listed in the interface ports. Defaults
+                                      to List when the interface specifies ports,
+                                      All otherwise.
+                                    type: string
                                 type: object
                               pciAddress:
                                 description: 'If specified, the virtual network interface
@@ -14406,10 +15724,25 @@ var CRDsValidation map[string]string = map[string]string{
                                 description: InterfaceSlirp connects to a given network
                                   using QEMU user networking mode.
                                 type: object
+                              spoofCheck:
+                                description: If set to true, frames sent by the guest
+                                  through the interface are dropped when their source
+                                  MAC address, ARP sender addresses or source IP address
+                                  do not match the ones assigned to the interface.
+                                  Only supported with the bridge and masquerade bindings.
+                                type: boolean
                               sriov:
                                 description: InterfaceSRIOV connects to a given network
                                   by passing-through an SR-IOV PCI device via vfio.
                                 type: object
+                              state:
+                                description: 'State represents the requested link
+                                  state of the interface. Setting it to down disconnects
+                                  the link as if the cable was unplugged, without
+                                  removing the interface from the guest. Can be changed
+                                  while the VMI is running. One of: up, down. Defaults
+                                  to up.'
+                                type: string
                               tag:
                                 description: If specified, the virtual network interface
                                   address and its tag will be provided to the guest
@@ -14419,6 +15752,13 @@ var CRDsValidation map[string]string = map[string]string{
                             - name
                             type: object
                           type: array
+                        logSerialConsole:
+                          description: Whether to log the auto-attached default serial
+                            console or not. The serial console output is collected
+                            to a size-capped file, and streamed by the guest-console-log
+                            container of the virt-launcher pod. Not relevant if autoattachSerialConsole
+                            is disabled. Defaults to true.
+                          type: boolean
                         networkInterfaceMultiqueue:
                           description: If specified, virtual network interfaces configured
                             with a virtio bus will also enable the vhost multiqueue
@@ -14430,6 +15770,28 @@ var CRDsValidation map[string]string = map[string]string{
                           description: Whether to have random number generator from
                             host
                           type: object
+                        serialPorts:
+                          description: SerialPorts are additional named serial ports,
+                            next to the serial console. They can be connected to through
+                            the console subresource.
+                          items:
+                            description: SerialPort is an additional serial port of
+                              the VMI.
+                            properties:
+                              name:
+                                description: Name of the port, used to connect to
+                                  it through the console subresource.
+                                type: string
+                              type:
+                                description: Type of the port. serial emulates an
+                                  ISA serial port, virtio a virtio-console port. Defaults
+                                  to virtio.
+                                type: string
+                            required:
+                            - name
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
                         sound:
                           description: Whether to emulate a sound device.
                           properties:
@@ -14459,6 +15821,31 @@ var CRDsValidation map[string]string = map[string]string{
                             like CentOS6 or RHEL6 which do not understand virtio_non_transitional
                             (virtio 1.0).
                           type: boolean
+                        video:
+                          description: Video configures the video device attached
+                            along with the graphics device. If not set, the default
+                            video device of the architecture is used.
+                          properties:
+                            resolution:
+                              description: Resolution is the preferred resolution
+                                of the display, reported to the guest.
+                              properties:
+                                height:
+                                  format: int32
+                                  type: integer
+                                width:
+                                  format: int32
+                                  type: integer
+                              required:
+                              - height
+                              - width
+                              type: object
+                            type:
+                              description: Type of the video device. One of virtio,
+                                bochs or vga. virtio is the only type supported on
+                                arm64.
+                              type: string
+                          type: object
                         watchdog:
                           description: Watchdog describes a watchdog device which
                             can be added to the vmi.
@@ -14719,6 +16106,10 @@ var CRDsValidation map[string]string = map[string]string{
                             efi:
                               description: If set, EFI will be used instead of BIOS.
                               properties:
+                                persistent:
+                                  description: If set to true, Persistent will persist
+                                    the EFI NVRAM across reboots. Defaults to false
+                                  type: boolean
                                 secureBoot:
                                   description: If set, SecureBoot will be enabled
                                     and the OVMF roms will be swapped for SecureBoot-enabled
@@ -15846,6 +17237,65 @@ var CRDsValidation map[string]string = map[string]string{
             numa:
               description: NUMA allows specifying settings for the guest NUMA topology
               properties:
+                cells:
+                  description: Cells explicitly declares the guest NUMA topology,
+                    without requiring dedicated CPUs. The cells must cover all the
+                    vCPUs and the whole guest memory. Can't be combined with GuestMappingPassthrough.
+                  items:
+                    description: NUMACell declares a guest NUMA cell.
+                    properties:
+                      cpuSet:
+                        description: CPUSet lists the vCPUs of the cell, in the cpuset
+                          format, e.g. 0-3,8
+                        type: string
+                      distances:
+                        description: Distances to the cells, including the cell itself.
+                          Left to the hypervisor defaults if empty.
+                        items:
+                          description: NUMADistance is the distance of a guest NUMA
+                            cell to another.
+                          properties:
+                            cellID:
+                              description: CellID is the ID of the other cell
+                              format: int32
+                              type: integer
+                            value:
+                              description: Value is the distance, 10 being the distance
+                                of a cell to itself
+                              format: int32
+                              type: integer
+                          required:
+                          - cellID
+                          - value
+                          type: object
+                        type: array
+                        x-kubernetes-list-type: atomic
+                      hostNodeSet:
+                        description: HostNodeSet binds the memory of the cell to host
+                          NUMA nodes, in the cpuset format, e.g. 0,1. The memory is
+                          not bound if empty.
+                        type: string
+                      id:
+                        description: ID of the cell. The cells must be numbered from
+                          0, in order.
+                        format: int32
+                        type: integer
+                      memory:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: Memory is the amount of guest memory of the cell.
+                          When hugepages are requested, it must be a multiple of the
+                          page size.
+                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                        x-kubernetes-int-or-string: true
+                    required:
+                    - cpuSet
+                    - id
+                    - memory
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
                 guestMappingPassthrough:
                   description: GuestMappingPassthrough will create an efficient guest
                     topology based on host CPUs exclusively assigned to a pod. The
@@ -15913,6 +17363,11 @@ var CRDsValidation map[string]string = map[string]string{
                 description: DeviceName is the resource name of the host device exposed
                   by a device plugin
                 type: string
+              hotpluggable:
+                description: Hotpluggable indicates whether the device is attached
+                  through an attachment pod, after the VMI is started, and can be
+                  detached again while the VMI is running.
+                type: boolean
               name:
                 type: string
               tag:
@@ -17940,13 +19395,83 @@ var CRDsValidation map[string]string = map[string]string{
                                     the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
                                     It is possible to specify special cases like "host-passthrough"
                                     to get the same CPU as the node and "host-model"
-                                    to get CPU closest to the node one. Defaults to
-                                    host-model.
+                                    to get CPU closest to the node one. "cluster-baseline"
+                                    gets the newest CPU model supported by all the
+                                    nodes of the cluster, and requires the ClusterCPUBaseline
+                                    feature gate. Defaults to host-model.
                                   type: string
                                 numa:
                                   description: NUMA allows specifying settings for
                                     the guest NUMA topology
                                   properties:
+                                    cells:
+                                      description: Cells explicitly declares the guest
+                                        NUMA topology, without requiring dedicated
+                                        CPUs. The cells must cover all the vCPUs and
+                                        the whole guest memory. Can't be combined
+                                        with GuestMappingPassthrough.
+                                      items:
+                                        description: NUMACell declares a guest NUMA
+                                          cell.
+                                        properties:
+                                          cpuSet:
+                                            description: CPUSet lists the vCPUs of
+                                              the cell, in the cpuset format, e.g.
+                                              0-3,8
+                                            type: string
+                                          distances:
+                                            description: Distances to the cells, including
+                                              the cell itself. Left to the hypervisor
+                                              defaults if empty.
+                                            items:
+                                              description: NUMADistance is the distance
+                                                of a guest NUMA cell to another.
+                                              properties:
+                                                cellID:
+                                                  description: CellID is the ID of
+                                                    the other cell
+                                                  format: int32
+                                                  type: integer
+                                                value:
+                                                  description: Value is the distance,
+                                                    10 being the distance of a cell
+                                                    to itself
+                                                  format: int32
+                                                  type: integer
+                                              required:
+                                              - cellID
+                                              - value
+                                              type: object
+                                            type: array
+                                            x-kubernetes-list-type: atomic
+                                          hostNodeSet:
+                                            description: HostNodeSet binds the memory
+                                              of the cell to host NUMA nodes, in the
+                                              cpuset format, e.g. 0,1. The memory
+                                              is not bound if empty.
+                                            type: string
+                                          id:
+                                            description: ID of the cell. The cells
+                                              must be numbered from 0, in order.
+                                            format: int32
+                                            type: integer
+                                          memory:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: Memory is the amount of guest
+                                              memory of the cell. When hugepages are
+                                              requested, it must be a multiple of
+                                              the page size.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                        required:
+                                        - cpuSet
+                                        - id
+                                        - memory
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     guestMappingPassthrough:
                                       description: GuestMappingPassthrough will create
                                         an efficient guest topology based on host
@@ -18233,6 +19758,12 @@ var CRDsValidation map[string]string = map[string]string{
                                         description: DeviceName is the resource name
                                           of the host device exposed by a device plugin
                                         type: string
+                                      hotpluggable:
+                                        description: Hotpluggable indicates whether
+                                          the device is attached through an attachment
+                                          pod, after the VMI is started, and can be
+                                          detached again while the VMI is running.
+                                        type: boolean
                                       name:
                                         type: string
                                       tag:
@@ -18280,6 +19811,82 @@ var CRDsValidation map[string]string = map[string]string{
                                           value is required to be unique across all
                                           devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: If specified, limits the inbound
+                                          and outbound traffic of the interface. Only
+                                          supported with the bridge, masquerade and
+                                          macvtap bindings. Can be changed while the
+                                          VMI is running.
+                                        properties:
+                                          inbound:
+                                            description: Limits of the traffic received
+                                              by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average rate in bytes
+                                                  per second the traffic is shaped
+                                                  to.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Amount of bytes which
+                                                  can be sent at the peak rate in
+                                                  a single burst.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Maximum rate in bytes
+                                                  per second at which the traffic
+                                                  can be sent while bursting.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Limits of the traffic sent
+                                              by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average rate in bytes
+                                                  per second the traffic is shaped
+                                                  to.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Amount of bytes which
+                                                  can be sent at the peak rate in
+                                                  a single burst.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Maximum rate in bytes
+                                                  per second at which the traffic
+                                                  can be sent while bursting.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       bootOrder:
                                         description: BootOrder is an integer value
                                           > 0, used to determine ordering of boot
@@ -18363,6 +19970,16 @@ var CRDsValidation map[string]string = map[string]string{
                                       passt:
                                         description: InterfacePasst connects to a
                                           given network.
+                                        properties:
+                                          portForwarding:
+                                            description: PortForwarding selects the
+                                              ports forwarded from the pod to the
+                                              guest. All forwards every TCP and UDP
+                                              port, List forwards only the ports listed
+                                              in the interface ports. Defaults to
+                                              List when the interface specifies ports,
+                                              All otherwise.
+                                            type: string
                                         type: object
                                       pciAddress:
                                         description: 'If specified, the virtual network
@@ -18405,11 +20022,29 @@ var CRDsValidation map[string]string = map[string]string{
                                           given network using QEMU user networking
                                           mode.
                                         type: object
+                                      spoofCheck:
+                                        description: If set to true, frames sent by
+                                          the guest through the interface are dropped
+                                          when their source MAC address, ARP sender
+                                          addresses or source IP address do not match
+                                          the ones assigned to the interface. Only
+                                          supported with the bridge and masquerade
+                                          bindings.
+                                        type: boolean
                                       sriov:
                                         description: InterfaceSRIOV connects to a
                                           given network by passing-through an SR-IOV
                                           PCI device via vfio.
                                         type: object
+                                      state:
+                                        description: 'State represents the requested
+                                          link state of the interface. Setting it
+                                          to down disconnects the link as if the cable
+                                          was unplugged, without removing the interface
+                                          from the guest. Can be changed while the
+                                          VMI is running. One of: up, down. Defaults
+                                          to up.'
+                                        type: string
                                       tag:
                                         description: If specified, the virtual network
                                           interface address and its tag will be provided
@@ -18419,6 +20054,14 @@ var CRDsValidation map[string]string = map[string]string{
                                     - name
                                     type: object
                                   type: array
+                                logSerialConsole:
+                                  description: Whether to log the auto-attached default
+                                    serial console or not. The serial console output
+                                    is collected to a size-capped file, and streamed
+                                    by the guest-console-log container of the virt-launcher
+                                    pod. Not relevant if autoattachSerialConsole is
+                                    disabled. Defaults to true.
+                                  type: boolean
                                 networkInterfaceMultiqueue:
                                   description: If specified, virtual network interfaces
                                     configured with a virtio bus will also enable
@@ -18431,6 +20074,28 @@ var CRDsValidation map[string]string = map[string]string{
                                   description: Whether to have random number generator
                                     from host
                                   type: object
+                                serialPorts:
+                                  description: SerialPorts are additional named serial
+                                    ports, next to the serial console. They can be
+                                    connected to through the console subresource.
+                                  items:
+                                    description: SerialPort is an additional serial
+                                      port of the VMI.
+                                    properties:
+                                      name:
+                                        description: Name of the port, used to connect
+                                          to it through the console subresource.
+                                        type: string
+                                      type:
+                                        description: Type of the port. serial emulates
+                                          an ISA serial port, virtio a virtio-console
+                                          port. Defaults to virtio.
+                                        type: string
+                                    required:
+                                    - name
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
                                 sound:
                                   description: Whether to emulate a sound device.
                                   properties:
@@ -18463,6 +20128,31 @@ var CRDsValidation map[string]string = map[string]string{
                                     which do not understand virtio_non_transitional
                                     (virtio 1.0).
                                   type: boolean
+                                video:
+                                  description: Video configures the video device attached
+                                    along with the graphics device. If not set, the
+                                    default video device of the architecture is used.
+                                  properties:
+                                    resolution:
+                                      description: Resolution is the preferred resolution
+                                        of the display, reported to the guest.
+                                      properties:
+                                        height:
+                                          format: int32
+                                          type: integer
+                                        width:
+                                          format: int32
+                                          type: integer
+                                      required:
+                                      - height
+                                      - width
+                                      type: object
+                                    type:
+                                      description: Type of the video device. One of
+                                        virtio, bochs or vga. virtio is the only type
+                                        supported on arm64.
+                                      type: string
+                                  type: object
                                 watchdog:
                                   description: Watchdog describes a watchdog device
                                     which can be added to the vmi.
@@ -18734,6 +20424,11 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: If set, EFI will be used instead
                                         of BIOS.
                                       properties:
+                                        persistent:
+                                          description: If set to true, Persistent
+                                            will persist the EFI NVRAM across reboots.
+                                            Defaults to false
+                                          type: boolean
                                         secureBoot:
                                           description: If set, SecureBoot will be
                                             enabled and the OVMF roms will be swapped
@@ -22655,12 +24350,85 @@ var CRDsValidation map[string]string = map[string]string{
                                         It is possible to specify special cases like
                                         "host-passthrough" to get the same CPU as
                                         the node and "host-model" to get CPU closest
-                                        to the node one. Defaults to host-model.
+                                        to the node one. "cluster-baseline" gets the
+                                        newest CPU model supported by all the nodes
+                                        of the cluster, and requires the ClusterCPUBaseline
+                                        feature gate. Defaults to host-model.
                                       type: string
                                     numa:
                                       description: NUMA allows specifying settings
                                         for the guest NUMA topology
                                       properties:
+                                        cells:
+                                          description: Cells explicitly declares the
+                                            guest NUMA topology, without requiring
+                                            dedicated CPUs. The cells must cover all
+                                            the vCPUs and the whole guest memory.
+                                            Can't be combined with GuestMappingPassthrough.
+                                          items:
+                                            description: NUMACell declares a guest
+                                              NUMA cell.
+                                            properties:
+                                              cpuSet:
+                                                description: CPUSet lists the vCPUs
+                                                  of the cell, in the cpuset format,
+                                                  e.g. 0-3,8
+                                                type: string
+                                              distances:
+                                                description: Distances to the cells,
+                                                  including the cell itself. Left
+                                                  to the hypervisor defaults if empty.
+                                                items:
+                                                  description: NUMADistance is the
+                                                    distance of a guest NUMA cell
+                                                    to another.
+                                                  properties:
+                                                    cellID:
+                                                      description: CellID is the ID
+                                                        of the other cell
+                                                      format: int32
+                                                      type: integer
+                                                    value:
+                                                      description: Value is the distance,
+                                                        10 being the distance of a
+                                                        cell to itself
+                                                      format: int32
+                                                      type: integer
+                                                  required:
+                                                  - cellID
+                                                  - value
+                                                  type: object
+                                                type: array
+                                                x-kubernetes-list-type: atomic
+                                              hostNodeSet:
+                                                description: HostNodeSet binds the
+                                                  memory of the cell to host NUMA
+                                                  nodes, in the cpuset format, e.g.
+                                                  0,1. The memory is not bound if
+                                                  empty.
+                                                type: string
+                                              id:
+                                                description: ID of the cell. The cells
+                                                  must be numbered from 0, in order.
+                                                format: int32
+                                                type: integer
+                                              memory:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Memory is the amount
+                                                  of guest memory of the cell. When
+                                                  hugepages are requested, it must
+                                                  be a multiple of the page size.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - cpuSet
+                                            - id
+                                            - memory
+                                            type: object
+                                          type: array
+                                          x-kubernetes-list-type: atomic
                                         guestMappingPassthrough:
                                           description: GuestMappingPassthrough will
                                             create an efficient guest topology based
@@ -22958,6 +24726,12 @@ var CRDsValidation map[string]string = map[string]string{
                                               name of the host device exposed by a
                                               device plugin
                                             type: string
+                                          hotpluggable:
+                                            description: Hotpluggable indicates whether
+                                              the device is attached through an attachment
+                                              pod, after the VMI is started, and can
+                                              be detached again while the VMI is running.
+                                            type: boolean
                                           name:
                                             type: string
                                           tag:
@@ -23007,6 +24781,82 @@ var CRDsValidation map[string]string = map[string]string{
                                               be unique across all devices and be
                                               between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: If specified, limits the
+                                              inbound and outbound traffic of the
+                                              interface. Only supported with the bridge,
+                                              masquerade and macvtap bindings. Can
+                                              be changed while the VMI is running.
+                                            properties:
+                                              inbound:
+                                                description: Limits of the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average rate in bytes
+                                                      per second the traffic is shaped
+                                                      to.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Amount of bytes which
+                                                      can be sent at the peak rate
+                                                      in a single burst.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Maximum rate in bytes
+                                                      per second at which the traffic
+                                                      can be sent while bursting.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Limits of the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average rate in bytes
+                                                      per second the traffic is shaped
+                                                      to.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Amount of bytes which
+                                                      can be sent at the peak rate
+                                                      in a single burst.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Maximum rate in bytes
+                                                      per second at which the traffic
+                                                      can be sent while bursting.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           bootOrder:
                                             description: BootOrder is an integer value
                                               > 0, used to determine ordering of boot
@@ -23092,6 +24942,16 @@ var CRDsValidation map[string]string = map[string]string{
                                           passt:
                                             description: InterfacePasst connects to
                                               a given network.
+                                            properties:
+                                              portForwarding:
+                                                description: PortForwarding selects
+                                                  the ports forwarded from the pod
+                                                  to the guest. All forwards every
+                                                  TCP and UDP port, List forwards
+                                                  only the ports listed in the interface
+                                                  ports. Defaults to List when the
+                                                  interface specifies ports, All otherwise.
+                                                type: string
                                             type: object
                                           pciAddress:
                                             description: 'If specified, the virtual
@@ -23137,11 +24997,29 @@ var CRDsValidation map[string]string = map[string]string{
                                               a given network using QEMU user networking
                                               mode.
                                             type: object
+                                          spoofCheck:
+                                            description: If set to true, frames sent
+                                              by the guest through the interface are
+                                              dropped when their source MAC address,
+                                              ARP sender addresses or source IP address
+                                              do not match the ones assigned to the
+                                              interface. Only supported with the bridge
+                                              and masquerade bindings.
+                                            type: boolean
                                           sriov:
                                             description: InterfaceSRIOV connects to
                                               a given network by passing-through an
                                               SR-IOV PCI device via vfio.
                                             type: object
+                                          state:
+                                            description: 'State represents the requested
+                                              link state of the interface. Setting
+                                              it to down disconnects the link as if
+                                              the cable was unplugged, without removing
+                                              the interface from the guest. Can be
+                                              changed while the VMI is running. One
+                                              of: up, down. Defaults to up.'
+                                            type: string
                                           tag:
                                             description: If specified, the virtual
                                               network interface address and its tag
@@ -23152,6 +25030,15 @@ var CRDsValidation map[string]string = map[string]string{
                                         - name
                                         type: object
                                       type: array
+                                    logSerialConsole:
+                                      description: Whether to log the auto-attached
+                                        default serial console or not. The serial
+                                        console output is collected to a size-capped
+                                        file, and streamed by the guest-console-log
+                                        container of the virt-launcher pod. Not relevant
+                                        if autoattachSerialConsole is disabled. Defaults
+                                        to true.
+                                      type: boolean
                                     networkInterfaceMultiqueue:
                                       description: If specified, virtual network interfaces
                                         configured with a virtio bus will also enable
@@ -23164,6 +25051,29 @@ var CRDsValidation map[string]string = map[string]string{
                                       description: Whether to have random number generator
                                         from host
                                       type: object
+                                    serialPorts:
+                                      description: SerialPorts are additional named
+                                        serial ports, next to the serial console.
+                                        They can be connected to through the console
+                                        subresource.
+                                      items:
+                                        description: SerialPort is an additional serial
+                                          port of the VMI.
+                                        properties:
+                                          name:
+                                            description: Name of the port, used to
+                                              connect to it through the console subresource.
+                                            type: string
+                                          type:
+                                            description: Type of the port. serial
+                                              emulates an ISA serial port, virtio
+                                              a virtio-console port. Defaults to virtio.
+                                            type: string
+                                        required:
+                                        - name
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
                                     sound:
                                       description: Whether to emulate a sound device.
                                       properties:
@@ -23196,6 +25106,33 @@ var CRDsValidation map[string]string = map[string]string{
                                         or RHEL6 which do not understand virtio_non_transitional
                                         (virtio 1.0).
                                       type: boolean
+                                    video:
+                                      description: Video configures the video device
+                                        attached along with the graphics device. If
+                                        not set, the default video device of the architecture
+                                        is used.
+                                      properties:
+                                        resolution:
+                                          description: Resolution is the preferred
+                                            resolution of the display, reported to
+                                            the guest.
+                                          properties:
+                                            height:
+                                              format: int32
+                                              type: integer
+                                            width:
+                                              format: int32
+                                              type: integer
+                                          required:
+                                          - height
+                                          - width
+                                          type: object
+                                        type:
+                                          description: Type of the video device. One
+                                            of virtio, bochs or vga. virtio is the
+                                            only type supported on arm64.
+                                          type: string
+                                      type: object
                                     watchdog:
                                       description: Watchdog describes a watchdog device
                                         which can be added to the vmi.
@@ -23476,6 +25413,11 @@ var CRDsValidation map[string]string = map[string]string{
                                           description: If set, EFI will be used instead
                                             of BIOS.
                                           properties:
+                                            persistent:
+                                              description: If set to true, Persistent
+                                                will persist the EFI NVRAM across
+                                                reboots. Defaults to false
+                                              type: boolean
                                             secureBoot:
                                               description: If set, SecureBoot will
                                                 be enabled and the OVMF roms will
@@ -28,6 +28,7 @@ import (
 	virtv1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/node"
 )
 
 const (
@@ -41,6 +42,7 @@ const (
 	VMInstancesGuestOSInfo = "virtualmachineinstances/guestosinfo"
 	VMInstancesFileSysList = "virtualmachineinstances/filesystemlist"
 	VMInstancesUserList    = "virtualmachineinstances/userlist"
+	VMInstancesDomainStats = "virtualmachineinstances/domainstats"
 )
 
 func GetAllCluster() []runtime.Object {
@@ -147,6 +149,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesDomainStats,
+					"virtualmachineinstances/guestfile",
 				},
 				Verbs: []string{
 					"get",
@@ -165,6 +169,12 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/unfreeze",
 					"virtualmachineinstances/softreboot",
 					"virtualmachineinstances/addinterface",
+					"virtualmachineinstances/addhostdevice",
+					"virtualmachineinstances/removehostdevice",
+					"virtualmachineinstances/guestexec",
+					"virtualmachineinstances/guestfile",
+					"virtualmachineinstances/addsshkey",
+					"virtualmachineinstances/removesshkey",
 				},
 				Verbs: []string{
 					"update",
@@ -195,6 +205,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"virtualmachines/migrate",
 					"virtualmachines/memorydump",
 					"virtualmachines/addinterface",
+					"virtualmachines/addhostdevice",
+					"virtualmachines/removehostdevice",
 				},
 				Verbs: []string{
 					"update",
@@ -297,6 +309,17 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					node.GroupName,
+				},
+				Resources: []string{
+					node.ResourceNodeCapabilitiesPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -327,6 +350,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesDomainStats,
+					"virtualmachineinstances/guestfile",
 				},
 				Verbs: []string{
 					"get",
@@ -345,6 +370,12 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachineinstances/unfreeze",
 					"virtualmachineinstances/softreboot",
 					"virtualmachineinstances/addinterface",
+					"virtualmachineinstances/addhostdevice",
+					"virtualmachineinstances/removehostdevice",
+					"virtualmachineinstances/guestexec",
+					"virtualmachineinstances/guestfile",
+					"virtualmachineinstances/addsshkey",
+					"virtualmachineinstances/removesshkey",
 				},
 				Verbs: []string{
 					"update",
@@ -375,6 +406,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"virtualmachines/migrate",
 					"virtualmachines/memorydump",
 					"virtualmachines/addinterface",
+					"virtualmachines/addhostdevice",
+					"virtualmachines/removehostdevice",
 				},
 				Verbs: []string{
 					"update",
@@ -488,6 +521,17 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					node.GroupName,
+				},
+				Resources: []string{
+					node.ResourceNodeCapabilitiesPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -515,6 +559,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					VMInstancesGuestOSInfo,
 					VMInstancesFileSysList,
 					VMInstancesUserList,
+					VMInstancesDomainStats,
 				},
 				Verbs: []string{
 					"get",
@@ -617,6 +662,17 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					node.GroupName,
+				},
+				Resources: []string{
+					node.ResourceNodeCapabilitiesPlural,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
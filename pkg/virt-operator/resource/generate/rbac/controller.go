@@ -194,6 +194,28 @@ func newControllerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch", "delete", "update", "create", "patch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"discovery.k8s.io",
+				},
+				Resources: []string{
+					"endpointslices",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "delete", "update", "create",
+				},
+			},
+			{
+				APIGroups: []string{
+					"externaldns.k8s.io",
+				},
+				Resources: []string{
+					"dnsendpoints",
+				},
+				Verbs: []string{
+					"get", "list", "watch", "delete", "update", "create",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -27,6 +27,7 @@ import (
 
 	virtv1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/migrations"
+	"kubevirt.io/api/node"
 
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
@@ -156,6 +157,28 @@ func newHandlerClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					node.GroupName,
+				},
+				Resources: []string{
+					node.ResourceNodeCapabilitiesPlural,
+				},
+				Verbs: []string{
+					"get", "create", "update", "delete",
+				},
+			},
+			{
+				APIGroups: []string{
+					node.GroupName,
+				},
+				Resources: []string{
+					node.ResourceNodeCapabilitiesPlural + "/status",
+				},
+				Verbs: []string{
+					"update",
+				},
+			},
 		},
 	}
 }
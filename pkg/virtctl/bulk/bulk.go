@@ -0,0 +1,266 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+// Package bulk applies a virtctl operation to the VMs or VMIs matching a label selector, or to all of them
+package bulk
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	SelectorFlag      = "selector"
+	SelectorFlagShort = "l"
+	AllFlag           = "all"
+	ParallelFlag      = "parallel"
+	WaitFlag          = "wait"
+	TimeoutFlag       = "timeout"
+
+	defaultParallel = 1
+	defaultTimeout  = 5 * time.Minute
+
+	resultSucceeded = "Succeeded"
+	resultFailed    = "Failed"
+	resultSkipped   = "Skipped"
+)
+
+// PollInterval is the interval at which the state of a VM is checked when waiting for it
+var PollInterval = 2 * time.Second
+
+// Options are the flags selecting the targets of a bulk operation and how it is applied
+type Options struct {
+	Selector string
+	All      bool
+	Parallel int
+	Wait     bool
+	Timeout  time.Duration
+}
+
+// AddFlags adds the flags selecting the targets of a bulk operation, kind is the kind of the targets in the descriptions
+func (o *Options) AddFlags(cmd *cobra.Command, kind string) {
+	cmd.Flags().StringVarP(&o.Selector, SelectorFlag, SelectorFlagShort, "", fmt.Sprintf("Selector (label query) to filter on, applies the operation to every matching %s of the namespace.", kind))
+	cmd.Flags().BoolVar(&o.All, AllFlag, false, fmt.Sprintf("Apply the operation to every %s of the namespace.", kind))
+	cmd.Flags().IntVar(&o.Parallel, ParallelFlag, defaultParallel, fmt.Sprintf("Number of %ss the operation is applied to concurrently with --selector or --all, including the wait.", kind))
+	cmd.MarkFlagsMutuallyExclusive(SelectorFlag, AllFlag)
+}
+
+// AddWaitFlags adds the flags waiting for the targets to reach the target state of the operation
+func (o *Options) AddWaitFlags(cmd *cobra.Command, kind string) {
+	cmd.Flags().BoolVar(&o.Wait, WaitFlag, false, fmt.Sprintf("Wait until every %s reaches the target state of the operation.", kind))
+	cmd.Flags().DurationVar(&o.Timeout, TimeoutFlag, defaultTimeout, fmt.Sprintf("Maximum time to wait for each %s with --wait.", kind))
+}
+
+// IsBulk returns whether the operation is applied to the targets selected by the flags instead of a named one
+func (o *Options) IsBulk() bool {
+	return o.Selector != "" || o.All
+}
+
+// ExactArgs validates the arguments of a command taking n arguments when it is applied to a named target, the last
+// one being the name, and n-1 with --selector or --all.
+func (o *Options) ExactArgs(nameOfCommand string, n int) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if !o.IsBulk() {
+			return templates.ExactArgs(nameOfCommand, n)(cmd, args)
+		}
+		if len(args) == n {
+			return fmt.Errorf("a name can't be combined with --%s or --%s", SelectorFlag, AllFlag)
+		}
+		return templates.ExactArgs(nameOfCommand, n-1)(cmd, args)
+	}
+}
+
+// Validate checks the values of the flags
+func (o *Options) Validate() error {
+	if o.Parallel < 1 {
+		return fmt.Errorf("--%s must be at least 1", ParallelFlag)
+	}
+	if o.Wait && o.Timeout <= 0 {
+		return fmt.Errorf("--%s must be positive", TimeoutFlag)
+	}
+	return nil
+}
+
+// Target is a VM or VMI selected by the flags
+type Target struct {
+	Name string
+	// Skip is the reason why the operation isn't applied to the target, if it is already in the target state for instance
+	Skip string
+}
+
+// Action applies the operation to a target, and returns the condition met once the target reaches the target state
+// of the operation. The condition can be nil if there is nothing to wait for.
+type Action func(ctx context.Context, name string) (Condition, error)
+
+// Condition returns whether a target reached the target state of the operation, and its current state to report it
+// if the wait times out. An error stops the wait.
+type Condition func(ctx context.Context) (done bool, state string, err error)
+
+// WaitFor waits until the condition is met or the timeout expires
+func WaitFor(ctx context.Context, timeout time.Duration, condition Condition) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	lastState := ""
+	err := wait.PollImmediateUntilWithContext(ctx, PollInterval, func(ctx context.Context) (bool, error) {
+		done, state, err := condition(ctx)
+		if state != "" {
+			lastState = state
+		}
+		return done, err
+	})
+	if err == wait.ErrWaitTimeout || err == context.DeadlineExceeded {
+		if lastState != "" {
+			return fmt.Errorf("timed out after %s waiting for the target state, the current state is %s", timeout, lastState)
+		}
+		return fmt.Errorf("timed out after %s waiting for the target state", timeout)
+	}
+	return err
+}
+
+type result struct {
+	name    string
+	result  string
+	message string
+}
+
+// Run applies the action to the targets, at most Parallel at a time, and waits for them with the condition returned by
+// the action if --wait is set. A line is printed as each target is done, then a summary of the results.
+// An error is returned if the operation failed for any of the targets.
+func (o *Options) Run(out io.Writer, kind string, done string, targets []Target, action Action) error {
+	if len(targets) == 0 {
+		fmt.Fprintf(out, "No %ss found\n", kind)
+		return nil
+	}
+
+	var (
+		mutex   sync.Mutex
+		results []result
+		wg      sync.WaitGroup
+	)
+	report := func(r result) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		results = append(results, r)
+		line := fmt.Sprintf("[%*d/%d] %s %s: %s", len(fmt.Sprint(len(targets))), len(results), len(targets), kind, r.name, strings.ToLower(r.result))
+		if r.message != "" {
+			line += ": " + r.message
+		}
+		fmt.Fprintln(out, line)
+	}
+
+	slots := make(chan struct{}, o.Parallel)
+	for _, target := range targets {
+		if target.Skip != "" {
+			report(result{name: target.Name, result: resultSkipped, message: target.Skip})
+			continue
+		}
+		slots <- struct{}{}
+		wg.Add(1)
+		go func(name string) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			report(o.apply(name, action))
+		}(target.Name)
+	}
+	wg.Wait()
+
+	return printSummary(out, kind, done, results)
+}
+
+func (o *Options) apply(name string, action Action) result {
+	ctx := context.Background()
+	condition, err := action(ctx, name)
+	if err != nil {
+		return result{name: name, result: resultFailed, message: err.Error()}
+	}
+	if o.Wait && condition != nil {
+		if err := WaitFor(ctx, o.Timeout, condition); err != nil {
+			return result{name: name, result: resultFailed, message: err.Error()}
+		}
+	}
+	return result{name: name, result: resultSucceeded}
+}
+
+func printSummary(out io.Writer, kind string, done string, results []result) error {
+	sort.Slice(results, func(i, j int) bool {
+		return results[i].name < results[j].name
+	})
+
+	counts := map[string]int{}
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "NAME\tRESULT\tMESSAGE")
+	for _, r := range results {
+		counts[r.result]++
+		fmt.Fprintf(w, "%s\t%s\t%s\n", r.name, r.result, r.message)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "%s %d of %d %ss, %d failed, %d skipped\n", done, counts[resultSucceeded], len(results), kind,
+		counts[resultFailed], counts[resultSkipped])
+	if counts[resultFailed] > 0 {
+		return fmt.Errorf("the operation failed for %d of %d %ss", counts[resultFailed], len(results), kind)
+	}
+	return nil
+}
+
+// ListVMs returns the VMs of the namespace matching the selector, sorted by name
+func (o *Options) ListVMs(virtClient kubecli.KubevirtClient, namespace string) ([]v1.VirtualMachine, error) {
+	list, err := virtClient.VirtualMachine(namespace).List(context.Background(), &metav1.ListOptions{LabelSelector: o.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing VirtualMachines: %v", err)
+	}
+	vms := list.Items
+	sort.Slice(vms, func(i, j int) bool {
+		return vms[i].Name < vms[j].Name
+	})
+	return vms, nil
+}
+
+// ListVMIs returns the VMIs of the namespace matching the selector, sorted by name
+func (o *Options) ListVMIs(virtClient kubecli.KubevirtClient, namespace string) ([]v1.VirtualMachineInstance, error) {
+	list, err := virtClient.VirtualMachineInstance(namespace).List(context.Background(), &metav1.ListOptions{LabelSelector: o.Selector})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing VirtualMachineInstances: %v", err)
+	}
+	vmis := list.Items
+	sort.Slice(vmis, func(i, j int) bool {
+		return vmis[i].Name < vmis[j].Name
+	})
+	return vmis, nil
+}
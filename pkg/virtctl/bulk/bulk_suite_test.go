@@ -0,0 +1,11 @@
+package bulk_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestBulk(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,162 @@
+package bulk_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
+)
+
+var _ = Describe("Bulk operations", func() {
+
+	var out *bytes.Buffer
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		bulk.PollInterval = 10 * time.Millisecond
+	})
+
+	succeed := func(ctx context.Context, name string) (bulk.Condition, error) {
+		return nil, nil
+	}
+
+	It("should apply the action to the targets and summarize the results", func() {
+		options := &bulk.Options{Parallel: 1}
+		targets := []bulk.Target{{Name: "vm-c"}, {Name: "vm-a", Skip: "VM is already running"}, {Name: "vm-b"}}
+
+		err := options.Run(out, "VirtualMachine", "Started", targets, func(ctx context.Context, name string) (bulk.Condition, error) {
+			if name == "vm-c" {
+				return nil, fmt.Errorf("not allowed")
+			}
+			return nil, nil
+		})
+		Expect(err).To(MatchError("the operation failed for 1 of 3 VirtualMachines"))
+		Expect(out.String()).To(SatisfyAll(
+			MatchRegexp(`\[[1-3]/3\] VirtualMachine vm-c: failed: not allowed\n`),
+			MatchRegexp(`\[[1-3]/3\] VirtualMachine vm-a: skipped: VM is already running\n`),
+			MatchRegexp(`\[[1-3]/3\] VirtualMachine vm-b: succeeded\n`),
+			MatchRegexp(`NAME\s+RESULT\s+MESSAGE\n\s*vm-a\s+Skipped\s+VM is already running\n\s*vm-b\s+Succeeded\s+\n\s*vm-c\s+Failed\s+not allowed\n`),
+			ContainSubstring("Started 1 of 3 VirtualMachines, 1 failed, 1 skipped"),
+		))
+	})
+
+	It("should apply the action to at most --parallel targets at a time", func() {
+		options := &bulk.Options{Parallel: 2}
+		var targets []bulk.Target
+		for i := 0; i < 6; i++ {
+			targets = append(targets, bulk.Target{Name: fmt.Sprintf("vm-%d", i)})
+		}
+
+		var mutex sync.Mutex
+		running, maxRunning := 0, 0
+		err := options.Run(out, "VirtualMachine", "Stopped", targets, func(ctx context.Context, name string) (bulk.Condition, error) {
+			mutex.Lock()
+			running++
+			if running > maxRunning {
+				maxRunning = running
+			}
+			mutex.Unlock()
+			time.Sleep(20 * time.Millisecond)
+			mutex.Lock()
+			running--
+			mutex.Unlock()
+			return nil, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(maxRunning).To(Equal(2))
+		Expect(out.String()).To(ContainSubstring("Stopped 6 of 6 VirtualMachines, 0 failed, 0 skipped"))
+	})
+
+	It("should report when no targets are found", func() {
+		options := &bulk.Options{Parallel: 1}
+		Expect(options.Run(out, "VirtualMachine", "Started", nil, succeed)).To(Succeed())
+		Expect(out.String()).To(Equal("No VirtualMachines found\n"))
+	})
+
+	Context("with --wait", func() {
+
+		It("should wait until the targets reach the target state", func() {
+			options := &bulk.Options{Parallel: 1, Wait: true, Timeout: time.Second}
+			polls := 0
+			err := options.Run(out, "VirtualMachine", "Started", []bulk.Target{{Name: "vm-a"}}, func(ctx context.Context, name string) (bulk.Condition, error) {
+				return func(ctx context.Context) (bool, string, error) {
+					polls++
+					return polls == 3, "Starting", nil
+				}, nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(polls).To(Equal(3))
+			Expect(out.String()).To(ContainSubstring("VirtualMachine vm-a: succeeded"))
+		})
+
+		It("should fail the targets which don't reach the target state in time", func() {
+			options := &bulk.Options{Parallel: 1, Wait: true, Timeout: 50 * time.Millisecond}
+			err := options.Run(out, "VirtualMachine", "Started", []bulk.Target{{Name: "vm-a"}}, func(ctx context.Context, name string) (bulk.Condition, error) {
+				return func(ctx context.Context) (bool, string, error) {
+					return false, "ErrorUnschedulable", nil
+				}, nil
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(out.String()).To(ContainSubstring("VirtualMachine vm-a: failed: timed out after 50ms waiting for the target state, the current state is ErrorUnschedulable"))
+		})
+
+		It("should fail the targets whose condition fails", func() {
+			options := &bulk.Options{Parallel: 1, Wait: true, Timeout: time.Second}
+			err := options.Run(out, "VirtualMachine", "Migrated", []bulk.Target{{Name: "vm-a"}}, func(ctx context.Context, name string) (bulk.Condition, error) {
+				return func(ctx context.Context) (bool, string, error) {
+					return false, "", fmt.Errorf("the migration failed")
+				}, nil
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(out.String()).To(ContainSubstring("VirtualMachine vm-a: failed: the migration failed"))
+		})
+	})
+
+	Context("validating the arguments", func() {
+
+		newCommand := func(options *bulk.Options) *cobra.Command {
+			cmd := &cobra.Command{
+				Use:  "start (VM)",
+				Args: options.ExactArgs("start", 1),
+				RunE: func(cmd *cobra.Command, args []string) error {
+					return options.Validate()
+				},
+			}
+			options.AddFlags(cmd, "VirtualMachine")
+			options.AddWaitFlags(cmd, "VirtualMachine")
+			cmd.SetOut(&bytes.Buffer{})
+			cmd.SetErr(&bytes.Buffer{})
+			return cmd
+		}
+
+		DescribeTable("should accept", func(args ...string) {
+			cmd := newCommand(&bulk.Options{})
+			cmd.SetArgs(args)
+			Expect(cmd.Execute()).To(Succeed())
+		},
+			Entry("a name", "testvm"),
+			Entry("a selector", "-l", "app=web"),
+			Entry("--all", "--all", "--parallel", "4", "--wait", "--timeout", "1m"),
+		)
+
+		DescribeTable("should reject", func(expected string, args ...string) {
+			cmd := newCommand(&bulk.Options{})
+			cmd.SetArgs(args)
+			Expect(cmd.Execute()).To(MatchError(ContainSubstring(expected)))
+		},
+			Entry("no name", "argument validation failed"),
+			Entry("a name with a selector", "a name can't be combined with --selector or --all", "testvm", "-l", "app=web"),
+			Entry("a name with --all", "a name can't be combined with --selector or --all", "testvm", "--all"),
+			Entry("a selector with --all", "none of the others can be", "-l", "app=web", "--all"),
+			Entry("no parallelism", "--parallel must be at least 1", "--all", "--parallel", "0"),
+			Entry("a negative timeout", "--timeout must be positive", "testvm", "--wait", "--timeout", "-1s"),
+		)
+	})
+})
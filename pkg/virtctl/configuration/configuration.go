@@ -65,6 +65,7 @@ func (c *command) run() error {
 
 	hostDeviceList := []string{}
 	gpuDeviceList := []string{}
+	usbDeviceList := []string{}
 
 	if kv.Spec.Configuration.PermittedHostDevices != nil {
 		for _, hd := range kv.Spec.Configuration.PermittedHostDevices.PciHostDevices {
@@ -74,11 +75,16 @@ func (c *command) run() error {
 		for _, hd := range kv.Spec.Configuration.PermittedHostDevices.MediatedDevices {
 			gpuDeviceList = append(gpuDeviceList, hd.ResourceName)
 		}
+
+		for _, hd := range kv.Spec.Configuration.PermittedHostDevices.USBHostDevices {
+			usbDeviceList = append(usbDeviceList, hd.ResourceName)
+		}
 	}
 
-	fmt.Printf("Permitted Devices: \nHost Devices: \n%s \nGPU Devices: \n%s\n",
+	fmt.Printf("Permitted Devices: \nHost Devices: \n%s \nGPU Devices: \n%s \nUSB Devices: \n%s\n",
 		fmt.Sprint(strings.Join(hostDeviceList, ", ")),
 		fmt.Sprint(strings.Join(gpuDeviceList, ", ")),
+		fmt.Sprint(strings.Join(usbDeviceList, ", ")),
 	)
 
 	return nil
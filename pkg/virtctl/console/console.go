@@ -22,6 +22,7 @@ package console
 import (
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"os/signal"
 	"time"
@@ -37,6 +38,11 @@ import (
 )
 
 var timeout int
+var serialPort string
+var showLog bool
+var follow bool
+var readOnly bool
+var exclusive bool
 
 func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
@@ -46,11 +52,25 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 		Args:    templates.ExactArgs("console", 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c := Console{clientConfig: clientConfig}
+			if showLog {
+				return c.RunLog(cmd, args)
+			}
+			if follow {
+				return fmt.Errorf("--follow requires --log")
+			}
+			if readOnly && exclusive {
+				return fmt.Errorf("--read-only and --exclusive are mutually exclusive")
+			}
 			return c.Run(args)
 		},
 	}
 
 	cmd.Flags().IntVar(&timeout, "timeout", 5, "The number of minutes to wait for the virtual machine instance to be ready.")
+	cmd.Flags().StringVar(&serialPort, "serial-port", "", "The name of an additional serial port to connect to, instead of the serial console.")
+	cmd.Flags().BoolVar(&showLog, "log", false, "Print the log of the serial console instead of connecting to it, including the output from before anyone connected.")
+	cmd.Flags().BoolVarP(&follow, "follow", "f", false, "Keep printing the log of the serial console as it grows, with --log.")
+	cmd.Flags().BoolVar(&readOnly, "read-only", false, "Observe the console along with the client holding it, without sending any input to it.")
+	cmd.Flags().BoolVar(&exclusive, "exclusive", false, "Fail if the console is held by another client, instead of taking it over.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -63,11 +83,35 @@ func usage() string {
 	usage := `  # Connect to the console on VirtualMachineInstance 'myvmi':
   {{ProgramName}} console myvmi
   # Configure one minute timeout (default 5 minutes)
-  {{ProgramName}} console --timeout=1 myvmi`
+  {{ProgramName}} console --timeout=1 myvmi
+  # Connect to the additional serial port 'debug' of VirtualMachineInstance 'myvmi':
+  {{ProgramName}} console --serial-port=debug myvmi
+  # Watch the serial console of VirtualMachineInstance 'myvmi', while someone else is using it:
+  {{ProgramName}} console --read-only myvmi
+  # Print the log of the serial console of VirtualMachineInstance 'myvmi', and keep following it:
+  {{ProgramName}} console --log -f myvmi`
 
 	return usage
 }
 
+func (c *Console) RunLog(cmd *cobra.Command, args []string) error {
+	if serialPort != "" {
+		return fmt.Errorf("only the serial console is logged, --serial-port can't be used with --log")
+	}
+
+	namespace, _, err := c.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtCli, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	return writeSerialConsoleLog(virtCli, namespace, args[0], follow, cmd.OutOrStdout())
+}
+
 func (c *Console) Run(args []string) error {
 	namespace, _, err := c.clientConfig.Namespace()
 	if err != nil {
@@ -93,7 +137,12 @@ func (c *Console) Run(args []string) error {
 	signal.Notify(waitInterrupt, os.Interrupt)
 
 	go func() {
-		con, err := virtCli.VirtualMachineInstance(namespace).SerialConsole(vmi, &kubecli.SerialConsoleOptions{ConnectionTimeout: time.Duration(timeout) * time.Minute})
+		con, err := virtCli.VirtualMachineInstance(namespace).SerialConsole(vmi, &kubecli.SerialConsoleOptions{
+			ConnectionTimeout: time.Duration(timeout) * time.Minute,
+			SerialPort:        serialPort,
+			ReadOnly:          readOnly,
+			Exclusive:         exclusive,
+		})
 		runningChan <- err
 
 		if err != nil {
@@ -112,21 +161,32 @@ func (c *Console) Run(args []string) error {
 		fmt.Println()
 		return nil
 	case err = <-runningChan:
+		if isConsoleHeld(err) {
+			return fmt.Errorf("the console of %s is held by another user, connect with --read-only to observe it or without --exclusive to take it over", vmi)
+		}
 		if err != nil {
 			return err
 		}
 	}
-	err = utils.AttachConsole(stdinReader, stdoutReader, stdinWriter, stdoutWriter,
-		fmt.Sprint("Successfully connected to ", vmi, " console. The escape sequence is ^]\n"),
-		resChan)
+	message := fmt.Sprint("Successfully connected to ", vmi, " console. The escape sequence is ^]\n")
+	if readOnly {
+		message = fmt.Sprint("Successfully connected to ", vmi, " console, read-only. The escape sequence is ^]\n")
+	}
+	err = utils.AttachConsole(stdinReader, stdoutReader, stdinWriter, stdoutWriter, message, resChan)
 
 	if err != nil {
 		if e, ok := err.(*websocket.CloseError); ok && e.Code == websocket.CloseAbnormalClosure {
 			fmt.Fprint(os.Stderr, "\nYou were disconnected from the console. This has one of the following reasons:"+
-				"\n - another user connected to the console of the target vm"+
+				"\n - another user took over the console of the target vm"+
 				"\n - network issues\n")
 		}
 		return err
 	}
 	return nil
 }
+
+// isConsoleHeld returns true if the console was refused because another user holds it
+func isConsoleHeld(err error) bool {
+	asyncErr, ok := err.(*kubecli.AsyncSubresourceError)
+	return ok && asyncErr.GetStatusCode() == http.StatusConflict
+}
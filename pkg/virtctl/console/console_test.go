@@ -0,0 +1,116 @@
+package console_test
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Console", func() {
+
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var vmi *v1.VirtualMachineInstance
+
+	launcherPod := func(name, nodeName string) *k8sv1.Pod {
+		return &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels: map[string]string{
+					v1.AppLabel:       "virt-launcher",
+					v1.CreatedByLabel: string(vmi.UID),
+				},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+				Containers: []k8sv1.Container{
+					{Name: "compute"},
+					{Name: "guest-console-log"},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		vmi = &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: vmiName, Namespace: metav1.NamespaceDefault, UID: "1234"},
+			Status:     v1.VirtualMachineInstanceStatus{NodeName: "node01"},
+		}
+	})
+
+	expectVMI := func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		vmiInterface.EXPECT().Get(context.Background(), vmiName, &metav1.GetOptions{}).Return(vmi, nil).AnyTimes()
+	}
+
+	Context("with --log", func() {
+		It("should print the log of the serial console from the launcher pod running the VMI", func() {
+			expectVMI()
+			// The pod of the migration target runs on another node
+			kubeClient := fake.NewSimpleClientset(launcherPod("target", "node02"), launcherPod("source", "node01"))
+			kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+			stdout := &bytes.Buffer{}
+			cmd := clientcmd.NewVirtctlCommand("console", "--log", vmiName)
+			cmd.SetOut(stdout)
+			Expect(cmd.Execute()).To(Succeed())
+			// The fake clientset returns the same content for every container
+			Expect(stdout.String()).To(Equal("fake logs"))
+		})
+
+		It("should fail if the serial console is not logged", func() {
+			vmi.Spec.Domain.Devices.LogSerialConsole = pointer.Bool(false)
+			expectVMI()
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand("console", "--log", vmiName)
+			Expect(cmd()).To(MatchError(ContainSubstring("is not logged")))
+		})
+
+		It("should fail if the launcher pod has no guest-console-log container", func() {
+			expectVMI()
+			pod := launcherPod("source", "node01")
+			pod.Spec.Containers = pod.Spec.Containers[:1]
+			kubeClient := fake.NewSimpleClientset(pod)
+			kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand("console", "--log", vmiName)
+			Expect(cmd()).To(MatchError(ContainSubstring("SerialConsoleLog feature gate")))
+		})
+
+		It("should fail if there is no launcher pod", func() {
+			expectVMI()
+			kubeClient := fake.NewSimpleClientset()
+			kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+			cmd := clientcmd.NewRepeatableVirtctlCommand("console", "--log", vmiName)
+			Expect(cmd()).To(MatchError(ContainSubstring("no virt-launcher pod found")))
+		})
+
+		DescribeTable("should reject", func(args ...string) {
+			cmd := clientcmd.NewRepeatableVirtctlCommand(append([]string{"console"}, args...)...)
+			Expect(cmd()).To(HaveOccurred())
+		},
+			Entry("an additional serial port", "--log", "--serial-port", "debug", vmiName),
+			Entry("--follow without --log", "--follow", vmiName),
+			Entry("--read-only with --exclusive", "--read-only", "--exclusive", vmiName),
+		)
+	})
+})
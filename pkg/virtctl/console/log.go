@@ -0,0 +1,90 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package console
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/util"
+)
+
+// writeSerialConsoleLog writes the serial console log, streamed by the guest-console-log container of the virt-launcher pod
+func writeSerialConsoleLog(virtCli kubecli.KubevirtClient, namespace string, name string, follow bool, out io.Writer) error {
+	vmi, err := virtCli.VirtualMachineInstance(namespace).Get(context.Background(), name, &metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+	if !util.IsSerialConsoleLogEnabled(vmi) {
+		return fmt.Errorf("the serial console of VirtualMachineInstance %s is not logged", name)
+	}
+
+	pod, err := launcherPod(virtCli, vmi)
+	if err != nil {
+		return err
+	}
+	if !hasContainer(pod, util.GuestConsoleLogContainerName) {
+		return fmt.Errorf("the serial console of VirtualMachineInstance %s is not logged, the SerialConsoleLog feature gate was disabled when it started", name)
+	}
+
+	logs, err := virtCli.CoreV1().Pods(namespace).GetLogs(pod.Name, &k8sv1.PodLogOptions{
+		Container: util.GuestConsoleLogContainerName,
+		Follow:    follow,
+	}).Stream(context.Background())
+	if err != nil {
+		return fmt.Errorf("Can't get the serial console log of VirtualMachineInstance %s: %v", name, err)
+	}
+	defer logs.Close()
+
+	_, err = io.Copy(out, logs)
+	return err
+}
+
+func launcherPod(virtCli kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance) (*k8sv1.Pod, error) {
+	pods, err := virtCli.CoreV1().Pods(vmi.Namespace).List(context.Background(), metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=virt-launcher,%s=%s", v1.AppLabel, v1.CreatedByLabel, vmi.UID),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// A migrating VMI has a pod on the source and on the target node
+	for i := range pods.Items {
+		if pods.Items[i].Spec.NodeName == vmi.Status.NodeName {
+			return &pods.Items[i], nil
+		}
+	}
+	return nil, fmt.Errorf("no virt-launcher pod found for VirtualMachineInstance %s", vmi.Name)
+}
+
+func hasContainer(pod *k8sv1.Pod, name string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,547 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vm
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/rand"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	"kubevirt.io/kubevirt/pkg/virtctl/create/params"
+)
+
+// populatedForPVCAnnotation makes CDI adopt a PVC restored from a snapshot for the DataVolume it names
+const populatedForPVCAnnotation = "cdi.kubevirt.io/storage.populatedFor"
+
+// sourceAnnotations are the annotations of the source VM specific to it, which are not copied
+var sourceAnnotations = []string{
+	k8sv1.LastAppliedConfigAnnotation,
+	v1.ControllerAPILatestVersionObservedAnnotation,
+	v1.ControllerAPIStorageVersionObservedAnnotation,
+}
+
+func (c *createVM) hasSource() bool {
+	return c.fromVM != "" || c.fromSnapshot != "" || c.fromExportManifest != ""
+}
+
+// copyVM creates a sanitized copy of the source VM, with its volumes backed by new DataVolumeTemplates or PVCs.
+// It also returns the objects the copy depends on, which need to be created along with it.
+func (c *createVM) copyVM(cmd *cobra.Command) (*v1.VirtualMachine, []runtime.Object, error) {
+	var (
+		vm           *v1.VirtualMachine
+		dependencies []runtime.Object
+		err          error
+	)
+	switch {
+	case c.fromVM != "":
+		vm, err = c.copyFromVM()
+	case c.fromSnapshot != "":
+		vm, dependencies, err = c.copyFromSnapshot()
+	case c.fromExportManifest != "":
+		vm, dependencies, err = c.copyFromExportManifest()
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := c.withSourceOverrides(cmd, vm); err != nil {
+		return nil, nil, err
+	}
+	return vm, dependencies, nil
+}
+
+func (c *createVM) copyFromVM() (*v1.VirtualMachine, error) {
+	namespace, name, err := params.SplitPrefixedName(c.fromVM)
+	if err != nil {
+		return nil, params.FlagErr(FromVMFlag, "%w", err)
+	}
+	if namespace == "" {
+		namespace = c.namespace
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	source, err := virtClient.VirtualMachine(namespace).Get(context.Background(), name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, params.FlagErr(FromVMFlag, "failed to get VirtualMachine %s/%s: %w", namespace, name, err)
+	}
+
+	vm, err := c.sanitizedCopy(FromVMFlag, source)
+	if err != nil {
+		return nil, err
+	}
+
+	// The volumes of the copy are cloned from the PVCs of the source
+	vm.Spec.DataVolumeTemplates = nil
+	for i := range vm.Spec.Template.Spec.Volumes {
+		vol := &vm.Spec.Template.Spec.Volumes[i]
+		claimName := volumeClaimName(vol)
+		if claimName == "" {
+			continue
+		}
+
+		dvt := copiedDataVolumeTemplate(vm, vol, source)
+		dvt.Spec.SourceRef = nil
+		dvt.Spec.Source = &cdiv1.DataVolumeSource{
+			PVC: &cdiv1.DataVolumeSourcePVC{
+				Namespace: source.Namespace,
+				Name:      claimName,
+			},
+		}
+		vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, *dvt)
+		setDataVolume(vol, dvt.Name)
+	}
+
+	return vm, nil
+}
+
+func (c *createVM) copyFromSnapshot() (*v1.VirtualMachine, []runtime.Object, error) {
+	namespace, name, err := params.SplitPrefixedName(c.fromSnapshot)
+	if err != nil {
+		return nil, nil, params.FlagErr(FromSnapshotFlag, "%w", err)
+	}
+	// VolumeSnapshots can only be restored to PVCs in their namespace
+	if namespace != "" && namespace != c.namespace {
+		return nil, nil, params.FlagErr(FromSnapshotFlag, "the VM has to be created in the namespace '%s' of the snapshot", namespace)
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	vmSnapshot, err := virtClient.VirtualMachineSnapshot(c.namespace).Get(context.Background(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, params.FlagErr(FromSnapshotFlag, "failed to get VirtualMachineSnapshot %s/%s: %w", c.namespace, name, err)
+	}
+	if vmSnapshot.Status == nil || vmSnapshot.Status.ReadyToUse == nil || !*vmSnapshot.Status.ReadyToUse || vmSnapshot.Status.VirtualMachineSnapshotContentName == nil {
+		return nil, nil, params.FlagErr(FromSnapshotFlag, "VirtualMachineSnapshot %s/%s is not ready to use", c.namespace, name)
+	}
+	content, err := virtClient.VirtualMachineSnapshotContent(c.namespace).Get(context.Background(), *vmSnapshot.Status.VirtualMachineSnapshotContentName, metav1.GetOptions{})
+	if err != nil {
+		return nil, nil, params.FlagErr(FromSnapshotFlag, "failed to get VirtualMachineSnapshotContent %s/%s: %w", c.namespace, *vmSnapshot.Status.VirtualMachineSnapshotContentName, err)
+	}
+	if content.Spec.Source.VirtualMachine == nil {
+		return nil, nil, params.FlagErr(FromSnapshotFlag, "VirtualMachineSnapshot %s/%s has no VirtualMachine source", c.namespace, name)
+	}
+
+	source := &v1.VirtualMachine{
+		ObjectMeta: content.Spec.Source.VirtualMachine.ObjectMeta,
+		Spec:       content.Spec.Source.VirtualMachine.Spec,
+	}
+	vm, err := c.sanitizedCopy(FromSnapshotFlag, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The volumes of the copy are restored from the volume snapshots, like a VirtualMachineRestore does.
+	// Volumes which had a DataVolumeTemplate keep one, which adopts the restored PVC.
+	var dependencies []runtime.Object
+	vm.Spec.DataVolumeTemplates = nil
+	for i := range vm.Spec.Template.Spec.Volumes {
+		vol := &vm.Spec.Template.Spec.Volumes[i]
+		if volumeClaimName(vol) == "" {
+			continue
+		}
+
+		volumeBackup := findVolumeBackup(content.Spec.VolumeBackups, vol.Name)
+		if volumeBackup == nil || volumeBackup.VolumeSnapshotName == nil {
+			return nil, nil, params.FlagErr(FromSnapshotFlag, "volume '%s' was not snapshotted", vol.Name)
+		}
+		volumeSnapshot, err := virtClient.KubernetesSnapshotClient().SnapshotV1().VolumeSnapshots(c.namespace).Get(context.Background(), *volumeBackup.VolumeSnapshotName, metav1.GetOptions{})
+		if err != nil {
+			return nil, nil, params.FlagErr(FromSnapshotFlag, "failed to get VolumeSnapshot %s/%s: %w", c.namespace, *volumeBackup.VolumeSnapshotName, err)
+		}
+
+		claimName := copiedVolumeName(vm, vol)
+		pvc := snapshot.CreateRestorePVCDef(claimName, volumeSnapshot, volumeBackup)
+		pvc.TypeMeta = metav1.TypeMeta{
+			Kind:       "PersistentVolumeClaim",
+			APIVersion: "v1",
+		}
+		dependencies = append(dependencies, pvc)
+
+		if vol.DataVolume != nil && findDataVolumeTemplate(source, vol.DataVolume.Name) != nil {
+			dvt := copiedDataVolumeTemplate(vm, vol, source)
+			vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, *dvt)
+			if pvc.Annotations == nil {
+				pvc.Annotations = map[string]string{}
+			}
+			pvc.Annotations[populatedForPVCAnnotation] = dvt.Name
+			setDataVolume(vol, dvt.Name)
+			continue
+		}
+
+		vol.VolumeSource = v1.VolumeSource{
+			PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+				PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+				},
+				Hotpluggable: isHotpluggable(vol),
+			},
+		}
+	}
+
+	return vm, dependencies, nil
+}
+
+func (c *createVM) copyFromExportManifest() (*v1.VirtualMachine, []runtime.Object, error) {
+	source, dataVolumes, dependencies, err := readExportManifest(c.fromExportManifest)
+	if err != nil {
+		return nil, nil, params.FlagErr(FromExportManifestFlag, "%w", err)
+	}
+
+	vm, err := c.sanitizedCopy(FromExportManifestFlag, source)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The volumes of the copy are imported from the export server, with the DataVolumeTemplates of the manifest
+	// or the DataVolumes exported next to the VM
+	vm.Spec.DataVolumeTemplates = nil
+	for i := range vm.Spec.Template.Spec.Volumes {
+		vol := &vm.Spec.Template.Spec.Volumes[i]
+		claimName := volumeClaimName(vol)
+		if claimName == "" {
+			continue
+		}
+
+		var dvt *v1.DataVolumeTemplateSpec
+		if vol.DataVolume != nil && findDataVolumeTemplate(source, claimName) != nil {
+			dvt = copiedDataVolumeTemplate(vm, vol, source)
+		} else if dv, exists := dataVolumes[claimName]; exists {
+			dvt = &v1.DataVolumeTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        copiedVolumeName(vm, vol),
+					Labels:      dv.Labels,
+					Annotations: dv.Annotations,
+				},
+				Spec: *dv.Spec.DeepCopy(),
+			}
+		} else {
+			return nil, nil, params.FlagErr(FromExportManifestFlag, "volume '%s' was not exported", vol.Name)
+		}
+		vm.Spec.DataVolumeTemplates = append(vm.Spec.DataVolumeTemplates, *dvt)
+		setDataVolume(vol, dvt.Name)
+	}
+
+	return vm, dependencies, nil
+}
+
+// readExportManifest reads the VirtualMachine, the DataVolumes and the other objects of a manifest retrieved
+// with 'vmexport download --manifest', in YAML or JSON
+func readExportManifest(path string) (*v1.VirtualMachine, map[string]*cdiv1.DataVolume, []runtime.Object, error) {
+	var reader io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		defer f.Close()
+		reader = f
+	}
+
+	var raws []json.RawMessage
+	decoder := k8syaml.NewYAMLOrJSONDecoder(bufio.NewReader(reader), 4096)
+	for {
+		raw := json.RawMessage{}
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, nil, nil, fmt.Errorf("failed to parse the manifest: %w", err)
+		}
+		if len(raw) == 0 || string(raw) == "null" {
+			continue
+		}
+		raws = append(raws, raw)
+	}
+
+	var (
+		vm           *v1.VirtualMachine
+		dataVolumes  = map[string]*cdiv1.DataVolume{}
+		dependencies []runtime.Object
+	)
+	for len(raws) > 0 {
+		raw := raws[0]
+		raws = raws[1:]
+
+		typeMeta := metav1.TypeMeta{}
+		if err := json.Unmarshal(raw, &typeMeta); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse the manifest: %w", err)
+		}
+
+		var obj runtime.Object
+		switch typeMeta.Kind {
+		case "List":
+			list := k8sv1.List{}
+			if err := json.Unmarshal(raw, &list); err != nil {
+				return nil, nil, nil, fmt.Errorf("failed to parse the manifest: %w", err)
+			}
+			for _, item := range list.Items {
+				raws = append(raws, item.Raw)
+			}
+			continue
+		case v1.VirtualMachineGroupVersionKind.Kind:
+			if vm != nil {
+				return nil, nil, nil, fmt.Errorf("the manifest contains more than one VirtualMachine")
+			}
+			vm = &v1.VirtualMachine{}
+			obj = vm
+		case "DataVolume":
+			obj = &cdiv1.DataVolume{}
+		case "ConfigMap":
+			obj = &k8sv1.ConfigMap{}
+			dependencies = append(dependencies, obj)
+		case "Secret":
+			obj = &k8sv1.Secret{}
+			dependencies = append(dependencies, obj)
+		default:
+			return nil, nil, nil, fmt.Errorf("unsupported kind '%s' in the manifest", typeMeta.Kind)
+		}
+		if err := json.Unmarshal(raw, obj); err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to parse the %s in the manifest: %w", typeMeta.Kind, err)
+		}
+		if dv, isDataVolume := obj.(*cdiv1.DataVolume); isDataVolume {
+			dataVolumes[dv.Name] = dv
+		}
+	}
+
+	if vm == nil {
+		return nil, nil, nil, fmt.Errorf("the manifest contains no VirtualMachine")
+	}
+	return vm, dataVolumes, dependencies, nil
+}
+
+// sanitizedCopy copies the source VM without its status and without anything which would conflict with the source,
+// like its MAC addresses and firmware UUID. The volumes still have to be replaced.
+func (c *createVM) sanitizedCopy(flag string, source *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+	if source.Spec.Template == nil {
+		return nil, params.FlagErr(flag, "the source VirtualMachine has no template")
+	}
+
+	if c.name == "" {
+		c.name = source.Name + "-" + rand.String(5)
+	}
+
+	vm := &v1.VirtualMachine{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       v1.VirtualMachineGroupVersionKind.Kind,
+			APIVersion: v1.VirtualMachineGroupVersionKind.GroupVersion().String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   c.name,
+			Labels: source.Labels,
+		},
+		Spec: *source.Spec.DeepCopy(),
+	}
+	for key, value := range source.Annotations {
+		if isSourceAnnotation(key) {
+			continue
+		}
+		if vm.Annotations == nil {
+			vm.Annotations = map[string]string{}
+		}
+		vm.Annotations[key] = value
+	}
+
+	// The ControllerRevisions of the source are owned by it, the copy gets its own
+	if vm.Spec.Instancetype != nil {
+		vm.Spec.Instancetype.RevisionName = ""
+	}
+	if vm.Spec.Preference != nil {
+		vm.Spec.Preference.RevisionName = ""
+	}
+
+	spec := &vm.Spec.Template.Spec
+	// Like with a VirtualMachineClone, empty MAC addresses are assigned by KubeMacPool if it is deployed,
+	// and an empty firmware UUID is derived from the name of the VM.
+	for i := range spec.Domain.Devices.Interfaces {
+		spec.Domain.Devices.Interfaces[i].MacAddress = ""
+	}
+	if spec.Domain.Firmware != nil {
+		spec.Domain.Firmware.UUID = ""
+		spec.Domain.Firmware.Serial = ""
+	}
+
+	var volumes []v1.Volume
+	for _, vol := range spec.Volumes {
+		// Memory dumps belong to the source
+		if vol.MemoryDump != nil {
+			continue
+		}
+		volumes = append(volumes, vol)
+	}
+	spec.Volumes = volumes
+
+	return vm, nil
+}
+
+// withSourceOverrides applies the flags to the copy which are not applied by the optFns
+func (c *createVM) withSourceOverrides(cmd *cobra.Command, vm *v1.VirtualMachine) error {
+	spec := &vm.Spec.Template.Spec
+
+	if cmd.Flags().Changed(TerminationGracePeriodFlag) {
+		spec.TerminationGracePeriodSeconds = &c.terminationGracePeriod
+	}
+
+	if cmd.Flags().Changed(MemoryFlag) {
+		if vm.Spec.Instancetype != nil {
+			return params.FlagErr(MemoryFlag, "not allowed to specify the memory of a VM with an instancetype")
+		}
+		memory, err := resource.ParseQuantity(c.memory)
+		if err != nil {
+			return params.FlagErr(MemoryFlag, "%w", err)
+		}
+		if spec.Domain.Memory == nil {
+			spec.Domain.Memory = &v1.Memory{}
+		}
+		spec.Domain.Memory.Guest = &memory
+	}
+
+	// The cloud-init flags replace the cloud-init volume of the source
+	if cmd.Flags().Changed(CloudInitUserDataFlag) || cmd.Flags().Changed(CloudInitNetworkDataFlag) {
+		var volumes []v1.Volume
+		for _, vol := range spec.Volumes {
+			if vol.CloudInitNoCloud != nil || vol.CloudInitConfigDrive != nil {
+				removeDisk(vm, vol.Name)
+				continue
+			}
+			volumes = append(volumes, vol)
+		}
+		spec.Volumes = volumes
+	}
+
+	// The boot orders of the source have to be taken into account by the volume flags
+	for _, disk := range spec.Domain.Devices.Disks {
+		if disk.BootOrder != nil {
+			c.bootOrders[*disk.BootOrder] = disk.Name
+		}
+	}
+
+	return nil
+}
+
+func isSourceAnnotation(key string) bool {
+	for _, annotation := range sourceAnnotations {
+		if key == annotation {
+			return true
+		}
+	}
+	return false
+}
+
+func removeDisk(vm *v1.VirtualMachine, name string) {
+	var disks []v1.Disk
+	for _, disk := range vm.Spec.Template.Spec.Domain.Devices.Disks {
+		if disk.Name != name {
+			disks = append(disks, disk)
+		}
+	}
+	vm.Spec.Template.Spec.Domain.Devices.Disks = disks
+}
+
+// volumeClaimName returns the name of the PVC backing a volume, or an empty string if it isn't backed by a PVC
+func volumeClaimName(vol *v1.Volume) string {
+	if vol.DataVolume != nil {
+		return vol.DataVolume.Name
+	}
+	if vol.PersistentVolumeClaim != nil {
+		return vol.PersistentVolumeClaim.ClaimName
+	}
+	return ""
+}
+
+// copiedVolumeName is the name of the DataVolume or PVC backing a volume of the copy
+func copiedVolumeName(vm *v1.VirtualMachine, vol *v1.Volume) string {
+	return fmt.Sprintf("%s-%s", vm.Name, vol.Name)
+}
+
+// copiedDataVolumeTemplate returns a DataVolumeTemplate for a volume of the copy, based on the one of the source
+// if the volume had one
+func copiedDataVolumeTemplate(vm *v1.VirtualMachine, vol *v1.Volume, source *v1.VirtualMachine) *v1.DataVolumeTemplateSpec {
+	dvt := &v1.DataVolumeTemplateSpec{
+		Spec: cdiv1.DataVolumeSpec{
+			Storage: &cdiv1.StorageSpec{},
+		},
+	}
+	if vol.DataVolume != nil {
+		if sourceTemplate := findDataVolumeTemplate(source, vol.DataVolume.Name); sourceTemplate != nil {
+			dvt = sourceTemplate.DeepCopy()
+			dvt.ObjectMeta = metav1.ObjectMeta{
+				Labels:      sourceTemplate.Labels,
+				Annotations: sourceTemplate.Annotations,
+			}
+		}
+	}
+	dvt.Name = copiedVolumeName(vm, vol)
+	return dvt
+}
+
+func findDataVolumeTemplate(vm *v1.VirtualMachine, name string) *v1.DataVolumeTemplateSpec {
+	for i := range vm.Spec.DataVolumeTemplates {
+		if vm.Spec.DataVolumeTemplates[i].Name == name {
+			return &vm.Spec.DataVolumeTemplates[i]
+		}
+	}
+	return nil
+}
+
+func findVolumeBackup(volumeBackups []snapshotv1.VolumeBackup, volumeName string) *snapshotv1.VolumeBackup {
+	for i := range volumeBackups {
+		if volumeBackups[i].VolumeName == volumeName {
+			return &volumeBackups[i]
+		}
+	}
+	return nil
+}
+
+// setDataVolume makes a volume use a DataVolume, keeping whether it is hotpluggable
+func setDataVolume(vol *v1.Volume, name string) {
+	vol.VolumeSource = v1.VolumeSource{
+		DataVolume: &v1.DataVolumeSource{
+			Name:         name,
+			Hotpluggable: isHotpluggable(vol),
+		},
+	}
+}
+
+func isHotpluggable(vol *v1.Volume) bool {
+	return (vol.DataVolume != nil && vol.DataVolume.Hotpluggable) ||
+		(vol.PersistentVolumeClaim != nil && vol.PersistentVolumeClaim.Hotpluggable)
+}
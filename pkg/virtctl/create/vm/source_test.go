@@ -0,0 +1,442 @@
+package vm_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	vsv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	v1 "kubevirt.io/api/core/v1"
+	snapshotv1 "kubevirt.io/api/snapshot/v1alpha1"
+	k8ssnapshotfake "kubevirt.io/client-go/generated/external-snapshotter/clientset/versioned/fake"
+	kubevirtfake "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/yaml"
+
+	. "kubevirt.io/kubevirt/pkg/virtctl/create/vm"
+)
+
+var _ = Describe("create vm from a source", func() {
+	var (
+		vmInterface *kubecli.MockVirtualMachineInterface
+		kubeClient  *k8sfake.Clientset
+		source      *v1.VirtualMachine
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		kubeClient = k8sfake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		source = newSourceVM()
+	})
+
+	Context("--from-vm", func() {
+		It("should copy the VM with cloned volumes", func() {
+			vmInterface.EXPECT().Get(context.Background(), source.Name, gomock.Any()).Return(source, nil)
+
+			out, err := runCmd(setFlag(FromVMFlag, source.Name), setFlag(NameFlag, "my-copy"))
+			Expect(err).ToNot(HaveOccurred())
+			vm := unmarshalVM(out)
+
+			Expect(vm.Name).To(Equal("my-copy"))
+			Expect(vm.Namespace).To(BeEmpty())
+			Expect(vm.ResourceVersion).To(BeEmpty())
+			Expect(vm.UID).To(BeEmpty())
+			Expect(vm.Labels).To(Equal(source.Labels))
+			Expect(vm.Annotations).To(Equal(map[string]string{"my-annotation": "value"}))
+			Expect(vm.Status).To(Equal(v1.VirtualMachineStatus{}))
+
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces).To(HaveLen(1))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.UUID).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.Serial).To(BeEmpty())
+			Expect(vm.Spec.Instancetype.RevisionName).To(BeEmpty())
+
+			Expect(vm.Spec.Template.Spec.Volumes).To(ConsistOf(
+				v1.Volume{Name: "rootdisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "my-copy-rootdisk"}}},
+				v1.Volume{Name: "datadisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "my-copy-datadisk", Hotpluggable: true}}},
+				source.Spec.Template.Spec.Volumes[2],
+			))
+			Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(2))
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("my-copy-rootdisk"))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.SourceRef).To(BeNil())
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: source.Namespace, Name: "my-vm-rootdisk"}))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.Storage.Resources.Requests).To(Equal(source.Spec.DataVolumeTemplates[0].Spec.Storage.Resources.Requests))
+			Expect(vm.Spec.DataVolumeTemplates[1].Name).To(Equal("my-copy-datadisk"))
+			Expect(vm.Spec.DataVolumeTemplates[1].Spec.Source.PVC).To(Equal(&cdiv1.DataVolumeSourcePVC{Namespace: source.Namespace, Name: "my-data"}))
+		})
+
+		It("should apply the flags on top of the copy", func() {
+			vmInterface.EXPECT().Get(context.Background(), source.Name, gomock.Any()).Return(source, nil)
+
+			out, err := runCmd(
+				setFlag(FromVMFlag, source.Name),
+				setFlag(RunStrategyFlag, string(v1.RunStrategyHalted)),
+				setFlag(TerminationGracePeriodFlag, "30"),
+				setFlag(InstancetypeFlag, "my-instancetype"),
+				setFlag(CloudInitUserDataFlag, "dXNlcjogdXNlcg=="),
+				setFlag(ContainerdiskVolumeFlag, "src:my.registry/my-image:my-tag,bootorder:2"),
+			)
+			Expect(err).ToNot(HaveOccurred())
+			vm := unmarshalVM(out)
+
+			Expect(vm.Name).To(HavePrefix(source.Name + "-"))
+			Expect(vm.Spec.Running).To(BeNil())
+			Expect(*vm.Spec.RunStrategy).To(Equal(v1.RunStrategyHalted))
+			Expect(*vm.Spec.Template.Spec.TerminationGracePeriodSeconds).To(Equal(int64(30)))
+			Expect(vm.Spec.Instancetype).To(Equal(&v1.InstancetypeMatcher{Name: "my-instancetype"}))
+
+			var volumeNames []string
+			for _, vol := range vm.Spec.Template.Spec.Volumes {
+				volumeNames = append(volumeNames, vol.Name)
+			}
+			Expect(volumeNames).To(ConsistOf("rootdisk", "datadisk", vm.Name+"-containerdisk-0", "cloudinitdisk"))
+			Expect(vm.Spec.Template.Spec.Volumes[3].CloudInitNoCloud.UserDataBase64).To(Equal("dXNlcjogdXNlcg=="))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Disks).ToNot(ContainElement(HaveField("Name", "cloudinit")))
+		})
+
+		It("should fail with a boot order already used by the source", func() {
+			vmInterface.EXPECT().Get(context.Background(), source.Name, gomock.Any()).Return(source, nil)
+
+			_, err := runCmd(
+				setFlag(FromVMFlag, source.Name),
+				setFlag(ContainerdiskVolumeFlag, "src:my.registry/my-image:my-tag,bootorder:1"),
+			)
+			Expect(err).To(MatchError("failed to parse \"--volume-containerdisk\" flag: bootorder 1 was specified multiple times"))
+		})
+
+		It("should fail to set the memory of a source with an instancetype", func() {
+			vmInterface.EXPECT().Get(context.Background(), source.Name, gomock.Any()).Return(source, nil)
+
+			_, err := runCmd(setFlag(FromVMFlag, source.Name), setFlag(MemoryFlag, "1Gi"))
+			Expect(err).To(MatchError("failed to parse \"--memory\" flag: not allowed to specify the memory of a VM with an instancetype"))
+		})
+
+		It("should create the copy with --apply", func() {
+			vmInterface.EXPECT().Get(context.Background(), source.Name, gomock.Any()).Return(source, nil)
+			vmInterface.EXPECT().Create(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				Expect(vm.Name).To(Equal("my-copy"))
+				Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(2))
+				vm.Namespace = metav1.NamespaceDefault
+				return vm, nil
+			})
+
+			out, err := runCmd(setFlag(FromVMFlag, source.Name), setFlag(NameFlag, "my-copy"), "--"+ApplyFlag)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(Equal("VirtualMachine default/my-copy created\n"))
+		})
+	})
+
+	Context("--from-snapshot", func() {
+		var (
+			kubevirtClient *kubevirtfake.Clientset
+			snapshotClient *k8ssnapshotfake.Clientset
+		)
+
+		BeforeEach(func() {
+			kubevirtClient = kubevirtfake.NewSimpleClientset()
+			snapshotClient = k8ssnapshotfake.NewSimpleClientset()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshot(metav1.NamespaceDefault).Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(metav1.NamespaceDefault)).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineSnapshotContent(metav1.NamespaceDefault).Return(kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshotContents(metav1.NamespaceDefault)).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().KubernetesSnapshotClient().Return(snapshotClient).AnyTimes()
+		})
+
+		createSnapshot := func(ready bool) {
+			contentName := "my-snapshot-content"
+			vmSnapshot := &snapshotv1.VirtualMachineSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-snapshot", Namespace: metav1.NamespaceDefault},
+				Status: &snapshotv1.VirtualMachineSnapshotStatus{
+					ReadyToUse:                        &ready,
+					VirtualMachineSnapshotContentName: &contentName,
+				},
+			}
+			_, err := kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshots(metav1.NamespaceDefault).Create(context.Background(), vmSnapshot, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			var volumeBackups []snapshotv1.VolumeBackup
+			for _, volumeName := range []string{"rootdisk", "datadisk"} {
+				volumeSnapshotName := "vs-" + volumeName
+				volumeBackups = append(volumeBackups, snapshotv1.VolumeBackup{
+					VolumeName: volumeName,
+					PersistentVolumeClaim: snapshotv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{Name: volumeName},
+						Spec: k8sv1.PersistentVolumeClaimSpec{
+							Resources: k8sv1.ResourceRequirements{
+								Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("10Gi")},
+							},
+						},
+					},
+					VolumeSnapshotName: &volumeSnapshotName,
+				})
+				_, err := snapshotClient.SnapshotV1().VolumeSnapshots(metav1.NamespaceDefault).Create(context.Background(), &vsv1.VolumeSnapshot{
+					ObjectMeta: metav1.ObjectMeta{Name: volumeSnapshotName, Namespace: metav1.NamespaceDefault},
+				}, metav1.CreateOptions{})
+				Expect(err).ToNot(HaveOccurred())
+			}
+			content := &snapshotv1.VirtualMachineSnapshotContent{
+				ObjectMeta: metav1.ObjectMeta{Name: contentName, Namespace: metav1.NamespaceDefault},
+				Spec: snapshotv1.VirtualMachineSnapshotContentSpec{
+					Source: snapshotv1.SourceSpec{
+						VirtualMachine: &snapshotv1.VirtualMachine{
+							ObjectMeta: source.ObjectMeta,
+							Spec:       source.Spec,
+						},
+					},
+					VolumeBackups: volumeBackups,
+				},
+			}
+			_, err = kubevirtClient.SnapshotV1alpha1().VirtualMachineSnapshotContents(metav1.NamespaceDefault).Create(context.Background(), content, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		It("should copy the VM with volumes restored from the snapshot", func() {
+			createSnapshot(true)
+
+			out, err := runCmd(setFlag(FromSnapshotFlag, "my-snapshot"), setFlag(NameFlag, "my-copy"))
+			Expect(err).ToNot(HaveOccurred())
+			docs := strings.Split(string(out), "---\n")
+			Expect(docs).To(HaveLen(3))
+
+			pvcs := []*k8sv1.PersistentVolumeClaim{{}, {}}
+			for i := range pvcs {
+				Expect(yaml.Unmarshal([]byte(docs[i]), pvcs[i])).To(Succeed())
+				Expect(pvcs[i].Kind).To(Equal("PersistentVolumeClaim"))
+			}
+			Expect(pvcs[0].Name).To(Equal("my-copy-rootdisk"))
+			Expect(pvcs[0].Annotations).To(HaveKeyWithValue("cdi.kubevirt.io/storage.populatedFor", "my-copy-rootdisk"))
+			Expect(pvcs[0].Spec.DataSource.Kind).To(Equal("VolumeSnapshot"))
+			Expect(pvcs[0].Spec.DataSource.Name).To(Equal("vs-rootdisk"))
+			Expect(pvcs[1].Name).To(Equal("my-copy-datadisk"))
+			Expect(pvcs[1].Annotations).ToNot(HaveKey("cdi.kubevirt.io/storage.populatedFor"))
+			Expect(pvcs[1].Spec.DataSource.Name).To(Equal("vs-datadisk"))
+
+			vm := unmarshalVM([]byte(docs[2]))
+			Expect(vm.Name).To(Equal("my-copy"))
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+			Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(1))
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("my-copy-rootdisk"))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec).To(Equal(source.Spec.DataVolumeTemplates[0].Spec))
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("my-copy-rootdisk"))
+			Expect(vm.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.ClaimName).To(Equal("my-copy-datadisk"))
+			Expect(vm.Spec.Template.Spec.Volumes[1].PersistentVolumeClaim.Hotpluggable).To(BeTrue())
+		})
+
+		It("should create the PVCs before the VM with --apply", func() {
+			createSnapshot(true)
+			vmInterface.EXPECT().Create(context.Background(), gomock.Any()).DoAndReturn(func(_ context.Context, vm *v1.VirtualMachine) (*v1.VirtualMachine, error) {
+				pvcs, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pvcs.Items).To(HaveLen(2))
+				vm.Namespace = metav1.NamespaceDefault
+				return vm, nil
+			})
+
+			_, err := runCmd(setFlag(FromSnapshotFlag, "my-snapshot"), "--"+ApplyFlag)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail if the snapshot is not ready", func() {
+			createSnapshot(false)
+
+			_, err := runCmd(setFlag(FromSnapshotFlag, "my-snapshot"))
+			Expect(err).To(MatchError("failed to parse \"--from-snapshot\" flag: VirtualMachineSnapshot default/my-snapshot is not ready to use"))
+		})
+
+		It("should fail with a snapshot in another namespace", func() {
+			_, err := runCmd(setFlag(FromSnapshotFlag, "other-ns/my-snapshot"))
+			Expect(err).To(MatchError("failed to parse \"--from-snapshot\" flag: the VM has to be created in the namespace 'other-ns' of the snapshot"))
+		})
+	})
+
+	Context("--from-export-manifest", func() {
+		const manifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: export-ca-cm-my-export
+data:
+  ca.pem: my-ca
+---
+apiVersion: kubevirt.io/v1
+kind: VirtualMachine
+metadata:
+  name: my-vm
+  namespace: my-ns
+spec:
+  running: true
+  dataVolumeTemplates:
+  - metadata:
+      name: my-vm-rootdisk
+    spec:
+      source:
+        http:
+          url: https://my-export/volumes/my-vm-rootdisk/disk.img.gz
+          certConfigMap: export-ca-cm-my-export
+      storage: {}
+  template:
+    spec:
+      domain:
+        devices:
+          interfaces:
+          - name: default
+            masquerade: {}
+            macAddress: "02:00:00:00:00:01"
+      networks:
+      - name: default
+        pod: {}
+      volumes:
+      - name: rootdisk
+        dataVolume:
+          name: my-vm-rootdisk
+      - name: datadisk
+        persistentVolumeClaim:
+          claimName: my-data
+---
+apiVersion: cdi.kubevirt.io/v1beta1
+kind: DataVolume
+metadata:
+  name: my-data
+  namespace: my-ns
+spec:
+  source:
+    http:
+      url: https://my-export/volumes/my-data/disk.img.gz
+      certConfigMap: export-ca-cm-my-export
+  pvc:
+    resources:
+      requests:
+        storage: 1Gi
+---
+`
+
+		writeManifest := func(content string) string {
+			path := filepath.Join(GinkgoT().TempDir(), "manifest.yaml")
+			Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+			return path
+		}
+
+		It("should copy the VM with volumes imported from the export", func() {
+			out, err := runCmd(setFlag(FromExportManifestFlag, writeManifest(manifest)), setFlag(NameFlag, "my-copy"))
+			Expect(err).ToNot(HaveOccurred())
+			docs := strings.Split(string(out), "---\n")
+			Expect(docs).To(HaveLen(2))
+
+			cm := &k8sv1.ConfigMap{}
+			Expect(yaml.Unmarshal([]byte(docs[0]), cm)).To(Succeed())
+			Expect(cm.Name).To(Equal("export-ca-cm-my-export"))
+			Expect(cm.Data).To(HaveKeyWithValue("ca.pem", "my-ca"))
+
+			vm := unmarshalVM([]byte(docs[1]))
+			Expect(vm.Name).To(Equal("my-copy"))
+			Expect(vm.Namespace).To(BeEmpty())
+			Expect(vm.Spec.Template.Spec.Domain.Devices.Interfaces[0].MacAddress).To(BeEmpty())
+			Expect(vm.Spec.DataVolumeTemplates).To(HaveLen(2))
+			Expect(vm.Spec.DataVolumeTemplates[0].Name).To(Equal("my-copy-rootdisk"))
+			Expect(vm.Spec.DataVolumeTemplates[0].Spec.Source.HTTP.URL).To(Equal("https://my-export/volumes/my-vm-rootdisk/disk.img.gz"))
+			Expect(vm.Spec.DataVolumeTemplates[1].Name).To(Equal("my-copy-datadisk"))
+			Expect(vm.Spec.DataVolumeTemplates[1].Spec.Source.HTTP.URL).To(Equal("https://my-export/volumes/my-data/disk.img.gz"))
+			Expect(vm.Spec.DataVolumeTemplates[1].Spec.PVC.Resources.Requests).To(HaveKeyWithValue(k8sv1.ResourceStorage, resource.MustParse("1Gi")))
+			Expect(vm.Spec.Template.Spec.Volumes[0].DataVolume.Name).To(Equal("my-copy-rootdisk"))
+			Expect(vm.Spec.Template.Spec.Volumes[1].DataVolume.Name).To(Equal("my-copy-datadisk"))
+		})
+
+		It("should fail if a volume was not exported", func() {
+			path := writeManifest(manifest[:strings.Index(manifest, "apiVersion: cdi.kubevirt.io/v1beta1")])
+			_, err := runCmd(setFlag(FromExportManifestFlag, path))
+			Expect(err).To(MatchError("failed to parse \"--from-export-manifest\" flag: volume 'datadisk' was not exported"))
+		})
+
+		It("should fail if the manifest has no VirtualMachine", func() {
+			_, err := runCmd(setFlag(FromExportManifestFlag, writeManifest("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: my-cm\n")))
+			Expect(err).To(MatchError("failed to parse \"--from-export-manifest\" flag: the manifest contains no VirtualMachine"))
+		})
+	})
+
+	It("should not allow more than one source", func() {
+		_, err := runCmd(setFlag(FromVMFlag, "my-vm"), setFlag(FromSnapshotFlag, "my-snapshot"))
+		Expect(err).To(MatchError(ContainSubstring("if any flags in the group [from-vm from-snapshot from-export-manifest] are set none of the others can be")))
+	})
+})
+
+func newSourceVM() *v1.VirtualMachine {
+	running := true
+	bootOrder := uint(1)
+	return &v1.VirtualMachine{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "my-vm",
+			Namespace:       metav1.NamespaceDefault,
+			UID:             "my-uid",
+			ResourceVersion: "42",
+			Labels:          map[string]string{"app": "my-app"},
+			Annotations: map[string]string{
+				"my-annotation":                                 "value",
+				k8sv1.LastAppliedConfigAnnotation:               "{}",
+				v1.ControllerAPILatestVersionObservedAnnotation: "v1",
+			},
+		},
+		Spec: v1.VirtualMachineSpec{
+			Running:      &running,
+			Instancetype: &v1.InstancetypeMatcher{Name: "u1.small", RevisionName: "my-vm-u1.small-1"},
+			DataVolumeTemplates: []v1.DataVolumeTemplateSpec{{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-vm-rootdisk"},
+				Spec: cdiv1.DataVolumeSpec{
+					SourceRef: &cdiv1.DataVolumeSourceRef{Kind: "DataSource", Name: "fedora"},
+					Storage: &cdiv1.StorageSpec{
+						Resources: k8sv1.ResourceRequirements{
+							Requests: k8sv1.ResourceList{k8sv1.ResourceStorage: resource.MustParse("30Gi")},
+						},
+					},
+				},
+			}},
+			Template: &v1.VirtualMachineInstanceTemplateSpec{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						Firmware: &v1.Firmware{UUID: "5d307ca9-b3ef-428c-8861-06e72d69f223", Serial: "my-serial"},
+						Devices: v1.Devices{
+							Disks: []v1.Disk{
+								{Name: "rootdisk", BootOrder: &bootOrder},
+								{Name: "cloudinit"},
+							},
+							Interfaces: []v1.Interface{{
+								Name:       "default",
+								MacAddress: "02:00:00:00:00:01",
+								InterfaceBindingMethod: v1.InterfaceBindingMethod{
+									Masquerade: &v1.InterfaceMasquerade{},
+								},
+							}},
+						},
+					},
+					Networks: []v1.Network{*v1.DefaultPodNetwork()},
+					Volumes: []v1.Volume{
+						{Name: "rootdisk", VolumeSource: v1.VolumeSource{DataVolume: &v1.DataVolumeSource{Name: "my-vm-rootdisk"}}},
+						{Name: "datadisk", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{
+							PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "my-data"},
+							Hotpluggable:                      true,
+						}}},
+						{Name: "cloudinit", VolumeSource: v1.VolumeSource{CloudInitNoCloud: &v1.CloudInitNoCloudSource{UserData: "#cloud-config"}}},
+						{Name: "memorydump", VolumeSource: v1.VolumeSource{MemoryDump: &v1.MemoryDumpVolumeSource{
+							PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+								PersistentVolumeClaimVolumeSource: k8sv1.PersistentVolumeClaimVolumeSource{ClaimName: "my-dump"},
+								Hotpluggable:                      true,
+							},
+						}}},
+					},
+				},
+			},
+		},
+		Status: v1.VirtualMachineStatus{Ready: true, PrintableStatus: v1.VirtualMachineStatusRunning},
+	}
+}
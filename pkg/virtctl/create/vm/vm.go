@@ -20,6 +20,7 @@
 package vm
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"strings"
@@ -28,9 +29,12 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/clientcmd"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/api/instancetype"
+	"kubevirt.io/client-go/kubecli"
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 	"sigs.k8s.io/yaml"
 
@@ -56,6 +60,10 @@ const (
 	CloudInitNetworkDataFlag   = "cloud-init-network-data"
 	InferInstancetypeFlag      = "infer-instancetype"
 	InferPreferenceFlag        = "infer-preference"
+	FromVMFlag                 = "from-vm"
+	FromSnapshotFlag           = "from-snapshot"
+	FromExportManifestFlag     = "from-export-manifest"
+	ApplyFlag                  = "apply"
 
 	cloudInitDisk    = "cloudinitdisk"
 	inferNoOptDefVal = "inferNoOptDefVal"
@@ -77,8 +85,14 @@ type createVM struct {
 	cloudInitNetworkData   string
 	inferInstancetype      string
 	inferPreference        string
+	fromVM                 string
+	fromSnapshot           string
+	fromExportManifest     string
+	apply                  bool
 
-	bootOrders map[uint]string
+	bootOrders   map[uint]string
+	clientConfig clientcmd.ClientConfig
+	namespace    string
 }
 
 type cloneVolume struct {
@@ -149,12 +163,12 @@ var runStrategies = []string{
 	string(v1.RunStrategyRerunOnFailure),
 }
 
-func NewCommand() *cobra.Command {
-	c := defaultCreateVM()
+func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := defaultCreateVM(clientConfig)
 	cmd := &cobra.Command{
 		Use:     VM,
 		Short:   "Create a VirtualMachine manifest.",
-		Long:    "Create a VirtualMachine manifest.\n\nIf no boot order was specified volumes have the following fixed boot order:\nContainerdisk > DataSource > Clone PVC > PVC",
+		Long:    "Create a VirtualMachine manifest.\n\nIf no boot order was specified volumes have the following fixed boot order:\nContainerdisk > DataSource > Clone PVC > PVC\n\nThe VM can be a copy of an existing VM, VirtualMachineSnapshot or exported VM manifest, with the other flags applied on top.\nThe copy has no status, new MAC addresses and firmware UUID, and its volumes are copies of the volumes of the source.",
 		Args:    cobra.NoArgs,
 		Example: c.usage(),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -186,18 +200,25 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&c.cloudInitUserData, CloudInitUserDataFlag, c.cloudInitUserData, "Specify the base64 encoded cloud-init user data of the VM.")
 	cmd.Flags().StringVar(&c.cloudInitNetworkData, CloudInitNetworkDataFlag, c.cloudInitNetworkData, "Specify the base64 encoded cloud-init network data of the VM.")
 
+	cmd.Flags().StringVar(&c.fromVM, FromVMFlag, c.fromVM, "Specify a VM to copy, its volumes are cloned.")
+	cmd.Flags().StringVar(&c.fromSnapshot, FromSnapshotFlag, c.fromSnapshot, "Specify a VirtualMachineSnapshot to copy the VM of, its volumes are restored from the snapshot.")
+	cmd.Flags().StringVar(&c.fromExportManifest, FromExportManifestFlag, c.fromExportManifest, "Specify the file of a VM manifest retrieved with 'vmexport download --manifest' to copy, its volumes are imported from the export. Use - to read it from stdin.")
+	cmd.MarkFlagsMutuallyExclusive(FromVMFlag, FromSnapshotFlag, FromExportManifestFlag)
+	cmd.Flags().BoolVar(&c.apply, ApplyFlag, c.apply, "Create the VM and the objects it depends on in the cluster instead of printing their manifests.")
+
 	cmd.Flags().SortFlags = false
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
 }
 
-func defaultCreateVM() createVM {
+func defaultCreateVM(clientConfig clientcmd.ClientConfig) createVM {
 	return createVM{
 		terminationGracePeriod: 180,
 		runStrategy:            string(v1.RunStrategyAlways),
 		memory:                 "512Mi",
 		bootOrders:             map[uint]string{},
+		clientConfig:           clientConfig,
 	}
 }
 
@@ -229,8 +250,22 @@ func volumeShouldNotExist(flag string, vm *v1.VirtualMachine, name string) error
 }
 
 func (c *createVM) run(cmd *cobra.Command) error {
-	c.setDefaults()
-	vm, err := c.newVM()
+	var (
+		vm           *v1.VirtualMachine
+		dependencies []runtime.Object
+		err          error
+	)
+	if c.hasSource() || c.apply {
+		if c.namespace, _, err = c.clientConfig.Namespace(); err != nil {
+			return err
+		}
+	}
+	if c.hasSource() {
+		vm, dependencies, err = c.copyVM(cmd)
+	} else {
+		c.setDefaults()
+		vm, err = c.newVM()
+	}
 	if err != nil {
 		return err
 	}
@@ -243,6 +278,20 @@ func (c *createVM) run(cmd *cobra.Command) error {
 		}
 	}
 
+	if c.apply {
+		return c.create(cmd, vm, dependencies)
+	}
+
+	// The dependencies come first, so that they exist when the VM is created from the output
+	for _, obj := range dependencies {
+		out, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		cmd.Print(string(out))
+		cmd.Println("---")
+	}
+
 	out, err := yaml.Marshal(vm)
 	if err != nil {
 		return err
@@ -252,6 +301,37 @@ func (c *createVM) run(cmd *cobra.Command) error {
 	return nil
 }
 
+// create creates the dependencies of the VM and then the VM in the namespace of the client configuration
+func (c *createVM) create(cmd *cobra.Command, vm *v1.VirtualMachine, dependencies []runtime.Object) error {
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(c.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	for _, obj := range dependencies {
+		switch o := obj.(type) {
+		case *k8sv1.PersistentVolumeClaim:
+			_, err = virtClient.CoreV1().PersistentVolumeClaims(c.namespace).Create(context.Background(), o, metav1.CreateOptions{})
+		case *k8sv1.ConfigMap:
+			_, err = virtClient.CoreV1().ConfigMaps(c.namespace).Create(context.Background(), o, metav1.CreateOptions{})
+		case *k8sv1.Secret:
+			_, err = virtClient.CoreV1().Secrets(c.namespace).Create(context.Background(), o, metav1.CreateOptions{})
+		default:
+			err = fmt.Errorf("unexpected dependency %T", obj)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to create the %s of the VM: %v", obj.GetObjectKind().GroupVersionKind().Kind, err)
+		}
+	}
+
+	created, err := virtClient.VirtualMachine(c.namespace).Create(context.Background(), vm)
+	if err != nil {
+		return fmt.Errorf("failed to create VirtualMachine %s/%s: %v", c.namespace, vm.Name, err)
+	}
+	cmd.Printf("VirtualMachine %s/%s created\n", created.Namespace, created.Name)
+	return nil
+}
+
 func (c *createVM) setDefaults() {
 	if c.name == "" {
 		c.name = "vm-" + rand.String(5)
@@ -308,7 +388,20 @@ func (c *createVM) usage() string {
   {{ProgramName}} create vm --instancetype=my-instancetype --preference=my-preference --volume-datasource=src:my-ds1 --volume-datasource=src:my-ds2
 
   # Create a manifest for a VirtualMachine with a specified VirtualMachineCluster{Instancetype,Preference} and directly used PVC
-  {{ProgramName}} create vm --instancetype=my-instancetype --preference=my-preference --volume-pvc=my-pvc`
+  {{ProgramName}} create vm --instancetype=my-instancetype --preference=my-preference --volume-pvc=my-pvc
+
+  # Create a manifest for a copy of a VirtualMachine with cloned volumes and a specified name
+  {{ProgramName}} create vm --from-vm=my-vm --name=my-copy
+
+  # Create a copy of a VirtualMachine in another namespace with a different instancetype
+  {{ProgramName}} create vm --from-vm=my-ns/my-vm --instancetype=my-instancetype --apply
+
+  # Create a manifest for a VirtualMachine and the PVCs restored from a VirtualMachineSnapshot
+  {{ProgramName}} create vm --from-snapshot=my-snapshot --run-strategy=Halted
+
+  # Create a VirtualMachine importing its volumes from an exported manifest
+  {{ProgramName}} vmexport download my-export --vm=my-vm --manifest > my-vm.yaml
+  {{ProgramName}} create vm --from-export-manifest=my-vm.yaml --apply`
 }
 
 func (c *createVM) newVM() (*v1.VirtualMachine, error) {
@@ -389,6 +482,7 @@ func withRunStrategy(c *createVM, vm *v1.VirtualMachine) error {
 	for _, runStrategy := range runStrategies {
 		if runStrategy == c.runStrategy {
 			vmRunStrategy := v1.VirtualMachineRunStrategy(c.runStrategy)
+			vm.Spec.Running = nil
 			vm.Spec.RunStrategy = &vmRunStrategy
 			return nil
 		}
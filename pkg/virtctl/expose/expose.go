@@ -11,6 +11,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -45,6 +46,7 @@ var strServiceType string
 var portName string
 var strIPFamily string
 var strIPFamilyPolicy string
+var networkName string
 
 // NewExposeCommand generates a new "expose" command
 func NewExposeCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
@@ -55,6 +57,7 @@ func NewExposeCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 A virtual machine instance replica set will be exposed as a service only if its selector is convertible to a selector that service supports, i.e. when the selector contains only the matchLabels component.
 Note that if no port is specified via --port and the exposed resource has multiple ports, all will be re-used by the new service.
 Also if no labels are specified, the new service will re-use the labels from the resource it exposes.
+When a network is specified via --network, the service is created without a pod selector and its endpoints are the addresses reported by the guest agent on that network, this requires the GuestEndpoints feature gate.
 
 Possible types are (case insensitive, both single and plurant forms):
 
@@ -80,6 +83,7 @@ virtualmachineinstance (vmi), virtualmachine (vm), virtualmachineinstancereplica
 	cmd.Flags().StringVar(&portName, "port-name", "", "Name of the port. Optional.")
 	cmd.Flags().StringVar(&strIPFamily, "ip-family", "", "IP family over which the service will be exposed. Valid values are 'IPv4', 'IPv6', 'IPv4,IPv6' or 'IPv6,IPv4'")
 	cmd.Flags().StringVar(&strIPFamilyPolicy, "ip-family-policy", "", "IP family policy defines whether the service can use IPv4, IPv6, or both. Valid values are 'SingleStack', 'PreferDualStack' or 'RequireDualStack'")
+	cmd.Flags().StringVar(&networkName, "network", "", "Name of the VM network whose guest agent reported addresses the service should direct traffic to. Optional, requires --port.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 
 	return cmd
@@ -93,7 +97,10 @@ func usage() string {
   {{ProgramName}} expose vmirs myvmirs --name=vmirs-service
 
   # Expose port 8080 as port 80 from a virtual machine instance replicaset on a service:
-  {{ProgramName}} expose vmirs myvmirs --port=80 --target-port=8080 --name=vmirs-service`
+  {{ProgramName}} expose vmirs myvmirs --port=80 --target-port=8080 --name=vmirs-service
+
+  # Expose port 80 of a virtual machine on the addresses its guest agent reports on the secondary network 'blue':
+  {{ProgramName}} expose vm myvm --port=80 --network=blue --name=myvm-blue`
 	return usage
 }
 
@@ -208,6 +215,10 @@ func (o *Command) RunE(args []string) error {
 		return fmt.Errorf("cannot expose %s without any label: %s", vmType, vmName)
 	}
 
+	if networkName != "" && port == 0 {
+		return fmt.Errorf("the --port flag is required when exposing the %s network", networkName)
+	}
+
 	if port == 0 && len(ports) == 0 {
 		return fmt.Errorf("couldn't find port via --port flag or introspection")
 	} else if port != 0 {
@@ -230,6 +241,15 @@ func (o *Command) RunE(args []string) error {
 		},
 	}
 
+	// route the service to the guest agent reported addresses instead of the pod ones
+	if networkName != "" {
+		service.Annotations = map[string]string{
+			virtv1.GuestEndpointsNetworkAnnotation:  networkName,
+			virtv1.GuestEndpointsSelectorAnnotation: labels.SelectorFromSet(serviceSelector).String(),
+		}
+		service.Spec.Selector = nil
+	}
+
 	// set external IP if provided
 	if len(externalIP) > 0 {
 		service.Spec.ExternalIPs = []string{externalIP}
@@ -338,6 +338,22 @@ var _ = Describe("Expose", func() {
 					Entry("a list of IPv4, IPv6 IPFamilies", &dualStack, "ipv4", "ipv6"),
 					Entry("a list of IPv6, IPv4 IPFamilies", &dualStack, "ipv6", "ipv4"))
 			})
+			Context("With a network", func() {
+				It("should create a service without pod selector routed to the guest endpoints", func() {
+					cmd := clientcmd.NewRepeatableVirtctlCommand(expose.COMMAND_EXPOSE, "vmi", vmName, "--name", "my-service",
+						"--port", "9999", "--network", "blue")
+					Expect(cmd()).To(Succeed())
+					Expect(obtainedService.Spec.Selector).To(BeEmpty())
+					Expect(obtainedService.Annotations).To(HaveKeyWithValue(v1.GuestEndpointsNetworkAnnotation, "blue"))
+					Expect(obtainedService.Annotations).To(HaveKeyWithValue(v1.GuestEndpointsSelectorAnnotation, "key=value"))
+				})
+				It("should fail without port", func() {
+					addPodNetworkWithPorts(&vmi.Spec)
+					cmd := clientcmd.NewRepeatableVirtctlCommand(expose.COMMAND_EXPOSE, "vmi", vmName, "--name", "my-service",
+						"--network", "blue")
+					Expect(cmd()).NotTo(Succeed())
+				})
+			})
 			Context("With parametrized IPFamilyPolicy", func() {
 				It("should succeed with singlestack", func() {
 					cmd := clientcmd.NewRepeatableVirtctlCommand(expose.COMMAND_EXPOSE, "vmi", vmName, "--name", "my-service",
@@ -0,0 +1,183 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package guestcp
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUESTCP = "guestcp"
+
+	permissionsFlag = "permissions"
+	ownerFlag       = "owner"
+
+	stdioPath = "-"
+)
+
+func NewGuestCPCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := GuestCP{
+		clientConfig: clientConfig,
+	}
+	cmd := &cobra.Command{
+		Use:   "guestcp SOURCE DESTINATION",
+		Short: "Copy a file from/to a virtual machine instance through the guest agent.",
+		Long: `Copy a file from/to a virtual machine instance through the guest agent, without network access to the guest.
+One of the locations is local, the other one is in the guest in the form of (VM|VMI)/NAME[.NAMESPACE]:PATH.
+The local location "-" is the standard input or output of virtctl.`,
+		Args:    templates.ExactArgs(COMMAND_GUESTCP, 2),
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.Flags().StringVar(&c.permissions, permissionsFlag, "", "Permissions of the file copied into the guest, in octal, e.g. 0644.")
+	cmd.Flags().StringVar(&c.owner, ownerFlag, "", "Owner of the file copied into the guest, as user[:group].")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := "  # Copy a file into the virtualmachineinstance 'myvmi':\n"
+	usage += "  {{ProgramName}} guestcp myfile.txt vmi/myvmi:/tmp/myfile.txt\n\n"
+	usage += "  # Copy a file into the virtualmachine 'myvm' in the namespace 'mynamespace', readable only by 'fedora':\n"
+	usage += "  {{ProgramName}} guestcp --owner fedora --permissions 0600 myfile.txt vm/myvm.mynamespace:/home/fedora/myfile.txt\n\n"
+	usage += "  # Copy a file out of the virtualmachineinstance 'myvmi' to the standard output:\n"
+	usage += "  {{ProgramName}} guestcp vmi/myvmi:/var/log/messages -"
+	return usage
+}
+
+type GuestCP struct {
+	clientConfig clientcmd.ClientConfig
+	permissions  string
+	owner        string
+}
+
+func (o *GuestCP) Run(cmd *cobra.Command, args []string) error {
+	local, remote, toRemote, err := templates.ParseSCPArguments(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	if remote.Username != "" {
+		return fmt.Errorf("a username is not supported, use --%s to set the owner of the file", ownerFlag)
+	}
+	if remote.Path == "" {
+		return fmt.Errorf("expected a path in the guest after ':'")
+	}
+	if !toRemote && (o.permissions != "" || o.owner != "") {
+		return fmt.Errorf("--%s and --%s only apply when copying into the guest", permissionsFlag, ownerFlag)
+	}
+
+	namespace := remote.Namespace
+	if namespace == "" {
+		namespace, _, err = o.clientConfig.Namespace()
+		if err != nil {
+			return err
+		}
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+	// A VM and its VMI share the name
+	vmis := virtClient.VirtualMachineInstance(namespace)
+
+	if toRemote {
+		return o.upload(cmd, vmis, local.Path, remote.Name, remote.Path)
+	}
+	return download(cmd, vmis, remote.Name, remote.Path, local.Path)
+}
+
+func (o *GuestCP) upload(cmd *cobra.Command, vmis kubecli.VirtualMachineInstanceInterface, localPath, name, remotePath string) error {
+	in := cmd.InOrStdin()
+	if localPath != stdioPath {
+		file, err := os.Open(localPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		in = file
+
+		// Copying into a directory keeps the name of the file, like cp does
+		if strings.HasSuffix(remotePath, "/") || strings.HasSuffix(remotePath, "\\") {
+			remotePath += filepath.Base(localPath)
+		}
+	}
+
+	options := &v1.GuestFileOptions{
+		Path:        remotePath,
+		Permissions: o.permissions,
+		Owner:       o.owner,
+	}
+	if err := vmis.GuestFileUpload(context.Background(), name, options, in); err != nil {
+		return fmt.Errorf("Error copying into VirtualMachineInstance %s: %v", name, err)
+	}
+	return nil
+}
+
+func download(cmd *cobra.Command, vmis kubecli.VirtualMachineInstanceInterface, name, remotePath, localPath string) error {
+	options := &v1.GuestFileOptions{Path: remotePath}
+	if localPath == stdioPath {
+		return downloadTo(cmd.OutOrStdout(), vmis, name, options)
+	}
+
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, guestBase(remotePath))
+	}
+	file, err := os.Create(localPath)
+	if err != nil {
+		return err
+	}
+	err = downloadTo(file, vmis, name, options)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Don't leave a truncated copy behind
+		os.Remove(localPath)
+	}
+	return err
+}
+
+func downloadTo(out io.Writer, vmis kubecli.VirtualMachineInstanceInterface, name string, options *v1.GuestFileOptions) error {
+	if err := vmis.GuestFileDownload(context.Background(), name, options, out); err != nil {
+		return fmt.Errorf("Error copying from VirtualMachineInstance %s: %v", name, err)
+	}
+	return nil
+}
+
+// guestBase returns the last element of a path in the guest, which can be a Windows path
+func guestBase(path string) string {
+	return path[strings.LastIndexAny(path, "/\\")+1:]
+}
@@ -0,0 +1,11 @@
+package guestcp_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestGuestCP(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,129 @@
+package guestcp_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/guestcp"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Guest cp", func() {
+
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var ctrl *gomock.Controller
+	var tmpDir string
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	expectDownload := func(namespace string, path string, content string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(namespace).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestFileDownload(context.Background(), vmiName, &v1.GuestFileOptions{Path: path}, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ *v1.GuestFileOptions, out io.Writer) error {
+				_, err := out.Write([]byte(content))
+				return err
+			}).Times(1)
+	}
+
+	expectUpload := func(options *v1.GuestFileOptions, content string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestFileUpload(context.Background(), vmiName, options, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ *v1.GuestFileOptions, in io.Reader) error {
+				data, err := io.ReadAll(in)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(data)).To(Equal(content))
+				return nil
+			}).Times(1)
+	}
+
+	DescribeTable("should reject invalid arguments", func(args ...string) {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(append([]string{guestcp.COMMAND_GUESTCP}, args...)...)
+		Expect(cmd()).To(HaveOccurred())
+	},
+		Entry("without a remote location", "a", "b"),
+		Entry("with two remote locations", "vmi/a:/x", "vmi/b:/y"),
+		Entry("with a username", "file", "root@vmi/testvmi:/x"),
+		Entry("without a path in the guest", "file", "vmi/testvmi:"),
+		Entry("with permissions when copying out of the guest", "--permissions", "0600", "vmi/testvmi:/x", "-"),
+	)
+
+	It("should copy a file out of the guest", func() {
+		expectDownload(metav1.NamespaceDefault, "/etc/hostname", "content")
+
+		target := filepath.Join(tmpDir, "hostname")
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUESTCP, "vmi/"+vmiName+":/etc/hostname", target)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(target)).To(Equal([]byte("content")))
+	})
+
+	It("should copy a file out of the guest of a VM into a directory", func() {
+		expectDownload("mynamespace", "/etc/hostname", "content")
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUESTCP, "vm/"+vmiName+".mynamespace:/etc/hostname", tmpDir)
+		Expect(cmd()).To(Succeed())
+		Expect(os.ReadFile(filepath.Join(tmpDir, "hostname"))).To(Equal([]byte("content")))
+	})
+
+	It("should copy a file out of the guest to stdout", func() {
+		expectDownload(metav1.NamespaceDefault, "/etc/hostname", "content")
+
+		stdout := &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand(guestcp.COMMAND_GUESTCP, vmiName+":/etc/hostname", "-")
+		cmd.SetOut(stdout)
+		Expect(cmd.Execute()).To(Succeed())
+		Expect(stdout.String()).To(Equal("content"))
+	})
+
+	It("should not leave a partial copy behind when the download fails", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestFileDownload(context.Background(), vmiName, gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ string, _ *v1.GuestFileOptions, out io.Writer) error {
+				_, err := out.Write([]byte("partial"))
+				Expect(err).ToNot(HaveOccurred())
+				return fmt.Errorf("unexpected EOF")
+			}).Times(1)
+
+		target := filepath.Join(tmpDir, "hostname")
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUESTCP, vmiName+":/etc/hostname", target)
+		Expect(cmd()).To(MatchError(ContainSubstring("unexpected EOF")))
+		Expect(target).ToNot(BeAnExistingFile())
+	})
+
+	It("should copy a file into the guest with its permissions and owner", func() {
+		source := filepath.Join(tmpDir, "myfile")
+		Expect(os.WriteFile(source, []byte("content"), 0600)).To(Succeed())
+		expectUpload(&v1.GuestFileOptions{Path: "/tmp/myfile", Permissions: "0600", Owner: "fedora:fedora"}, "content")
+
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guestcp.COMMAND_GUESTCP,
+			"--permissions", "0600", "--owner", "fedora:fedora", source, vmiName+":/tmp/")
+		Expect(cmd()).To(Succeed())
+	})
+
+	It("should copy stdin into the guest", func() {
+		expectUpload(&v1.GuestFileOptions{Path: "/tmp/myfile"}, "content")
+
+		cmd := clientcmd.NewVirtctlCommand(guestcp.COMMAND_GUESTCP, "-", "vmi/"+vmiName+":/tmp/myfile")
+		cmd.SetIn(strings.NewReader("content"))
+		Expect(cmd.Execute()).To(Succeed())
+	})
+})
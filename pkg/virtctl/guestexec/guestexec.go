@@ -0,0 +1,126 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package guestexec
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+	"kubevirt.io/kubevirt/pkg/virtctl/utils"
+)
+
+const (
+	COMMAND_GUESTEXEC = "guestexec"
+
+	timeoutFlag = "timeout"
+	stdinFlag   = "stdin"
+)
+
+func NewGuestExecCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := GuestExec{
+		clientConfig: clientConfig,
+	}
+	cmd := &cobra.Command{
+		Use:   "guestexec (VMI) -- COMMAND [ARGS...]",
+		Short: "Run a command in a virtual machine instance through the guest agent",
+		Long: `Run a command in a virtual machine instance through the guest agent, and exit with its exit code.
+The output is returned once the command exited, commands can't be interactive.
+The command keeps running in the guest if it times out, it has to be killed in the guest.`,
+		Args:    cobra.MinimumNArgs(2),
+		Example: usage(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.Flags().Int32Var(&c.timeoutSeconds, timeoutFlag, 0, "Seconds to wait for the command to exit, the server default is used if unset.")
+	cmd.Flags().BoolVarP(&c.stdin, stdinFlag, "i", false, "Pass the standard input of virtctl to the command.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	usage := "  # List the root directory of the virtualmachineinstance 'myvmi':\n"
+	usage += "  {{ProgramName}} guestexec myvmi -- ls -l /\n\n"
+	usage += "  # Pass a file to a command in the virtualmachineinstance 'myvmi':\n"
+	usage += "  {{ProgramName}} guestexec -i myvmi -- wc -l < myfile"
+	return usage
+}
+
+type GuestExec struct {
+	clientConfig   clientcmd.ClientConfig
+	timeoutSeconds int32
+	stdin          bool
+}
+
+func (o *GuestExec) Run(cmd *cobra.Command, args []string) error {
+	vmi := args[0]
+	options := &v1.GuestExecOptions{
+		Command:        args[1:],
+		TimeoutSeconds: o.timeoutSeconds,
+	}
+
+	if o.stdin {
+		stdin, err := io.ReadAll(cmd.InOrStdin())
+		if err != nil {
+			return fmt.Errorf("Error reading stdin: %v", err)
+		}
+		options.Stdin = stdin
+	}
+
+	namespace, _, err := o.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	result, err := virtClient.VirtualMachineInstance(namespace).GuestExec(context.Background(), vmi, options)
+	if err != nil {
+		return fmt.Errorf("Error running command in VirtualMachineInstance %s: %v", vmi, err)
+	}
+
+	if _, err := cmd.OutOrStdout().Write(result.Stdout); err != nil {
+		return err
+	}
+	if _, err := cmd.ErrOrStderr().Write(result.Stderr); err != nil {
+		return err
+	}
+	if result.StdoutTruncated {
+		cmd.PrintErrln("Warning: the guest agent truncated the standard output of the command")
+	}
+	if result.StderrTruncated {
+		cmd.PrintErrln("Warning: the guest agent truncated the standard error of the command")
+	}
+	if result.ExitCode != 0 {
+		return &utils.ExitCodeError{Code: int(result.ExitCode)}
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package guestexec_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestGuestExec(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
@@ -0,0 +1,92 @@
+package guestexec_test
+
+import (
+	"bytes"
+	"context"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
+	"kubevirt.io/kubevirt/pkg/virtctl/utils"
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Guest exec", func() {
+
+	const vmiName = "testvmi"
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var ctrl *gomock.Controller
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	It("should fail without a command", func() {
+		cmd := clientcmd.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUESTEXEC, vmiName)
+		Expect(cmd()).To(HaveOccurred())
+	})
+
+	It("should run the command and write its output", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, &v1.GuestExecOptions{
+			Command:        []string{"ls", "-l", "/"},
+			TimeoutSeconds: 10,
+		}).Return(&v1.GuestExecResult{Stdout: []byte("out"), Stderr: []byte("err")}, nil).Times(1)
+
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand(guestexec.COMMAND_GUESTEXEC, "--timeout", "10", vmiName, "--", "ls", "-l", "/")
+		cmd.SetOut(stdout)
+		cmd.SetErr(stderr)
+		Expect(cmd.Execute()).To(Succeed())
+		Expect(stdout.String()).To(Equal("out"))
+		Expect(stderr.String()).To(Equal("err"))
+	})
+
+	It("should warn when the output was truncated", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, gomock.Any()).Return(&v1.GuestExecResult{
+			Stdout:          []byte("out"),
+			StdoutTruncated: true,
+		}, nil).Times(1)
+
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		cmd := clientcmd.NewVirtctlCommand(guestexec.COMMAND_GUESTEXEC, vmiName, "--", "cat", "/var/log/messages")
+		cmd.SetOut(stdout)
+		cmd.SetErr(stderr)
+		Expect(cmd.Execute()).To(Succeed())
+		Expect(stdout.String()).To(Equal("out"))
+		Expect(stderr.String()).To(ContainSubstring("truncated the standard output"))
+		Expect(stderr.String()).ToNot(ContainSubstring("standard error"))
+	})
+
+	It("should pass stdin to the command", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, &v1.GuestExecOptions{
+			Command: []string{"wc", "-l"},
+			Stdin:   []byte("a\nb\n"),
+		}).Return(&v1.GuestExecResult{Stdout: []byte("2\n")}, nil).Times(1)
+
+		cmd := clientcmd.NewVirtctlCommand(guestexec.COMMAND_GUESTEXEC, "-i", vmiName, "--", "wc", "-l")
+		cmd.SetIn(strings.NewReader("a\nb\n"))
+		cmd.SetOut(&bytes.Buffer{})
+		Expect(cmd.Execute()).To(Succeed())
+	})
+
+	It("should return the exit code of the command", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().GuestExec(context.Background(), vmiName, gomock.Any()).Return(&v1.GuestExecResult{ExitCode: 3}, nil).Times(1)
+
+		cmd := clientcmd.NewVirtctlCommand(guestexec.COMMAND_GUESTEXEC, vmiName, "--", "false")
+		Expect(cmd.Execute()).To(MatchError(&utils.ExitCodeError{Code: 3}))
+	})
+})
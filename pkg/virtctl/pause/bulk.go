@@ -0,0 +1,130 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package pause
+
+import (
+	"context"
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kubevirtV1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
+)
+
+// runBulk pauses or unpauses the VMs or VMIs selected by --selector or --all
+func (vc *VirtCommand) runBulk(resourceType, namespace string, virtClient kubecli.KubevirtClient, dryRunOption []string) error {
+	pause := vc.command == COMMAND_PAUSE
+
+	var (
+		kind    string
+		targets []bulk.Target
+	)
+	switch resourceType {
+	case ARG_VM_LONG, ARG_VM_SHORT:
+		kind = "VirtualMachine"
+		vms, err := vc.bulkOptions.ListVMs(virtClient, namespace)
+		if err != nil {
+			return err
+		}
+		for _, vm := range vms {
+			targets = append(targets, bulk.Target{Name: vm.Name, Skip: vmSkipReason(vm.Status.PrintableStatus, pause)})
+		}
+	case ARG_VMI_LONG, ARG_VMI_SHORT:
+		kind = "VirtualMachineInstance"
+		vmis, err := vc.bulkOptions.ListVMIs(virtClient, namespace)
+		if err != nil {
+			return err
+		}
+		for i := range vmis {
+			targets = append(targets, bulk.Target{Name: vmis[i].Name, Skip: vmiSkipReason(&vmis[i], pause)})
+		}
+	default:
+		return fmt.Errorf("unsupported resource type %s", resourceType)
+	}
+
+	done := "Unpaused"
+	if pause {
+		done = "Paused"
+	}
+	return vc.bulkOptions.Run(vc.cmd.OutOrStdout(), kind, done, targets, func(ctx context.Context, name string) (bulk.Condition, error) {
+		// The VMI of a VM has its name
+		if pause {
+			if err := virtClient.VirtualMachineInstance(namespace).Pause(ctx, name, &kubevirtV1.PauseOptions{DryRun: dryRunOption}); err != nil {
+				return nil, fmt.Errorf("Error pausing VirtualMachineInstance %s: %v", name, err)
+			}
+		} else {
+			if err := virtClient.VirtualMachineInstance(namespace).Unpause(ctx, name, &kubevirtV1.UnpauseOptions{DryRun: dryRunOption}); err != nil {
+				return nil, fmt.Errorf("Error unpausing VirtualMachineInstance %s: %v", name, err)
+			}
+		}
+		return pausedCondition(virtClient, namespace, name, pause), nil
+	})
+}
+
+func vmSkipReason(status kubevirtV1.VirtualMachinePrintableStatus, pause bool) string {
+	switch {
+	case status == kubevirtV1.VirtualMachineStatusStopped:
+		return "VM is not running"
+	case pause && status == kubevirtV1.VirtualMachineStatusPaused:
+		return "VM is already paused"
+	case !pause && status != kubevirtV1.VirtualMachineStatusPaused:
+		return "VM is not paused"
+	}
+	return ""
+}
+
+func vmiSkipReason(vmi *kubevirtV1.VirtualMachineInstance, pause bool) string {
+	switch {
+	case vmi.Status.Phase != kubevirtV1.Running:
+		return "VMI is not running"
+	case pause && isPaused(vmi):
+		return "VMI is already paused"
+	case !pause && !isPaused(vmi):
+		return "VMI is not paused"
+	}
+	return ""
+}
+
+func isPaused(vmi *kubevirtV1.VirtualMachineInstance) bool {
+	for _, condition := range vmi.Status.Conditions {
+		if condition.Type == kubevirtV1.VirtualMachineInstancePaused {
+			return condition.Status == k8sv1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// pausedCondition is met once the VMI is paused, or unpaused
+func pausedCondition(virtClient kubecli.KubevirtClient, namespace, name string, pause bool) bulk.Condition {
+	return func(ctx context.Context) (bool, string, error) {
+		vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, name, &v1.GetOptions{})
+		if err != nil {
+			return false, "", fmt.Errorf("Error getting VirtualMachineInstance %s: %v", name, err)
+		}
+		if isPaused(vmi) {
+			return pause, "paused", nil
+		}
+		return !pause, "not paused", nil
+	}
+}
@@ -34,6 +34,7 @@ import (
 
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
@@ -51,63 +52,82 @@ var (
 )
 
 func NewPauseCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	bulkOptions := &bulk.Options{}
 	cmd := &cobra.Command{
 		Use:   "pause vm|vmi (VM)|(VMI)",
 		Short: "Pause a virtual machine",
 		Long: `Pauses a virtual machine by freezing it. Machine state is kept in memory.
 First argument is the resource type, possible types are (case insensitive, both singular and plural forms) virtualmachineinstance (vmi) or virtualmachine (vm).
-Second argument is the name of the resource.`,
-		Args:    templates.ExactArgs(COMMAND_PAUSE, 2),
+Second argument is the name of the resource, it is omitted with --selector or --all.`,
+		Args:    bulkOptions.ExactArgs(COMMAND_PAUSE, 2),
 		Example: usage(COMMAND_PAUSE),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c := VirtCommand{
 				command:      COMMAND_PAUSE,
 				clientConfig: clientConfig,
+				cmd:          cmd,
+				bulkOptions:  bulkOptions,
 			}
 			return c.Run(args)
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes.")
+	bulkOptions.AddFlags(cmd, "virtual machine")
+	bulkOptions.AddWaitFlags(cmd, "virtual machine")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func NewUnpauseCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	bulkOptions := &bulk.Options{}
 	cmd := &cobra.Command{
 		Use:   "unpause vm|vmi (VM)|(VMI)",
 		Short: "Unpause a virtual machine",
 		Long: `Unpauses a virtual machine.
 First argument is the resource type, possible types are (case insensitive, both singular and plural forms) virtualmachineinstance (vmi) or virtualmachine (vm).
-Second argument is the name of the resource.`,
-		Args:    templates.ExactArgs("unpause", 2),
+Second argument is the name of the resource, it is omitted with --selector or --all.`,
+		Args:    bulkOptions.ExactArgs("unpause", 2),
 		Example: usage(COMMAND_UNPAUSE),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c := VirtCommand{
 				command:      COMMAND_UNPAUSE,
 				clientConfig: clientConfig,
+				cmd:          cmd,
+				bulkOptions:  bulkOptions,
 			}
 			return c.Run(args)
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes.")
+	bulkOptions.AddFlags(cmd, "virtual machine")
+	bulkOptions.AddWaitFlags(cmd, "virtual machine")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func usage(cmd string) string {
 	usage := fmt.Sprintf("  # %s a virtualmachine called 'myvm':\n", strings.Title(cmd))
-	usage += fmt.Sprintf("  {{ProgramName}} %s vm myvm", cmd)
+	usage += fmt.Sprintf("  {{ProgramName}} %s vm myvm\n\n", cmd)
+	usage += fmt.Sprintf("  # %s the virtualmachineinstances labeled 'app=web' and wait until they are done:\n", strings.Title(cmd))
+	usage += fmt.Sprintf("  {{ProgramName}} %s vmi -l app=web --wait", cmd)
 	return usage
 }
 
 type VirtCommand struct {
 	clientConfig clientcmd.ClientConfig
 	command      string
+	cmd          *cobra.Command
+	bulkOptions  *bulk.Options
 }
 
 func (vc *VirtCommand) Run(args []string) error {
 	resourceType := strings.ToLower(args[0])
-	resourceName := args[1]
+	if err := vc.bulkOptions.Validate(); err != nil {
+		return err
+	}
+	if vc.bulkOptions.Wait && dryRun {
+		return fmt.Errorf("--%s can't be combined with --dry-run", bulk.WaitFlag)
+	}
 	namespace, _, err := vc.clientConfig.Namespace()
 	if err != nil {
 		return err
@@ -123,6 +143,11 @@ func (vc *VirtCommand) Run(args []string) error {
 		fmt.Println("Dry Run execution")
 		dryRunOption = []string{v1.DryRunAll}
 	}
+	if vc.bulkOptions.IsBulk() {
+		return vc.runBulk(resourceType, namespace, virtClient, dryRunOption)
+	}
+
+	resourceName := args[1]
 	switch vc.command {
 	case COMMAND_PAUSE:
 		switch resourceType {
@@ -177,6 +202,12 @@ func (vc *VirtCommand) Run(args []string) error {
 			printLog(resourceName, vc.command)
 		}
 	}
+	if vc.bulkOptions.Wait {
+		if err := bulk.WaitFor(context.Background(), vc.bulkOptions.Timeout, pausedCondition(virtClient, namespace, resourceName, vc.command == COMMAND_PAUSE)); err != nil {
+			return fmt.Errorf("Error waiting for VirtualMachineInstance %s: %v", resourceName, err)
+		}
+		fmt.Printf("VMI %s was %sd\n", resourceName, vc.command)
+	}
 	return nil
 }
 
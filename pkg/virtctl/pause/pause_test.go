@@ -2,6 +2,8 @@ package pause_test
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
@@ -10,11 +12,13 @@ import (
 	"kubevirt.io/client-go/api"
 
 	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
 	"kubevirt.io/kubevirt/pkg/virtctl/pause"
 
 	"kubevirt.io/kubevirt/tests/clientcmd"
@@ -136,4 +140,60 @@ var _ = Describe("Pausing", func() {
 		Entry("", &v1.UnpauseOptions{}),
 		Entry("with dry-run option", &v1.UnpauseOptions{DryRun: []string{k8smetav1.DryRunAll}}),
 	)
+
+	Context("with --selector or --all", func() {
+
+		newVMI := func(name string, phase v1.VirtualMachineInstancePhase, paused bool) v1.VirtualMachineInstance {
+			vmi := api.NewMinimalVMI(name)
+			vmi.Status.Phase = phase
+			if paused {
+				vmi.Status.Conditions = []v1.VirtualMachineInstanceCondition{{Type: v1.VirtualMachineInstancePaused, Status: k8sv1.ConditionTrue}}
+			}
+			return *vmi
+		}
+
+		BeforeEach(func() {
+			bulk.PollInterval = 10 * time.Millisecond
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		})
+
+		It("should pause the selected VMIs and wait until they are paused", func() {
+			vmiInterface.EXPECT().List(gomock.Any(), &k8smetav1.ListOptions{LabelSelector: "app=web"}).Return(&v1.VirtualMachineInstanceList{Items: []v1.VirtualMachineInstance{
+				newVMI("vmi-a", v1.Running, false),
+				newVMI("vmi-b", v1.Running, true),
+				newVMI("vmi-c", v1.Scheduling, false),
+			}}, nil)
+			vmiInterface.EXPECT().Pause(gomock.Any(), "vmi-a", &v1.PauseOptions{}).Return(nil)
+			running, paused := newVMI("vmi-a", v1.Running, false), newVMI("vmi-a", v1.Running, true)
+			gomock.InOrder(
+				vmiInterface.EXPECT().Get(gomock.Any(), "vmi-a", gomock.Any()).Return(&running, nil),
+				vmiInterface.EXPECT().Get(gomock.Any(), "vmi-a", gomock.Any()).Return(&paused, nil),
+			)
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(pause.COMMAND_PAUSE, "vmi", "-l", "app=web", "--wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(SatisfyAll(
+				MatchRegexp(`vmi-a\s+Succeeded`),
+				MatchRegexp(`vmi-b\s+Skipped\s+VMI is already paused`),
+				MatchRegexp(`vmi-c\s+Skipped\s+VMI is not running`),
+				ContainSubstring("Paused 1 of 3 VirtualMachineInstances, 0 failed, 2 skipped"),
+			))
+		})
+
+		It("should unpause all the paused VMs", func() {
+			vmInterface.EXPECT().List(gomock.Any(), &k8smetav1.ListOptions{}).Return(&v1.VirtualMachineList{Items: []v1.VirtualMachine{
+				{ObjectMeta: k8smetav1.ObjectMeta{Name: "vm-a"}, Status: v1.VirtualMachineStatus{PrintableStatus: v1.VirtualMachineStatusPaused}},
+				{ObjectMeta: k8smetav1.ObjectMeta{Name: "vm-b"}, Status: v1.VirtualMachineStatus{PrintableStatus: v1.VirtualMachineStatusRunning}},
+			}}, nil)
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface)
+			vmiInterface.EXPECT().Unpause(gomock.Any(), "vm-a", &v1.UnpauseOptions{}).Return(fmt.Errorf("not allowed"))
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(pause.COMMAND_UNPAUSE, "vm", "--all")()
+			Expect(err).To(HaveOccurred())
+			Expect(string(out)).To(SatisfyAll(
+				MatchRegexp(`vm-a\s+Failed\s+Error unpausing VirtualMachineInstance vm-a: not allowed`),
+				MatchRegexp(`vm-b\s+Skipped\s+VM is not paused`),
+			))
+		})
+	})
 })
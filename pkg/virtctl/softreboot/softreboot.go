@@ -27,8 +27,10 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/client-go/tools/clientcmd"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
@@ -37,35 +39,46 @@ const (
 )
 
 func NewSoftRebootCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	bulkOptions := &bulk.Options{}
 	cmd := &cobra.Command{
 		Use:     "soft-reboot (VMI)",
 		Short:   "Soft reboot a virtual machine instance",
 		Long:    `Soft reboot a virtual machine instance`,
-		Args:    templates.ExactArgs(COMMAND_SOFT_REBOOT, 1),
+		Args:    bulkOptions.ExactArgs(COMMAND_SOFT_REBOOT, 1),
 		Example: usage(COMMAND_SOFT_REBOOT),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			c := SoftReboot{
 				clientConfig: clientConfig,
+				cmd:          cmd,
+				bulkOptions:  bulkOptions,
 			}
 			return c.Run(args)
 		},
 	}
+	// A soft reboot has no state to wait for, the guest reboots without the VMI changing
+	bulkOptions.AddFlags(cmd, "virtual machine instance")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func usage(cmd string) string {
 	usage := fmt.Sprintf("  # %s a virtualmachineinstance called 'myvmi':\n", strings.Title(cmd))
-	usage += fmt.Sprintf("  {{ProgramName}} %s myvmi", cmd)
+	usage += fmt.Sprintf("  {{ProgramName}} %s myvmi\n\n", cmd)
+	usage += fmt.Sprintf("  # %s the virtualmachineinstances labeled 'app=web', two at a time:\n", strings.Title(cmd))
+	usage += fmt.Sprintf("  {{ProgramName}} %s -l app=web --parallel 2", cmd)
 	return usage
 }
 
 type SoftReboot struct {
 	clientConfig clientcmd.ClientConfig
+	cmd          *cobra.Command
+	bulkOptions  *bulk.Options
 }
 
 func (o *SoftReboot) Run(args []string) error {
-	vmi := args[0]
+	if err := o.bulkOptions.Validate(); err != nil {
+		return err
+	}
 
 	namespace, _, err := o.clientConfig.Namespace()
 	if err != nil {
@@ -77,6 +90,11 @@ func (o *SoftReboot) Run(args []string) error {
 		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
 	}
 
+	if o.bulkOptions.IsBulk() {
+		return o.runBulk(namespace, virtClient)
+	}
+
+	vmi := args[0]
 	if err = virtClient.VirtualMachineInstance(namespace).SoftReboot(context.Background(), vmi); err != nil {
 		return fmt.Errorf("Error soft rebooting VirtualMachineInstance %s: %v", vmi, err)
 	}
@@ -84,3 +102,26 @@ func (o *SoftReboot) Run(args []string) error {
 	fmt.Printf("VMI %s was scheduled to %s\n", vmi, COMMAND_SOFT_REBOOT)
 	return nil
 }
+
+// runBulk soft reboots the running VMIs selected by --selector or --all
+func (o *SoftReboot) runBulk(namespace string, virtClient kubecli.KubevirtClient) error {
+	vmis, err := o.bulkOptions.ListVMIs(virtClient, namespace)
+	if err != nil {
+		return err
+	}
+	targets := make([]bulk.Target, 0, len(vmis))
+	for _, vmi := range vmis {
+		target := bulk.Target{Name: vmi.Name}
+		if vmi.Status.Phase != v1.Running {
+			target.Skip = "VMI is not running"
+		}
+		targets = append(targets, target)
+	}
+
+	return o.bulkOptions.Run(o.cmd.OutOrStdout(), "VirtualMachineInstance", "Soft rebooted", targets, func(ctx context.Context, name string) (bulk.Condition, error) {
+		if err := virtClient.VirtualMachineInstance(namespace).SoftReboot(ctx, name); err != nil {
+			return nil, fmt.Errorf("Error soft rebooting VirtualMachineInstance %s: %v", name, err)
+		}
+		return nil, nil
+	})
+}
@@ -10,6 +10,7 @@ import (
 
 	"kubevirt.io/kubevirt/tests/clientcmd"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 
@@ -46,4 +47,21 @@ var _ = Describe("Soft rebooting", func() {
 		cmd := clientcmd.NewVirtctlCommand(softreboot.COMMAND_SOFT_REBOOT, vmiName)
 		Expect(cmd.Execute()).To(Succeed())
 	})
+
+	It("should soft reboot the running VMIs matching the selector", func() {
+		running, pending := api.NewMinimalVMI("vmi-a"), api.NewMinimalVMI("vmi-b")
+		running.Status.Phase = v1.Running
+		pending.Status.Phase = v1.Pending
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(2)
+		vmiInterface.EXPECT().List(gomock.Any(), &metav1.ListOptions{LabelSelector: "app=web"}).Return(&v1.VirtualMachineInstanceList{Items: []v1.VirtualMachineInstance{*running, *pending}}, nil)
+		vmiInterface.EXPECT().SoftReboot(gomock.Any(), "vmi-a").Return(nil)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut(softreboot.COMMAND_SOFT_REBOOT, "-l", "app=web")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(SatisfyAll(
+			MatchRegexp(`vmi-b\s+Skipped\s+VMI is not running`),
+			ContainSubstring("Soft rebooted 1 of 2 VirtualMachineInstances, 0 failed, 1 skipped"),
+		))
+	})
 })
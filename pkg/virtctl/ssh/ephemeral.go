@@ -0,0 +1,207 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package ssh
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/glog"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+const (
+	ephemeralFlag    = "ephemeral"
+	ephemeralTTLFlag = "ephemeral-ttl"
+
+	defaultEphemeralTTL = 2 * time.Minute
+)
+
+// hostKeysTimeout is how long to wait for the host keys of the guest to be reported in the VMI status
+var hostKeysTimeout = 30 * time.Second
+
+// ephemeralKey is a key pair generated for a single session, authorized in the guest through the guest agent
+// until its TTL elapsed
+type ephemeralKey struct {
+	privateKey *ecdsa.PrivateKey
+	signer     ssh.Signer
+	// hostKeys are the host keys of the guest reported by the guest agent, the only ones the session accepts
+	hostKeys []v1.SSHHostKey
+}
+
+func newEphemeralKey() (*ephemeralKey, error) {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate the ephemeral key: %v", err)
+	}
+	signer, err := ssh.NewSignerFromKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+	return &ephemeralKey{privateKey: privateKey, signer: signer}, nil
+}
+
+func (k *ephemeralKey) authorizedKey() string {
+	return string(ssh.MarshalAuthorizedKey(k.signer.PublicKey()))
+}
+
+// hostKeyCallback accepts only the host keys whose fingerprint the guest agent reported
+func (k *ephemeralKey) hostKeyCallback(hostname string, _ net.Addr, key ssh.PublicKey) error {
+	fingerprint := ssh.FingerprintSHA256(key)
+	for _, hostKey := range k.hostKeys {
+		if hostKey.Fingerprint == fingerprint {
+			return nil
+		}
+	}
+	return fmt.Errorf("the %s host key of %s with fingerprint %s was not reported by the guest agent", key.Type(), hostname, fingerprint)
+}
+
+// writeFiles writes the private key and a known_hosts file with the host keys of the guest under dir,
+// for the local SSH client
+func (k *ephemeralKey) writeFiles(dir string, hostKeyAlias string) (identityFile string, knownHostsFile string, err error) {
+	der, err := x509.MarshalECPrivateKey(k.privateKey)
+	if err != nil {
+		return "", "", err
+	}
+	identityFile = filepath.Join(dir, "id_ecdsa")
+	if err := os.WriteFile(identityFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0600); err != nil {
+		return "", "", err
+	}
+
+	knownHosts := ""
+	for _, hostKey := range k.hostKeys {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey.PublicKey))
+		if err != nil {
+			return "", "", fmt.Errorf("invalid host key reported for the guest: %v", err)
+		}
+		knownHosts += knownhosts.Line([]string{hostKeyAlias}, key) + "\n"
+	}
+	knownHostsFile = filepath.Join(dir, "known_hosts")
+	if err := os.WriteFile(knownHostsFile, []byte(knownHosts), 0600); err != nil {
+		return "", "", err
+	}
+	return identityFile, knownHostsFile, nil
+}
+
+// authorizeEphemeralKey generates a key pair, authorizes it in the guest for the TTL and waits for the host keys
+// of the guest. The returned function removes the key from the guest.
+func authorizeEphemeralKey(virtCli kubecli.KubevirtClient, namespace, name, user string, ttl time.Duration) (*ephemeralKey, func(), error) {
+	if user == "" {
+		return nil, nil, fmt.Errorf("a user is required with --%s", ephemeralFlag)
+	}
+	key, err := newEphemeralKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vmiClient := virtCli.VirtualMachineInstance(namespace)
+	err = vmiClient.AddSSHKey(context.Background(), name, &v1.AddSSHKeyOptions{
+		User:       user,
+		PublicKey:  key.authorizedKey(),
+		TTLSeconds: int32(ttl.Seconds()),
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to authorize the ephemeral key in the guest: %v", err)
+	}
+	remove := func() {
+		err := vmiClient.RemoveSSHKey(context.Background(), name, &v1.RemoveSSHKeyOptions{
+			User:      user,
+			PublicKey: key.authorizedKey(),
+		})
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Failed to remove the ephemeral key from the guest, it expires within %v: %v\n", ttl, err)
+		}
+	}
+
+	err = wait.PollImmediate(time.Second, hostKeysTimeout, func() (bool, error) {
+		vmi, err := vmiClient.Get(context.Background(), name, &metav1.GetOptions{})
+		if err != nil {
+			return false, err
+		}
+		key.hostKeys = vmi.Status.SSHHostKeys
+		return len(key.hostKeys) > 0, nil
+	})
+	if err != nil {
+		remove()
+		return nil, nil, fmt.Errorf("the guest agent didn't report the SSH host keys of the guest: %v", err)
+	}
+	for _, hostKey := range key.hostKeys {
+		glog.V(3).Infof("Accepting the %s host key %s reported by the guest agent", hostKey.Type, hostKey.Fingerprint)
+	}
+	return key, remove, nil
+}
+
+// prepareEphemeralKey authorizes an ephemeral key in the guest, and sets up the local SSH client to use it
+// when it is wrapped. The returned function removes the key from the guest.
+func (o *SSH) prepareEphemeralKey(namespace, name string) (func(), error) {
+	virtCli, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return nil, err
+	}
+	key, remove, err := authorizeEphemeralKey(virtCli, namespace, name, o.options.SSHUsername, o.ephemeralTTL)
+	if err != nil {
+		return nil, err
+	}
+	o.ephemeralKey = key
+	if !o.options.WrapLocalSSH {
+		return remove, nil
+	}
+
+	dir, err := os.MkdirTemp("", "virtctl-ssh-")
+	if err != nil {
+		remove()
+		return nil, err
+	}
+	cleanup := func() {
+		remove()
+		os.RemoveAll(dir)
+	}
+	hostKeyAlias := fmt.Sprintf("%s.%s", name, namespace)
+	identityFile, knownHostsFile, err := key.writeFiles(dir, hostKeyAlias)
+	if err != nil {
+		cleanup()
+		return nil, err
+	}
+	o.options.IdentityFilePath = identityFile
+	o.options.IdentityFilePathProvided = true
+	o.options.AdditionalSSHLocalOptions = append(o.options.AdditionalSSHLocalOptions,
+		"-o", "IdentitiesOnly=yes",
+		"-o", "UserKnownHostsFile="+knownHostsFile,
+		"-o", "GlobalKnownHostsFile=/dev/null",
+		"-o", "StrictHostKeyChecking=yes",
+		"-o", "HostKeyAlias="+hostKeyAlias,
+	)
+	return cleanup, nil
+}
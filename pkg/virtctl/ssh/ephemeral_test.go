@@ -0,0 +1,139 @@
+package ssh
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+var _ = Describe("Ephemeral SSH keys", func() {
+	const (
+		vmiName   = "testvmi"
+		namespace = "default"
+	)
+
+	var (
+		virtClient   *kubecli.MockKubevirtClient
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		hostKey      ssh.PublicKey
+		reported     v1.SSHHostKey
+	)
+
+	newHostKey := func() ssh.PublicKey {
+		key, _, err := ed25519.GenerateKey(rand.Reader)
+		Expect(err).ToNot(HaveOccurred())
+		sshKey, err := ssh.NewPublicKey(key)
+		Expect(err).ToNot(HaveOccurred())
+		return sshKey
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachineInstance(namespace).Return(vmiInterface).AnyTimes()
+
+		hostKey = newHostKey()
+		reported = v1.SSHHostKey{
+			Type:        hostKey.Type(),
+			PublicKey:   strings.TrimSpace(string(ssh.MarshalAuthorizedKey(hostKey))),
+			Fingerprint: ssh.FingerprintSHA256(hostKey),
+		}
+	})
+
+	vmiWithHostKeys := func(hostKeys ...v1.SSHHostKey) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{}
+		vmi.Status.SSHHostKeys = hostKeys
+		return vmi
+	}
+
+	It("should authorize the key, wait for the host keys and remove the key", func() {
+		var publicKey string
+		vmiInterface.EXPECT().AddSSHKey(context.Background(), vmiName, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, options *v1.AddSSHKeyOptions) error {
+				Expect(options.User).To(Equal("jdoe"))
+				Expect(options.TTLSeconds).To(BeEquivalentTo(120))
+				publicKey = options.PublicKey
+				return nil
+			})
+		gomock.InOrder(
+			vmiInterface.EXPECT().Get(context.Background(), vmiName, &metav1.GetOptions{}).Return(vmiWithHostKeys(), nil),
+			vmiInterface.EXPECT().Get(context.Background(), vmiName, &metav1.GetOptions{}).Return(vmiWithHostKeys(reported), nil),
+		)
+
+		key, remove, err := authorizeEphemeralKey(virtClient, namespace, vmiName, "jdoe", 2*time.Minute)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(key.hostKeys).To(ConsistOf(reported))
+		Expect(publicKey).To(Equal(key.authorizedKey()))
+
+		vmiInterface.EXPECT().RemoveSSHKey(context.Background(), vmiName, &v1.RemoveSSHKeyOptions{
+			User:      "jdoe",
+			PublicKey: publicKey,
+		}).Return(nil)
+		remove()
+	})
+
+	It("should remove the key when the host keys are not reported", func() {
+		defer func(timeout time.Duration) { hostKeysTimeout = timeout }(hostKeysTimeout)
+		hostKeysTimeout = 10 * time.Millisecond
+
+		vmiInterface.EXPECT().AddSSHKey(context.Background(), vmiName, gomock.Any()).Return(nil)
+		vmiInterface.EXPECT().Get(context.Background(), vmiName, &metav1.GetOptions{}).Return(vmiWithHostKeys(), nil).AnyTimes()
+		vmiInterface.EXPECT().RemoveSSHKey(context.Background(), vmiName, gomock.Any()).Return(nil)
+
+		_, _, err := authorizeEphemeralKey(virtClient, namespace, vmiName, "jdoe", 2*time.Minute)
+		Expect(err).To(MatchError(ContainSubstring("didn't report the SSH host keys")))
+	})
+
+	It("should require a user", func() {
+		_, _, err := authorizeEphemeralKey(virtClient, namespace, vmiName, "", 2*time.Minute)
+		Expect(err).To(MatchError(ContainSubstring("a user is required")))
+	})
+
+	It("should only accept the host keys reported by the guest agent", func() {
+		key, err := newEphemeralKey()
+		Expect(err).ToNot(HaveOccurred())
+		key.hostKeys = []v1.SSHHostKey{reported}
+
+		Expect(key.hostKeyCallback("vmi/testvmi.default:22", nil, hostKey)).To(Succeed())
+		Expect(key.hostKeyCallback("vmi/testvmi.default:22", nil, newHostKey())).To(MatchError(ContainSubstring("was not reported by the guest agent")))
+	})
+
+	It("should write the files for the local SSH client", func() {
+		key, err := newEphemeralKey()
+		Expect(err).ToNot(HaveOccurred())
+		key.hostKeys = []v1.SSHHostKey{reported}
+		dir := GinkgoT().TempDir()
+
+		identityFile, knownHostsFile, err := key.writeFiles(dir, "testvmi.default")
+		Expect(err).ToNot(HaveOccurred())
+
+		info, err := os.Stat(identityFile)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		privateKey, err := os.ReadFile(identityFile)
+		Expect(err).ToNot(HaveOccurred())
+		signer, err := ssh.ParsePrivateKey(privateKey)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(signer.PublicKey().Marshal()).To(Equal(key.signer.PublicKey().Marshal()))
+
+		callback, err := knownhosts.New(knownHostsFile)
+		Expect(err).ToNot(HaveOccurred())
+		addr := &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 22}
+		Expect(callback("testvmi.default:22", addr, hostKey)).To(Succeed())
+	})
+})
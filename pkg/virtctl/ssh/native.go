@@ -41,12 +41,15 @@ func addAdditionalCommandlineArgs(flagset *pflag.FlagSet, opts *SSHOptions) {
 type NativeSSHConnection struct {
 	ClientConfig clientcmd.ClientConfig
 	Options      SSHOptions
+	// ephemeralKey replaces the other authentication methods and the known hosts file when set
+	ephemeralKey *ephemeralKey
 }
 
 func (o *SSH) nativeSSH(kind, namespace, name string) error {
 	conn := NativeSSHConnection{
 		ClientConfig: o.clientConfig,
 		Options:      o.options,
+		ephemeralKey: o.ephemeralKey,
 	}
 	client, err := conn.PrepareSSHClient(kind, namespace, name)
 	if err != nil {
@@ -66,7 +69,10 @@ func (o *NativeSSHConnection) PrepareSSHClient(kind, namespace, name string) (*s
 	authMethods := o.getAuthMethods(kind, namespace, name)
 
 	hostKeyCallback := ssh.InsecureIgnoreHostKey()
-	if len(o.Options.KnownHostsFilePath) > 0 {
+	if o.ephemeralKey != nil {
+		authMethods = []ssh.AuthMethod{ssh.PublicKeys(o.ephemeralKey.signer)}
+		hostKeyCallback = o.ephemeralKey.hostKeyCallback
+	} else if len(o.Options.KnownHostsFilePath) > 0 {
 		hostKeyCallback, err = InteractiveHostKeyCallback(o.Options.KnownHostsFilePath)
 		if err != nil {
 			return nil, err
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/spf13/cobra"
@@ -47,6 +48,7 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	c := &SSH{
 		clientConfig: clientConfig,
 		options:      DefaultSSHOptions(),
+		ephemeralTTL: defaultEphemeralTTL,
 	}
 
 	cmd := &cobra.Command{
@@ -62,6 +64,10 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	AddCommandlineArgs(cmd.Flags(), &c.options)
 	cmd.Flags().StringVarP(&c.command, commandToExecute, commandToExecuteShort, c.command,
 		fmt.Sprintf(`--%s='ls /': Specify a command to execute in the VM`, commandToExecute))
+	cmd.Flags().BoolVar(&c.ephemeral, ephemeralFlag, c.ephemeral,
+		fmt.Sprintf("--%s=true: Authenticate with a key pair generated for this session, authorized in the guest through the guest agent until the session ends, and verify the host key reported by the guest agent", ephemeralFlag))
+	cmd.Flags().DurationVar(&c.ephemeralTTL, ephemeralTTLFlag, c.ephemeralTTL,
+		fmt.Sprintf("--%s=10m: How long the ephemeral key stays authorized in the guest if it isn't removed when the session ends", ephemeralTTLFlag))
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -106,6 +112,9 @@ type SSH struct {
 	clientConfig clientcmd.ClientConfig
 	options      SSHOptions
 	command      string
+	ephemeral    bool
+	ephemeralTTL time.Duration
+	ephemeralKey *ephemeralKey
 }
 
 type SSHOptions struct {
@@ -126,6 +135,14 @@ func (o *SSH) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if o.ephemeral {
+		cleanup, err := o.prepareEphemeralKey(namespace, name)
+		if err != nil {
+			return err
+		}
+		defer cleanup()
+	}
+
 	if o.options.WrapLocalSSH {
 		clientArgs := o.buildSSHTarget(kind, namespace, name)
 		return RunLocalClient(kind, namespace, name, &o.options, clientArgs)
@@ -163,10 +180,14 @@ func usage() string {
   {{ProgramName}} ssh jdoe@vm/testvm.mynamespace [--%s]
 
   # Specify a username and namespace:
-  {{ProgramName}} ssh --namespace=mynamespace --%s=jdoe testvmi`,
+  {{ProgramName}} ssh --namespace=mynamespace --%s=jdoe testvmi
+
+  # Connect to 'testvmi' with a key pair generated for this session, authorized through the guest agent:
+  {{ProgramName}} ssh jdoe@testvmi --%s`,
 		IdentityFilePathFlag,
 		IdentityFilePathFlag,
 		usernameFlag,
+		ephemeralFlag,
 	) + additionalUsage()
 }
 
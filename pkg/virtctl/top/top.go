@@ -0,0 +1,350 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package top
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_TOP = "top"
+	COMMAND_VM  = "vm"
+
+	intervalFlag = "interval"
+
+	defaultInterval = 2 * time.Second
+)
+
+func NewTopCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_TOP,
+		Short: "Display the resource usage of virtual machines.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Printf(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(NewTopVMCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewTopVMCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := TopVM{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "vm [VM]",
+		Short: "Display the CPU, memory, disk and network usage of running virtual machines.",
+		Long: `Display the CPU, memory, disk and network usage of running virtual machines, from the domain statistics
+collected by virt-handler. The rates are computed from two samples taken an interval apart.
+CPU usage is relative to one CPU, a VM using two vCPUs fully is at 200%.
+Memory is the memory used by the guest when it is reported by the balloon driver, the resident memory of the VM otherwise.`,
+		Example: usage(),
+		Args:    cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.Flags().DurationVar(&c.interval, intervalFlag, defaultInterval, "Interval between the two samples the rates are computed from.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Display the resource usage of the running virtual machines of the namespace:
+  {{ProgramName}} top vm
+
+  # Display the resource usage of the virtual machine 'myvm', per disk and network interface:
+  {{ProgramName}} top vm myvm
+
+  # Compute the rates over 10 seconds:
+  {{ProgramName}} top vm --interval=10s`
+}
+
+type TopVM struct {
+	clientConfig clientcmd.ClientConfig
+	interval     time.Duration
+}
+
+// vmUsage is the resource usage of a VM between two samples of its domain statistics
+type vmUsage struct {
+	name string
+	// cpuPercent is relative to one CPU, nil if it can't be computed
+	cpuPercent  *float64
+	vcpus       int
+	memoryUsed  int64
+	memoryTotal int64
+	devices     []deviceRates
+	err         error
+}
+
+// deviceRates are the rates of a disk or a network interface, in bytes per second
+type deviceRates struct {
+	kind  string
+	name  string
+	read  float64
+	write float64
+}
+
+func (o *TopVM) Run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := o.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+	vmiClient := virtClient.VirtualMachineInstance(namespace)
+
+	var names []string
+	if len(args) == 1 {
+		names = args
+	} else {
+		names, err = runningVMIs(vmiClient)
+		if err != nil {
+			return err
+		}
+		if len(names) == 0 {
+			cmd.Printf("No running virtual machines found in %s namespace.\n", namespace)
+			return nil
+		}
+	}
+
+	first := sample(vmiClient, names)
+	time.Sleep(o.interval)
+	second := sample(vmiClient, names)
+
+	var usages []vmUsage
+	for _, name := range names {
+		usage := computeUsage(name, first[name], second[name])
+		if len(args) == 1 && usage.err != nil {
+			return fmt.Errorf("Error getting the domain stats of VirtualMachineInstance %s: %v", name, usage.err)
+		}
+		usages = append(usages, usage)
+	}
+
+	return printUsages(cmd.OutOrStdout(), cmd.ErrOrStderr(), usages, len(args) == 1)
+}
+
+func runningVMIs(vmiClient kubecli.VirtualMachineInstanceInterface) ([]string, error) {
+	vmis, err := vmiClient.List(context.Background(), &metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error listing VirtualMachineInstances: %v", err)
+	}
+	var names []string
+	for _, vmi := range vmis.Items {
+		if vmi.Status.Phase == v1.Running {
+			names = append(names, vmi.Name)
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+type statsResult struct {
+	stats *v1.VirtualMachineInstanceDomainStats
+	err   error
+}
+
+func sample(vmiClient kubecli.VirtualMachineInstanceInterface, names []string) map[string]statsResult {
+	results := map[string]statsResult{}
+	for _, name := range names {
+		domStats, err := vmiClient.DomainStats(context.Background(), name)
+		results[name] = statsResult{stats: domStats, err: err}
+	}
+	return results
+}
+
+func computeUsage(name string, first, second statsResult) vmUsage {
+	usage := vmUsage{name: name}
+	if first.err != nil {
+		usage.err = first.err
+		return usage
+	}
+	if second.err != nil {
+		usage.err = second.err
+		return usage
+	}
+	prev, cur := first.stats, second.stats
+
+	usage.vcpus = cur.VCPUs
+	if mem := cur.Memory; mem != nil {
+		switch {
+		case mem.AvailableBytes > 0 && mem.UsableBytes > 0:
+			usage.memoryUsed = mem.AvailableBytes - mem.UsableBytes
+			usage.memoryTotal = mem.AvailableBytes
+		case mem.AvailableBytes > 0 && mem.UnusedBytes > 0:
+			usage.memoryUsed = mem.AvailableBytes - mem.UnusedBytes
+			usage.memoryTotal = mem.AvailableBytes
+		default:
+			usage.memoryUsed = mem.RSSBytes
+			usage.memoryTotal = mem.ActualBalloonBytes
+		}
+	}
+
+	elapsed := cur.Timestamp.Sub(prev.Timestamp.Time).Seconds()
+	if elapsed <= 0 {
+		return usage
+	}
+
+	cpuPercent := float64(cur.CPUTimeNanoseconds-prev.CPUTimeNanoseconds) / (elapsed * float64(time.Second)) * 100
+	if cpuPercent < 0 {
+		cpuPercent = 0
+	}
+	usage.cpuPercent = &cpuPercent
+
+	prevDisks := map[string]v1.DomainDiskStats{}
+	for _, disk := range prev.Disks {
+		prevDisks[disk.Name] = disk
+	}
+	for _, disk := range cur.Disks {
+		prevDisk, exists := prevDisks[disk.Name]
+		if !exists {
+			// Hotplugged between the samples
+			prevDisk = disk
+		}
+		usage.devices = append(usage.devices, deviceRates{
+			kind:  "disk",
+			name:  disk.Name,
+			read:  rate(prevDisk.ReadBytes, disk.ReadBytes, elapsed),
+			write: rate(prevDisk.WriteBytes, disk.WriteBytes, elapsed),
+		})
+	}
+
+	prevIfaces := map[string]v1.DomainInterfaceStats{}
+	for _, iface := range prev.Interfaces {
+		prevIfaces[iface.Name] = iface
+	}
+	for _, iface := range cur.Interfaces {
+		prevIface, exists := prevIfaces[iface.Name]
+		if !exists {
+			prevIface = iface
+		}
+		usage.devices = append(usage.devices, deviceRates{
+			kind:  "interface",
+			name:  iface.Name,
+			read:  rate(prevIface.RxBytes, iface.RxBytes, elapsed),
+			write: rate(prevIface.TxBytes, iface.TxBytes, elapsed),
+		})
+	}
+
+	return usage
+}
+
+// rate returns the rate of a counter, a counter going backward was reset and its new value is the increase
+func rate(prev, cur int64, elapsed float64) float64 {
+	if cur < prev {
+		return float64(cur) / elapsed
+	}
+	return float64(cur-prev) / elapsed
+}
+
+func (u *vmUsage) totals(kind string) (float64, float64) {
+	var read, write float64
+	for _, device := range u.devices {
+		if device.kind == kind {
+			read += device.read
+			write += device.write
+		}
+	}
+	return read, write
+}
+
+func printUsages(out, errOut io.Writer, usages []vmUsage, details bool) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "NAME\tCPU\tVCPUS\tMEMORY\tDISK READ\tDISK WRITE\tNET RX\tNET TX")
+	for _, usage := range usages {
+		if usage.err != nil {
+			fmt.Fprintf(errOut, "Error getting the domain stats of VirtualMachineInstance %s: %v\n", usage.name, usage.err)
+			continue
+		}
+		diskRead, diskWrite := usage.totals("disk")
+		netRx, netTx := usage.totals("interface")
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\t%s\n", usage.name, formatPercent(usage.cpuPercent), usage.vcpus,
+			formatMemory(usage.memoryUsed, usage.memoryTotal), formatRate(usage, diskRead), formatRate(usage, diskWrite),
+			formatRate(usage, netRx), formatRate(usage, netTx))
+	}
+
+	if details {
+		for _, usage := range usages {
+			if usage.err != nil || len(usage.devices) == 0 {
+				continue
+			}
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, "DEVICE\tNAME\tREAD/RX\tWRITE/TX")
+			for _, device := range usage.devices {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", device.kind, device.name, formatBytes(device.read)+"/s", formatBytes(device.write)+"/s")
+			}
+		}
+	}
+	return w.Flush()
+}
+
+func formatPercent(percent *float64) string {
+	if percent == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%.1f%%", *percent)
+}
+
+func formatMemory(used, total int64) string {
+	if used == 0 && total == 0 {
+		return "-"
+	}
+	if total == 0 {
+		return formatBytes(float64(used))
+	}
+	return formatBytes(float64(used)) + "/" + formatBytes(float64(total))
+}
+
+func formatRate(usage vmUsage, value float64) string {
+	if usage.cpuPercent == nil {
+		return "-"
+	}
+	return formatBytes(value) + "/s"
+}
+
+// formatBytes formats a number of bytes with the binary suffixes of the Kubernetes quantities
+func formatBytes(value float64) string {
+	suffixes := []string{"", "Ki", "Mi", "Gi", "Ti", "Pi"}
+	i := 0
+	for value >= 1024 && i < len(suffixes)-1 {
+		value /= 1024
+		i++
+	}
+	if i == 0 {
+		return fmt.Sprintf("%.0f", value)
+	}
+	return fmt.Sprintf("%.1f%s", value, suffixes[i])
+}
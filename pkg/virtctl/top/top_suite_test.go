@@ -0,0 +1,11 @@
+package top_test
+
+import (
+	"testing"
+
+	"kubevirt.io/client-go/testutils"
+)
+
+func TestTop(t *testing.T) {
+	testutils.KubeVirtTestSuiteSetup(t)
+}
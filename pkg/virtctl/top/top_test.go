@@ -0,0 +1,105 @@
+package top_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("Top", func() {
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	start := time.Unix(1700000000, 0)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+	})
+
+	// newStats returns the stats of a VM sampled after the given number of seconds, with constant rates
+	newStats := func(seconds int64) *v1.VirtualMachineInstanceDomainStats {
+		return &v1.VirtualMachineInstanceDomainStats{
+			Timestamp:          metav1.NewMicroTime(start.Add(time.Duration(seconds) * time.Second)),
+			CPUTimeNanoseconds: seconds * int64(time.Second) / 2,
+			VCPUs:              2,
+			Memory: &v1.DomainMemoryStats{
+				AvailableBytes: 2 * 1024 * 1024 * 1024,
+				UsableBytes:    1024 * 1024 * 1024,
+			},
+			Disks: []v1.DomainDiskStats{
+				{Name: "rootdisk", ReadBytes: seconds * 1024 * 1024, WriteBytes: seconds * 2048},
+				{Name: "datadisk", ReadBytes: seconds * 1024 * 1024},
+			},
+			Interfaces: []v1.DomainInterfaceStats{
+				{Name: "default", RxBytes: seconds * 512, TxBytes: seconds * 256},
+			},
+		}
+	}
+
+	It("should display the usage of a VM with the rates of its devices", func() {
+		gomock.InOrder(
+			vmiInterface.EXPECT().DomainStats(gomock.Any(), "testvm").Return(newStats(10), nil),
+			vmiInterface.EXPECT().DomainStats(gomock.Any(), "testvm").Return(newStats(12), nil),
+		)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("top", "vm", "testvm", "--interval=0s")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(SatisfyAll(
+			MatchRegexp(`NAME\s+CPU\s+VCPUS\s+MEMORY\s+DISK READ\s+DISK WRITE\s+NET RX\s+NET TX`),
+			MatchRegexp(`testvm\s+50.0%\s+2\s+1.0Gi/2.0Gi\s+2.0Mi/s\s+2.0Ki/s\s+512/s\s+256/s`),
+			MatchRegexp(`disk\s+rootdisk\s+1.0Mi/s\s+2.0Ki/s`),
+			MatchRegexp(`disk\s+datadisk\s+1.0Mi/s\s+0/s`),
+			MatchRegexp(`interface\s+default\s+512/s\s+256/s`),
+		))
+	})
+
+	It("should display the usage of the running VMs of the namespace", func() {
+		vmiInterface.EXPECT().List(gomock.Any(), gomock.Any()).Return(&v1.VirtualMachineInstanceList{Items: []v1.VirtualMachineInstance{
+			{ObjectMeta: metav1.ObjectMeta{Name: "vm-b"}, Status: v1.VirtualMachineInstanceStatus{Phase: v1.Running}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "vm-a"}, Status: v1.VirtualMachineInstanceStatus{Phase: v1.Running}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "vm-pending"}, Status: v1.VirtualMachineInstanceStatus{Phase: v1.Pending}},
+		}}, nil)
+		gomock.InOrder(
+			vmiInterface.EXPECT().DomainStats(gomock.Any(), "vm-a").Return(newStats(10), nil),
+			vmiInterface.EXPECT().DomainStats(gomock.Any(), "vm-b").Return(nil, fmt.Errorf("not reachable")),
+			vmiInterface.EXPECT().DomainStats(gomock.Any(), "vm-a").Return(newStats(11), nil),
+			vmiInterface.EXPECT().DomainStats(gomock.Any(), "vm-b").Return(nil, fmt.Errorf("not reachable")),
+		)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("top", "vm", "--interval=0s")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(SatisfyAll(
+			MatchRegexp(`vm-a\s+50.0%\s+2`),
+			Not(ContainSubstring("vm-b")),
+			Not(ContainSubstring("vm-pending")),
+			Not(ContainSubstring("rootdisk")),
+		))
+	})
+
+	It("should not compute the rates when the samples have the same timestamp", func() {
+		vmiInterface.EXPECT().DomainStats(gomock.Any(), "testvm").Return(newStats(10), nil).Times(2)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("top", "vm", "testvm", "--interval=0s")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(MatchRegexp(`testvm\s+-\s+2\s+1.0Gi/2.0Gi\s+-\s+-\s+-\s+-`))
+	})
+
+	It("should fail when the stats of the VM can't be fetched", func() {
+		vmiInterface.EXPECT().DomainStats(gomock.Any(), "testvm").Return(nil, fmt.Errorf("VMI is not running")).Times(2)
+
+		_, err := clientcmd.NewRepeatableVirtctlCommandWithOut("top", "vm", "testvm", "--interval=0s")()
+		Expect(err).To(MatchError(ContainSubstring("Error getting the domain stats of VirtualMachineInstance testvm: VMI is not running")))
+	})
+})
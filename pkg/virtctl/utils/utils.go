@@ -75,3 +75,13 @@ func AttachConsole(stdinReader, stdoutReader *io.PipeReader, stdinWriter, stdout
 
 	return err
 }
+
+// ExitCodeError makes virtctl exit with Code without printing an error,
+// e.g. to pass on the exit code of a command run in the guest
+type ExitCodeError struct {
+	Code int
+}
+
+func (e *ExitCodeError) Error() string {
+	return fmt.Sprintf("exit status %d", e.Code)
+}
@@ -0,0 +1,246 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
+)
+
+const bulkKind = "VirtualMachine"
+
+// bulkDone is the verb summarizing each lifecycle command once it is applied to several VMs
+var bulkDone = map[string]string{
+	COMMAND_START:   "Started",
+	COMMAND_STOP:    "Stopped",
+	COMMAND_RESTART: "Restarted",
+	COMMAND_MIGRATE: "Migrated",
+}
+
+func bulkUsage(cmd string) string {
+	usage := fmt.Sprintf("\n\n  # %s the virtual machines labeled 'app=web', two at a time, and wait until they are done:\n", strings.Title(cmd))
+	usage += fmt.Sprintf("  {{ProgramName}} %s -l app=web --parallel 2 --wait\n\n", cmd)
+	usage += fmt.Sprintf("  # %s all the virtual machines of the namespace:\n", strings.Title(cmd))
+	usage += fmt.Sprintf("  {{ProgramName}} %s --all", cmd)
+	return usage
+}
+
+// runLifecycle starts, stops, restarts or migrates the named VM, or the VMs selected by --selector or --all
+func (o *Command) runLifecycle(namespace string, virtClient kubecli.KubevirtClient, dryRunOption []string) error {
+	if err := o.bulkOptions.Validate(); err != nil {
+		return err
+	}
+	if o.bulkOptions.Wait && len(dryRunOption) > 0 {
+		return fmt.Errorf("--%s can't be combined with --%s", bulk.WaitFlag, dryRunArg)
+	}
+	action, err := o.lifecycleAction(namespace, virtClient, dryRunOption)
+	if err != nil {
+		return err
+	}
+
+	if o.bulkOptions.IsBulk() {
+		vms, err := o.bulkOptions.ListVMs(virtClient, namespace)
+		if err != nil {
+			return err
+		}
+		targets := make([]bulk.Target, 0, len(vms))
+		for _, vm := range vms {
+			targets = append(targets, bulk.Target{Name: vm.Name, Skip: skipReason(o.command, vm.Status.PrintableStatus)})
+		}
+		return o.bulkOptions.Run(o.cmd.OutOrStdout(), bulkKind, bulkDone[o.command], targets, action)
+	}
+
+	ctx := context.Background()
+	condition, err := action(ctx, vmiName)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("VM %s was scheduled to %s\n", vmiName, o.command)
+	if o.bulkOptions.Wait {
+		if err := bulk.WaitFor(ctx, o.bulkOptions.Timeout, condition); err != nil {
+			return fmt.Errorf("Error waiting for VirtualMachine %s: %v", vmiName, err)
+		}
+		fmt.Printf("VM %s was %s\n", vmiName, strings.ToLower(bulkDone[o.command]))
+	}
+	return nil
+}
+
+// skipReason returns why a lifecycle command isn't applied to a VM selected by --selector or --all with the given
+// status, or an empty string if it is
+func skipReason(command string, status v1.VirtualMachinePrintableStatus) string {
+	switch command {
+	case COMMAND_START:
+		if status == v1.VirtualMachineStatusRunning || status == v1.VirtualMachineStatusPaused || status == v1.VirtualMachineStatusMigrating {
+			return "VM is already running"
+		}
+	case COMMAND_STOP:
+		if status == v1.VirtualMachineStatusStopped {
+			return "VM is already stopped"
+		}
+	case COMMAND_RESTART, COMMAND_MIGRATE:
+		if status == v1.VirtualMachineStatusStopped {
+			return "VM is not running"
+		}
+	}
+	return ""
+}
+
+// lifecycleAction validates the flags of a lifecycle command and returns the action applying it to a VM
+func (o *Command) lifecycleAction(namespace string, virtClient kubecli.KubevirtClient, dryRunOption []string) (bulk.Action, error) {
+	switch o.command {
+	case COMMAND_START:
+		return func(ctx context.Context, name string) (bulk.Condition, error) {
+			err := virtClient.VirtualMachine(namespace).Start(ctx, name, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
+			if err != nil {
+				return nil, fmt.Errorf("Error starting VirtualMachine %v", err)
+			}
+			target := v1.VirtualMachineStatusRunning
+			if startPaused {
+				target = v1.VirtualMachineStatusPaused
+			}
+			return vmStatusCondition(virtClient, namespace, name, target), nil
+		}, nil
+	case COMMAND_STOP:
+		if gracePeriodIsSet(gracePeriod) && !forceRestart {
+			return nil, fmt.Errorf("Can not set gracePeriod without --force=true")
+		}
+		if forceRestart && !gracePeriodIsSet(gracePeriod) {
+			return nil, fmt.Errorf("Can not force stop without gracePeriod")
+		}
+		return func(ctx context.Context, name string) (bulk.Condition, error) {
+			if forceRestart {
+				err := virtClient.VirtualMachine(namespace).ForceStop(ctx, name, &v1.StopOptions{GracePeriod: &gracePeriod, DryRun: dryRunOption})
+				if err != nil {
+					return nil, fmt.Errorf("Error force stopping VirtualMachine, %v", err)
+				}
+			} else {
+				err := virtClient.VirtualMachine(namespace).Stop(ctx, name, &v1.StopOptions{DryRun: dryRunOption})
+				if err != nil {
+					return nil, fmt.Errorf("Error stopping VirtualMachine %v", err)
+				}
+			}
+			return vmStatusCondition(virtClient, namespace, name, v1.VirtualMachineStatusStopped), nil
+		}, nil
+	case COMMAND_RESTART:
+		if gracePeriodIsSet(gracePeriod) && !forceRestart {
+			return nil, fmt.Errorf("Can not set gracePeriod without --force=true")
+		}
+		if forceRestart && !gracePeriodIsSet(gracePeriod) {
+			return nil, fmt.Errorf("Can not force restart without gracePeriod")
+		}
+		return func(ctx context.Context, name string) (bulk.Condition, error) {
+			// The VMI is replaced by a new one on restart, its UID tells them apart
+			var oldUID types.UID
+			if o.bulkOptions.Wait {
+				vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, name, &metav1.GetOptions{})
+				if err != nil && !errors.IsNotFound(err) {
+					return nil, fmt.Errorf("Error getting VirtualMachineInstance %s: %v", name, err)
+				}
+				if err == nil {
+					oldUID = vmi.UID
+				}
+			}
+			if forceRestart {
+				err := virtClient.VirtualMachine(namespace).ForceRestart(ctx, name, &v1.RestartOptions{GracePeriodSeconds: &gracePeriod, DryRun: dryRunOption})
+				if err != nil {
+					return nil, fmt.Errorf("Error restarting VirtualMachine, %v", err)
+				}
+			} else {
+				err := virtClient.VirtualMachine(namespace).Restart(ctx, name, &v1.RestartOptions{DryRun: dryRunOption})
+				if err != nil {
+					return nil, fmt.Errorf("Error restarting VirtualMachine %v", err)
+				}
+			}
+			return vmiRestartedCondition(virtClient, namespace, name, oldUID), nil
+		}, nil
+	case COMMAND_MIGRATE:
+		return func(ctx context.Context, name string) (bulk.Condition, error) {
+			// The migration state of the VMI is the one of its latest migration, its UID tells them apart
+			var oldMigrationUID types.UID
+			if o.bulkOptions.Wait {
+				vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, name, &metav1.GetOptions{})
+				if err != nil {
+					return nil, fmt.Errorf("Error getting VirtualMachineInstance %s: %v", name, err)
+				}
+				if vmi.Status.MigrationState != nil {
+					oldMigrationUID = vmi.Status.MigrationState.MigrationUID
+				}
+			}
+			err := virtClient.VirtualMachine(namespace).Migrate(ctx, name, &v1.MigrateOptions{DryRun: dryRunOption})
+			if err != nil {
+				return nil, fmt.Errorf("Error migrating VirtualMachine %v", err)
+			}
+			return vmiMigratedCondition(virtClient, namespace, name, oldMigrationUID), nil
+		}, nil
+	}
+	return nil, fmt.Errorf("unsupported command %s", o.command)
+}
+
+func vmStatusCondition(virtClient kubecli.KubevirtClient, namespace, name string, target v1.VirtualMachinePrintableStatus) bulk.Condition {
+	return func(ctx context.Context) (bool, string, error) {
+		vm, err := virtClient.VirtualMachine(namespace).Get(ctx, name, &metav1.GetOptions{})
+		if err != nil {
+			return false, "", fmt.Errorf("Error getting VirtualMachine %s: %v", name, err)
+		}
+		return vm.Status.PrintableStatus == target, string(vm.Status.PrintableStatus), nil
+	}
+}
+
+func vmiRestartedCondition(virtClient kubecli.KubevirtClient, namespace, name string, oldUID types.UID) bulk.Condition {
+	return func(ctx context.Context) (bool, string, error) {
+		vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, name, &metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return false, "waiting for the new VMI", nil
+		} else if err != nil {
+			return false, "", fmt.Errorf("Error getting VirtualMachineInstance %s: %v", name, err)
+		}
+		if vmi.UID == oldUID {
+			return false, "waiting for the new VMI", nil
+		}
+		return vmi.Status.Phase == v1.Running, string(vmi.Status.Phase), nil
+	}
+}
+
+func vmiMigratedCondition(virtClient kubecli.KubevirtClient, namespace, name string, oldMigrationUID types.UID) bulk.Condition {
+	return func(ctx context.Context) (bool, string, error) {
+		vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, name, &metav1.GetOptions{})
+		if err != nil {
+			return false, "", fmt.Errorf("Error getting VirtualMachineInstance %s: %v", name, err)
+		}
+		state := vmi.Status.MigrationState
+		if state == nil || state.MigrationUID == oldMigrationUID {
+			return false, "migration pending", nil
+		}
+		if state.Failed {
+			return false, "", fmt.Errorf("the migration to node %s failed", state.TargetNode)
+		}
+		return state.Completed, fmt.Sprintf("migrating to node %s", state.TargetNode), nil
+	}
+}
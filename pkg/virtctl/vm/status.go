@@ -0,0 +1,561 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package vm
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/clientcmd"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_VM     = "vm"
+	COMMAND_STATUS = "status"
+
+	eventsArg = "events"
+
+	defaultStatusEvents = 10
+)
+
+// printableStatusExplanations explains the printable statuses of the VMs, and where to look for the cause
+var printableStatusExplanations = map[v1.VirtualMachinePrintableStatus]string{
+	v1.VirtualMachineStatusStopped:                 "The VM is stopped and isn't expected to start, it can be started with 'virtctl start'.",
+	v1.VirtualMachineStatusProvisioning:            "The volumes of the VM are being provisioned, see the progress of the DataVolumes.",
+	v1.VirtualMachineStatusStarting:                "The VMI was created and its launcher pod is being scheduled and started.",
+	v1.VirtualMachineStatusRunning:                 "The VM is running.",
+	v1.VirtualMachineStatusPaused:                  "The VM is paused, see the Paused condition of the VMI for the reason.",
+	v1.VirtualMachineStatusStopping:                "The VM is being stopped, the VMI is shutting down.",
+	v1.VirtualMachineStatusTerminating:             "The VM is being deleted, along with its VMI and DataVolumes.",
+	v1.VirtualMachineStatusCrashLoopBackOff:        "The VMI failed repeatedly, the next start is delayed. See the events for the failures.",
+	v1.VirtualMachineStatusMigrating:               "The VMI is being migrated to another node, see the migration.",
+	v1.VirtualMachineStatusUnknown:                 "The state of the VMI can't be determined, usually because its node isn't reachable.",
+	v1.VirtualMachineStatusUnschedulable:           "The launcher pod can't be scheduled, see the scheduling failure of the pod.",
+	v1.VirtualMachineStatusErrImagePull:            "An image of a containerDisk or of the launcher can't be pulled, see the containers of the pod.",
+	v1.VirtualMachineStatusImagePullBackOff:        "An image of a containerDisk or of the launcher can't be pulled, kubelet is backing off before retrying.",
+	v1.VirtualMachineStatusPvcNotFound:             "A volume of the VM references a PVC which doesn't exist.",
+	v1.VirtualMachineStatusDataVolumeError:         "A DataVolume of the VM reports an error, see the DataVolumes and the events.",
+	v1.VirtualMachineStatusWaitingForVolumeBinding: "A PVC of the VM isn't bound yet, usually waiting for the first consumer or for a provisioner.",
+}
+
+// NewVMCommand returns the parent of the commands inspecting a VirtualMachine
+func NewVMCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_VM,
+		Short: "Inspect a virtual machine.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Printf(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(NewStatusCommand(clientConfig))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func NewStatusCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	c := Status{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:   "status (VM)",
+		Short: "Explain the status of a virtual machine.",
+		Long: `Explain the status of a virtual machine, with the conditions of the VM and of the VMI, the launcher pod and
+its scheduling failures, the migration, the progress of the DataVolumes, the recent events and the guest agent data.`,
+		Example: usageStatus(),
+		Args:    templates.ExactArgs(COMMAND_STATUS, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.Flags().IntVar(&c.events, eventsArg, defaultStatusEvents, "Number of recent events to show, all of them are shown if negative.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usageStatus() string {
+	return `  # Explain why the virtual machine 'myvm' isn't running:
+  {{ProgramName}} vm status myvm
+
+  # Show the 30 most recent events:
+  {{ProgramName}} vm status myvm --events=30`
+}
+
+type Status struct {
+	clientConfig clientcmd.ClientConfig
+	events       int
+}
+
+// dataVolumeStatus is the DataVolume of a volume, nil if it doesn't exist
+type dataVolumeStatus struct {
+	name string
+	dv   *cdiv1.DataVolume
+}
+
+// vmStatus gathers the objects a VM status is made of
+type vmStatus struct {
+	vm          *v1.VirtualMachine
+	vmi         *v1.VirtualMachineInstance
+	pods        []k8sv1.Pod
+	migration   *v1.VirtualMachineInstanceMigration
+	dataVolumes []dataVolumeStatus
+	events      []k8sv1.Event
+	guestInfo   *v1.VirtualMachineInstanceGuestAgentInfo
+	// warnings are the parts of the status which couldn't be fetched
+	warnings []string
+}
+
+func (o *Status) Run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := o.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtClient, err := kubecli.GetKubevirtClientFromClientConfig(o.clientConfig)
+	if err != nil {
+		return fmt.Errorf("Cannot obtain KubeVirt client: %v", err)
+	}
+
+	status, err := getVMStatus(virtClient, namespace, args[0], o.events)
+	if err != nil {
+		return err
+	}
+	return status.print(cmd.OutOrStdout())
+}
+
+func getVMStatus(virtClient kubecli.KubevirtClient, namespace, name string, maxEvents int) (*vmStatus, error) {
+	ctx := context.Background()
+	status := &vmStatus{}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(ctx, name, &metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting VirtualMachine %s: %v", name, err)
+	}
+	status.vm = vm
+
+	vmi, err := virtClient.VirtualMachineInstance(namespace).Get(ctx, name, &metav1.GetOptions{})
+	if err == nil {
+		status.vmi = vmi
+	} else if !errors.IsNotFound(err) {
+		status.warn("VirtualMachineInstance: %v", err)
+	}
+
+	if status.vmi != nil {
+		pods, err := virtClient.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", v1.CreatedByLabel, status.vmi.UID),
+		})
+		if err != nil {
+			status.warn("launcher pods: %v", err)
+		} else {
+			status.pods = launcherPods(pods.Items, status.vmi)
+		}
+
+		migrations, err := virtClient.VirtualMachineInstanceMigration(namespace).List(&metav1.ListOptions{
+			LabelSelector: fmt.Sprintf("%s=%s", v1.MigrationSelectorLabel, name),
+		})
+		if err != nil {
+			status.warn("migrations: %v", err)
+		} else {
+			status.migration = latestMigration(migrations.Items, name)
+		}
+
+		if isAgentConnected(status.vmi) {
+			guestInfo, err := virtClient.VirtualMachineInstance(namespace).GuestOsInfo(ctx, name)
+			if err != nil {
+				status.warn("guest agent: %v", err)
+			} else {
+				status.guestInfo = &guestInfo
+			}
+		}
+	}
+
+	for _, dvName := range dataVolumeNames(vm) {
+		dv, err := virtClient.CdiClient().CdiV1beta1().DataVolumes(namespace).Get(ctx, dvName, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			status.dataVolumes = append(status.dataVolumes, dataVolumeStatus{name: dvName})
+		} else if err != nil {
+			status.warn("DataVolume %s: %v", dvName, err)
+		} else {
+			status.dataVolumes = append(status.dataVolumes, dataVolumeStatus{name: dvName, dv: dv})
+		}
+	}
+
+	events, err := status.getEvents(virtClient, namespace)
+	if err != nil {
+		status.warn("events: %v", err)
+	}
+	if maxEvents >= 0 && len(events) > maxEvents {
+		events = events[len(events)-maxEvents:]
+	}
+	status.events = events
+
+	return status, nil
+}
+
+func (s *vmStatus) warn(format string, args ...interface{}) {
+	s.warnings = append(s.warnings, fmt.Sprintf(format, args...))
+}
+
+// launcherPods returns the launcher pods of the VMI, the pod of the source and of the target during a migration
+func launcherPods(pods []k8sv1.Pod, vmi *v1.VirtualMachineInstance) []k8sv1.Pod {
+	var launchers []k8sv1.Pod
+	for _, pod := range pods {
+		if _, isActive := vmi.Status.ActivePods[pod.UID]; isActive || pod.DeletionTimestamp == nil {
+			launchers = append(launchers, pod)
+		}
+	}
+	sort.Slice(launchers, func(i, j int) bool {
+		return launchers[i].CreationTimestamp.Before(&launchers[j].CreationTimestamp)
+	})
+	return launchers
+}
+
+func latestMigration(migrations []v1.VirtualMachineInstanceMigration, vmiName string) *v1.VirtualMachineInstanceMigration {
+	var latest *v1.VirtualMachineInstanceMigration
+	for i := range migrations {
+		migration := &migrations[i]
+		if migration.Spec.VMIName != vmiName {
+			continue
+		}
+		if latest == nil || latest.CreationTimestamp.Before(&migration.CreationTimestamp) {
+			latest = migration
+		}
+	}
+	return latest
+}
+
+func isAgentConnected(vmi *v1.VirtualMachineInstance) bool {
+	for _, cond := range vmi.Status.Conditions {
+		if cond.Type == v1.VirtualMachineInstanceAgentConnected && cond.Status == k8sv1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+func dataVolumeNames(vm *v1.VirtualMachine) []string {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	var names []string
+	for _, volume := range vm.Spec.Template.Spec.Volumes {
+		if volume.DataVolume != nil {
+			names = append(names, volume.DataVolume.Name)
+		}
+	}
+	return names
+}
+
+// getEvents returns the events of the VM, the VMI and the launcher pods, the oldest first
+func (s *vmStatus) getEvents(virtClient kubecli.KubevirtClient, namespace string) ([]k8sv1.Event, error) {
+	uids := []types.UID{s.vm.UID}
+	if s.vmi != nil {
+		uids = append(uids, s.vmi.UID)
+	}
+	for _, pod := range s.pods {
+		uids = append(uids, pod.UID)
+	}
+
+	var events []k8sv1.Event
+	seen := map[types.UID]bool{}
+	for _, uid := range uids {
+		if uid == "" || seen[uid] {
+			continue
+		}
+		seen[uid] = true
+		list, err := virtClient.CoreV1().Events(namespace).List(context.Background(), metav1.ListOptions{
+			FieldSelector: fmt.Sprintf("involvedObject.uid=%s", uid),
+		})
+		if err != nil {
+			return events, err
+		}
+		for _, event := range list.Items {
+			// Field selectors are not supported by every client, the involved object is checked again
+			if event.InvolvedObject.UID == uid {
+				events = append(events, event)
+			}
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return eventTime(events[i]).Before(eventTime(events[j]))
+	})
+	return events, nil
+}
+
+func eventTime(event k8sv1.Event) time.Time {
+	switch {
+	case !event.LastTimestamp.IsZero():
+		return event.LastTimestamp.Time
+	case !event.EventTime.IsZero():
+		return event.EventTime.Time
+	default:
+		return event.FirstTimestamp.Time
+	}
+}
+
+func (s *vmStatus) print(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	fmt.Fprintf(w, "Name:\t%s\n", s.vm.Name)
+	fmt.Fprintf(w, "Namespace:\t%s\n", s.vm.Namespace)
+	fmt.Fprintf(w, "Status:\t%s\n", s.vm.Status.PrintableStatus)
+	if explanation, ok := printableStatusExplanations[s.vm.Status.PrintableStatus]; ok {
+		fmt.Fprintf(w, "\t%s\n", explanation)
+	}
+	if failure := s.vm.Status.StartFailure; failure != nil {
+		retry := ""
+		if failure.RetryAfterTimestamp != nil {
+			retry = fmt.Sprintf(", next start in %s", humanDuration(time.Until(failure.RetryAfterTimestamp.Time)))
+		}
+		fmt.Fprintf(w, "Start failures:\t%d consecutive%s\n", failure.ConsecutiveFailCount, retry)
+	}
+	if runStrategy, err := s.vm.RunStrategy(); err == nil {
+		fmt.Fprintf(w, "Run strategy:\t%s\n", runStrategy)
+	}
+	fmt.Fprintf(w, "Ready:\t%t\n", s.vm.Status.Ready)
+	printVMConditions(w, s.vm.Status.Conditions)
+
+	fmt.Fprintln(w)
+	if s.vmi == nil {
+		fmt.Fprintln(w, "VirtualMachineInstance:\t<none>")
+	} else {
+		fmt.Fprintln(w, "VirtualMachineInstance:")
+		fmt.Fprintf(w, "  Phase:\t%s\n", s.vmi.Status.Phase)
+		if s.vmi.Status.Reason != "" {
+			fmt.Fprintf(w, "  Reason:\t%s\n", s.vmi.Status.Reason)
+		}
+		fmt.Fprintf(w, "  Node:\t%s\n", valueOrNone(s.vmi.Status.NodeName))
+		fmt.Fprintf(w, "  IP addresses:\t%s\n", valueOrNone(strings.Join(vmiIPs(s.vmi), ", ")))
+		printVMIConditions(w, s.vmi.Status.Conditions)
+	}
+
+	for _, pod := range s.pods {
+		fmt.Fprintln(w)
+		printPod(w, &pod)
+	}
+
+	if s.migration != nil || (s.vmi != nil && s.vmi.Status.MigrationState != nil) {
+		fmt.Fprintln(w)
+		printMigration(w, s.migration, s.vmi)
+	}
+
+	if len(s.dataVolumes) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "DataVolumes:")
+		fmt.Fprintln(w, "  NAME\tPHASE\tPROGRESS\tMESSAGE")
+		for _, dvStatus := range s.dataVolumes {
+			dv := dvStatus.dv
+			if dv == nil {
+				fmt.Fprintf(w, "  %s\t<not found>\t\t\n", dvStatus.name)
+				continue
+			}
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", dv.Name, valueOrNone(string(dv.Status.Phase)), valueOrNone(string(dv.Status.Progress)), dataVolumeMessage(dv))
+		}
+	}
+
+	if s.guestInfo != nil {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Guest agent:")
+		fmt.Fprintf(w, "  Version:\t%s\n", s.guestInfo.GAVersion)
+		fmt.Fprintf(w, "  Hostname:\t%s\n", s.guestInfo.Hostname)
+		fmt.Fprintf(w, "  OS:\t%s\n", valueOrNone(s.guestInfo.OS.PrettyName))
+		fmt.Fprintf(w, "  Kernel:\t%s\n", valueOrNone(s.guestInfo.OS.KernelRelease))
+		fmt.Fprintf(w, "  Timezone:\t%s\n", valueOrNone(s.guestInfo.Timezone))
+		if len(s.guestInfo.UserList) > 0 {
+			var users []string
+			for _, user := range s.guestInfo.UserList {
+				users = append(users, user.UserName)
+			}
+			fmt.Fprintf(w, "  Users:\t%s\n", strings.Join(users, ", "))
+		}
+	} else if s.vmi != nil && s.vmi.Status.Phase == v1.Running {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Guest agent:\t<not connected>")
+	}
+
+	fmt.Fprintln(w)
+	if len(s.events) == 0 {
+		fmt.Fprintln(w, "Events:\t<none>")
+	} else {
+		fmt.Fprintln(w, "Events:")
+		fmt.Fprintln(w, "  LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE")
+		for _, event := range s.events {
+			fmt.Fprintf(w, "  %s\t%s\t%s\t%s/%s\t%s\n", humanDuration(time.Since(eventTime(event))), event.Type, event.Reason,
+				strings.ToLower(event.InvolvedObject.Kind), event.InvolvedObject.Name, strings.TrimSpace(event.Message))
+		}
+	}
+
+	if len(s.warnings) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Could not get:")
+		for _, warning := range s.warnings {
+			fmt.Fprintf(w, "  %s\n", warning)
+		}
+	}
+
+	return w.Flush()
+}
+
+func printVMConditions(w io.Writer, conditions []v1.VirtualMachineCondition) {
+	if len(conditions) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "Conditions:")
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tMESSAGE")
+	for _, cond := range conditions {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+}
+
+func printVMIConditions(w io.Writer, conditions []v1.VirtualMachineInstanceCondition) {
+	if len(conditions) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "  Conditions:")
+	fmt.Fprintln(w, "    TYPE\tSTATUS\tREASON\tMESSAGE")
+	for _, cond := range conditions {
+		fmt.Fprintf(w, "    %s\t%s\t%s\t%s\n", cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+}
+
+func printPod(w io.Writer, pod *k8sv1.Pod) {
+	fmt.Fprintf(w, "Launcher pod:\t%s\n", pod.Name)
+	fmt.Fprintf(w, "  Phase:\t%s\n", pod.Status.Phase)
+	fmt.Fprintf(w, "  Node:\t%s\n", valueOrNone(pod.Spec.NodeName))
+	for _, cond := range pod.Status.Conditions {
+		if cond.Type == k8sv1.PodScheduled && cond.Status == k8sv1.ConditionFalse {
+			fmt.Fprintf(w, "  Scheduling failure:\t%s: %s\n", cond.Reason, cond.Message)
+		}
+	}
+
+	statuses := append(append([]k8sv1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+	if len(statuses) == 0 {
+		return
+	}
+	fmt.Fprintln(w, "  Containers:")
+	fmt.Fprintln(w, "    NAME\tSTATE\tREASON\tMESSAGE")
+	for _, status := range statuses {
+		state, reason, message := containerState(status.State)
+		fmt.Fprintf(w, "    %s\t%s\t%s\t%s\n", status.Name, state, reason, message)
+	}
+}
+
+func containerState(state k8sv1.ContainerState) (string, string, string) {
+	switch {
+	case state.Waiting != nil:
+		return "Waiting", state.Waiting.Reason, state.Waiting.Message
+	case state.Terminated != nil:
+		return "Terminated", state.Terminated.Reason, state.Terminated.Message
+	case state.Running != nil:
+		return "Running", "", ""
+	default:
+		return "Unknown", "", ""
+	}
+}
+
+func printMigration(w io.Writer, migration *v1.VirtualMachineInstanceMigration, vmi *v1.VirtualMachineInstance) {
+	fmt.Fprintln(w, "Migration:")
+	if migration != nil {
+		fmt.Fprintf(w, "  Name:\t%s\n", migration.Name)
+		fmt.Fprintf(w, "  Phase:\t%s\n", migration.Status.Phase)
+	}
+	if vmi == nil || vmi.Status.MigrationState == nil {
+		return
+	}
+	state := vmi.Status.MigrationState
+	fmt.Fprintf(w, "  Source node:\t%s\n", valueOrNone(state.SourceNode))
+	fmt.Fprintf(w, "  Target node:\t%s\n", valueOrNone(state.TargetNode))
+	if state.StartTimestamp != nil {
+		fmt.Fprintf(w, "  Started:\t%s ago\n", humanDuration(time.Since(state.StartTimestamp.Time)))
+	}
+	switch {
+	case state.Failed:
+		fmt.Fprintln(w, "  Result:\tFailed")
+	case state.Completed:
+		fmt.Fprintln(w, "  Result:\tCompleted")
+	case state.AbortRequested:
+		fmt.Fprintf(w, "  Result:\tAbort %s\n", valueOrNone(string(state.AbortStatus)))
+	}
+}
+
+// dataVolumeMessage returns the message of the first condition explaining why the DataVolume isn't ready
+func dataVolumeMessage(dv *cdiv1.DataVolume) string {
+	for _, cond := range dv.Status.Conditions {
+		if cond.Type == cdiv1.DataVolumeReady && cond.Status == k8sv1.ConditionTrue {
+			return ""
+		}
+	}
+	for _, cond := range dv.Status.Conditions {
+		if cond.Message != "" && cond.Status != k8sv1.ConditionTrue {
+			return cond.Message
+		}
+	}
+	return ""
+}
+
+func vmiIPs(vmi *v1.VirtualMachineInstance) []string {
+	var ips []string
+	for _, iface := range vmi.Status.Interfaces {
+		if len(iface.IPs) > 0 {
+			ips = append(ips, iface.IPs...)
+		} else if iface.IP != "" {
+			ips = append(ips, iface.IP)
+		}
+	}
+	return ips
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+func humanDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	switch {
+	case d < time.Minute:
+		return fmt.Sprintf("%ds", int(d.Seconds()))
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 48*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+}
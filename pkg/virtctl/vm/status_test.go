@@ -0,0 +1,227 @@
+package vm_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	cdifake "kubevirt.io/client-go/generated/containerized-data-importer/clientset/versioned/fake"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"kubevirt.io/kubevirt/tests/clientcmd"
+)
+
+var _ = Describe("VirtualMachine status", func() {
+
+	var vmInterface *kubecli.MockVirtualMachineInterface
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+	var migrationInterface *kubecli.MockVirtualMachineInstanceMigrationInterface
+	var vm *v1.VirtualMachine
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmInterface = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		migrationInterface = kubecli.NewMockVirtualMachineInstanceMigrationInterface(ctrl)
+
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstanceMigration(k8smetav1.NamespaceDefault).Return(migrationInterface).AnyTimes()
+
+		runStrategy := v1.RunStrategyAlways
+		vm = &v1.VirtualMachine{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmName, Namespace: k8smetav1.NamespaceDefault, UID: "vm-uid"},
+			Spec: v1.VirtualMachineSpec{
+				RunStrategy: &runStrategy,
+				Template: &v1.VirtualMachineInstanceTemplateSpec{
+					Spec: v1.VirtualMachineInstanceSpec{
+						Volumes: []v1.Volume{{
+							Name: "rootdisk",
+							VolumeSource: v1.VolumeSource{
+								DataVolume: &v1.DataVolumeSource{Name: "testvm-rootdisk"},
+							},
+						}},
+					},
+				},
+			},
+		}
+	})
+
+	withClients := func(k8sObjects []runtime.Object, cdiObjects ...runtime.Object) {
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(fake.NewSimpleClientset(k8sObjects...).CoreV1()).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().CdiClient().Return(cdifake.NewSimpleClientset(cdiObjects...)).AnyTimes()
+	}
+
+	newEvent := func(name string, uid string, kind string, reason string, message string) *k8sv1.Event {
+		return &k8sv1.Event{
+			ObjectMeta:     k8smetav1.ObjectMeta{Name: name, Namespace: k8smetav1.NamespaceDefault},
+			InvolvedObject: k8sv1.ObjectReference{Kind: kind, Name: name, UID: types.UID(uid)},
+			Type:           k8sv1.EventTypeWarning,
+			Reason:         reason,
+			Message:        message,
+			LastTimestamp:  k8smetav1.Now(),
+		}
+	}
+
+	It("should explain why the VM can't be scheduled", func() {
+		vm.Status.PrintableStatus = v1.VirtualMachineStatusUnschedulable
+		vm.Status.Conditions = []v1.VirtualMachineCondition{{
+			Type: v1.VirtualMachineReady, Status: k8sv1.ConditionFalse, Reason: "PodNotScheduled",
+		}}
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmName, Namespace: k8smetav1.NamespaceDefault, UID: "vmi-uid"},
+			Status:     v1.VirtualMachineInstanceStatus{Phase: v1.Scheduling},
+		}
+		pod := &k8sv1.Pod{
+			ObjectMeta: k8smetav1.ObjectMeta{
+				Name:      "virt-launcher-testvm-abcde",
+				Namespace: k8smetav1.NamespaceDefault,
+				UID:       "pod-uid",
+				Labels:    map[string]string{v1.CreatedByLabel: "vmi-uid"},
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodPending,
+				Conditions: []k8sv1.PodCondition{{
+					Type: k8sv1.PodScheduled, Status: k8sv1.ConditionFalse, Reason: "Unschedulable",
+					Message: "0/3 nodes are available: 3 Insufficient memory.",
+				}},
+			},
+		}
+		otherPod := &k8sv1.Pod{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "virt-launcher-othervm-abcde", Namespace: k8smetav1.NamespaceDefault, UID: "other-uid"},
+		}
+		podEvent := newEvent(pod.Name, "pod-uid", "Pod", "FailedScheduling", "0/3 nodes are available: 3 Insufficient memory.")
+		otherEvent := newEvent(otherPod.Name, "other-uid", "Pod", "Unrelated", "should not be shown")
+		dv := &v1beta1.DataVolume{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "testvm-rootdisk", Namespace: k8smetav1.NamespaceDefault},
+			Status:     v1beta1.DataVolumeStatus{Phase: v1beta1.Succeeded, Progress: "100.0%"},
+		}
+		withClients([]runtime.Object{pod, otherPod, podEvent, otherEvent}, dv)
+
+		vmInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vm, nil)
+		vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vmi, nil)
+		migrationInterface.EXPECT().List(gomock.Any()).Return(&v1.VirtualMachineInstanceMigrationList{}, nil)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("vm", "status", vmName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(SatisfyAll(
+			ContainSubstring("ErrorUnschedulable"),
+			ContainSubstring("The launcher pod can't be scheduled"),
+			ContainSubstring("PodNotScheduled"),
+			ContainSubstring("Scheduling"),
+			MatchRegexp(`Launcher pod:\s+virt-launcher-testvm-abcde`),
+			MatchRegexp(`Scheduling failure:\s+Unschedulable: 0/3 nodes are available: 3 Insufficient memory.`),
+			MatchRegexp(`testvm-rootdisk\s+Succeeded\s+100.0%`),
+			ContainSubstring("FailedScheduling"),
+			Not(ContainSubstring("virt-launcher-othervm-abcde")),
+			Not(ContainSubstring("should not be shown")),
+		))
+	})
+
+	It("should show the VM without VMI and the missing DataVolumes", func() {
+		vm.Status.PrintableStatus = v1.VirtualMachineStatusStopped
+		withClients(nil)
+
+		vmInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vm, nil)
+		vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vmName))
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("vm", "status", vmName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(SatisfyAll(
+			ContainSubstring("The VM is stopped"),
+			MatchRegexp(`Run strategy:\s+Always`),
+			MatchRegexp(`VirtualMachineInstance:\s+<none>`),
+			MatchRegexp(`testvm-rootdisk\s+<not found>`),
+			MatchRegexp(`Events:\s+<none>`),
+		))
+	})
+
+	It("should show the guest agent data and the migration of a running VM", func() {
+		vm.Status.PrintableStatus = v1.VirtualMachineStatusMigrating
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: vmName, Namespace: k8smetav1.NamespaceDefault, UID: "vmi-uid"},
+			Status: v1.VirtualMachineInstanceStatus{
+				Phase:    v1.Running,
+				NodeName: "node01",
+				Conditions: []v1.VirtualMachineInstanceCondition{{
+					Type: v1.VirtualMachineInstanceAgentConnected, Status: k8sv1.ConditionTrue,
+				}},
+				Interfaces: []v1.VirtualMachineInstanceNetworkInterface{{Name: "default", IPs: []string{"10.0.0.5", "fd00::5"}}},
+				MigrationState: &v1.VirtualMachineInstanceMigrationState{
+					SourceNode: "node01",
+					TargetNode: "node02",
+				},
+			},
+		}
+		migrations := &v1.VirtualMachineInstanceMigrationList{Items: []v1.VirtualMachineInstanceMigration{
+			{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "old-migration", CreationTimestamp: k8smetav1.Unix(1000, 0)},
+				Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vmName},
+				Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationSucceeded},
+			},
+			{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "new-migration", CreationTimestamp: k8smetav1.Unix(2000, 0)},
+				Spec:       v1.VirtualMachineInstanceMigrationSpec{VMIName: vmName},
+				Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationRunning},
+			},
+		}}
+		withClients(nil)
+
+		vmInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vm, nil)
+		vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vmi, nil)
+		vmiInterface.EXPECT().GuestOsInfo(gomock.Any(), vmName).Return(v1.VirtualMachineInstanceGuestAgentInfo{
+			GAVersion: "5.1.0",
+			Hostname:  "testvm.example.com",
+			OS:        v1.VirtualMachineInstanceGuestOSInfo{PrettyName: "Fedora Linux 37", KernelRelease: "6.0.7"},
+			UserList:  []v1.VirtualMachineInstanceGuestOSUser{{UserName: "fedora"}},
+		}, nil)
+		migrationInterface.EXPECT().List(gomock.Any()).Return(migrations, nil)
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("vm", "status", vmName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(SatisfyAll(
+			ContainSubstring("10.0.0.5, fd00::5"),
+			ContainSubstring("new-migration"),
+			Not(ContainSubstring("old-migration")),
+			MatchRegexp(`Target node:\s+node02`),
+			ContainSubstring("Fedora Linux 37"),
+			ContainSubstring("testvm.example.com"),
+			ContainSubstring("fedora"),
+		))
+	})
+
+	It("should report the parts of the status which can't be fetched", func() {
+		vm.Status.PrintableStatus = v1.VirtualMachineStatusUnknown
+		withClients(nil, &v1beta1.DataVolume{ObjectMeta: k8smetav1.ObjectMeta{Name: "testvm-rootdisk", Namespace: k8smetav1.NamespaceDefault}})
+
+		vmInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vm, nil)
+		vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(nil, errors.NewForbidden(v1.Resource("virtualmachineinstance"), vmName, nil))
+
+		out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("vm", "status", vmName)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(SatisfyAll(
+			ContainSubstring("Could not get:"),
+			ContainSubstring("VirtualMachineInstance: virtualmachineinstance.kubevirt.io \"testvm\" is forbidden"),
+		))
+	})
+
+	It("should fail if the VM doesn't exist", func() {
+		vmInterface.EXPECT().Get(context.Background(), nonExistingVM, gomock.Any()).Return(nil, errors.NewNotFound(v1.Resource("virtualmachine"), nonExistingVM))
+
+		_, err := clientcmd.NewRepeatableVirtctlCommandWithOut("vm", "status", nonExistingVM)()
+		Expect(err).To(MatchError(ContainSubstring("Error getting VirtualMachine non-existing-vm")))
+	})
+})
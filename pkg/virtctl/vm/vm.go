@@ -36,23 +36,28 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
 const (
-	COMMAND_START          = "start"
-	COMMAND_STOP           = "stop"
-	COMMAND_RESTART        = "restart"
-	COMMAND_MIGRATE        = "migrate"
-	COMMAND_MIGRATE_CANCEL = "migrate-cancel"
-	COMMAND_GUESTOSINFO    = "guestosinfo"
-	COMMAND_USERLIST       = "userlist"
-	COMMAND_FSLIST         = "fslist"
-	COMMAND_ADDVOLUME      = "addvolume"
-	COMMAND_REMOVEVOLUME   = "removevolume"
-	COMMAND_EXPAND         = "expand"
+	COMMAND_START            = "start"
+	COMMAND_STOP             = "stop"
+	COMMAND_RESTART          = "restart"
+	COMMAND_MIGRATE          = "migrate"
+	COMMAND_MIGRATE_CANCEL   = "migrate-cancel"
+	COMMAND_GUESTOSINFO      = "guestosinfo"
+	COMMAND_USERLIST         = "userlist"
+	COMMAND_FSLIST           = "fslist"
+	COMMAND_ADDVOLUME        = "addvolume"
+	COMMAND_REMOVEVOLUME     = "removevolume"
+	COMMAND_ADDHOSTDEVICE    = "addhostdevice"
+	COMMAND_REMOVEHOSTDEVICE = "removehostdevice"
+	COMMAND_EXPAND           = "expand"
 
 	volumeNameArg         = "volume-name"
+	hostDeviceNameArg     = "host-device-name"
+	deviceNameArg         = "device-name"
 	notDefinedGracePeriod = -1
 	dryRunCommandUsage    = "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes."
 
@@ -74,45 +79,51 @@ const (
 )
 
 var (
-	vmiName      string
-	vmName       string
-	forceRestart bool
-	gracePeriod  int64
-	volumeName   string
-	serial       string
-	persist      bool
-	startPaused  bool
-	dryRun       bool
-	cache        string
-	filePath     string
-	outputFormat string
+	vmiName        string
+	vmName         string
+	forceRestart   bool
+	gracePeriod    int64
+	volumeName     string
+	hostDeviceName string
+	deviceName     string
+	serial         string
+	persist        bool
+	startPaused    bool
+	dryRun         bool
+	cache          string
+	filePath       string
+	outputFormat   string
 )
 
 func NewStartCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	bulkOptions := &bulk.Options{}
 	cmd := &cobra.Command{
 		Use:     "start (VM)",
 		Short:   "Start a virtual machine.",
-		Example: usage(COMMAND_START),
-		Args:    templates.ExactArgs("start", 1),
+		Example: usage(COMMAND_START) + bulkUsage(COMMAND_START),
+		Args:    bulkOptions.ExactArgs("start", 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			c := Command{command: COMMAND_START, clientConfig: clientConfig}
+			c := Command{command: COMMAND_START, clientConfig: clientConfig, cmd: cmd, bulkOptions: bulkOptions}
 			return c.Run(args)
 		},
 	}
 	cmd.Flags().BoolVar(&startPaused, pausedArg, false, "--paused=false: If set to true, start virtual machine in paused state")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	bulkOptions.AddFlags(cmd, bulkKind)
+	bulkOptions.AddWaitFlags(cmd, bulkKind)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func NewStopCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	bulkOptions := &bulk.Options{}
 	cmd := &cobra.Command{
 		Use:     "stop (VM)",
 		Short:   "Stop a virtual machine.",
-		Example: usage(COMMAND_STOP),
-		Args:    templates.ExactArgs("stop", 1),
+		Example: usage(COMMAND_STOP) + bulkUsage(COMMAND_STOP),
+		Args:    bulkOptions.ExactArgs("stop", 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			c := Command{command: COMMAND_STOP, clientConfig: clientConfig}
+			c := Command{command: COMMAND_STOP, clientConfig: clientConfig, cmd: cmd, bulkOptions: bulkOptions}
 			return c.Run(args)
 		},
 	}
@@ -120,40 +131,48 @@ func NewStopCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd.Flags().BoolVar(&forceRestart, forceArg, false, "--force=false: Only used when grace-period=0. If true, immediately remove VMI pod from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation.")
 	cmd.Flags().Int64Var(&gracePeriod, gracePeriodArg, notDefinedGracePeriod, "--grace-period=-1: Period of time in seconds given to the VMI to terminate gracefully. Can only be set to 0 when --force is true (force deletion). Currently only setting 0 is supported.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	bulkOptions.AddFlags(cmd, bulkKind)
+	bulkOptions.AddWaitFlags(cmd, bulkKind)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func NewRestartCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	bulkOptions := &bulk.Options{}
 	cmd := &cobra.Command{
 		Use:     "restart (VM)",
 		Short:   "Restart a virtual machine.",
-		Example: usage(COMMAND_RESTART),
-		Args:    templates.ExactArgs("restart", 1),
+		Example: usage(COMMAND_RESTART) + bulkUsage(COMMAND_RESTART),
+		Args:    bulkOptions.ExactArgs("restart", 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			c := Command{command: COMMAND_RESTART, clientConfig: clientConfig}
+			c := Command{command: COMMAND_RESTART, clientConfig: clientConfig, cmd: cmd, bulkOptions: bulkOptions}
 			return c.Run(args)
 		},
 	}
 	cmd.Flags().BoolVar(&forceRestart, forceArg, false, "--force=false: Only used when grace-period=0. If true, immediately remove VMI pod from API and bypass graceful deletion. Note that immediate deletion of some resources may result in inconsistency or data loss and requires confirmation.")
 	cmd.Flags().Int64Var(&gracePeriod, gracePeriodArg, notDefinedGracePeriod, "--grace-period=-1: Period of time in seconds given to the VMI to terminate gracefully. Can only be set to 0 when --force is true (force deletion). Currently only setting 0 is supported.")
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	bulkOptions.AddFlags(cmd, bulkKind)
+	bulkOptions.AddWaitFlags(cmd, bulkKind)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
 
 func NewMigrateCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	bulkOptions := &bulk.Options{}
 	cmd := &cobra.Command{
 		Use:     "migrate (VM)",
 		Short:   "Migrate a virtual machine.",
-		Example: usage(COMMAND_MIGRATE),
-		Args:    templates.ExactArgs("migrate", 1),
+		Example: usage(COMMAND_MIGRATE) + bulkUsage(COMMAND_MIGRATE),
+		Args:    bulkOptions.ExactArgs("migrate", 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			c := Command{command: COMMAND_MIGRATE, clientConfig: clientConfig}
+			c := Command{command: COMMAND_MIGRATE, clientConfig: clientConfig, cmd: cmd, bulkOptions: bulkOptions}
 			return c.Run(args)
 		},
 	}
 	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	bulkOptions.AddFlags(cmd, bulkKind)
+	bulkOptions.AddWaitFlags(cmd, bulkKind)
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -259,6 +278,47 @@ func NewRemoveVolumeCommand(clientConfig clientcmd.ClientConfig) *cobra.Command
 	return cmd
 }
 
+func NewAddHostDeviceCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "addhostdevice VMI",
+		Short:   "add a host device to a running VM",
+		Example: usageAddHostDevice(),
+		Args:    templates.ExactArgs("addhostdevice", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_ADDHOSTDEVICE, clientConfig: clientConfig}
+			return c.Run(args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&hostDeviceName, hostDeviceNameArg, "", "name used in hostDevices section of spec")
+	cmd.MarkFlagRequired(hostDeviceNameArg)
+	cmd.Flags().StringVar(&deviceName, deviceNameArg, "", "resource name of the device, as advertised by the device plugin")
+	cmd.MarkFlagRequired(deviceNameArg)
+	cmd.Flags().BoolVar(&persist, persistArg, false, "if set, the added host device will be persisted in the VM spec (if it exists)")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+
+	return cmd
+}
+
+func NewRemoveHostDeviceCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "removehostdevice VMI",
+		Short:   "remove a host device from a running VM",
+		Example: usageRemoveHostDevice(),
+		Args:    templates.ExactArgs("removehostdevice", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := Command{command: COMMAND_REMOVEHOSTDEVICE, clientConfig: clientConfig}
+			return c.Run(args)
+		},
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	cmd.Flags().StringVar(&hostDeviceName, hostDeviceNameArg, "", "name used in hostDevices section of spec")
+	cmd.MarkFlagRequired(hostDeviceNameArg)
+	cmd.Flags().BoolVar(&persist, persistArg, false, "if set, the removed host device will be removed from the VM spec as well (if it exists)")
+	cmd.Flags().BoolVar(&dryRun, dryRunArg, false, dryRunCommandUsage)
+	return cmd
+}
+
 func NewExpandCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "expand (VM)",
@@ -309,6 +369,7 @@ type Command struct {
 	clientConfig clientcmd.ClientConfig
 	command      string
 	cmd          *cobra.Command
+	bulkOptions  *bulk.Options
 }
 
 func usage(cmd string) string {
@@ -359,6 +420,24 @@ func usageRemoveVolume() string {
   `
 }
 
+func usageAddHostDevice() string {
+	return `  #Dynamically attach a GPU to a running VM.
+  {{ProgramName}} addhostdevice fedora --host-device-name=gpu1 --device-name=nvidia.com/GP102GL_Tesla_P40
+
+  #Dynamically attach a GPU to a running VM and persisting it in the VM spec. At next VM restart the GPU will be attached like any other host device.
+  {{ProgramName}} addhostdevice fedora --host-device-name=gpu1 --device-name=nvidia.com/GP102GL_Tesla_P40 --persist
+  `
+}
+
+func usageRemoveHostDevice() string {
+	return `  #Remove a host device that was dynamically attached to a running VM.
+  {{ProgramName}} removehostdevice fedora --host-device-name=gpu1
+
+  #Remove a host device dynamically attached to a running VM and persisting it in the VM spec.
+  {{ProgramName}} removehostdevice fedora --host-device-name=gpu1 --persist
+  `
+}
+
 func addVolume(vmiName, volumeName, namespace string, virtClient kubecli.KubevirtClient, dryRunOption *[]string) error {
 	volumeSource, err := getVolumeSourceFromVolume(volumeName, namespace, virtClient)
 	if err != nil {
@@ -422,6 +501,43 @@ func removeVolume(vmiName, volumeName, namespace string, virtClient kubecli.Kube
 	return nil
 }
 
+func addHostDevice(vmiName, hostDeviceName, deviceName, namespace string, virtClient kubecli.KubevirtClient, dryRunOption *[]string) error {
+	hotplugRequest := &v1.AddHostDeviceOptions{
+		Name:       hostDeviceName,
+		DeviceName: deviceName,
+		DryRun:     *dryRunOption,
+	}
+	var err error
+	if !persist {
+		err = virtClient.VirtualMachineInstance(namespace).AddHostDevice(context.Background(), vmiName, hotplugRequest)
+	} else {
+		err = virtClient.VirtualMachine(namespace).AddHostDevice(context.Background(), vmiName, hotplugRequest)
+	}
+	if err != nil {
+		return fmt.Errorf("error adding host device, %v", err)
+	}
+	fmt.Printf("Successfully submitted add host device request to VM %s for host device %s\n", vmiName, hostDeviceName)
+	return nil
+}
+
+func removeHostDevice(vmiName, hostDeviceName, namespace string, virtClient kubecli.KubevirtClient, dryRunOption *[]string) error {
+	unplugRequest := &v1.RemoveHostDeviceOptions{
+		Name:   hostDeviceName,
+		DryRun: *dryRunOption,
+	}
+	var err error
+	if !persist {
+		err = virtClient.VirtualMachineInstance(namespace).RemoveHostDevice(context.Background(), vmiName, unplugRequest)
+	} else {
+		err = virtClient.VirtualMachine(namespace).RemoveHostDevice(context.Background(), vmiName, unplugRequest)
+	}
+	if err != nil {
+		return fmt.Errorf("error removing host device, %v", err)
+	}
+	fmt.Printf("Successfully submitted remove host device request to VM %s for host device %s\n", vmiName, hostDeviceName)
+	return nil
+}
+
 func gracePeriodIsSet(period int64) bool {
 	return period != notDefinedGracePeriod
 }
@@ -531,54 +647,8 @@ func (o *Command) Run(args []string) error {
 		fmt.Printf("Dry Run execution\n")
 	}
 	switch o.command {
-	case COMMAND_START:
-		err = virtClient.VirtualMachine(namespace).Start(context.Background(), vmiName, &v1.StartOptions{Paused: startPaused, DryRun: dryRunOption})
-		if err != nil {
-			return fmt.Errorf("Error starting VirtualMachine %v", err)
-		}
-	case COMMAND_STOP:
-		if gracePeriodIsSet(gracePeriod) && forceRestart == false {
-			return fmt.Errorf("Can not set gracePeriod without --force=true")
-		}
-		if forceRestart {
-			if gracePeriodIsSet(gracePeriod) {
-				err = virtClient.VirtualMachine(namespace).ForceStop(context.Background(), vmiName, &v1.StopOptions{GracePeriod: &gracePeriod, DryRun: dryRunOption})
-				if err != nil {
-					return fmt.Errorf("Error force stopping VirtualMachine, %v", err)
-				}
-			} else if !gracePeriodIsSet(gracePeriod) {
-				return fmt.Errorf("Can not force stop without gracePeriod")
-			}
-			break
-		}
-		err = virtClient.VirtualMachine(namespace).Stop(context.Background(), vmiName, &v1.StopOptions{DryRun: dryRunOption})
-		if err != nil {
-			return fmt.Errorf("Error stopping VirtualMachine %v", err)
-		}
-	case COMMAND_RESTART:
-		if gracePeriod != notDefinedGracePeriod && forceRestart == false {
-			return fmt.Errorf("Can not set gracePeriod without --force=true")
-		}
-		if forceRestart {
-			if gracePeriod != notDefinedGracePeriod {
-				err = virtClient.VirtualMachine(namespace).ForceRestart(context.Background(), vmiName, &v1.RestartOptions{GracePeriodSeconds: &gracePeriod, DryRun: dryRunOption})
-				if err != nil {
-					return fmt.Errorf("Error restarting VirtualMachine, %v", err)
-				}
-			} else if gracePeriod == notDefinedGracePeriod {
-				return fmt.Errorf("Can not force restart without gracePeriod")
-			}
-			break
-		}
-		err = virtClient.VirtualMachine(namespace).Restart(context.Background(), vmiName, &v1.RestartOptions{DryRun: dryRunOption})
-		if err != nil {
-			return fmt.Errorf("Error restarting VirtualMachine %v", err)
-		}
-	case COMMAND_MIGRATE:
-		err = virtClient.VirtualMachine(namespace).Migrate(context.Background(), vmiName, &v1.MigrateOptions{DryRun: dryRunOption})
-		if err != nil {
-			return fmt.Errorf("Error migrating VirtualMachine %v", err)
-		}
+	case COMMAND_START, COMMAND_STOP, COMMAND_RESTART, COMMAND_MIGRATE:
+		return o.runLifecycle(namespace, virtClient, dryRunOption)
 	case COMMAND_MIGRATE_CANCEL:
 		// get a list of migrations for vmiName (use LabelSelector filter)
 		labelselector := fmt.Sprintf("%s==%s", v1.MigrationSelectorLabel, vmiName)
@@ -651,6 +721,10 @@ func (o *Command) Run(args []string) error {
 		return addVolume(args[0], volumeName, namespace, virtClient, &dryRunOption)
 	case COMMAND_REMOVEVOLUME:
 		return removeVolume(args[0], volumeName, namespace, virtClient, &dryRunOption)
+	case COMMAND_ADDHOSTDEVICE:
+		return addHostDevice(args[0], hostDeviceName, deviceName, namespace, virtClient, &dryRunOption)
+	case COMMAND_REMOVEHOSTDEVICE:
+		return removeHostDevice(args[0], hostDeviceName, namespace, virtClient, &dryRunOption)
 	case COMMAND_EXPAND:
 		return expandVirtualMachine(namespace, virtClient, o)
 	}
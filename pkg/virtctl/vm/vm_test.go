@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"time"
 
 	"k8s.io/utils/pointer"
 
@@ -17,9 +18,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/yaml"
 
 	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/api"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/bulk"
 	"kubevirt.io/kubevirt/tests/clientcmd"
 
 	"k8s.io/client-go/kubernetes/fake"
@@ -341,6 +344,96 @@ var _ = Describe("VirtualMachine", func() {
 
 	})
 
+	Context("with --selector or --all", func() {
+
+		newVM := func(name string, status v1.VirtualMachinePrintableStatus) v1.VirtualMachine {
+			vm := kubecli.NewMinimalVM(name)
+			vm.Status.PrintableStatus = status
+			return *vm
+		}
+
+		BeforeEach(func() {
+			bulk.PollInterval = 10 * time.Millisecond
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).AnyTimes()
+		})
+
+		It("should start the selected VMs which are not running", func() {
+			vmInterface.EXPECT().List(gomock.Any(), &k8smetav1.ListOptions{LabelSelector: "app=web"}).Return(&v1.VirtualMachineList{Items: []v1.VirtualMachine{
+				newVM("vm-b", v1.VirtualMachineStatusRunning),
+				newVM("vm-a", v1.VirtualMachineStatusStopped),
+			}}, nil)
+			vmInterface.EXPECT().Start(gomock.Any(), "vm-a", &startOpts).Return(nil)
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("start", "-l", "app=web", "--parallel", "2")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(SatisfyAll(
+				MatchRegexp(`vm-a\s+Succeeded`),
+				MatchRegexp(`vm-b\s+Skipped\s+VM is already running`),
+				ContainSubstring("Started 1 of 2 VirtualMachines, 0 failed, 1 skipped"),
+			))
+		})
+
+		It("should stop all the VMs and wait until they are stopped", func() {
+			vmInterface.EXPECT().List(gomock.Any(), &k8smetav1.ListOptions{}).Return(&v1.VirtualMachineList{Items: []v1.VirtualMachine{
+				newVM("vm-a", v1.VirtualMachineStatusRunning),
+			}}, nil)
+			vmInterface.EXPECT().Stop(gomock.Any(), "vm-a", &stopOpts).Return(nil)
+			stopping, stopped := newVM("vm-a", v1.VirtualMachineStatusStopping), newVM("vm-a", v1.VirtualMachineStatusStopped)
+			gomock.InOrder(
+				vmInterface.EXPECT().Get(gomock.Any(), "vm-a", gomock.Any()).Return(&stopping, nil),
+				vmInterface.EXPECT().Get(gomock.Any(), "vm-a", gomock.Any()).Return(&stopped, nil),
+			)
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("stop", "--all", "--wait")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("Stopped 1 of 1 VirtualMachines, 0 failed, 0 skipped"))
+		})
+
+		It("should report the VMs whose migration failed", func() {
+			vmInterface.EXPECT().List(gomock.Any(), gomock.Any()).Return(&v1.VirtualMachineList{Items: []v1.VirtualMachine{
+				newVM("vm-a", v1.VirtualMachineStatusRunning),
+			}}, nil)
+			vmi := api.NewMinimalVMI("vm-a")
+			migratedVMI := vmi.DeepCopy()
+			migratedVMI.Status.MigrationState = &v1.VirtualMachineInstanceMigrationState{MigrationUID: "migration", TargetNode: "node02", Failed: true}
+			gomock.InOrder(
+				vmiInterface.EXPECT().Get(gomock.Any(), "vm-a", gomock.Any()).Return(vmi, nil),
+				vmInterface.EXPECT().Migrate(gomock.Any(), "vm-a", &v1.MigrateOptions{}).Return(nil),
+				vmiInterface.EXPECT().Get(gomock.Any(), "vm-a", gomock.Any()).Return(migratedVMI, nil),
+			)
+
+			out, err := clientcmd.NewRepeatableVirtctlCommandWithOut("migrate", "--all", "--wait")()
+			Expect(err).To(MatchError("the operation failed for 1 of 1 VirtualMachines"))
+			Expect(string(out)).To(MatchRegexp(`vm-a\s+Failed\s+the migration to node node02 failed`))
+		})
+
+		It("should wait until a named VM is restarted", func() {
+			vmi := api.NewMinimalVMI(vmName)
+			vmi.UID = "old"
+			restartedVMI := api.NewMinimalVMI(vmName)
+			restartedVMI.UID = "new"
+			restartedVMI.Status.Phase = v1.Running
+			gomock.InOrder(
+				vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vmi, nil),
+				vmInterface.EXPECT().Restart(gomock.Any(), vmName, &restartOpts).Return(nil),
+				vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(vmi, nil),
+				vmiInterface.EXPECT().Get(gomock.Any(), vmName, gomock.Any()).Return(restartedVMI, nil),
+			)
+
+			Expect(clientcmd.NewRepeatableVirtctlCommand("restart", vmName, "--wait")()).To(Succeed())
+		})
+
+		DescribeTable("should reject", func(expected string, args ...string) {
+			err := clientcmd.NewRepeatableVirtctlCommand(args...)()
+			Expect(err).To(MatchError(ContainSubstring(expected)))
+		},
+			Entry("a name with a selector", "a name can't be combined with --selector or --all", "start", vmName, "-l", "app=web"),
+			Entry("--wait with --dry-run", "--wait can't be combined with --dry-run", "stop", "--all", "--wait", "--dry-run"),
+			Entry("a grace period without --force", "Can not set gracePeriod without --force=true", "restart", "--all", "--grace-period=0"),
+		)
+	})
+
 	Context("guest agent", func() {
 
 		It("should return guest agent data", func() {
@@ -586,6 +679,84 @@ var _ = Describe("VirtualMachine", func() {
 		)
 	})
 
+	Context("Host device hotplug", func() {
+		const (
+			hostDeviceName = "gpu1"
+			deviceName     = "nvidia.com/GP102GL_Tesla_P40"
+		)
+
+		DescribeTable("should fail with missing required or invalid parameters", func(commandName, errorString string, args ...string) {
+			commandAndArgs := []string{commandName}
+			commandAndArgs = append(commandAndArgs, args...)
+			cmd := clientcmd.NewRepeatableVirtctlCommand(commandAndArgs...)
+			res := cmd()
+			Expect(res).To(HaveOccurred())
+			Expect(res.Error()).To(ContainSubstring(errorString))
+		},
+			Entry("addhostdevice no args", "addhostdevice", "argument validation failed"),
+			Entry("addhostdevice name, missing required host-device-name", "addhostdevice", "required flag(s)", "testvmi", "--device-name=blah"),
+			Entry("addhostdevice name, missing required device-name", "addhostdevice", "required flag(s)", "testvmi", "--host-device-name=blah"),
+			Entry("removehostdevice no args", "removehostdevice", "argument validation failed"),
+			Entry("removehostdevice name, missing required host-device-name", "removehostdevice", "required flag(s)", "testvmi"),
+		)
+
+		DescribeTable("addhostdevice should call correct endpoint", func(persist bool, args ...string) {
+			expectOptions := func(ctx context.Context, name string, opts *v1.AddHostDeviceOptions) error {
+				Expect(name).To(Equal("testvmi"))
+				Expect(opts.Name).To(Equal(hostDeviceName))
+				Expect(opts.DeviceName).To(Equal(deviceName))
+				return nil
+			}
+			if persist {
+				kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+				vmInterface.EXPECT().AddHostDevice(context.Background(), "testvmi", gomock.Any()).DoAndReturn(expectOptions)
+			} else {
+				kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+				vmiInterface.EXPECT().AddHostDevice(context.Background(), "testvmi", gomock.Any()).DoAndReturn(expectOptions)
+			}
+			commandAndArgs := []string{"addhostdevice", "testvmi", "--host-device-name=" + hostDeviceName, "--device-name=" + deviceName}
+			commandAndArgs = append(commandAndArgs, args...)
+			cmd := clientcmd.NewVirtctlCommand(commandAndArgs...)
+			Expect(cmd.Execute()).To(Succeed())
+		},
+			Entry("no persist should call VMI endpoint", false),
+			Entry("with persist should call VM endpoint", true, "--persist"),
+			Entry("no persist with dry-run should call VMI endpoint", false, "--dry-run"),
+			Entry("with persist with dry-run should call VM endpoint", true, "--persist", "--dry-run"),
+		)
+
+		DescribeTable("removehostdevice should call correct endpoint", func(persist bool, args ...string) {
+			expectOptions := func(ctx context.Context, name string, opts *v1.RemoveHostDeviceOptions) error {
+				Expect(name).To(Equal("testvmi"))
+				Expect(opts.Name).To(Equal(hostDeviceName))
+				return nil
+			}
+			if persist {
+				kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(k8smetav1.NamespaceDefault).Return(vmInterface).Times(1)
+				vmInterface.EXPECT().RemoveHostDevice(context.Background(), "testvmi", gomock.Any()).DoAndReturn(expectOptions)
+			} else {
+				kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+				vmiInterface.EXPECT().RemoveHostDevice(context.Background(), "testvmi", gomock.Any()).DoAndReturn(expectOptions)
+			}
+			commandAndArgs := []string{"removehostdevice", "testvmi", "--host-device-name=" + hostDeviceName}
+			commandAndArgs = append(commandAndArgs, args...)
+			cmd := clientcmd.NewVirtctlCommand(commandAndArgs...)
+			Expect(cmd.Execute()).To(Succeed())
+		},
+			Entry("no persist should call VMI endpoint", false),
+			Entry("with persist should call VM endpoint", true, "--persist"),
+		)
+
+		It("removehostdevice should report error if call returns error", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(k8smetav1.NamespaceDefault).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().RemoveHostDevice(context.Background(), "testvmi", gomock.Any()).Return(fmt.Errorf("error removing host device"))
+			cmd := clientcmd.NewRepeatableVirtctlCommand("removehostdevice", "testvmi", "--host-device-name="+hostDeviceName)
+			res := cmd()
+			Expect(res).To(HaveOccurred())
+			Expect(res.Error()).To(ContainSubstring("error removing host device"))
+		})
+	})
+
 	Context("Expand command", func() {
 		BeforeEach(func() {
 			vm = kubecli.NewMinimalVM(vmName)
@@ -0,0 +1,72 @@
+package token
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func NewTokenCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
+	t := Token{clientConfig: clientConfig}
+	cmd := &cobra.Command{
+		Use:     "token (VMI)",
+		Short:   "Get a short-lived token to connect to the VNC display of a virtual machine instance from a web client, without cluster credentials.",
+		Example: usage(),
+		Args:    templates.ExactArgs("token", 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c := t
+			return c.Run(cmd, args)
+		},
+	}
+	cmd.Flags().BoolVar(&t.readOnly, "read-only", false, "only allow the token to observe the VNC display")
+	cmd.Flags().DurationVar(&t.expiration, "expiration", 0, "how long the token is valid for, between 10s and 1h. Defaults to 5m")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `   # Get a token to connect to the VNC display of 'testvmi' for the next minute:
+   {{ProgramName}} vnc token testvmi --expiration 1m
+
+   # Get a token to observe the VNC display of 'testvmi':
+   {{ProgramName}} vnc token testvmi --read-only`
+}
+
+type Token struct {
+	clientConfig clientcmd.ClientConfig
+	readOnly     bool
+	expiration   time.Duration
+}
+
+func (t *Token) Run(cmd *cobra.Command, args []string) error {
+	namespace, _, err := t.clientConfig.Namespace()
+	if err != nil {
+		return err
+	}
+
+	virtCli, err := kubecli.GetKubevirtClientFromClientConfig(t.clientConfig)
+	if err != nil {
+		return err
+	}
+
+	vmi := args[0]
+	token, err := virtCli.VirtualMachineInstance(namespace).VNCToken(context.Background(), vmi, &v1.VNCTokenOptions{
+		ReadOnly:          t.readOnly,
+		ExpirationSeconds: int32(t.expiration.Seconds()),
+	})
+	if err != nil {
+		return fmt.Errorf("Can't get a VNC token for VMI %s: %v", vmi, err)
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), token.Token)
+	return nil
+}
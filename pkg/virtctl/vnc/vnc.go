@@ -25,6 +25,7 @@ import (
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -40,6 +41,7 @@ import (
 
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 	"kubevirt.io/kubevirt/pkg/virtctl/vnc/screenshot"
+	"kubevirt.io/kubevirt/pkg/virtctl/vnc/token"
 )
 
 const (
@@ -66,6 +68,8 @@ var listenAddressFmt string
 var listenAddress = "127.0.0.1"
 var proxyOnly bool
 var customPort = 0
+var readOnly bool
+var exclusive bool
 
 func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd := &cobra.Command{
@@ -74,6 +78,9 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 		Example: usage(),
 		Args:    templates.ExactArgs("vnc", 1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if readOnly && exclusive {
+				return fmt.Errorf("--read-only and --exclusive are mutually exclusive")
+			}
 			c := VNC{clientConfig: clientConfig}
 			return c.Run(cmd, args)
 		},
@@ -82,8 +89,11 @@ func NewCommand(clientConfig clientcmd.ClientConfig) *cobra.Command {
 	cmd.Flags().BoolVar(&proxyOnly, "proxy-only", proxyOnly, "--proxy-only=false: Setting this true will run only the virtctl vnc proxy and show the port where VNC viewers can connect")
 	cmd.Flags().IntVar(&customPort, "port", customPort,
 		"--port=0: Assigning a port value to this will try to run the proxy on the given port if the port is accessible; If unassigned, the proxy will run on a random port")
+	cmd.Flags().BoolVar(&readOnly, "read-only", readOnly, "--read-only=false: Setting this true will observe the display along with the client holding it, the keyboard and mouse input is not sent to the VMI")
+	cmd.Flags().BoolVar(&exclusive, "exclusive", exclusive, "--exclusive=false: Setting this true will fail if the display is held by another client, instead of taking it over")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	cmd.AddCommand(screenshot.NewScreenshotCommand(clientConfig))
+	cmd.AddCommand(token.NewTokenCommand(clientConfig))
 	return cmd
 }
 
@@ -105,7 +115,10 @@ func (o *VNC) Run(cmd *cobra.Command, args []string) error {
 	}
 
 	// setup connection with VM
-	vnc, err := virtCli.VirtualMachineInstance(namespace).VNC(vmi)
+	vnc, err := virtCli.VirtualMachineInstance(namespace).VNCWithOptions(vmi, &kubecli.VNCOptions{ReadOnly: readOnly, Exclusive: exclusive})
+	if asyncErr, ok := err.(*kubecli.AsyncSubresourceError); ok && asyncErr.GetStatusCode() == http.StatusConflict {
+		return fmt.Errorf("the VNC console of %s is held by another user, connect with --read-only to observe it or without --exclusive to take it over", vmi)
+	}
 	if err != nil {
 		return fmt.Errorf("Can't access VMI %s: %s", vmi, err.Error())
 	}
@@ -332,5 +345,7 @@ func remoteViewerArgs(port int) (args []string) {
 
 func usage() string {
 	return `  # Connect to 'testvmi' via remote-viewer:
-   {{ProgramName}} vnc testvmi`
+   {{ProgramName}} vnc testvmi
+  # Watch the display of 'testvmi' while someone else is using it:
+   {{ProgramName}} vnc --read-only testvmi`
 }
@@ -71,6 +71,27 @@ func (in *AccessCredentialSecretSource) DeepCopy() *AccessCredentialSecretSource
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddHostDeviceOptions) DeepCopyInto(out *AddHostDeviceOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddHostDeviceOptions.
+func (in *AddHostDeviceOptions) DeepCopy() *AddHostDeviceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AddHostDeviceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddInterfaceOptions) DeepCopyInto(out *AddInterfaceOptions) {
 	*out = *in
@@ -87,6 +108,22 @@ func (in *AddInterfaceOptions) DeepCopy() *AddInterfaceOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddSSHKeyOptions) DeepCopyInto(out *AddSSHKeyOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddSSHKeyOptions.
+func (in *AddSSHKeyOptions) DeepCopy() *AddSSHKeyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AddSSHKeyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddVolumeOptions) DeepCopyInto(out *AddVolumeOptions) {
 	*out = *in
@@ -207,6 +244,33 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimits) DeepCopyInto(out *BandwidthLimits) {
+	*out = *in
+	out.Average = in.Average.DeepCopy()
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimits.
+func (in *BandwidthLimits) DeepCopy() *BandwidthLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -311,6 +375,50 @@ func (in *CPU) DeepCopy() *CPU {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUBaselineConfiguration) DeepCopyInto(out *CPUBaselineConfiguration) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUBaselineConfiguration.
+func (in *CPUBaselineConfiguration) DeepCopy() *CPUBaselineConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUBaselineConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CPUBaselineStatus) DeepCopyInto(out *CPUBaselineStatus) {
+	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CPUBaselineStatus.
+func (in *CPUBaselineStatus) DeepCopy() *CPUBaselineStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CPUBaselineStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CPUFeature) DeepCopyInto(out *CPUFeature) {
 	*out = *in
@@ -892,6 +1000,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.LogSerialConsole != nil {
+		in, out := &in.LogSerialConsole, &out.LogSerialConsole
+		*out = new(bool)
+		**out = **in
+	}
 	if in.AutoattachMemBalloon != nil {
 		in, out := &in.AutoattachMemBalloon, &out.AutoattachMemBalloon
 		*out = new(bool)
@@ -956,6 +1069,16 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(TPMDevice)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Video != nil {
+		in, out := &in.Video, &out.Video
+		*out = new(VideoDevice)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SerialPorts != nil {
+		in, out := &in.SerialPorts, &out.SerialPorts
+		*out = make([]SerialPort, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -1074,6 +1197,38 @@ func (in *DiskVerification) DeepCopy() *DiskVerification {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainDiskStats) DeepCopyInto(out *DomainDiskStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainDiskStats.
+func (in *DomainDiskStats) DeepCopy() *DomainDiskStats {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainDiskStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainInterfaceStats) DeepCopyInto(out *DomainInterfaceStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainInterfaceStats.
+func (in *DomainInterfaceStats) DeepCopy() *DomainInterfaceStats {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainInterfaceStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainMemoryDumpInfo) DeepCopyInto(out *DomainMemoryDumpInfo) {
 	*out = *in
@@ -1098,6 +1253,22 @@ func (in *DomainMemoryDumpInfo) DeepCopy() *DomainMemoryDumpInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DomainMemoryStats) DeepCopyInto(out *DomainMemoryStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainMemoryStats.
+func (in *DomainMemoryStats) DeepCopy() *DomainMemoryStats {
+	if in == nil {
+		return nil
+	}
+	out := new(DomainMemoryStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	*out = *in
@@ -1208,6 +1379,11 @@ func (in *EFI) DeepCopyInto(out *EFI) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Persistent != nil {
+		in, out := &in.Persistent, &out.Persistent
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1682,6 +1858,74 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecOptions) DeepCopyInto(out *GuestExecOptions) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stdin != nil {
+		in, out := &in.Stdin, &out.Stdin
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecOptions.
+func (in *GuestExecOptions) DeepCopy() *GuestExecOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecResult) DeepCopyInto(out *GuestExecResult) {
+	*out = *in
+	if in.Stdout != nil {
+		in, out := &in.Stdout, &out.Stdout
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Stderr != nil {
+		in, out := &in.Stderr, &out.Stderr
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecResult.
+func (in *GuestExecResult) DeepCopy() *GuestExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestFileOptions) DeepCopyInto(out *GuestFileOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestFileOptions.
+func (in *GuestFileOptions) DeepCopy() *GuestFileOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestFileOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
 	*out = *in
@@ -1755,6 +1999,22 @@ func (in *HostDevice) DeepCopy() *HostDevice {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDeviceStatus) DeepCopyInto(out *HostDeviceStatus) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDeviceStatus.
+func (in *HostDeviceStatus) DeepCopy() *HostDeviceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDeviceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDisk) DeepCopyInto(out *HostDisk) {
 	*out = *in
@@ -1923,6 +2183,16 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SpoofCheck != nil {
+		in, out := &in.SpoofCheck, &out.SpoofCheck
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1936,6 +2206,32 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimits)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2320,6 +2616,11 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(SeccompConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CPUBaseline != nil {
+		in, out := &in.CPUBaseline, &out.CPUBaseline
+		*out = new(CPUBaselineConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2467,6 +2768,16 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]GenerationStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.MediatedDevicesRebalancing != nil {
+		in, out := &in.MediatedDevicesRebalancing, &out.MediatedDevicesRebalancing
+		*out = new(MediatedDevicesRebalancingStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CPUBaseline != nil {
+		in, out := &in.CPUBaseline, &out.CPUBaseline
+		*out = new(CPUBaselineStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2587,6 +2898,38 @@ func (in *Machine) DeepCopy() *Machine {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDeviceTypeMinimum) DeepCopyInto(out *MediatedDeviceTypeMinimum) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDeviceTypeMinimum.
+func (in *MediatedDeviceTypeMinimum) DeepCopy() *MediatedDeviceTypeMinimum {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedDeviceTypeMinimum)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDeviceTypeRequest) DeepCopyInto(out *MediatedDeviceTypeRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDeviceTypeRequest.
+func (in *MediatedDeviceTypeRequest) DeepCopy() *MediatedDeviceTypeRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedDeviceTypeRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfiguration) {
 	*out = *in
@@ -2607,6 +2950,11 @@ func (in *MediatedDevicesConfiguration) DeepCopyInto(out *MediatedDevicesConfigu
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Rebalancing != nil {
+		in, out := &in.Rebalancing, &out.Rebalancing
+		*out = new(MediatedDevicesRebalancing)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2620,6 +2968,62 @@ func (in *MediatedDevicesConfiguration) DeepCopy() *MediatedDevicesConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDevicesRebalancing) DeepCopyInto(out *MediatedDevicesRebalancing) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.MinimumNodesPerType != nil {
+		in, out := &in.MinimumNodesPerType, &out.MinimumNodesPerType
+		*out = make([]MediatedDeviceTypeMinimum, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDevicesRebalancing.
+func (in *MediatedDevicesRebalancing) DeepCopy() *MediatedDevicesRebalancing {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedDevicesRebalancing)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MediatedDevicesRebalancingStatus) DeepCopyInto(out *MediatedDevicesRebalancingStatus) {
+	*out = *in
+	if in.PendingRequests != nil {
+		in, out := &in.PendingRequests, &out.PendingRequests
+		*out = make([]MediatedDeviceTypeRequest, len(*in))
+		copy(*out, *in)
+	}
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]NodeMediatedDeviceTypesPlan, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MediatedDevicesRebalancingStatus.
+func (in *MediatedDevicesRebalancingStatus) DeepCopy() *MediatedDevicesRebalancingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MediatedDevicesRebalancingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MediatedHostDevice) DeepCopyInto(out *MediatedHostDevice) {
 	*out = *in
@@ -2796,6 +3200,13 @@ func (in *NUMA) DeepCopyInto(out *NUMA) {
 		*out = new(NUMAGuestMappingPassthrough)
 		**out = **in
 	}
+	if in.Cells != nil {
+		in, out := &in.Cells, &out.Cells
+		*out = make([]NUMACell, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -2809,6 +3220,44 @@ func (in *NUMA) DeepCopy() *NUMA {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMACell) DeepCopyInto(out *NUMACell) {
+	*out = *in
+	out.Memory = in.Memory.DeepCopy()
+	if in.Distances != nil {
+		in, out := &in.Distances, &out.Distances
+		*out = make([]NUMADistance, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMACell.
+func (in *NUMACell) DeepCopy() *NUMACell {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMACell)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NUMADistance) DeepCopyInto(out *NUMADistance) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NUMADistance.
+func (in *NUMADistance) DeepCopy() *NUMADistance {
+	if in == nil {
+		return nil
+	}
+	out := new(NUMADistance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NUMAGuestMappingPassthrough) DeepCopyInto(out *NUMAGuestMappingPassthrough) {
 	*out = *in
@@ -2927,6 +3376,27 @@ func (in *NodeMediatedDeviceTypesConfig) DeepCopy() *NodeMediatedDeviceTypesConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeMediatedDeviceTypesPlan) DeepCopyInto(out *NodeMediatedDeviceTypesPlan) {
+	*out = *in
+	if in.MediatedDeviceTypes != nil {
+		in, out := &in.MediatedDeviceTypes, &out.MediatedDeviceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeMediatedDeviceTypesPlan.
+func (in *NodeMediatedDeviceTypesPlan) DeepCopy() *NodeMediatedDeviceTypesPlan {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeMediatedDeviceTypesPlan)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodePlacement) DeepCopyInto(out *NodePlacement) {
 	*out = *in
@@ -3034,6 +3504,13 @@ func (in *PermittedHostDevices) DeepCopyInto(out *PermittedHostDevices) {
 		*out = make([]MediatedHostDevice, len(*in))
 		copy(*out, *in)
 	}
+	if in.USBHostDevices != nil {
+		in, out := &in.USBHostDevices, &out.USBHostDevices
+		*out = make([]USBHostDevice, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -3342,6 +3819,43 @@ func (in *ReloadableComponentConfiguration) DeepCopy() *ReloadableComponentConfi
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoveHostDeviceOptions) DeepCopyInto(out *RemoveHostDeviceOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoveHostDeviceOptions.
+func (in *RemoveHostDeviceOptions) DeepCopy() *RemoveHostDeviceOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoveHostDeviceOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RemoveSSHKeyOptions) DeepCopyInto(out *RemoveSSHKeyOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RemoveSSHKeyOptions.
+func (in *RemoveSSHKeyOptions) DeepCopy() *RemoveSSHKeyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(RemoveSSHKeyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemoveVolumeOptions) DeepCopyInto(out *RemoveVolumeOptions) {
 	*out = *in
@@ -3468,6 +3982,22 @@ func (in *SMBiosConfiguration) DeepCopy() *SMBiosConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHHostKey) DeepCopyInto(out *SSHHostKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHHostKey.
+func (in *SSHHostKey) DeepCopy() *SSHHostKey {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHHostKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHPublicKeyAccessCredential) DeepCopyInto(out *SSHPublicKeyAccessCredential) {
 	*out = *in
@@ -3591,6 +4121,22 @@ func (in *SecretVolumeSource) DeepCopy() *SecretVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SerialPort) DeepCopyInto(out *SerialPort) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SerialPort.
+func (in *SerialPort) DeepCopy() *SerialPort {
+	if in == nil {
+		return nil
+	}
+	out := new(SerialPort)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServiceAccountVolumeSource) DeepCopyInto(out *ServiceAccountVolumeSource) {
 	*out = *in
@@ -3861,6 +4407,43 @@ func (in *TopologyHints) DeepCopy() *TopologyHints {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBHostDevice) DeepCopyInto(out *USBHostDevice) {
+	*out = *in
+	if in.Selectors != nil {
+		in, out := &in.Selectors, &out.Selectors
+		*out = make([]USBSelector, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBHostDevice.
+func (in *USBHostDevice) DeepCopy() *USBHostDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(USBHostDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *USBSelector) DeepCopyInto(out *USBSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new USBSelector.
+func (in *USBSelector) DeepCopy() *USBSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(USBSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnpauseOptions) DeepCopyInto(out *UnpauseOptions) {
 	*out = *in
@@ -4006,6 +4589,39 @@ func (in *VMISelector) DeepCopy() *VMISelector {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VNCToken) DeepCopyInto(out *VNCToken) {
+	*out = *in
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNCToken.
+func (in *VNCToken) DeepCopy() *VNCToken {
+	if in == nil {
+		return nil
+	}
+	out := new(VNCToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VNCTokenOptions) DeepCopyInto(out *VNCTokenOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNCTokenOptions.
+func (in *VNCTokenOptions) DeepCopy() *VNCTokenOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VNCTokenOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VSOCKOptions) DeepCopyInto(out *VSOCKOptions) {
 	*out = *in
@@ -4027,6 +4643,43 @@ func (in *VSOCKOptions) DeepCopy() *VSOCKOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoDevice) DeepCopyInto(out *VideoDevice) {
+	*out = *in
+	if in.Resolution != nil {
+		in, out := &in.Resolution, &out.Resolution
+		*out = new(VideoResolution)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoDevice.
+func (in *VideoDevice) DeepCopy() *VideoDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VideoResolution) DeepCopyInto(out *VideoResolution) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VideoResolution.
+func (in *VideoResolution) DeepCopy() *VideoResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(VideoResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachine) DeepCopyInto(out *VirtualMachine) {
 	*out = *in
@@ -4119,6 +4772,55 @@ func (in *VirtualMachineInstanceCondition) DeepCopy() *VirtualMachineInstanceCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceConsoleSession) DeepCopyInto(out *VirtualMachineInstanceConsoleSession) {
+	*out = *in
+	in.ConnectedTimestamp.DeepCopyInto(&out.ConnectedTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceConsoleSession.
+func (in *VirtualMachineInstanceConsoleSession) DeepCopy() *VirtualMachineInstanceConsoleSession {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceConsoleSession)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceDomainStats) DeepCopyInto(out *VirtualMachineInstanceDomainStats) {
+	*out = *in
+	in.Timestamp.DeepCopyInto(&out.Timestamp)
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(DomainMemoryStats)
+		**out = **in
+	}
+	if in.Disks != nil {
+		in, out := &in.Disks, &out.Disks
+		*out = make([]DomainDiskStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.Interfaces != nil {
+		in, out := &in.Interfaces, &out.Interfaces
+		*out = make([]DomainInterfaceStats, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceDomainStats.
+func (in *VirtualMachineInstanceDomainStats) DeepCopy() *VirtualMachineInstanceDomainStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceDomainStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceFileSystem) DeepCopyInto(out *VirtualMachineInstanceFileSystem) {
 	*out = *in
@@ -4934,6 +5636,11 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.HostDeviceStatus != nil {
+		in, out := &in.HostDeviceStatus, &out.HostDeviceStatus
+		*out = make([]HostDeviceStatus, len(*in))
+		copy(*out, *in)
+	}
 	if in.TopologyHints != nil {
 		in, out := &in.TopologyHints, &out.TopologyHints
 		*out = new(TopologyHints)
@@ -4949,6 +5656,18 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(Machine)
 		**out = **in
 	}
+	if in.ConsoleSessions != nil {
+		in, out := &in.ConsoleSessions, &out.ConsoleSessions
+		*out = make([]VirtualMachineInstanceConsoleSession, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SSHHostKeys != nil {
+		in, out := &in.SSHHostKeys, &out.SSHHostKeys
+		*out = make([]SSHHostKey, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -34,6 +34,7 @@ const (
 	IOThreadsPolicyAuto    IOThreadsPolicy = "auto"
 	CPUModeHostPassthrough                 = "host-passthrough"
 	CPUModeHostModel                       = "host-model"
+	CPUModeClusterBaseline                 = "cluster-baseline"
 	DefaultCPUModel                        = CPUModeHostModel
 )
 
@@ -237,6 +238,10 @@ type EFI struct {
 	// Defaults to true
 	// +optional
 	SecureBoot *bool `json:"secureBoot,omitempty"`
+	// If set to true, Persistent will persist the EFI NVRAM across reboots.
+	// Defaults to false
+	// +optional
+	Persistent *bool `json:"persistent,omitempty"`
 }
 
 // If set, the VM will be booted from the defined kernel / initrd.
@@ -300,6 +305,8 @@ type CPU struct {
 	// List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.
 	// It is possible to specify special cases like "host-passthrough" to get the same CPU as the node
 	// and "host-model" to get CPU closest to the node one.
+	// "cluster-baseline" gets the newest CPU model supported by all the nodes of the cluster,
+	// and requires the ClusterCPUBaseline feature gate.
 	// Defaults to host-model.
 	// +optional
 	Model string `json:"model,omitempty"`
@@ -343,6 +350,37 @@ type NUMA struct {
 	// The created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.
 	// +opitonal
 	GuestMappingPassthrough *NUMAGuestMappingPassthrough `json:"guestMappingPassthrough,omitempty"`
+	// Cells explicitly declares the guest NUMA topology, without requiring dedicated CPUs.
+	// The cells must cover all the vCPUs and the whole guest memory. Can't be combined with GuestMappingPassthrough.
+	// +optional
+	// +listType=atomic
+	Cells []NUMACell `json:"cells,omitempty"`
+}
+
+// NUMACell declares a guest NUMA cell.
+type NUMACell struct {
+	// ID of the cell. The cells must be numbered from 0, in order.
+	ID uint32 `json:"id"`
+	// CPUSet lists the vCPUs of the cell, in the cpuset format, e.g. 0-3,8
+	CPUSet string `json:"cpuSet"`
+	// Memory is the amount of guest memory of the cell. When hugepages are requested, it must be a multiple of the page size.
+	Memory resource.Quantity `json:"memory"`
+	// Distances to the cells, including the cell itself. Left to the hypervisor defaults if empty.
+	// +optional
+	// +listType=atomic
+	Distances []NUMADistance `json:"distances,omitempty"`
+	// HostNodeSet binds the memory of the cell to host NUMA nodes, in the cpuset format, e.g. 0,1.
+	// The memory is not bound if empty.
+	// +optional
+	HostNodeSet string `json:"hostNodeSet,omitempty"`
+}
+
+// NUMADistance is the distance of a guest NUMA cell to another.
+type NUMADistance struct {
+	// CellID is the ID of the other cell
+	CellID uint32 `json:"cellID"`
+	// Value is the distance, 10 being the distance of a cell to itself
+	Value uint32 `json:"value"`
 }
 
 // CPUFeature allows specifying a CPU feature.
@@ -421,6 +459,11 @@ type Devices struct {
 	// Whether to attach the default serial console or not.
 	// Serial console access will not be available if set to false. Defaults to true.
 	AutoattachSerialConsole *bool `json:"autoattachSerialConsole,omitempty"`
+	// Whether to log the auto-attached default serial console or not.
+	// The serial console output is collected to a size-capped file, and streamed by the guest-console-log container of the virt-launcher pod.
+	// Not relevant if autoattachSerialConsole is disabled. Defaults to true.
+	// +optional
+	LogSerialConsole *bool `json:"logSerialConsole,omitempty"`
 	// Whether to attach the Memory balloon device with default period.
 	// Period can be adjusted in virt-config.
 	// Defaults to true.
@@ -464,8 +507,59 @@ type Devices struct {
 	// Whether to emulate a TPM device.
 	// +optional
 	TPM *TPMDevice `json:"tpm,omitempty"`
+	// Video configures the video device attached along with the graphics device.
+	// If not set, the default video device of the architecture is used.
+	// +optional
+	Video *VideoDevice `json:"video,omitempty"`
+	// SerialPorts are additional named serial ports, next to the serial console.
+	// They can be connected to through the console subresource.
+	// +optional
+	// +listType=atomic
+	SerialPorts []SerialPort `json:"serialPorts,omitempty"`
+}
+
+// VideoDevice configures the emulated video device.
+type VideoDevice struct {
+	// Type of the video device. One of virtio, bochs or vga.
+	// virtio is the only type supported on arm64.
+	// +optional
+	Type VideoType `json:"type,omitempty"`
+	// Resolution is the preferred resolution of the display, reported to the guest.
+	// +optional
+	Resolution *VideoResolution `json:"resolution,omitempty"`
+}
+
+type VideoType string
+
+const (
+	VideoTypeVirtio VideoType = "virtio"
+	VideoTypeBochs  VideoType = "bochs"
+	VideoTypeVGA    VideoType = "vga"
+)
+
+// VideoResolution is a display resolution in pixels.
+type VideoResolution struct {
+	Width  uint32 `json:"width"`
+	Height uint32 `json:"height"`
+}
+
+// SerialPort is an additional serial port of the VMI.
+type SerialPort struct {
+	// Name of the port, used to connect to it through the console subresource.
+	Name string `json:"name"`
+	// Type of the port. serial emulates an ISA serial port, virtio a virtio-console port.
+	// Defaults to virtio.
+	// +optional
+	Type SerialPortType `json:"type,omitempty"`
 }
 
+type SerialPortType string
+
+const (
+	SerialPortTypeSerial SerialPortType = "serial"
+	SerialPortTypeVirtio SerialPortType = "virtio"
+)
+
 // Represent a subset of client devices that can be accessed by VMI. At the
 // moment only, USB devices using Usbredir's library and tooling. Another fit
 // would be a smartcard with libcacard.
@@ -564,6 +658,10 @@ type HostDevice struct {
 	// If specified, the virtual network interface address and its tag will be provided to the guest via config drive
 	// +optional
 	Tag string `json:"tag,omitempty"`
+	// Hotpluggable indicates whether the device is attached through an attachment pod, after the VMI is
+	// started, and can be detached again while the VMI is running.
+	// +optional
+	Hotpluggable bool `json:"hotpluggable,omitempty"`
 }
 
 type Disk struct {
@@ -1186,6 +1284,55 @@ type Interface struct {
 	// This value is required to be unique across all devices and be between 1 and (16*1024-1).
 	// +optional
 	ACPIIndex int `json:"acpiIndex,omitempty"`
+	// If set to true, frames sent by the guest through the interface are dropped when their source MAC address,
+	// ARP sender addresses or source IP address do not match the ones assigned to the interface.
+	// Only supported with the bridge and masquerade bindings.
+	// +optional
+	SpoofCheck *bool `json:"spoofCheck,omitempty"`
+	// State represents the requested link state of the interface.
+	// Setting it to down disconnects the link as if the cable was unplugged, without removing the interface from the guest.
+	// Can be changed while the VMI is running.
+	// One of: up, down. Defaults to up.
+	// +optional
+	State InterfaceState `json:"state,omitempty"`
+	// If specified, limits the inbound and outbound traffic of the interface.
+	// Only supported with the bridge, masquerade and macvtap bindings.
+	// Can be changed while the VMI is running.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+}
+
+// InterfaceState represents the requested link state of an interface.
+type InterfaceState string
+
+const (
+	// InterfaceStateLinkUp means the link of the interface is connected.
+	InterfaceStateLinkUp InterfaceState = "up"
+	// InterfaceStateLinkDown means the link of the interface is disconnected.
+	InterfaceStateLinkDown InterfaceState = "down"
+)
+
+// InterfaceBandwidth limits the traffic of an interface in each direction.
+type InterfaceBandwidth struct {
+	// Limits of the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimits `json:"inbound,omitempty"`
+	// Limits of the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimits `json:"outbound,omitempty"`
+}
+
+// BandwidthLimits defines the rate limits of traffic in one direction.
+// Rates are expressed in bytes per second and are rounded up to the next kibibyte.
+type BandwidthLimits struct {
+	// Average rate in bytes per second the traffic is shaped to.
+	Average resource.Quantity `json:"average"`
+	// Maximum rate in bytes per second at which the traffic can be sent while bursting.
+	// +optional
+	Peak *resource.Quantity `json:"peak,omitempty"`
+	// Amount of bytes which can be sent at the peak rate in a single burst.
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
 }
 
 // Extra DHCP options to use in the interface.
@@ -1259,7 +1406,23 @@ type InterfaceSRIOV struct{}
 type InterfaceMacvtap struct{}
 
 // InterfacePasst connects to a given network.
-type InterfacePasst struct{}
+type InterfacePasst struct {
+	// PortForwarding selects the ports forwarded from the pod to the guest.
+	// All forwards every TCP and UDP port, List forwards only the ports listed in the interface ports.
+	// Defaults to List when the interface specifies ports, All otherwise.
+	// +optional
+	PortForwarding PasstPortForwarding `json:"portForwarding,omitempty"`
+}
+
+// PasstPortForwarding is the port forwarding mode of a passt interface.
+type PasstPortForwarding string
+
+const (
+	// PasstPortForwardingAll forwards all the TCP and UDP ports to the guest.
+	PasstPortForwardingAll PasstPortForwarding = "All"
+	// PasstPortForwardingList forwards only the ports listed in the interface ports to the guest.
+	PasstPortForwardingList PasstPortForwarding = "List"
+)
 
 // Port represents a port to expose from the virtual machine.
 // Default protocol TCP.
@@ -123,6 +123,7 @@ func (EFI) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":           "If set, EFI will be used instead of BIOS.",
 		"secureBoot": "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
+		"persistent": "If set to true, Persistent will persist the EFI NVRAM across reboots.\nDefaults to false\n+optional",
 	}
 }
 
@@ -159,7 +160,7 @@ func (CPU) SwaggerDoc() map[string]string {
 		"cores":                 "Cores specifies the number of cores inside the vmi.\nMust be a value greater or equal 1.",
 		"sockets":               "Sockets specifies the number of sockets inside the vmi.\nMust be a value greater or equal 1.",
 		"threads":               "Threads specifies the number of threads inside the vmi.\nMust be a value greater or equal 1.",
-		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\nDefaults to host-model.\n+optional",
+		"model":                 "Model specifies the CPU model inside the VMI.\nList of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map.\nIt is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node\nand \"host-model\" to get CPU closest to the node one.\n\"cluster-baseline\" gets the newest CPU model supported by all the nodes of the cluster,\nand requires the ClusterCPUBaseline feature gate.\nDefaults to host-model.\n+optional",
 		"features":              "Features specifies the CPU features list inside the VMI.\n+optional",
 		"dedicatedCpuPlacement": "DedicatedCPUPlacement requests the scheduler to place the VirtualMachineInstance on a node\nwith enough dedicated pCPUs and pin the vCPUs to it.\n+optional",
 		"numa":                  "NUMA allows specifying settings for the guest NUMA topology\n+optional",
@@ -184,6 +185,26 @@ func (NUMAGuestMappingPassthrough) SwaggerDoc() map[string]string {
 func (NUMA) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"guestMappingPassthrough": "GuestMappingPassthrough will create an efficient guest topology based on host CPUs exclusively assigned to a pod.\nThe created topology ensures that memory and CPUs on the virtual numa nodes never cross boundaries of host numa nodes.\n+opitonal",
+		"cells":                   "Cells explicitly declares the guest NUMA topology, without requiring dedicated CPUs.\nThe cells must cover all the vCPUs and the whole guest memory. Can't be combined with GuestMappingPassthrough.\n+optional\n+listType=atomic",
+	}
+}
+
+func (NUMACell) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "NUMACell declares a guest NUMA cell.",
+		"id":          "ID of the cell. The cells must be numbered from 0, in order.",
+		"cpuSet":      "CPUSet lists the vCPUs of the cell, in the cpuset format, e.g. 0-3,8",
+		"memory":      "Memory is the amount of guest memory of the cell. When hugepages are requested, it must be a multiple of the page size.",
+		"distances":   "Distances to the cells, including the cell itself. Left to the hypervisor defaults if empty.\n+optional\n+listType=atomic",
+		"hostNodeSet": "HostNodeSet binds the memory of the cell to host NUMA nodes, in the cpuset format, e.g. 0,1.\nThe memory is not bound if empty.\n+optional",
+	}
+}
+
+func (NUMADistance) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "NUMADistance is the distance of a guest NUMA cell to another.",
+		"cellID": "CellID is the ID of the other cell",
+		"value":  "Value is the distance, 10 being the distance of a cell to itself",
 	}
 }
 
@@ -236,6 +257,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
 		"autoattachGraphicsDevice":   "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
 		"autoattachSerialConsole":    "Whether to attach the default serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
+		"logSerialConsole":           "Whether to log the auto-attached default serial console or not.\nThe serial console output is collected to a size-capped file, and streamed by the guest-console-log container of the virt-launcher pod.\nNot relevant if autoattachSerialConsole is disabled. Defaults to true.\n+optional",
 		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
 		"autoattachInputDevice":      "Whether to attach an Input Device.\nDefaults to false.\n+optional",
 		"autoattachVSOCK":            "Whether to attach the VSOCK CID to the VM or not.\nVSOCK access will be available if set to true. Defaults to false.",
@@ -248,6 +270,30 @@ func (Devices) SwaggerDoc() map[string]string {
 		"clientPassthrough":          "To configure and access client devices such as redirecting USB\n+optional",
 		"sound":                      "Whether to emulate a sound device.\n+optional",
 		"tpm":                        "Whether to emulate a TPM device.\n+optional",
+		"video":                      "Video configures the video device attached along with the graphics device.\nIf not set, the default video device of the architecture is used.\n+optional",
+		"serialPorts":                "SerialPorts are additional named serial ports, next to the serial console.\nThey can be connected to through the console subresource.\n+optional\n+listType=atomic",
+	}
+}
+
+func (VideoDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "VideoDevice configures the emulated video device.",
+		"type":       "Type of the video device. One of virtio, bochs or vga.\nvirtio is the only type supported on arm64.\n+optional",
+		"resolution": "Resolution is the preferred resolution of the display, reported to the guest.\n+optional",
+	}
+}
+
+func (VideoResolution) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VideoResolution is a display resolution in pixels.",
+	}
+}
+
+func (SerialPort) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "SerialPort is an additional serial port of the VMI.",
+		"name": "Name of the port, used to connect to it through the console subresource.",
+		"type": "Type of the port. serial emulates an ISA serial port, virtio a virtio-console port.\nDefaults to virtio.\n+optional",
 	}
 }
 
@@ -310,8 +356,9 @@ func (VGPUDisplayOptions) SwaggerDoc() map[string]string {
 
 func (HostDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"deviceName": "DeviceName is the resource name of the host device exposed by a device plugin",
-		"tag":        "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"deviceName":   "DeviceName is the resource name of the host device exposed by a device plugin",
+		"tag":          "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
+		"hotpluggable": "Hotpluggable indicates whether the device is attached through an attachment pod, after the VMI is\nstarted, and can be detached again while the VMI is running.\n+optional",
 	}
 }
 
@@ -629,6 +676,26 @@ func (Interface) SwaggerDoc() map[string]string {
 		"dhcpOptions": "If specified the network interface will pass additional DHCP options to the VMI\n+optional",
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
+		"spoofCheck":  "If set to true, frames sent by the guest through the interface are dropped when their source MAC address,\nARP sender addresses or source IP address do not match the ones assigned to the interface.\nOnly supported with the bridge and masquerade bindings.\n+optional",
+		"state":       "State represents the requested link state of the interface.\nSetting it to down disconnects the link as if the cable was unplugged, without removing the interface from the guest.\nCan be changed while the VMI is running.\nOne of: up, down. Defaults to up.\n+optional",
+		"bandwidth":   "If specified, limits the inbound and outbound traffic of the interface.\nOnly supported with the bridge, masquerade and macvtap bindings.\nCan be changed while the VMI is running.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth limits the traffic of an interface in each direction.",
+		"inbound":  "Limits of the traffic received by the guest.\n+optional",
+		"outbound": "Limits of the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimits) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimits defines the rate limits of traffic in one direction.\nRates are expressed in bytes per second and are rounded up to the next kibibyte.",
+		"average": "Average rate in bytes per second the traffic is shaped to.",
+		"peak":    "Maximum rate in bytes per second at which the traffic can be sent while bursting.\n+optional",
+		"burst":   "Amount of bytes which can be sent at the peak rate in a single burst.\n+optional",
 	}
 }
 
@@ -688,7 +755,8 @@ func (InterfaceMacvtap) SwaggerDoc() map[string]string {
 
 func (InterfacePasst) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "InterfacePasst connects to a given network.",
+		"":               "InterfacePasst connects to a given network.",
+		"portForwarding": "PortForwarding selects the ports forwarded from the pod to the guest.\nAll forwards every TCP and UDP port, List forwards only the ports listed in the interface ports.\nDefaults to List when the interface specifies ports, All otherwise.\n+optional",
 	}
 }
 
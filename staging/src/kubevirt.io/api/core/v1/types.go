@@ -247,6 +247,11 @@ type VirtualMachineInstanceStatus struct {
 	// +listType=atomic
 	VolumeStatus []VolumeStatus `json:"volumeStatus,omitempty"`
 
+	// HostDeviceStatus contains the statuses of the hot-attached host devices
+	// +optional
+	// +listType=atomic
+	HostDeviceStatus []HostDeviceStatus `json:"hostDeviceStatus,omitempty"`
+
 	// FSFreezeStatus is the state of the fs of the guest
 	// it can be either frozen or thawed
 	// +optional
@@ -276,6 +281,55 @@ type VirtualMachineInstanceStatus struct {
 	// than the machine type selected in the spec, due to qemus machine type alias mechanism.
 	// +optional
 	Machine *Machine `json:"machine,omitempty"`
+
+	// ConsoleSessions lists the clients connected to the serial console, the additional serial ports and the VNC console
+	// +optional
+	// +listType=atomic
+	ConsoleSessions []VirtualMachineInstanceConsoleSession `json:"consoleSessions,omitempty"`
+
+	// SSHHostKeys are the public host keys of the SSH server of the guest, reported by the guest agent
+	// when an ephemeral SSH key is added, so that SSH clients can verify the guest
+	// +optional
+	// +listType=atomic
+	SSHHostKeys []SSHHostKey `json:"sshHostKeys,omitempty"`
+}
+
+// SSHHostKey is a public host key of the SSH server of the guest
+type SSHHostKey struct {
+	// Type is the algorithm of the key, e.g. ssh-ed25519
+	Type string `json:"type"`
+	// PublicKey is the key in the authorized_keys format
+	PublicKey string `json:"publicKey"`
+	// Fingerprint is the SHA256 fingerprint of the key, as printed by ssh-keygen -l
+	Fingerprint string `json:"fingerprint"`
+}
+
+// VirtualMachineInstanceConsoleType is the kind of console a client is connected to
+type VirtualMachineInstanceConsoleType string
+
+const (
+	// SerialConsoleType is the serial console, or an additional serial port
+	SerialConsoleType VirtualMachineInstanceConsoleType = "serial"
+	// VNCConsoleType is the VNC console
+	VNCConsoleType VirtualMachineInstanceConsoleType = "vnc"
+)
+
+// VirtualMachineInstanceConsoleSession is a client connected to a console of the VMI.
+// A console is held by at most one client which isn't read-only, the others only observe it.
+type VirtualMachineInstanceConsoleSession struct {
+	// Console is the kind of console the client is connected to
+	Console VirtualMachineInstanceConsoleType `json:"console"`
+	// SerialPort is the name of the additional serial port the client is connected to, empty for the serial console
+	// +optional
+	SerialPort string `json:"serialPort,omitempty"`
+	// User is the name of the user who opened the session
+	// +optional
+	User string `json:"user,omitempty"`
+	// ReadOnly is true for the observers, whose input is not sent to the console
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// ConnectedTimestamp is when the client connected
+	ConnectedTimestamp metav1.Time `json:"connectedTimestamp"`
 }
 
 // PersistentVolumeClaimInfo contains the relavant information virt-handler needs cached about a PVC
@@ -350,6 +404,40 @@ type HotplugVolumeStatus struct {
 	AttachPodUID types.UID `json:"attachPodUID,omitempty"`
 }
 
+// HostDeviceStatus represents information about the status of a host device hot-attached to the VirtualMachineInstance.
+type HostDeviceStatus struct {
+	// Name is the name of the host device
+	Name string `json:"name"`
+	// Phase is the phase
+	Phase HostDevicePhase `json:"phase,omitempty"`
+	// Reason is a brief description of why we are in the current hotplug host device phase
+	Reason string `json:"reason,omitempty"`
+	// Message is a detailed message about the current hotplug host device phase
+	Message string `json:"message,omitempty"`
+	// AttachPodName is the name of the pod used to allocate the host device on the node.
+	AttachPodName string `json:"attachPodName,omitempty"`
+	// AttachPodUID is the UID of the pod used to allocate the host device on the node.
+	AttachPodUID types.UID `json:"attachPodUID,omitempty"`
+	// Address is the host address of the allocated device, a PCI address or a mediated device UUID.
+	Address string `json:"address,omitempty"`
+}
+
+// HostDevicePhase indicates the current phase of the host device hotplug process.
+type HostDevicePhase string
+
+const (
+	// HostDevicePending means the attachment pod of the host device is not running yet.
+	HostDevicePending HostDevicePhase = "Pending"
+	// HostDeviceAttachedToNode means the attachment pod has allocated the host device on the node.
+	HostDeviceAttachedToNode HostDevicePhase = "AttachedToNode"
+	// HostDeviceReady means the host device is attached to the domain.
+	HostDeviceReady HostDevicePhase = "Ready"
+	// HostDeviceDetaching means the host device was removed from the spec and is being detached from the domain.
+	HostDeviceDetaching HostDevicePhase = "Detaching"
+	// HostDeviceDetached means the host device was detached from the domain, and the attachment pod can be removed.
+	HostDeviceDetached HostDevicePhase = "Detached"
+)
+
 // VolumePhase indicates the current phase of the hotplug process.
 type VolumePhase string
 
@@ -754,6 +842,12 @@ const (
 	MigrationJobNameAnnotation                    string = "kubevirt.io/migrationJobName"
 	ControllerAPILatestVersionObservedAnnotation  string = "kubevirt.io/latest-observed-api-version"
 	ControllerAPIStorageVersionObservedAnnotation string = "kubevirt.io/storage-observed-api-version"
+	// HotplugHostDeviceAnnotation is set on the attachment pods of hot-attached host devices. It holds the name
+	// of the VMI host device the pod allocates the device plugin resource for.
+	HotplugHostDeviceAnnotation string = "kubevirt.io/hotplug-host-device"
+	// MediatedDeviceTypesAnnotation is set on nodes by the mediated devices rebalancer. It holds the comma separated
+	// list of mediated device types to configure on the node, overriding the MediatedDevicesConfiguration.
+	MediatedDeviceTypesAnnotation string = "kubevirt.io/mediated-device-types"
 	// Used by functional tests to force a VMI to fail the migration internally within launcher
 	FuncTestForceLauncherMigrationFailureAnnotation string = "kubevirt.io/func-test-force-launcher-migration-failure"
 	// Used by functional tests to prevent virt launcher from finishing the target pod preparation.
@@ -937,6 +1031,15 @@ const (
 	// vm has the pod networking bind with a bridge
 	AllowPodBridgeNetworkLiveMigrationAnnotation string = "kubevirt.io/allow-pod-bridge-network-live-migration"
 
+	// GuestEndpointsNetworkAnnotation can be set on a Service without a selector to have KubeVirt maintain
+	// its EndpointSlices with the IP addresses reported by the guest agent on the named VMI network.
+	// It requires the GuestEndpoints feature gate.
+	GuestEndpointsNetworkAnnotation string = "kubevirt.io/guest-endpoints-network"
+
+	// GuestEndpointsSelectorAnnotation holds the label selector of the VMIs backing a Service
+	// annotated with GuestEndpointsNetworkAnnotation.
+	GuestEndpointsSelectorAnnotation string = "kubevirt.io/guest-endpoints-selector"
+
 	// VirtualMachineGenerationAnnotation is the generation of a Virtual Machine.
 	VirtualMachineGenerationAnnotation string = "kubevirt.io/vm-generation"
 
@@ -1920,6 +2023,12 @@ type KubeVirtStatus struct {
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	// MediatedDevicesRebalancing reports the plan of the mediated device types rebalancing
+	// +optional
+	MediatedDevicesRebalancing *MediatedDevicesRebalancingStatus `json:"mediatedDevicesRebalancing,omitempty"`
+	// CPUBaseline reports the newest CPU model and features supported by all the nodes selected for the cluster CPU baseline
+	// +optional
+	CPUBaseline *CPUBaselineStatus `json:"cpuBaseline,omitempty"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2147,11 +2256,164 @@ type VirtualMachineInstanceFileSystem struct {
 	TotalBytes     int    `json:"totalBytes"`
 }
 
+// VirtualMachineInstanceDomainStats are the cumulative runtime statistics of the domain of a VirtualMachineInstance,
+// collected by virt-handler like for its metrics. Rates are computed from two samples.
+type VirtualMachineInstanceDomainStats struct {
+	// Timestamp is when the statistics were collected, with a precision allowing to compute rates over short intervals
+	Timestamp metav1.MicroTime `json:"timestamp"`
+	// CPUTimeNanoseconds is the CPU time used by the domain
+	// +optional
+	CPUTimeNanoseconds int64 `json:"cpuTimeNanoseconds,omitempty"`
+	// VCPUs is the number of vCPUs of the domain
+	// +optional
+	VCPUs int `json:"vCPUs,omitempty"`
+	// Memory holds the memory statistics, most of them are only reported with the balloon driver in the guest
+	// +optional
+	Memory *DomainMemoryStats `json:"memory,omitempty"`
+	// Disks holds the statistics of the disks of the domain
+	// +optional
+	// +listType=atomic
+	Disks []DomainDiskStats `json:"disks,omitempty"`
+	// Interfaces holds the statistics of the network interfaces of the domain
+	// +optional
+	// +listType=atomic
+	Interfaces []DomainInterfaceStats `json:"interfaces,omitempty"`
+}
+
+// DomainMemoryStats are the memory statistics of a domain
+type DomainMemoryStats struct {
+	// ActualBalloonBytes is the memory currently assigned to the guest
+	// +optional
+	ActualBalloonBytes int64 `json:"actualBalloonBytes,omitempty"`
+	// AvailableBytes is the memory usable by the guest OS
+	// +optional
+	AvailableBytes int64 `json:"availableBytes,omitempty"`
+	// UnusedBytes is the memory left completely unused by the guest OS
+	// +optional
+	UnusedBytes int64 `json:"unusedBytes,omitempty"`
+	// UsableBytes is the memory the guest OS can reclaim without swapping, including its caches
+	// +optional
+	UsableBytes int64 `json:"usableBytes,omitempty"`
+	// RSSBytes is the resident memory of the domain on the node
+	// +optional
+	RSSBytes int64 `json:"rssBytes,omitempty"`
+	// SwapInBytes is the memory swapped in by the guest OS
+	// +optional
+	SwapInBytes int64 `json:"swapInBytes,omitempty"`
+	// SwapOutBytes is the memory swapped out by the guest OS
+	// +optional
+	SwapOutBytes int64 `json:"swapOutBytes,omitempty"`
+}
+
+// DomainDiskStats are the cumulative I/O statistics of a disk of a domain
+type DomainDiskStats struct {
+	// Name is the name of the disk in the VirtualMachineInstance, or of the device in the domain if it has none
+	Name string `json:"name"`
+	// +optional
+	ReadBytes int64 `json:"readBytes,omitempty"`
+	// +optional
+	ReadRequests int64 `json:"readRequests,omitempty"`
+	// +optional
+	WriteBytes int64 `json:"writeBytes,omitempty"`
+	// +optional
+	WriteRequests int64 `json:"writeRequests,omitempty"`
+}
+
+// DomainInterfaceStats are the cumulative traffic statistics of a network interface of a domain
+type DomainInterfaceStats struct {
+	// Name is the name of the interface in the VirtualMachineInstance, or of the device in the domain if it has none
+	Name string `json:"name"`
+	// +optional
+	RxBytes int64 `json:"rxBytes,omitempty"`
+	// +optional
+	RxPackets int64 `json:"rxPackets,omitempty"`
+	// +optional
+	TxBytes int64 `json:"txBytes,omitempty"`
+	// +optional
+	TxPackets int64 `json:"txPackets,omitempty"`
+}
+
 // FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command
 type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
 }
 
+// GuestExecOptions are the options of the guestexec subresource, which runs a command through the guest agent
+type GuestExecOptions struct {
+	// Command is the path of the executable to run in the guest, followed by its arguments
+	// +listType=atomic
+	Command []string `json:"command"`
+	// Stdin is passed to the standard input of the command
+	// +optional
+	// +listType=atomic
+	Stdin []byte `json:"stdin,omitempty"`
+	// TimeoutSeconds is how long to wait for the command to exit. Defaults to 30 seconds.
+	// +optional
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GuestExecResult is the outcome of a command run through the guest agent
+type GuestExecResult struct {
+	// ExitCode is the exit code of the command
+	ExitCode int32 `json:"exitCode"`
+	// Stdout is the standard output of the command
+	// +optional
+	// +listType=atomic
+	Stdout []byte `json:"stdout,omitempty"`
+	// Stderr is the standard error of the command
+	// +optional
+	// +listType=atomic
+	Stderr []byte `json:"stderr,omitempty"`
+	// StdoutTruncated is set when the guest agent dropped the end of the standard output, it captures 16MiB at most
+	// +optional
+	StdoutTruncated bool `json:"stdoutTruncated,omitempty"`
+	// StderrTruncated is set when the guest agent dropped the end of the standard error, it captures 16MiB at most
+	// +optional
+	StderrTruncated bool `json:"stderrTruncated,omitempty"`
+}
+
+// GuestFileOptions are the options of the guestfile subresource, which copies a file into or out of the guest through the guest agent
+type GuestFileOptions struct {
+	// Path is the absolute path of the file in the guest
+	Path string `json:"path"`
+	// Permissions are set on an uploaded file, in octal, e.g. 0644
+	// +optional
+	Permissions string `json:"permissions,omitempty"`
+	// Owner is set on an uploaded file, as user[:group]
+	// +optional
+	Owner string `json:"owner,omitempty"`
+}
+
+// AddSSHKeyOptions are the options of the addsshkey subresource, which authorizes an ephemeral SSH public key
+// for a user of the guest through the guest agent
+type AddSSHKeyOptions struct {
+	// User is the user of the guest the key is authorized for
+	User string `json:"user"`
+	// PublicKey is the public key in the authorized_keys format
+	PublicKey string `json:"publicKey"`
+	// TTLSeconds is how long the key stays authorized before the SSH server of the guest refuses it. Defaults to 120 seconds.
+	// +optional
+	TTLSeconds int32 `json:"ttlSeconds,omitempty"`
+}
+
+// RemoveSSHKeyOptions are the options of the removesshkey subresource, which removes an ephemeral SSH public key
+// before it expires
+type RemoveSSHKeyOptions struct {
+	// User is the user of the guest the key is authorized for
+	User string `json:"user"`
+	// PublicKey is the public key in the authorized_keys format
+	PublicKey string `json:"publicKey"`
+}
+
+// VNCToken is a short-lived token giving access to the VNC display of a single VirtualMachineInstance, through
+// the VNC websocket endpoint of virt-api, without other credentials
+type VNCToken struct {
+	// Token is passed in the token query parameter of the VNC websocket endpoint of virt-api
+	Token string `json:"token"`
+	// ExpirationTimestamp is when the token stops being accepted. Connections opened before remain open.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp"`
+}
+
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
 type VirtualMachineMemoryDumpRequest struct {
 	// ClaimName is the name of the pvc that will contain the memory dump
@@ -2216,6 +2478,16 @@ type ScreenshotOptions struct {
 	MoveCursor bool `json:"moveCursor"`
 }
 
+// VNCTokenOptions are the options of the vnc/token subresource
+type VNCTokenOptions struct {
+	// ReadOnly tokens only give access to observe the VNC display
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+	// ExpirationSeconds is how long the token is valid for. Defaults to 5 minutes.
+	// +optional
+	ExpirationSeconds int32 `json:"expirationSeconds,omitempty"`
+}
+
 type VSOCKOptions struct {
 	TargetPort uint32 `json:"targetPort"`
 	UseTLS     *bool  `json:"useTLS,omitempty"`
@@ -2236,6 +2508,36 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// AddHostDeviceOptions is provided when dynamically hot plugging a host device
+type AddHostDeviceOptions struct {
+	// Name is the name of the host device in the VMI spec
+	Name string `json:"name"`
+	// DeviceName is the resource name of the host device exposed by a device plugin
+	DeviceName string `json:"deviceName"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
+// RemoveHostDeviceOptions is provided when dynamically hot unplugging a host device
+type RemoveHostDeviceOptions struct {
+	// Name is the name of the host device that should be removed
+	Name string `json:"name"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 // AddInterfaceOptions is provided when dynamically hot plugging a network interface
 type AddInterfaceOptions struct {
 	// NetworkAttachmentDefinitionName references a NetworkAttachmentDefinition CRD object. Format:
@@ -2323,8 +2625,44 @@ type KubeVirtConfiguration struct {
 	SeccompConfiguration           *SeccompConfiguration             `json:"seccompConfiguration,omitempty"`
 
 	// VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.
-	// The storage class must support RWX in filesystem mode.
+	// The storage class must support filesystem mode, and the access mode set in VMStateStorageAccessMode.
 	VMStateStorageClass string `json:"vmStateStorageClass,omitempty"`
+
+	// VMStateStorageAccessMode is the access mode of the PVCs created to preserve VM state.
+	// ReadWriteMany volumes are shared by the source and the target of a migration, while the
+	// state kept on ReadWriteOnce volumes is transferred to a new volume on every migration.
+	// Defaults to ReadWriteMany
+	// +kubebuilder:validation:Enum=ReadWriteMany;ReadWriteOnce
+	// +optional
+	VMStateStorageAccessMode k8sv1.PersistentVolumeAccessMode `json:"vmStateStorageAccessMode,omitempty"`
+
+	// CPUBaseline selects the nodes the cluster-baseline CPU model is computed for.
+	// Requires the ClusterCPUBaseline feature gate.
+	// +optional
+	CPUBaseline *CPUBaselineConfiguration `json:"cpuBaseline,omitempty"`
+}
+
+// CPUBaselineConfiguration holds the nodes the cluster-baseline CPU model is computed for.
+// +k8s:openapi-gen=true
+type CPUBaselineConfiguration struct {
+	// NodeSelector restricts the cluster CPU baseline to a group of nodes.
+	// All the schedulable nodes are selected if empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// CPUBaselineStatus holds the newest CPU model and features supported by all the nodes selected for the cluster CPU baseline.
+// +k8s:openapi-gen=true
+type CPUBaselineStatus struct {
+	// Model is the newest named CPU model supported by all the nodes, empty if they have none in common
+	// +optional
+	Model string `json:"model,omitempty"`
+	// Features are the CPU features supported by all the nodes
+	// +optional
+	// +listType=atomic
+	Features []string `json:"features,omitempty"`
+	// Nodes is the number of nodes the baseline is computed for
+	Nodes int `json:"nodes"`
 }
 
 type ArchConfiguration struct {
@@ -2360,6 +2698,8 @@ const (
 	VirtioFS SupportContainerType = "virtiofs"
 	// SideCar is the container resources for a side car
 	SideCar SupportContainerType = "sidecar"
+	// GuestConsoleLog is the container resources of the container streaming the serial console log
+	GuestConsoleLog SupportContainerType = "guest-console-log"
 )
 
 // SupportContainerResources are used to specify the cpu/memory request and limits for the containers that support various features of Virtual Machines. These containers are usually idle and don't require a lot of memory or cpu.
@@ -2512,6 +2852,7 @@ type LogVerbosity struct {
 const (
 	PCIResourcePrefix  = "PCI_RESOURCE"
 	MDevResourcePrefix = "MDEV_PCI_RESOURCE"
+	USBResourcePrefix  = "USB_RESOURCE"
 )
 
 // PermittedHostDevices holds information about devices allowed for passthrough
@@ -2520,6 +2861,8 @@ type PermittedHostDevices struct {
 	PciHostDevices []PciHostDevice `json:"pciHostDevices,omitempty"`
 	// +listType=atomic
 	MediatedDevices []MediatedHostDevice `json:"mediatedDevices,omitempty"`
+	// +listType=atomic
+	USBHostDevices []USBHostDevice `json:"usbHostDevices,omitempty"`
 }
 
 // PciHostDevice represents a host PCI device allowed for passthrough
@@ -2544,6 +2887,34 @@ type MediatedHostDevice struct {
 	ExternalResourceProvider bool   `json:"externalResourceProvider,omitempty"`
 }
 
+// USBHostDevice represents host USB devices allowed for passthrough
+type USBHostDevice struct {
+	// The name of the resource that is representing the devices. Exposed by
+	// a device plugin and requested by VMs. Typically of the form
+	// vendor.com/product_name
+	ResourceName string `json:"resourceName"`
+	// Selectors identify the host USB devices exposed under the resource.
+	// Every matching device can be allocated on its own.
+	// +listType=atomic
+	Selectors []USBSelector `json:"selectors"`
+	// If true, KubeVirt will leave the allocation and monitoring to an
+	// external device plugin
+	ExternalResourceProvider bool `json:"externalResourceProvider,omitempty"`
+}
+
+// USBSelector selects host USB devices by their vendor and product IDs,
+// and optionally by the port they are plugged into
+type USBSelector struct {
+	// The vendor ID of the USB device, e.g. 0529
+	Vendor string `json:"vendor"`
+	// The product ID of the USB device, e.g. 0001
+	Product string `json:"product"`
+	// The bus path of the port the device is plugged into, as named under
+	// /sys/bus/usb/devices, e.g. 1-2.3. Devices plugged into any port match if empty.
+	// +optional
+	BusPath string `json:"busPath,omitempty"`
+}
+
 // MediatedDevicesConfiguration holds information about MDEV types to be defined, if available
 type MediatedDevicesConfiguration struct {
 	// Deprecated. Use mediatedDeviceTypes instead.
@@ -2556,6 +2927,67 @@ type MediatedDevicesConfiguration struct {
 	// +optional
 	// +listType=atomic
 	NodeMediatedDeviceTypes []NodeMediatedDeviceTypesConfig `json:"nodeMediatedDeviceTypes,omitempty"`
+	// Rebalancing lets virt-controller reconfigure idle GPUs to the mediated device types requested by pending VMIs.
+	// Requires the MediatedDevicesRebalancing feature gate.
+	// +optional
+	Rebalancing *MediatedDevicesRebalancing `json:"rebalancing,omitempty"`
+}
+
+// MediatedDevicesRebalancing holds the constraints of the mediated device types rebalancing.
+// +k8s:openapi-gen=true
+type MediatedDevicesRebalancing struct {
+	// NodeSelector selects the nodes whose GPUs may be reconfigured. The selected nodes must support all the
+	// mediated device types requested by the VMIs. All nodes are selected if empty.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+	// MinimumNodesPerType is the number of nodes which keep offering a mediated device type,
+	// even if no pending VMI requests it.
+	// +optional
+	// +listType=atomic
+	MinimumNodesPerType []MediatedDeviceTypeMinimum `json:"minimumNodesPerType,omitempty"`
+}
+
+// MediatedDeviceTypeMinimum is the minimum number of nodes offering a mediated device type.
+// +k8s:openapi-gen=true
+type MediatedDeviceTypeMinimum struct {
+	// MediatedDeviceType is the name of the mediated device type
+	MediatedDeviceType string `json:"mediatedDeviceType"`
+	// Nodes is the minimum number of nodes offering the type
+	Nodes int `json:"nodes"`
+}
+
+// MediatedDevicesRebalancingStatus reports the plan of the mediated device types rebalancing.
+// +k8s:openapi-gen=true
+type MediatedDevicesRebalancingStatus struct {
+	// PendingRequests lists the mediated device types requested by unschedulable VMIs
+	// +optional
+	// +listType=atomic
+	PendingRequests []MediatedDeviceTypeRequest `json:"pendingRequests,omitempty"`
+	// Nodes lists the nodes whose mediated device types were set by the rebalancing
+	// +optional
+	// +listType=atomic
+	Nodes []NodeMediatedDeviceTypesPlan `json:"nodes,omitempty"`
+}
+
+// MediatedDeviceTypeRequest is the number of unschedulable VMIs requesting a mediated device type.
+// +k8s:openapi-gen=true
+type MediatedDeviceTypeRequest struct {
+	// MediatedDeviceType is the name of the mediated device type
+	MediatedDeviceType string `json:"mediatedDeviceType"`
+	// VirtualMachineInstances is the number of unschedulable VMIs requesting the type
+	VirtualMachineInstances int `json:"virtualMachineInstances"`
+}
+
+// NodeMediatedDeviceTypesPlan holds the mediated device types assigned to a node by the rebalancing.
+// +k8s:openapi-gen=true
+type NodeMediatedDeviceTypesPlan struct {
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName"`
+	// MediatedDeviceTypes are the mediated device types assigned to the node
+	// +listType=atomic
+	MediatedDeviceTypes []string `json:"mediatedDeviceTypes"`
+	// Ready tells whether the node advertises the resources of all its assigned types
+	Ready bool `json:"ready"`
 }
 
 // NodeMediatedDeviceTypesConfig holds information about MDEV types to be defined in a specifc node that matches the NodeSelector field.
@@ -2579,6 +3011,11 @@ type NetworkConfiguration struct {
 	NetworkInterface                  string `json:"defaultNetworkInterface,omitempty"`
 	PermitSlirpInterface              *bool  `json:"permitSlirpInterface,omitempty"`
 	PermitBridgeInterfaceOnPodNetwork *bool  `json:"permitBridgeInterfaceOnPodNetwork,omitempty"`
+	// SecondaryNetworkDNSDomain is the DNS domain appended to the `<vmi>.<network>.<namespace>`
+	// records published for the secondary network interfaces of VMIs.
+	// Requires the SecondaryNetworkDNS feature gate.
+	// +optional
+	SecondaryNetworkDNSDomain string `json:"secondaryNetworkDNSDomain,omitempty"`
 }
 
 // GuestAgentPing configures the guest-agent based ping probe
@@ -72,6 +72,7 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"evacuationNodeName":            "EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want\nto evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.\n+optional",
 		"activePods":                    "ActivePods is a mapping of pod UID to node name.\nIt is possible for multiple pods to be running for a single VMI during migration.",
 		"volumeStatus":                  "VolumeStatus contains the statuses of all the volumes\n+optional\n+listType=atomic",
+		"hostDeviceStatus":              "HostDeviceStatus contains the statuses of the hot-attached host devices\n+optional\n+listType=atomic",
 		"fsFreezeStatus":                "FSFreezeStatus is the state of the fs of the guest\nit can be either frozen or thawed\n+optional",
 		"topologyHints":                 "+optional",
 		"virtualMachineRevisionName":    "VirtualMachineRevisionName is used to get the vm revision of the vmi when doing\nan online vm snapshot\n+optional",
@@ -79,6 +80,28 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"VSOCKCID":                      "VSOCKCID is used to track the allocated VSOCK CID in the VM.\n+optional",
 		"selinuxContext":                "SELinuxContext is the actual SELinux context of the virt-launcher pod\n+optional",
 		"machine":                       "Machine shows the final resulting qemu machine type. This can be different\nthan the machine type selected in the spec, due to qemus machine type alias mechanism.\n+optional",
+		"consoleSessions":               "ConsoleSessions lists the clients connected to the serial console, the additional serial ports and the VNC console\n+optional\n+listType=atomic",
+		"sshHostKeys":                   "SSHHostKeys are the public host keys of the SSH server of the guest, reported by the guest agent\nwhen an ephemeral SSH key is added, so that SSH clients can verify the guest\n+optional\n+listType=atomic",
+	}
+}
+
+func (SSHHostKey) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "SSHHostKey is a public host key of the SSH server of the guest",
+		"type":        "Type is the algorithm of the key, e.g. ssh-ed25519",
+		"publicKey":   "PublicKey is the key in the authorized_keys format",
+		"fingerprint": "Fingerprint is the SHA256 fingerprint of the key, as printed by ssh-keygen -l",
+	}
+}
+
+func (VirtualMachineInstanceConsoleSession) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineInstanceConsoleSession is a client connected to a console of the VMI.\nA console is held by at most one client which isn't read-only, the others only observe it.",
+		"console":            "Console is the kind of console the client is connected to",
+		"serialPort":         "SerialPort is the name of the additional serial port the client is connected to, empty for the serial console\n+optional",
+		"user":               "User is the name of the user who opened the session\n+optional",
+		"readOnly":           "ReadOnly is true for the observers, whose input is not sent to the console\n+optional",
+		"connectedTimestamp": "ConnectedTimestamp is when the client connected",
 	}
 }
 
@@ -127,6 +150,19 @@ func (HotplugVolumeStatus) SwaggerDoc() map[string]string {
 	}
 }
 
+func (HostDeviceStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "HostDeviceStatus represents information about the status of a host device hot-attached to the VirtualMachineInstance.",
+		"name":          "Name is the name of the host device",
+		"phase":         "Phase is the phase",
+		"reason":        "Reason is a brief description of why we are in the current hotplug host device phase",
+		"message":       "Message is a detailed message about the current hotplug host device phase",
+		"attachPodName": "AttachPodName is the name of the pod used to allocate the host device on the node.",
+		"attachPodUID":  "AttachPodUID is the UID of the pod used to allocate the host device on the node.",
+		"address":       "Address is the host address of the allocated device, a PCI address or a mediated device UUID.",
+	}
+}
+
 func (VirtualMachineInstanceCondition) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"lastProbeTime":      "+nullable",
@@ -515,8 +551,10 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
-		"generations": "+listType=atomic",
+		"":                           "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"generations":                "+listType=atomic",
+		"mediatedDevicesRebalancing": "MediatedDevicesRebalancing reports the plan of the mediated device types rebalancing\n+optional",
+		"cpuBaseline":                "CPUBaseline reports the newest CPU model and features supported by all the nodes selected for the cluster CPU baseline\n+optional",
 	}
 }
 
@@ -623,12 +661,113 @@ func (VirtualMachineInstanceFileSystem) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachineInstanceDomainStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "VirtualMachineInstanceDomainStats are the cumulative runtime statistics of the domain of a VirtualMachineInstance,\ncollected by virt-handler like for its metrics. Rates are computed from two samples.",
+		"timestamp":          "Timestamp is when the statistics were collected, with a precision allowing to compute rates over short intervals",
+		"cpuTimeNanoseconds": "CPUTimeNanoseconds is the CPU time used by the domain\n+optional",
+		"vCPUs":              "VCPUs is the number of vCPUs of the domain\n+optional",
+		"memory":             "Memory holds the memory statistics, most of them are only reported with the balloon driver in the guest\n+optional",
+		"disks":              "Disks holds the statistics of the disks of the domain\n+optional\n+listType=atomic",
+		"interfaces":         "Interfaces holds the statistics of the network interfaces of the domain\n+optional\n+listType=atomic",
+	}
+}
+
+func (DomainMemoryStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "DomainMemoryStats are the memory statistics of a domain",
+		"actualBalloonBytes": "ActualBalloonBytes is the memory currently assigned to the guest\n+optional",
+		"availableBytes":     "AvailableBytes is the memory usable by the guest OS\n+optional",
+		"unusedBytes":        "UnusedBytes is the memory left completely unused by the guest OS\n+optional",
+		"usableBytes":        "UsableBytes is the memory the guest OS can reclaim without swapping, including its caches\n+optional",
+		"rssBytes":           "RSSBytes is the resident memory of the domain on the node\n+optional",
+		"swapInBytes":        "SwapInBytes is the memory swapped in by the guest OS\n+optional",
+		"swapOutBytes":       "SwapOutBytes is the memory swapped out by the guest OS\n+optional",
+	}
+}
+
+func (DomainDiskStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "DomainDiskStats are the cumulative I/O statistics of a disk of a domain",
+		"name":          "Name is the name of the disk in the VirtualMachineInstance, or of the device in the domain if it has none",
+		"readBytes":     "+optional",
+		"readRequests":  "+optional",
+		"writeBytes":    "+optional",
+		"writeRequests": "+optional",
+	}
+}
+
+func (DomainInterfaceStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "DomainInterfaceStats are the cumulative traffic statistics of a network interface of a domain",
+		"name":      "Name is the name of the interface in the VirtualMachineInstance, or of the device in the domain if it has none",
+		"rxBytes":   "+optional",
+		"rxPackets": "+optional",
+		"txBytes":   "+optional",
+		"txPackets": "+optional",
+	}
+}
+
 func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"": "FreezeUnfreezeTimeout represent the time unfreeze will be triggered if guest was not unfrozen by unfreeze command",
 	}
 }
 
+func (GuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestExecOptions are the options of the guestexec subresource, which runs a command through the guest agent",
+		"command":        "Command is the path of the executable to run in the guest, followed by its arguments\n+listType=atomic",
+		"stdin":          "Stdin is passed to the standard input of the command\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is how long to wait for the command to exit. Defaults to 30 seconds.\n+optional",
+	}
+}
+
+func (GuestExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "GuestExecResult is the outcome of a command run through the guest agent",
+		"exitCode":        "ExitCode is the exit code of the command",
+		"stdout":          "Stdout is the standard output of the command\n+optional\n+listType=atomic",
+		"stderr":          "Stderr is the standard error of the command\n+optional\n+listType=atomic",
+		"stdoutTruncated": "StdoutTruncated is set when the guest agent dropped the end of the standard output, it captures 16MiB at most\n+optional",
+		"stderrTruncated": "StderrTruncated is set when the guest agent dropped the end of the standard error, it captures 16MiB at most\n+optional",
+	}
+}
+
+func (GuestFileOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "GuestFileOptions are the options of the guestfile subresource, which copies a file into or out of the guest through the guest agent",
+		"path":        "Path is the absolute path of the file in the guest",
+		"permissions": "Permissions are set on an uploaded file, in octal, e.g. 0644\n+optional",
+		"owner":       "Owner is set on an uploaded file, as user[:group]\n+optional",
+	}
+}
+
+func (AddSSHKeyOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "AddSSHKeyOptions are the options of the addsshkey subresource, which authorizes an ephemeral SSH public key\nfor a user of the guest through the guest agent",
+		"user":       "User is the user of the guest the key is authorized for",
+		"publicKey":  "PublicKey is the public key in the authorized_keys format",
+		"ttlSeconds": "TTLSeconds is how long the key stays authorized before the SSH server of the guest refuses it. Defaults to 120 seconds.\n+optional",
+	}
+}
+
+func (RemoveSSHKeyOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "RemoveSSHKeyOptions are the options of the removesshkey subresource, which removes an ephemeral SSH public key\nbefore it expires",
+		"user":      "User is the user of the guest the key is authorized for",
+		"publicKey": "PublicKey is the public key in the authorized_keys format",
+	}
+}
+
+func (VNCToken) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VNCToken is a short-lived token giving access to the VNC display of a single VirtualMachineInstance, through\nthe VNC websocket endpoint of virt-api, without other credentials",
+		"token":               "Token is passed in the token query parameter of the VNC websocket endpoint of virt-api",
+		"expirationTimestamp": "ExpirationTimestamp is when the token stops being accepted. Connections opened before remain open.",
+	}
+}
+
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
@@ -656,6 +795,14 @@ func (ScreenshotOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (VNCTokenOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "VNCTokenOptions are the options of the vnc/token subresource",
+		"readOnly":          "ReadOnly tokens only give access to observe the VNC display\n+optional",
+		"expirationSeconds": "ExpirationSeconds is how long the token is valid for. Defaults to 5 minutes.\n+optional",
+	}
+}
+
 func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -668,6 +815,23 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (AddHostDeviceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":           "AddHostDeviceOptions is provided when dynamically hot plugging a host device",
+		"name":       "Name is the name of the host device in the VMI spec",
+		"deviceName": "DeviceName is the resource name of the host device exposed by a device plugin",
+		"dryRun":     "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
+func (RemoveHostDeviceOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "RemoveHostDeviceOptions is provided when dynamically hot unplugging a host device",
+		"name":   "Name is the name of the host device that should be removed",
+		"dryRun": "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (AddInterfaceOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                                "AddInterfaceOptions is provided when dynamically hot plugging a network interface",
@@ -708,7 +872,25 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"additionalGuestMemoryOverheadRatio": "AdditionalGuestMemoryOverheadRatio can be used to increase the virtualization infrastructure\noverhead. This is useful, since the calculation of this overhead is not accurate and cannot\nbe entirely known in advance. The ratio that is being set determines by which factor to increase\nthe overhead calculated by Kubevirt. A higher ratio means that the VMs would be less compromised\nby node pressures, but would mean that fewer VMs could be scheduled to a node.\nIf not set, the default is 1.",
 		"supportContainerResources":          "+listType=map\n+listMapKey=type\nSupportContainerResources specifies the resource requirements for various types of supporting containers such as container disks/virtiofs/sidecars and hotplug attachment pods. If omitted a sensible default will be supplied.",
 		"supportedGuestAgentVersions":        "deprecated",
-		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.\nThe storage class must support RWX in filesystem mode.",
+		"vmStateStorageClass":                "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM.\nThe storage class must support filesystem mode, and the access mode set in VMStateStorageAccessMode.",
+		"vmStateStorageAccessMode":           "VMStateStorageAccessMode is the access mode of the PVCs created to preserve VM state.\nReadWriteMany volumes are shared by the source and the target of a migration, while the\nstate kept on ReadWriteOnce volumes is transferred to a new volume on every migration.\nDefaults to ReadWriteMany\n+kubebuilder:validation:Enum=ReadWriteMany;ReadWriteOnce\n+optional",
+		"cpuBaseline":                        "CPUBaseline selects the nodes the cluster-baseline CPU model is computed for.\nRequires the ClusterCPUBaseline feature gate.\n+optional",
+	}
+}
+
+func (CPUBaselineConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "CPUBaselineConfiguration holds the nodes the cluster-baseline CPU model is computed for.\n+k8s:openapi-gen=true",
+		"nodeSelector": "NodeSelector restricts the cluster CPU baseline to a group of nodes.\nAll the schedulable nodes are selected if empty.\n+optional",
+	}
+}
+
+func (CPUBaselineStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "CPUBaselineStatus holds the newest CPU model and features supported by all the nodes selected for the cluster CPU baseline.\n+k8s:openapi-gen=true",
+		"model":    "Model is the newest named CPU model supported by all the nodes, empty if they have none in common\n+optional",
+		"features": "Features are the CPU features supported by all the nodes\n+optional\n+listType=atomic",
+		"nodes":    "Nodes is the number of nodes the baseline is computed for",
 	}
 }
 
@@ -806,6 +988,7 @@ func (PermittedHostDevices) SwaggerDoc() map[string]string {
 		"":                "PermittedHostDevices holds information about devices allowed for passthrough",
 		"pciHostDevices":  "+listType=atomic",
 		"mediatedDevices": "+listType=atomic",
+		"usbHostDevices":  "+listType=atomic",
 	}
 }
 
@@ -824,12 +1007,72 @@ func (MediatedHostDevice) SwaggerDoc() map[string]string {
 	}
 }
 
+func (USBHostDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                         "USBHostDevice represents host USB devices allowed for passthrough",
+		"resourceName":             "The name of the resource that is representing the devices. Exposed by\na device plugin and requested by VMs. Typically of the form\nvendor.com/product_name",
+		"selectors":                "Selectors identify the host USB devices exposed under the resource.\nEvery matching device can be allocated on its own.\n+listType=atomic",
+		"externalResourceProvider": "If true, KubeVirt will leave the allocation and monitoring to an\nexternal device plugin",
+	}
+}
+
+func (USBSelector) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "USBSelector selects host USB devices by their vendor and product IDs,\nand optionally by the port they are plugged into",
+		"vendor":  "The vendor ID of the USB device, e.g. 0529",
+		"product": "The product ID of the USB device, e.g. 0001",
+		"busPath": "The bus path of the port the device is plugged into, as named under\n/sys/bus/usb/devices, e.g. 1-2.3. Devices plugged into any port match if empty.\n+optional",
+	}
+}
+
 func (MediatedDevicesConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":                        "MediatedDevicesConfiguration holds information about MDEV types to be defined, if available",
 		"mediatedDevicesTypes":    "Deprecated. Use mediatedDeviceTypes instead.\n+optional\n+listType=atomic",
 		"mediatedDeviceTypes":     "+optional\n+listType=atomic",
 		"nodeMediatedDeviceTypes": "+optional\n+listType=atomic",
+		"rebalancing":             "Rebalancing lets virt-controller reconfigure idle GPUs to the mediated device types requested by pending VMIs.\nRequires the MediatedDevicesRebalancing feature gate.\n+optional",
+	}
+}
+
+func (MediatedDevicesRebalancing) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "MediatedDevicesRebalancing holds the constraints of the mediated device types rebalancing.\n+k8s:openapi-gen=true",
+		"nodeSelector":        "NodeSelector selects the nodes whose GPUs may be reconfigured. The selected nodes must support all the\nmediated device types requested by the VMIs. All nodes are selected if empty.\n+optional",
+		"minimumNodesPerType": "MinimumNodesPerType is the number of nodes which keep offering a mediated device type,\neven if no pending VMI requests it.\n+optional\n+listType=atomic",
+	}
+}
+
+func (MediatedDeviceTypeMinimum) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "MediatedDeviceTypeMinimum is the minimum number of nodes offering a mediated device type.\n+k8s:openapi-gen=true",
+		"mediatedDeviceType": "MediatedDeviceType is the name of the mediated device type",
+		"nodes":              "Nodes is the minimum number of nodes offering the type",
+	}
+}
+
+func (MediatedDevicesRebalancingStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                "MediatedDevicesRebalancingStatus reports the plan of the mediated device types rebalancing.\n+k8s:openapi-gen=true",
+		"pendingRequests": "PendingRequests lists the mediated device types requested by unschedulable VMIs\n+optional\n+listType=atomic",
+		"nodes":           "Nodes lists the nodes whose mediated device types were set by the rebalancing\n+optional\n+listType=atomic",
+	}
+}
+
+func (MediatedDeviceTypeRequest) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                        "MediatedDeviceTypeRequest is the number of unschedulable VMIs requesting a mediated device type.\n+k8s:openapi-gen=true",
+		"mediatedDeviceType":      "MediatedDeviceType is the name of the mediated device type",
+		"virtualMachineInstances": "VirtualMachineInstances is the number of unschedulable VMIs requesting the type",
+	}
+}
+
+func (NodeMediatedDeviceTypesPlan) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "NodeMediatedDeviceTypesPlan holds the mediated device types assigned to a node by the rebalancing.\n+k8s:openapi-gen=true",
+		"nodeName":            "NodeName is the name of the node",
+		"mediatedDeviceTypes": "MediatedDeviceTypes are the mediated device types assigned to the node\n+listType=atomic",
+		"ready":               "Ready tells whether the node advertises the resources of all its assigned types",
 	}
 }
 
@@ -844,7 +1087,8 @@ func (NodeMediatedDeviceTypesConfig) SwaggerDoc() map[string]string {
 
 func (NetworkConfiguration) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"": "NetworkConfiguration holds network options",
+		"":                          "NetworkConfiguration holds network options",
+		"secondaryNetworkDNSDomain": "SecondaryNetworkDNSDomain is the DNS domain appended to the `<vmi>.<network>.<namespace>`\nrecords published for the secondary network interfaces of VMIs.\nRequires the SecondaryNetworkDNS feature gate.\n+optional",
 	}
 }
 
@@ -0,0 +1,156 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright 2023 Red Hat, Inc.
+ *
+ */
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeCapabilities reports the virtualization capabilities of a node, as discovered by virt-handler.
+// It is named after the node and is garbage collected along with it.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +k8s:openapi-gen=true
+// +genclient
+// +genclient:nonNamespaced
+type NodeCapabilities struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +nullable
+	Status NodeCapabilitiesStatus `json:"status,omitempty"`
+}
+
+type NodeCapabilitiesStatus struct {
+	// Hypervisor holds the versions of the virtualization stack of the node
+	// +optional
+	Hypervisor *HypervisorVersions `json:"hypervisor,omitempty"`
+	// CPU holds the CPU models and features which can be provided to guests
+	// +optional
+	CPU *CPUCapabilities `json:"cpu,omitempty"`
+	// MachineTypes lists the machine types supported by the emulator
+	// +optional
+	// +listType=atomic
+	MachineTypes []MachineType `json:"machineTypes,omitempty"`
+	// NUMACells describes the NUMA topology of the node, along with the hugepages of every cell
+	// +optional
+	// +listType=atomic
+	NUMACells []NUMACell `json:"numaCells,omitempty"`
+	// IOMMUGroups lists the PCI devices of the node by IOMMU group
+	// +optional
+	// +listType=atomic
+	IOMMUGroups []IOMMUGroup `json:"iommuGroups,omitempty"`
+}
+
+type HypervisorVersions struct {
+	// +optional
+	LibvirtVersion string `json:"libvirtVersion,omitempty"`
+	// +optional
+	QEMUVersion string `json:"qemuVersion,omitempty"`
+}
+
+type CPUCapabilities struct {
+	// +optional
+	Arch string `json:"arch,omitempty"`
+	// +optional
+	Vendor string `json:"vendor,omitempty"`
+	// HostModel is the CPU model used by guests with the host-model CPU mode
+	// +optional
+	HostModel string `json:"hostModel,omitempty"`
+	// HostModelRequiredFeatures are the features the host-model CPU adds on top of the host model
+	// +optional
+	// +listType=atomic
+	HostModelRequiredFeatures []string `json:"hostModelRequiredFeatures,omitempty"`
+	// Models are the usable CPU models, without the obsolete ones
+	// +optional
+	// +listType=atomic
+	Models []string `json:"models,omitempty"`
+	// +optional
+	// +listType=atomic
+	Features []string `json:"features,omitempty"`
+	// +optional
+	// +listType=atomic
+	HypervFeatures []string `json:"hypervFeatures,omitempty"`
+	// +optional
+	TSC *TSCCounter `json:"tsc,omitempty"`
+	// SEV is true when the node supports AMD Secure Encrypted Virtualization
+	// +optional
+	SEV bool `json:"sev,omitempty"`
+}
+
+type TSCCounter struct {
+	Frequency int64 `json:"frequency"`
+	Scalable  bool  `json:"scalable"`
+}
+
+type MachineType struct {
+	Name string `json:"name"`
+	// Canonical is the versioned machine type the name is an alias of
+	// +optional
+	Canonical string `json:"canonical,omitempty"`
+	// +optional
+	MaxCPUs int32 `json:"maxCPUs,omitempty"`
+}
+
+type NUMACell struct {
+	ID uint32 `json:"id"`
+	// +optional
+	Memory *resource.Quantity `json:"memory,omitempty"`
+	// CPUSet lists the host CPUs of the cell in the cpuset format, e.g. 0-3,8-11
+	// +optional
+	CPUSet string `json:"cpuSet,omitempty"`
+	// +optional
+	// +listType=atomic
+	HugePages []HugePages `json:"hugePages,omitempty"`
+	// Distances to the other cells, as reported by the firmware
+	// +optional
+	// +listType=atomic
+	Distances []NUMADistance `json:"distances,omitempty"`
+}
+
+type HugePages struct {
+	PageSize resource.Quantity `json:"pageSize"`
+	// Count is the number of pages of the cell, both free and in use
+	Count int64 `json:"count"`
+}
+
+type NUMADistance struct {
+	CellID uint32 `json:"cellID"`
+	Value  int64  `json:"value"`
+}
+
+type IOMMUGroup struct {
+	ID uint32 `json:"id"`
+	// Devices are the PCI addresses of the devices of the group
+	// +listType=atomic
+	Devices []string `json:"devices"`
+}
+
+// NodeCapabilitiesList is a list of NodeCapabilities
+//
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type NodeCapabilitiesList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	// +listType=atomic
+	Items []NodeCapabilities `json:"items"`
+}
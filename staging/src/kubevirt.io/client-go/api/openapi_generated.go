@@ -303,16 +303,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/clone/v1alpha1.VirtualMachineCloneStatus":                                   schema_kubevirtio_api_clone_v1alpha1_VirtualMachineCloneStatus(ref),
 		"kubevirt.io/api/core/v1.AccessCredential":                                                   schema_kubevirtio_api_core_v1_AccessCredential(ref),
 		"kubevirt.io/api/core/v1.AccessCredentialSecretSource":                                       schema_kubevirtio_api_core_v1_AccessCredentialSecretSource(ref),
+		"kubevirt.io/api/core/v1.AddHostDeviceOptions":                                               schema_kubevirtio_api_core_v1_AddHostDeviceOptions(ref),
 		"kubevirt.io/api/core/v1.AddInterfaceOptions":                                                schema_kubevirtio_api_core_v1_AddInterfaceOptions(ref),
+		"kubevirt.io/api/core/v1.AddSSHKeyOptions":                                                   schema_kubevirtio_api_core_v1_AddSSHKeyOptions(ref),
 		"kubevirt.io/api/core/v1.AddVolumeOptions":                                                   schema_kubevirtio_api_core_v1_AddVolumeOptions(ref),
 		"kubevirt.io/api/core/v1.ArchConfiguration":                                                  schema_kubevirtio_api_core_v1_ArchConfiguration(ref),
 		"kubevirt.io/api/core/v1.ArchSpecificConfiguration":                                          schema_kubevirtio_api_core_v1_ArchSpecificConfiguration(ref),
 		"kubevirt.io/api/core/v1.AuthorizedKeysFile":                                                 schema_kubevirtio_api_core_v1_AuthorizedKeysFile(ref),
 		"kubevirt.io/api/core/v1.BIOS":                                                               schema_kubevirtio_api_core_v1_BIOS(ref),
+		"kubevirt.io/api/core/v1.BandwidthLimits":                                                    schema_kubevirtio_api_core_v1_BandwidthLimits(ref),
 		"kubevirt.io/api/core/v1.BlockSize":                                                          schema_kubevirtio_api_core_v1_BlockSize(ref),
 		"kubevirt.io/api/core/v1.Bootloader":                                                         schema_kubevirtio_api_core_v1_Bootloader(ref),
 		"kubevirt.io/api/core/v1.CDRomTarget":                                                        schema_kubevirtio_api_core_v1_CDRomTarget(ref),
 		"kubevirt.io/api/core/v1.CPU":                                                                schema_kubevirtio_api_core_v1_CPU(ref),
+		"kubevirt.io/api/core/v1.CPUBaselineConfiguration":                                           schema_kubevirtio_api_core_v1_CPUBaselineConfiguration(ref),
+		"kubevirt.io/api/core/v1.CPUBaselineStatus":                                                  schema_kubevirtio_api_core_v1_CPUBaselineStatus(ref),
 		"kubevirt.io/api/core/v1.CPUFeature":                                                         schema_kubevirtio_api_core_v1_CPUFeature(ref),
 		"kubevirt.io/api/core/v1.CertConfig":                                                         schema_kubevirtio_api_core_v1_CertConfig(ref),
 		"kubevirt.io/api/core/v1.Chassis":                                                            schema_kubevirtio_api_core_v1_Chassis(ref),
@@ -343,7 +348,10 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.DiskDevice":                                                         schema_kubevirtio_api_core_v1_DiskDevice(ref),
 		"kubevirt.io/api/core/v1.DiskTarget":                                                         schema_kubevirtio_api_core_v1_DiskTarget(ref),
 		"kubevirt.io/api/core/v1.DiskVerification":                                                   schema_kubevirtio_api_core_v1_DiskVerification(ref),
+		"kubevirt.io/api/core/v1.DomainDiskStats":                                                    schema_kubevirtio_api_core_v1_DomainDiskStats(ref),
+		"kubevirt.io/api/core/v1.DomainInterfaceStats":                                               schema_kubevirtio_api_core_v1_DomainInterfaceStats(ref),
 		"kubevirt.io/api/core/v1.DomainMemoryDumpInfo":                                               schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref),
+		"kubevirt.io/api/core/v1.DomainMemoryStats":                                                  schema_kubevirtio_api_core_v1_DomainMemoryStats(ref),
 		"kubevirt.io/api/core/v1.DomainSpec":                                                         schema_kubevirtio_api_core_v1_DomainSpec(ref),
 		"kubevirt.io/api/core/v1.DownwardAPIVolumeSource":                                            schema_kubevirtio_api_core_v1_DownwardAPIVolumeSource(ref),
 		"kubevirt.io/api/core/v1.DownwardMetricsVolumeSource":                                        schema_kubevirtio_api_core_v1_DownwardMetricsVolumeSource(ref),
@@ -366,9 +374,13 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.GenerationStatus":                                                   schema_kubevirtio_api_core_v1_GenerationStatus(ref),
 		"kubevirt.io/api/core/v1.GuestAgentCommandInfo":                                              schema_kubevirtio_api_core_v1_GuestAgentCommandInfo(ref),
 		"kubevirt.io/api/core/v1.GuestAgentPing":                                                     schema_kubevirtio_api_core_v1_GuestAgentPing(ref),
+		"kubevirt.io/api/core/v1.GuestExecOptions":                                                   schema_kubevirtio_api_core_v1_GuestExecOptions(ref),
+		"kubevirt.io/api/core/v1.GuestExecResult":                                                    schema_kubevirtio_api_core_v1_GuestExecResult(ref),
+		"kubevirt.io/api/core/v1.GuestFileOptions":                                                   schema_kubevirtio_api_core_v1_GuestFileOptions(ref),
 		"kubevirt.io/api/core/v1.HPETTimer":                                                          schema_kubevirtio_api_core_v1_HPETTimer(ref),
 		"kubevirt.io/api/core/v1.Handler":                                                            schema_kubevirtio_api_core_v1_Handler(ref),
 		"kubevirt.io/api/core/v1.HostDevice":                                                         schema_kubevirtio_api_core_v1_HostDevice(ref),
+		"kubevirt.io/api/core/v1.HostDeviceStatus":                                                   schema_kubevirtio_api_core_v1_HostDeviceStatus(ref),
 		"kubevirt.io/api/core/v1.HostDisk":                                                           schema_kubevirtio_api_core_v1_HostDisk(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeSource":                                                schema_kubevirtio_api_core_v1_HotplugVolumeSource(ref),
 		"kubevirt.io/api/core/v1.HotplugVolumeStatus":                                                schema_kubevirtio_api_core_v1_HotplugVolumeStatus(ref),
@@ -378,6 +390,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Input":                                                              schema_kubevirtio_api_core_v1_Input(ref),
 		"kubevirt.io/api/core/v1.InstancetypeMatcher":                                                schema_kubevirtio_api_core_v1_InstancetypeMatcher(ref),
 		"kubevirt.io/api/core/v1.Interface":                                                          schema_kubevirtio_api_core_v1_Interface(ref),
+		"kubevirt.io/api/core/v1.InterfaceBandwidth":                                                 schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref),
 		"kubevirt.io/api/core/v1.InterfaceBindingMethod":                                             schema_kubevirtio_api_core_v1_InterfaceBindingMethod(ref),
 		"kubevirt.io/api/core/v1.InterfaceBridge":                                                    schema_kubevirtio_api_core_v1_InterfaceBridge(ref),
 		"kubevirt.io/api/core/v1.InterfaceMacvtap":                                                   schema_kubevirtio_api_core_v1_InterfaceMacvtap(ref),
@@ -401,7 +414,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.LogVerbosity":                                                       schema_kubevirtio_api_core_v1_LogVerbosity(ref),
 		"kubevirt.io/api/core/v1.LunTarget":                                                          schema_kubevirtio_api_core_v1_LunTarget(ref),
 		"kubevirt.io/api/core/v1.Machine":                                                            schema_kubevirtio_api_core_v1_Machine(ref),
+		"kubevirt.io/api/core/v1.MediatedDeviceTypeMinimum":                                          schema_kubevirtio_api_core_v1_MediatedDeviceTypeMinimum(ref),
+		"kubevirt.io/api/core/v1.MediatedDeviceTypeRequest":                                          schema_kubevirtio_api_core_v1_MediatedDeviceTypeRequest(ref),
 		"kubevirt.io/api/core/v1.MediatedDevicesConfiguration":                                       schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref),
+		"kubevirt.io/api/core/v1.MediatedDevicesRebalancing":                                         schema_kubevirtio_api_core_v1_MediatedDevicesRebalancing(ref),
+		"kubevirt.io/api/core/v1.MediatedDevicesRebalancingStatus":                                   schema_kubevirtio_api_core_v1_MediatedDevicesRebalancingStatus(ref),
 		"kubevirt.io/api/core/v1.MediatedHostDevice":                                                 schema_kubevirtio_api_core_v1_MediatedHostDevice(ref),
 		"kubevirt.io/api/core/v1.Memory":                                                             schema_kubevirtio_api_core_v1_Memory(ref),
 		"kubevirt.io/api/core/v1.MemoryDumpVolumeSource":                                             schema_kubevirtio_api_core_v1_MemoryDumpVolumeSource(ref),
@@ -409,11 +426,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.MigrationConfiguration":                                             schema_kubevirtio_api_core_v1_MigrationConfiguration(ref),
 		"kubevirt.io/api/core/v1.MultusNetwork":                                                      schema_kubevirtio_api_core_v1_MultusNetwork(ref),
 		"kubevirt.io/api/core/v1.NUMA":                                                               schema_kubevirtio_api_core_v1_NUMA(ref),
+		"kubevirt.io/api/core/v1.NUMACell":                                                           schema_kubevirtio_api_core_v1_NUMACell(ref),
+		"kubevirt.io/api/core/v1.NUMADistance":                                                       schema_kubevirtio_api_core_v1_NUMADistance(ref),
 		"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough":                                        schema_kubevirtio_api_core_v1_NUMAGuestMappingPassthrough(ref),
 		"kubevirt.io/api/core/v1.Network":                                                            schema_kubevirtio_api_core_v1_Network(ref),
 		"kubevirt.io/api/core/v1.NetworkConfiguration":                                               schema_kubevirtio_api_core_v1_NetworkConfiguration(ref),
 		"kubevirt.io/api/core/v1.NetworkSource":                                                      schema_kubevirtio_api_core_v1_NetworkSource(ref),
 		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig":                                      schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref),
+		"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesPlan":                                        schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesPlan(ref),
 		"kubevirt.io/api/core/v1.NodePlacement":                                                      schema_kubevirtio_api_core_v1_NodePlacement(ref),
 		"kubevirt.io/api/core/v1.PITTimer":                                                           schema_kubevirtio_api_core_v1_PITTimer(ref),
 		"kubevirt.io/api/core/v1.PauseOptions":                                                       schema_kubevirtio_api_core_v1_PauseOptions(ref),
@@ -433,18 +453,22 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.RateLimiter":                                                        schema_kubevirtio_api_core_v1_RateLimiter(ref),
 		"kubevirt.io/api/core/v1.Realtime":                                                           schema_kubevirtio_api_core_v1_Realtime(ref),
 		"kubevirt.io/api/core/v1.ReloadableComponentConfiguration":                                   schema_kubevirtio_api_core_v1_ReloadableComponentConfiguration(ref),
+		"kubevirt.io/api/core/v1.RemoveHostDeviceOptions":                                            schema_kubevirtio_api_core_v1_RemoveHostDeviceOptions(ref),
+		"kubevirt.io/api/core/v1.RemoveSSHKeyOptions":                                                schema_kubevirtio_api_core_v1_RemoveSSHKeyOptions(ref),
 		"kubevirt.io/api/core/v1.RemoveVolumeOptions":                                                schema_kubevirtio_api_core_v1_RemoveVolumeOptions(ref),
 		"kubevirt.io/api/core/v1.ResourceRequirements":                                               schema_kubevirtio_api_core_v1_ResourceRequirements(ref),
 		"kubevirt.io/api/core/v1.RestartOptions":                                                     schema_kubevirtio_api_core_v1_RestartOptions(ref),
 		"kubevirt.io/api/core/v1.Rng":                                                                schema_kubevirtio_api_core_v1_Rng(ref),
 		"kubevirt.io/api/core/v1.SEV":                                                                schema_kubevirtio_api_core_v1_SEV(ref),
 		"kubevirt.io/api/core/v1.SMBiosConfiguration":                                                schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref),
+		"kubevirt.io/api/core/v1.SSHHostKey":                                                         schema_kubevirtio_api_core_v1_SSHHostKey(ref),
 		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredential":                                       schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredential(ref),
 		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialPropagationMethod":                      schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialPropagationMethod(ref),
 		"kubevirt.io/api/core/v1.SSHPublicKeyAccessCredentialSource":                                 schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredentialSource(ref),
 		"kubevirt.io/api/core/v1.ScreenshotOptions":                                                  schema_kubevirtio_api_core_v1_ScreenshotOptions(ref),
 		"kubevirt.io/api/core/v1.SeccompConfiguration":                                               schema_kubevirtio_api_core_v1_SeccompConfiguration(ref),
 		"kubevirt.io/api/core/v1.SecretVolumeSource":                                                 schema_kubevirtio_api_core_v1_SecretVolumeSource(ref),
+		"kubevirt.io/api/core/v1.SerialPort":                                                         schema_kubevirtio_api_core_v1_SerialPort(ref),
 		"kubevirt.io/api/core/v1.ServiceAccountVolumeSource":                                         schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref),
 		"kubevirt.io/api/core/v1.SoundDevice":                                                        schema_kubevirtio_api_core_v1_SoundDevice(ref),
 		"kubevirt.io/api/core/v1.StartOptions":                                                       schema_kubevirtio_api_core_v1_StartOptions(ref),
@@ -457,6 +481,8 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.Timer":                                                              schema_kubevirtio_api_core_v1_Timer(ref),
 		"kubevirt.io/api/core/v1.TokenBucketRateLimiter":                                             schema_kubevirtio_api_core_v1_TokenBucketRateLimiter(ref),
 		"kubevirt.io/api/core/v1.TopologyHints":                                                      schema_kubevirtio_api_core_v1_TopologyHints(ref),
+		"kubevirt.io/api/core/v1.USBHostDevice":                                                      schema_kubevirtio_api_core_v1_USBHostDevice(ref),
+		"kubevirt.io/api/core/v1.USBSelector":                                                        schema_kubevirtio_api_core_v1_USBSelector(ref),
 		"kubevirt.io/api/core/v1.UnpauseOptions":                                                     schema_kubevirtio_api_core_v1_UnpauseOptions(ref),
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredential":                                       schema_kubevirtio_api_core_v1_UserPasswordAccessCredential(ref),
 		"kubevirt.io/api/core/v1.UserPasswordAccessCredentialPropagationMethod":                      schema_kubevirtio_api_core_v1_UserPasswordAccessCredentialPropagationMethod(ref),
@@ -464,11 +490,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/core/v1.VGPUDisplayOptions":                                                 schema_kubevirtio_api_core_v1_VGPUDisplayOptions(ref),
 		"kubevirt.io/api/core/v1.VGPUOptions":                                                        schema_kubevirtio_api_core_v1_VGPUOptions(ref),
 		"kubevirt.io/api/core/v1.VMISelector":                                                        schema_kubevirtio_api_core_v1_VMISelector(ref),
+		"kubevirt.io/api/core/v1.VNCToken":                                                           schema_kubevirtio_api_core_v1_VNCToken(ref),
+		"kubevirt.io/api/core/v1.VNCTokenOptions":                                                    schema_kubevirtio_api_core_v1_VNCTokenOptions(ref),
 		"kubevirt.io/api/core/v1.VSOCKOptions":                                                       schema_kubevirtio_api_core_v1_VSOCKOptions(ref),
+		"kubevirt.io/api/core/v1.VideoDevice":                                                        schema_kubevirtio_api_core_v1_VideoDevice(ref),
+		"kubevirt.io/api/core/v1.VideoResolution":                                                    schema_kubevirtio_api_core_v1_VideoResolution(ref),
 		"kubevirt.io/api/core/v1.VirtualMachine":                                                     schema_kubevirtio_api_core_v1_VirtualMachine(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineCondition":                                            schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstance":                                             schema_kubevirtio_api_core_v1_VirtualMachineInstance(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceCondition":                                    schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceConsoleSession":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceConsoleSession(ref),
+		"kubevirt.io/api/core/v1.VirtualMachineInstanceDomainStats":                                  schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainStats(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystem":                                   schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemInfo":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemInfo(ref),
 		"kubevirt.io/api/core/v1.VirtualMachineInstanceFileSystemList":                               schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystemList(ref),
@@ -566,6 +598,17 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicySpec":                                    schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicySpec(ref),
 		"kubevirt.io/api/migrations/v1alpha1.MigrationPolicyStatus":                                  schema_kubevirtio_api_migrations_v1alpha1_MigrationPolicyStatus(ref),
 		"kubevirt.io/api/migrations/v1alpha1.Selectors":                                              schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref),
+		"kubevirt.io/api/node/v1alpha1.CPUCapabilities":                                              schema_kubevirtio_api_node_v1alpha1_CPUCapabilities(ref),
+		"kubevirt.io/api/node/v1alpha1.HugePages":                                                    schema_kubevirtio_api_node_v1alpha1_HugePages(ref),
+		"kubevirt.io/api/node/v1alpha1.HypervisorVersions":                                           schema_kubevirtio_api_node_v1alpha1_HypervisorVersions(ref),
+		"kubevirt.io/api/node/v1alpha1.IOMMUGroup":                                                   schema_kubevirtio_api_node_v1alpha1_IOMMUGroup(ref),
+		"kubevirt.io/api/node/v1alpha1.MachineType":                                                  schema_kubevirtio_api_node_v1alpha1_MachineType(ref),
+		"kubevirt.io/api/node/v1alpha1.NUMACell":                                                     schema_kubevirtio_api_node_v1alpha1_NUMACell(ref),
+		"kubevirt.io/api/node/v1alpha1.NUMADistance":                                                 schema_kubevirtio_api_node_v1alpha1_NUMADistance(ref),
+		"kubevirt.io/api/node/v1alpha1.NodeCapabilities":                                             schema_kubevirtio_api_node_v1alpha1_NodeCapabilities(ref),
+		"kubevirt.io/api/node/v1alpha1.NodeCapabilitiesList":                                         schema_kubevirtio_api_node_v1alpha1_NodeCapabilitiesList(ref),
+		"kubevirt.io/api/node/v1alpha1.NodeCapabilitiesStatus":                                       schema_kubevirtio_api_node_v1alpha1_NodeCapabilitiesStatus(ref),
+		"kubevirt.io/api/node/v1alpha1.TSCCounter":                                                   schema_kubevirtio_api_node_v1alpha1_TSCCounter(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePool":                                           schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolCondition":                                  schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolCondition(ref),
 		"kubevirt.io/api/pool/v1alpha1.VirtualMachinePoolList":                                       schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePoolList(ref),
@@ -14541,6 +14584,53 @@ func schema_kubevirtio_api_core_v1_AccessCredentialSecretSource(ref common.Refer
 	}
 }
 
+func schema_kubevirtio_api_core_v1_AddHostDeviceOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddHostDeviceOptions is provided when dynamically hot plugging a host device",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the host device in the VMI spec",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deviceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceName is the resource name of the host device exposed by a device plugin",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name", "deviceName"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_AddInterfaceOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14569,6 +14659,41 @@ func schema_kubevirtio_api_core_v1_AddInterfaceOptions(ref common.ReferenceCallb
 	}
 }
 
+func schema_kubevirtio_api_core_v1_AddSSHKeyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "AddSSHKeyOptions are the options of the addsshkey subresource, which authorizes an ephemeral SSH public key for a user of the guest through the guest agent",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the user of the guest the key is authorized for",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKey is the public key in the authorized_keys format",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"ttlSeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TTLSeconds is how long the key stays authorized before the SSH server of the guest refuses it. Defaults to 120 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"user", "publicKey"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_AddVolumeOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14741,6 +14866,40 @@ func schema_kubevirtio_api_core_v1_BIOS(ref common.ReferenceCallback) common.Ope
 	}
 }
 
+func schema_kubevirtio_api_core_v1_BandwidthLimits(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "BandwidthLimits defines the rate limits of traffic in one direction. Rates are expressed in bytes per second and are rounded up to the next kibibyte.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"average": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Average rate in bytes per second the traffic is shaped to.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"peak": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Maximum rate in bytes per second at which the traffic can be sent while bursting.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"burst": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Amount of bytes which can be sent at the peak rate in a single burst.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+				},
+				Required: []string{"average"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_BlockSize(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -14856,7 +15015,7 @@ func schema_kubevirtio_api_core_v1_CPU(ref common.ReferenceCallback) common.Open
 					},
 					"model": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node and \"host-model\" to get CPU closest to the node one. Defaults to host-model.",
+							Description: "Model specifies the CPU model inside the VMI. List of available models https://github.com/libvirt/libvirt/tree/master/src/cpu_map. It is possible to specify special cases like \"host-passthrough\" to get the same CPU as the node and \"host-model\" to get CPU closest to the node one. \"cluster-baseline\" gets the newest CPU model supported by all the nodes of the cluster, and requires the ClusterCPUBaseline feature gate. Defaults to host-model.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -14908,6 +15067,81 @@ func schema_kubevirtio_api_core_v1_CPU(ref common.ReferenceCallback) common.Open
 	}
 }
 
+func schema_kubevirtio_api_core_v1_CPUBaselineConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUBaselineConfiguration holds the nodes the cluster-baseline CPU model is computed for.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector restricts the cluster CPU baseline to a group of nodes. All the schedulable nodes are selected if empty.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_CPUBaselineStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CPUBaselineStatus holds the newest CPU model and features supported by all the nodes selected for the cluster CPU baseline.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"model": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Model is the newest named CPU model supported by all the nodes, empty if they have none in common",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"features": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Features are the CPU features supported by all the nodes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of nodes the baseline is computed for",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"nodes"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_CPUFeature(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -15860,6 +16094,13 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Format:      "",
 						},
 					},
+					"logSerialConsole": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to log the auto-attached default serial console or not. The serial console output is collected to a size-capped file, and streamed by the guest-console-log container of the virt-launcher pod. Not relevant if autoattachSerialConsole is disabled. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 					"autoattachMemBalloon": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Whether to attach the Memory balloon device with default period. Period can be adjusted in virt-config. Defaults to true.",
@@ -15973,11 +16214,35 @@ func schema_kubevirtio_api_core_v1_Devices(ref common.ReferenceCallback) common.
 							Ref:         ref("kubevirt.io/api/core/v1.TPMDevice"),
 						},
 					},
+					"video": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Video configures the video device attached along with the graphics device. If not set, the default video device of the architecture is used.",
+							Ref:         ref("kubevirt.io/api/core/v1.VideoDevice"),
+						},
+					},
+					"serialPorts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SerialPorts are additional named serial ports, next to the serial console. They can be connected to through the console subresource.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.SerialPort"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.Watchdog"},
+			"kubevirt.io/api/core/v1.ClientPassthroughDevices", "kubevirt.io/api/core/v1.Disk", "kubevirt.io/api/core/v1.Filesystem", "kubevirt.io/api/core/v1.GPU", "kubevirt.io/api/core/v1.HostDevice", "kubevirt.io/api/core/v1.Input", "kubevirt.io/api/core/v1.Interface", "kubevirt.io/api/core/v1.Rng", "kubevirt.io/api/core/v1.SerialPort", "kubevirt.io/api/core/v1.SoundDevice", "kubevirt.io/api/core/v1.TPMDevice", "kubevirt.io/api/core/v1.VideoDevice", "kubevirt.io/api/core/v1.Watchdog"},
 	}
 }
 
@@ -16163,91 +16428,243 @@ func schema_kubevirtio_api_core_v1_DiskVerification(ref common.ReferenceCallback
 	}
 }
 
-func schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_DomainDiskStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DomainMemoryDumpInfo represents the memory dump information",
+				Description: "DomainDiskStats are the cumulative I/O statistics of a disk of a domain",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"startTimestamp": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "StartTimestamp is the time when the memory dump started",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "Name is the name of the disk in the VirtualMachineInstance, or of the device in the domain if it has none",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"endTimestamp": {
+					"readBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "EndTimestamp is the time when the memory dump completed",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"claimName": {
+					"readRequests": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ClaimName is the name of the pvc the memory was dumped to",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"targetFileName": {
+					"writeBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TargetFileName is the name of the memory dump output",
-							Type:        []string{"string"},
-							Format:      "",
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"writeRequests": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_DomainSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_DomainInterfaceStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DomainInterfaceStats are the cumulative traffic statistics of a network interface of a domain",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources describes the Compute Resources required by this vmi.",
-							Ref:         ref("kubevirt.io/api/core/v1.ResourceRequirements"),
-						},
-					},
-					"cpu": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CPU allow specified the detailed CPU topology inside the vmi.",
-							Ref:         ref("kubevirt.io/api/core/v1.CPU"),
+							Description: "Name is the name of the interface in the VirtualMachineInstance, or of the device in the domain if it has none",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"memory": {
+					"rxBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Memory allow specifying the VMI memory features.",
-							Ref:         ref("kubevirt.io/api/core/v1.Memory"),
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"machine": {
+					"rxPackets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Machine type.",
-							Ref:         ref("kubevirt.io/api/core/v1.Machine"),
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"firmware": {
+					"txBytes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Firmware.",
-							Ref:         ref("kubevirt.io/api/core/v1.Firmware"),
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"clock": {
+					"txPackets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Clock sets the clock and timers of the vmi.",
-							Ref:         ref("kubevirt.io/api/core/v1.Clock"),
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"features": {
-						SchemaProps: spec.SchemaProps{
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DomainMemoryDumpInfo(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DomainMemoryDumpInfo represents the memory dump information",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"startTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StartTimestamp is the time when the memory dump started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"endTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "EndTimestamp is the time when the memory dump completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"claimName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ClaimName is the name of the pvc the memory was dumped to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"targetFileName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetFileName is the name of the memory dump output",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DomainMemoryStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DomainMemoryStats are the memory statistics of a domain",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"actualBalloonBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ActualBalloonBytes is the memory currently assigned to the guest",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"availableBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AvailableBytes is the memory usable by the guest OS",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"unusedBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UnusedBytes is the memory left completely unused by the guest OS",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"usableBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UsableBytes is the memory the guest OS can reclaim without swapping, including its caches",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"rssBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RSSBytes is the resident memory of the domain on the node",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"swapInBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SwapInBytes is the memory swapped in by the guest OS",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"swapOutBytes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SwapOutBytes is the memory swapped out by the guest OS",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_DomainSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources describes the Compute Resources required by this vmi.",
+							Ref:         ref("kubevirt.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU allow specified the detailed CPU topology inside the vmi.",
+							Ref:         ref("kubevirt.io/api/core/v1.CPU"),
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory allow specifying the VMI memory features.",
+							Ref:         ref("kubevirt.io/api/core/v1.Memory"),
+						},
+					},
+					"machine": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Machine type.",
+							Ref:         ref("kubevirt.io/api/core/v1.Machine"),
+						},
+					},
+					"firmware": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Firmware.",
+							Ref:         ref("kubevirt.io/api/core/v1.Firmware"),
+						},
+					},
+					"clock": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Clock sets the clock and timers of the vmi.",
+							Ref:         ref("kubevirt.io/api/core/v1.Clock"),
+						},
+					},
+					"features": {
+						SchemaProps: spec.SchemaProps{
 							Description: "Features like acpi, apic, hyperv, smm.",
 							Ref:         ref("kubevirt.io/api/core/v1.Features"),
 						},
@@ -16346,6 +16763,13 @@ func schema_kubevirtio_api_core_v1_EFI(ref common.ReferenceCallback) common.Open
 							Format:      "",
 						},
 					},
+					"persistent": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set to true, Persistent will persist the EFI NVRAM across reboots. Defaults to false",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -16947,122 +17371,338 @@ func schema_kubevirtio_api_core_v1_GuestAgentPing(ref common.ReferenceCallback)
 	}
 }
 
-func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_GuestExecOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GuestExecOptions are the options of the guestexec subresource, which runs a command through the guest agent",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"tickPolicy": {
+					"command": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of \"delay\", \"catchup\", \"merge\", \"discard\".",
+							Description: "Command is the path of the executable to run in the guest, followed by its arguments",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"stdin": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Stdin is passed to the standard input of the command",
 							Type:        []string{"string"},
-							Format:      "",
+							Format:      "byte",
 						},
 					},
-					"present": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "TimeoutSeconds is how long to wait for the command to exit. Defaults to 30 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"command"},
 			},
 		},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_GuestExecResult(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Handler defines a specific action that should be taken",
+				Description: "GuestExecResult is the outcome of a command run through the guest agent",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"exec": {
+					"exitCode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One and only one of the following should be specified. Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent. If the guest agent is not available, this probe will fail.",
-							Ref:         ref("k8s.io/api/core/v1.ExecAction"),
+							Description: "ExitCode is the exit code of the command",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"guestAgentPing": {
+					"stdout": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "GuestAgentPing contacts the qemu-guest-agent for availability checks.",
-							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentPing"),
+							Description: "Stdout is the standard output of the command",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-					"httpGet": {
+					"stderr": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "HTTPGet specifies the http request to perform.",
-							Ref:         ref("k8s.io/api/core/v1.HTTPGetAction"),
+							Description: "Stderr is the standard error of the command",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-					"tcpSocket": {
+					"stdoutTruncated": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported",
-							Ref:         ref("k8s.io/api/core/v1.TCPSocketAction"),
+							Description: "StdoutTruncated is set when the guest agent dropped the end of the standard output, it captures 16MiB at most",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"stderrTruncated": {
+						SchemaProps: spec.SchemaProps{
+							Description: "StderrTruncated is set when the guest agent dropped the end of the standard error, it captures 16MiB at most",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"exitCode"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentPing"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_HostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_GuestFileOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "GuestFileOptions are the options of the guestfile subresource, which copies a file into or out of the guest through the guest agent",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "Path is the absolute path of the file in the guest",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"deviceName": {
+					"permissions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DeviceName is the resource name of the host device exposed by a device plugin",
+							Description: "Permissions are set on an uploaded file, in octal, e.g. 0644",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"tag": {
+					"owner": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the virtual network interface address and its tag will be provided to the guest via config drive",
+							Description: "Owner is set on an uploaded file, as user[:group]",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"name", "deviceName"},
+				Required: []string{"path"},
 			},
 		},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_HostDisk(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_HPETTimer(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Represents a disk created on the cluster level",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"path": {
+					"tickPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The path to HostDisk image located on the cluster",
+							Description: "TickPolicy determines what happens when QEMU misses a deadline for injecting a tick to the guest. One of \"delay\", \"catchup\", \"merge\", \"discard\".",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"type": {
+					"present": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'",
-							Type:        []string{"string"},
+							Description: "Enabled set to false makes sure that the machine type or a preset can't add the timer. Defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_Handler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Handler defines a specific action that should be taken",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"exec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "One and only one of the following should be specified. Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent. If the guest agent is not available, this probe will fail.",
+							Ref:         ref("k8s.io/api/core/v1.ExecAction"),
+						},
+					},
+					"guestAgentPing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "GuestAgentPing contacts the qemu-guest-agent for availability checks.",
+							Ref:         ref("kubevirt.io/api/core/v1.GuestAgentPing"),
+						},
+					},
+					"httpGet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HTTPGet specifies the http request to perform.",
+							Ref:         ref("k8s.io/api/core/v1.HTTPGetAction"),
+						},
+					},
+					"tcpSocket": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TCPSocket specifies an action involving a TCP port. TCP hooks not yet supported",
+							Ref:         ref("k8s.io/api/core/v1.TCPSocketAction"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ExecAction", "k8s.io/api/core/v1.HTTPGetAction", "k8s.io/api/core/v1.TCPSocketAction", "kubevirt.io/api/core/v1.GuestAgentPing"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"deviceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DeviceName is the resource name of the host device exposed by a device plugin",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the virtual network interface address and its tag will be provided to the guest via config drive",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hotpluggable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hotpluggable indicates whether the device is attached through an attachment pod, after the VMI is started, and can be detached again while the VMI is running.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "deviceName"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HostDeviceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostDeviceStatus represents information about the status of a host device hot-attached to the VirtualMachineInstance.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the host device",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a brief description of why we are in the current hotplug host device phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is a detailed message about the current hotplug host device phase",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attachPodName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttachPodName is the name of the pod used to allocate the host device on the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attachPodUID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AttachPodUID is the UID of the pod used to allocate the host device on the node.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"address": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Address is the host address of the allocated device, a PCI address or a mediated device UUID.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_HostDisk(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Represents a disk created on the cluster level",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The path to HostDisk image located on the cluster",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Contains information if disk.img exists or should be created allowed options are 'Disk' and 'DiskOrCreate'",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
@@ -17380,12 +18020,59 @@ func schema_kubevirtio_api_core_v1_Interface(ref common.ReferenceCallback) commo
 							Format:      "int32",
 						},
 					},
+					"spoofCheck": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If set to true, frames sent by the guest through the interface are dropped when their source MAC address, ARP sender addresses or source IP address do not match the ones assigned to the interface. Only supported with the bridge and masquerade bindings.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"state": {
+						SchemaProps: spec.SchemaProps{
+							Description: "State represents the requested link state of the interface. Setting it to down disconnects the link as if the cable was unplugged, without removing the interface from the guest. Can be changed while the VMI is running. One of: up, down. Defaults to up.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bandwidth": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, limits the inbound and outbound traffic of the interface. Only supported with the bridge, masquerade and macvtap bindings. Can be changed while the VMI is running.",
+							Ref:         ref("kubevirt.io/api/core/v1.InterfaceBandwidth"),
+						},
+					},
 				},
 				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMacvtap", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasst", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.InterfaceSlirp", "kubevirt.io/api/core/v1.Port"},
+			"kubevirt.io/api/core/v1.DHCPOptions", "kubevirt.io/api/core/v1.InterfaceBandwidth", "kubevirt.io/api/core/v1.InterfaceBridge", "kubevirt.io/api/core/v1.InterfaceMacvtap", "kubevirt.io/api/core/v1.InterfaceMasquerade", "kubevirt.io/api/core/v1.InterfacePasst", "kubevirt.io/api/core/v1.InterfaceSRIOV", "kubevirt.io/api/core/v1.InterfaceSlirp", "kubevirt.io/api/core/v1.Port"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_InterfaceBandwidth(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InterfaceBandwidth limits the traffic of an interface in each direction.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"inbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limits of the traffic received by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.BandwidthLimits"),
+						},
+					},
+					"outbound": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limits of the traffic sent by the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.BandwidthLimits"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.BandwidthLimits"},
 	}
 }
 
@@ -17473,6 +18160,15 @@ func schema_kubevirtio_api_core_v1_InterfacePasst(ref common.ReferenceCallback)
 			SchemaProps: spec.SchemaProps{
 				Description: "InterfacePasst connects to a given network.",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"portForwarding": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PortForwarding selects the ports forwarded from the pod to the guest. All forwards every TCP and UDP port, List forwards only the ports listed in the interface ports. Defaults to List when the interface specifies ports, All otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
 			},
 		},
 	}
@@ -17919,16 +18615,29 @@ func schema_kubevirtio_api_core_v1_KubeVirtConfiguration(ref common.ReferenceCal
 					},
 					"vmStateStorageClass": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM. The storage class must support RWX in filesystem mode.",
+							Description: "VMStateStorageClass is the name of the storage class to use for the PVCs created to preserve VM state, like TPM. The storage class must support filesystem mode, and the access mode set in VMStateStorageAccessMode.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"vmStateStorageAccessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VMStateStorageAccessMode is the access mode of the PVCs created to preserve VM state. ReadWriteMany volumes are shared by the source and the target of a migration, while the state kept on ReadWriteOnce volumes is transferred to a new volume on every migration. Defaults to ReadWriteMany",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"cpuBaseline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUBaseline selects the nodes the cluster-baseline CPU model is computed for. Requires the ClusterCPUBaseline feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.CPUBaselineConfiguration"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.ArchConfiguration", "kubevirt.io/api/core/v1.CPUBaselineConfiguration", "kubevirt.io/api/core/v1.DeveloperConfiguration", "kubevirt.io/api/core/v1.MediatedDevicesConfiguration", "kubevirt.io/api/core/v1.MigrationConfiguration", "kubevirt.io/api/core/v1.NetworkConfiguration", "kubevirt.io/api/core/v1.PermittedHostDevices", "kubevirt.io/api/core/v1.ReloadableComponentConfiguration", "kubevirt.io/api/core/v1.SMBiosConfiguration", "kubevirt.io/api/core/v1.SeccompConfiguration", "kubevirt.io/api/core/v1.SupportContainerResources", "kubevirt.io/api/core/v1.TLSConfiguration"},
 	}
 }
 
@@ -18273,11 +18982,23 @@ func schema_kubevirtio_api_core_v1_KubeVirtStatus(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"mediatedDevicesRebalancing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MediatedDevicesRebalancing reports the plan of the mediated device types rebalancing",
+							Ref:         ref("kubevirt.io/api/core/v1.MediatedDevicesRebalancingStatus"),
+						},
+					},
+					"cpuBaseline": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUBaseline reports the newest CPU model and features supported by all the nodes selected for the cluster CPU baseline",
+							Ref:         ref("kubevirt.io/api/core/v1.CPUBaselineStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition"},
+			"kubevirt.io/api/core/v1.CPUBaselineStatus", "kubevirt.io/api/core/v1.GenerationStatus", "kubevirt.io/api/core/v1.KubeVirtCondition", "kubevirt.io/api/core/v1.MediatedDevicesRebalancingStatus"},
 	}
 }
 
@@ -18458,6 +19179,62 @@ func schema_kubevirtio_api_core_v1_Machine(ref common.ReferenceCallback) common.
 	}
 }
 
+func schema_kubevirtio_api_core_v1_MediatedDeviceTypeMinimum(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MediatedDeviceTypeMinimum is the minimum number of nodes offering a mediated device type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mediatedDeviceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MediatedDeviceType is the name of the mediated device type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the minimum number of nodes offering the type",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"mediatedDeviceType", "nodes"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MediatedDeviceTypeRequest(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MediatedDeviceTypeRequest is the number of unschedulable VMIs requesting a mediated device type.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mediatedDeviceType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MediatedDeviceType is the name of the mediated device type",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"virtualMachineInstances": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VirtualMachineInstances is the number of unschedulable VMIs requesting the type",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"mediatedDeviceType", "virtualMachineInstances"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -18519,11 +19296,116 @@ func schema_kubevirtio_api_core_v1_MediatedDevicesConfiguration(ref common.Refer
 							},
 						},
 					},
-				},
+					"rebalancing": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Rebalancing lets virt-controller reconfigure idle GPUs to the mediated device types requested by pending VMIs. Requires the MediatedDevicesRebalancing feature gate.",
+							Ref:         ref("kubevirt.io/api/core/v1.MediatedDevicesRebalancing"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MediatedDevicesRebalancing", "kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MediatedDevicesRebalancing(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MediatedDevicesRebalancing holds the constraints of the mediated device types rebalancing.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector selects the nodes whose GPUs may be reconfigured. The selected nodes must support all the mediated device types requested by the VMIs. All nodes are selected if empty.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"minimumNodesPerType": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MinimumNodesPerType is the number of nodes which keep offering a mediated device type, even if no pending VMI requests it.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.MediatedDeviceTypeMinimum"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.MediatedDeviceTypeMinimum"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_MediatedDevicesRebalancingStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MediatedDevicesRebalancingStatus reports the plan of the mediated device types rebalancing.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pendingRequests": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "PendingRequests lists the mediated device types requested by unschedulable VMIs",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.MediatedDeviceTypeRequest"),
+									},
+								},
+							},
+						},
+					},
+					"nodes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes lists the nodes whose mediated device types were set by the rebalancing",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.NodeMediatedDeviceTypesPlan"),
+									},
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.NodeMediatedDeviceTypesConfig"},
+			"kubevirt.io/api/core/v1.MediatedDeviceTypeRequest", "kubevirt.io/api/core/v1.NodeMediatedDeviceTypesPlan"},
 	}
 }
 
@@ -18797,11 +19679,118 @@ func schema_kubevirtio_api_core_v1_NUMA(ref common.ReferenceCallback) common.Ope
 							Ref:         ref("kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"),
 						},
 					},
+					"cells": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Cells explicitly declares the guest NUMA topology, without requiring dedicated CPUs. The cells must cover all the vCPUs and the whole guest memory. Can't be combined with GuestMappingPassthrough.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.NUMACell"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.NUMACell", "kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NUMACell(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NUMACell declares a guest NUMA cell.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ID of the cell. The cells must be numbered from 0, in order.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"cpuSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUSet lists the vCPUs of the cell, in the cpuset format, e.g. 0-3,8",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory is the amount of guest memory of the cell. When hugepages are requested, it must be a multiple of the page size.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"distances": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Distances to the cells, including the cell itself. Left to the hypervisor defaults if empty.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.NUMADistance"),
+									},
+								},
+							},
+						},
+					},
+					"hostNodeSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostNodeSet binds the memory of the cell to host NUMA nodes, in the cpuset format, e.g. 0,1. The memory is not bound if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"id", "cpuSet", "memory"},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.NUMAGuestMappingPassthrough"},
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/core/v1.NUMADistance"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_NUMADistance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NUMADistance is the distance of a guest NUMA cell to another.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cellID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CellID is the ID of the other cell",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Value is the distance, 10 being the distance of a cell to itself",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"cellID", "value"},
+			},
+		},
 	}
 }
 
@@ -18874,6 +19863,13 @@ func schema_kubevirtio_api_core_v1_NetworkConfiguration(ref common.ReferenceCall
 							Format: "",
 						},
 					},
+					"secondaryNetworkDNSDomain": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SecondaryNetworkDNSDomain is the DNS domain appended to the `<vmi>.<network>.<namespace>` records published for the secondary network interfaces of VMIs. Requires the SecondaryNetworkDNS feature gate.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -18971,6 +19967,53 @@ func schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesConfig(ref common.Refe
 	}
 }
 
+func schema_kubevirtio_api_core_v1_NodeMediatedDeviceTypesPlan(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeMediatedDeviceTypesPlan holds the mediated device types assigned to a node by the rebalancing.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName is the name of the node",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"mediatedDeviceTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MediatedDeviceTypes are the mediated device types assigned to the node",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"ready": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ready tells whether the node advertises the resources of all its assigned types",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"nodeName", "mediatedDeviceTypes", "ready"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_NodePlacement(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -19168,11 +20211,28 @@ func schema_kubevirtio_api_core_v1_PermittedHostDevices(ref common.ReferenceCall
 							},
 						},
 					},
+					"usbHostDevices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.USBHostDevice"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciHostDevice"},
+			"kubevirt.io/api/core/v1.MediatedHostDevice", "kubevirt.io/api/core/v1.PciHostDevice", "kubevirt.io/api/core/v1.USBHostDevice"},
 	}
 }
 
@@ -19653,16 +20713,16 @@ func schema_kubevirtio_api_core_v1_ReloadableComponentConfiguration(ref common.R
 	}
 }
 
-func schema_kubevirtio_api_core_v1_RemoveVolumeOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_RemoveHostDeviceOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
+				Description: "RemoveHostDeviceOptions is provided when dynamically hot unplugging a host device",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name represents the name that maps to both the disk and volume that should be removed",
+							Description: "Name is the name of the host device that should be removed",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -19693,29 +20753,97 @@ func schema_kubevirtio_api_core_v1_RemoveVolumeOptions(ref common.ReferenceCallb
 	}
 }
 
-func schema_kubevirtio_api_core_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_RemoveSSHKeyOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "RemoveSSHKeyOptions are the options of the removesshkey subresource, which removes an ephemeral SSH public key before it expires",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"requests": {
+					"user": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Requests is a description of the initial vmi resources. Valid resource keys are \"memory\" and \"cpu\".",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "User is the user of the guest the key is authorized for",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"limits": {
+					"publicKey": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Limits describes the maximum amount of compute resources allowed. Valid resource keys are \"memory\" and \"cpu\".",
+							Description: "PublicKey is the public key in the authorized_keys format",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"user", "publicKey"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_RemoveVolumeOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RemoveVolumeOptions is provided when dynamically hot unplugging volume and disk",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name represents the name that maps to both the disk and volume that should be removed",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dryRun": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "When present, indicates that modifications should not be persisted. An invalid or unrecognized dryRun directive will result in an error response and no further processing of the request. Valid values are: - All: all dry run stages will be processed",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_ResourceRequirements(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requests": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Requests is a description of the initial vmi resources. Valid resource keys are \"memory\" and \"cpu\".",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"limits": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Limits describes the maximum amount of compute resources allowed. Valid resource keys are \"memory\" and \"cpu\".",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -19858,6 +20986,41 @@ func schema_kubevirtio_api_core_v1_SMBiosConfiguration(ref common.ReferenceCallb
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SSHHostKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SSHHostKey is a public host key of the SSH server of the guest",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the algorithm of the key, e.g. ssh-ed25519",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"publicKey": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PublicKey is the key in the authorized_keys format",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fingerprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fingerprint is the SHA256 fingerprint of the key, as printed by ssh-keygen -l",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"type", "publicKey", "fingerprint"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_SSHPublicKeyAccessCredential(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20008,6 +21171,34 @@ func schema_kubevirtio_api_core_v1_SecretVolumeSource(ref common.ReferenceCallba
 	}
 }
 
+func schema_kubevirtio_api_core_v1_SerialPort(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "SerialPort is an additional serial port of the VMI.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the port, used to connect to it through the console subresource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the port. serial emulates an ISA serial port, virtio a virtio-console port. Defaults to virtio.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_ServiceAccountVolumeSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20388,6 +21579,89 @@ func schema_kubevirtio_api_core_v1_TopologyHints(ref common.ReferenceCallback) c
 	}
 }
 
+func schema_kubevirtio_api_core_v1_USBHostDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "USBHostDevice represents host USB devices allowed for passthrough",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"resourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the resource that is representing the devices. Exposed by a device plugin and requested by VMs. Typically of the form vendor.com/product_name",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"selectors": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Selectors identify the host USB devices exposed under the resource. Every matching device can be allocated on its own.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.USBSelector"),
+									},
+								},
+							},
+						},
+					},
+					"externalResourceProvider": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If true, KubeVirt will leave the allocation and monitoring to an external device plugin",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"resourceName", "selectors"},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.USBSelector"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_USBSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "USBSelector selects host USB devices by their vendor and product IDs, and optionally by the port they are plugged into",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"vendor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The vendor ID of the USB device, e.g. 0529",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"product": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The product ID of the USB device, e.g. 0001",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"busPath": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The bus path of the port the device is plugged into, as named under /sys/bus/usb/devices, e.g. 1-2.3. Devices plugged into any port match if empty.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"vendor", "product"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_UnpauseOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -20570,83 +21844,193 @@ func schema_kubevirtio_api_core_v1_VMISelector(ref common.ReferenceCallback) com
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VNCToken(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VNCToken is a short-lived token giving access to the VNC display of a single VirtualMachineInstance, through the VNC websocket endpoint of virt-api, without other credentials",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"targetPort": {
+					"token": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"integer"},
-							Format: "int64",
+							Description: "Token is passed in the token query parameter of the VNC websocket endpoint of virt-api",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"useTLS": {
+					"expirationTimestamp": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"boolean"},
-							Format: "",
+							Description: "ExpirationTimestamp is when the token stops being accepted. Connections opened before remain open.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
-				Required: []string{"targetPort"},
+				Required: []string{"token", "expirationTimestamp"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VNCTokenOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachine handles the VirtualMachines that are not running or are in a stopped state The VirtualMachine contains the template to create the VirtualMachineInstance. It also mirrors the running state of the created VirtualMachineInstance in its status.",
+				Description: "VNCTokenOptions are the options of the vnc/token subresource",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"readOnly": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
+							Description: "ReadOnly tokens only give access to observe the VNC display",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"expirationSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "ExpirationSeconds is how long the token is valid for. Defaults to 5 minutes.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VSOCKOptions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"targetPort": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Spec contains the specification of VirtualMachineInstance created",
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+							Type:   []string{"integer"},
+							Format: "int64",
 						},
 					},
-					"status": {
+					"useTLS": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status holds the current state of the controller and brief information about its associated VirtualMachineInstance",
-							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStatus"),
+							Type:   []string{"boolean"},
+							Format: "",
 						},
 					},
 				},
-				Required: []string{"spec"},
+				Required: []string{"targetPort"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec", "kubevirt.io/api/core/v1.VirtualMachineStatus"},
 	}
 }
 
-func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_kubevirtio_api_core_v1_VideoDevice(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VirtualMachineCondition represents the state of VirtualMachine",
+				Description: "VideoDevice configures the emulated video device.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type of the video device. One of virtio, bochs or vga. virtio is the only type supported on arm64.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resolution": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resolution is the preferred resolution of the display, reported to the guest.",
+							Ref:         ref("kubevirt.io/api/core/v1.VideoResolution"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/core/v1.VideoResolution"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VideoResolution(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VideoResolution is a display resolution in pixels.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"width": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"height": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+				},
+				Required: []string{"width", "height"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachine(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachine handles the VirtualMachines that are not running or are in a stopped state The VirtualMachine contains the template to create the VirtualMachineInstance. It also mirrors the running state of the created VirtualMachineInstance in its status.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Spec contains the specification of VirtualMachineInstance created",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status holds the current state of the controller and brief information about its associated VirtualMachineInstance",
+							Ref:         ref("kubevirt.io/api/core/v1.VirtualMachineStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/core/v1.VirtualMachineSpec", "kubevirt.io/api/core/v1.VirtualMachineStatus"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineCondition represents the state of VirtualMachine",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"type": {
@@ -20788,6 +22172,134 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceCondition(ref common.Re
 	}
 }
 
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceConsoleSession(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceConsoleSession is a client connected to a console of the VMI. A console is held by at most one client which isn't read-only, the others only observe it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"console": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Console is the kind of console the client is connected to",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"serialPort": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SerialPort is the name of the additional serial port the client is connected to, empty for the serial console",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Description: "User is the name of the user who opened the session",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"readOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ReadOnly is true for the observers, whose input is not sent to the console",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"connectedTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ConnectedTimestamp is when the client connected",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+				Required: []string{"console", "connectedTimestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_kubevirtio_api_core_v1_VirtualMachineInstanceDomainStats(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VirtualMachineInstanceDomainStats are the cumulative runtime statistics of the domain of a VirtualMachineInstance, collected by virt-handler like for its metrics. Rates are computed from two samples.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"timestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Timestamp is when the statistics were collected, with a precision allowing to compute rates over short intervals",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime"),
+						},
+					},
+					"cpuTimeNanoseconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUTimeNanoseconds is the CPU time used by the domain",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"vCPUs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VCPUs is the number of vCPUs of the domain",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Memory holds the memory statistics, most of them are only reported with the balloon driver in the guest",
+							Ref:         ref("kubevirt.io/api/core/v1.DomainMemoryStats"),
+						},
+					},
+					"disks": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Disks holds the statistics of the disks of the domain",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.DomainDiskStats"),
+									},
+								},
+							},
+						},
+					},
+					"interfaces": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Interfaces holds the statistics of the network interfaces of the domain",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.DomainInterfaceStats"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"timestamp"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.MicroTime", "kubevirt.io/api/core/v1.DomainDiskStats", "kubevirt.io/api/core/v1.DomainInterfaceStats", "kubevirt.io/api/core/v1.DomainMemoryStats"},
+	}
+}
+
 func schema_kubevirtio_api_core_v1_VirtualMachineInstanceFileSystem(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -22434,6 +23946,24 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							},
 						},
 					},
+					"hostDeviceStatus": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "HostDeviceStatus contains the statuses of the hot-attached host devices",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.HostDeviceStatus"),
+									},
+								},
+							},
+						},
+					},
 					"fsFreezeStatus": {
 						SchemaProps: spec.SchemaProps{
 							Description: "FSFreezeStatus is the state of the fs of the guest it can be either frozen or thawed",
@@ -22480,11 +24010,47 @@ func schema_kubevirtio_api_core_v1_VirtualMachineInstanceStatus(ref common.Refer
 							Ref:         ref("kubevirt.io/api/core/v1.Machine"),
 						},
 					},
+					"consoleSessions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "ConsoleSessions lists the clients connected to the serial console, the additional serial ports and the VNC console",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.VirtualMachineInstanceConsoleSession"),
+									},
+								},
+							},
+						},
+					},
+					"sshHostKeys": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "SSHHostKeys are the public host keys of the SSH server of the guest, reported by the guest agent when an ephemeral SSH key is added, so that SSH clients can verify the guest",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/core/v1.SSHHostKey"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
+			"kubevirt.io/api/core/v1.HostDeviceStatus", "kubevirt.io/api/core/v1.Machine", "kubevirt.io/api/core/v1.SSHHostKey", "kubevirt.io/api/core/v1.TopologyHints", "kubevirt.io/api/core/v1.VirtualMachineInstanceCondition", "kubevirt.io/api/core/v1.VirtualMachineInstanceConsoleSession", "kubevirt.io/api/core/v1.VirtualMachineInstanceGuestOSInfo", "kubevirt.io/api/core/v1.VirtualMachineInstanceMigrationState", "kubevirt.io/api/core/v1.VirtualMachineInstanceNetworkInterface", "kubevirt.io/api/core/v1.VirtualMachineInstancePhaseTransitionTimestamp", "kubevirt.io/api/core/v1.VolumeStatus"},
 	}
 }
 
@@ -25864,6 +27430,535 @@ func schema_kubevirtio_api_migrations_v1alpha1_Selectors(ref common.ReferenceCal
 	}
 }
 
+func schema_kubevirtio_api_node_v1alpha1_CPUCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"arch": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"vendor": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"hostModel": {
+						SchemaProps: spec.SchemaProps{
+							Description: "HostModel is the CPU model used by guests with the host-model CPU mode",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hostModelRequiredFeatures": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "HostModelRequiredFeatures are the features the host-model CPU adds on top of the host model",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"models": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Models are the usable CPU models, without the obsolete ones",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"features": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"hypervFeatures": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+					"tsc": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/node/v1alpha1.TSCCounter"),
+						},
+					},
+					"sev": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SEV is true when the node supports AMD Secure Encrypted Virtualization",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/node/v1alpha1.TSCCounter"},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_HugePages(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"pageSize": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"count": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Count is the number of pages of the cell, both free and in use",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"pageSize", "count"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_HypervisorVersions(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"libvirtVersion": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"qemuVersion": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_IOMMUGroup(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"devices": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Devices are the PCI addresses of the devices of the group",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Type:   []string{"string"},
+										Format: "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"id", "devices"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_MachineType(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"canonical": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Canonical is the versioned machine type the name is an alias of",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"maxCPUs": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_NUMACell(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"memory": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"cpuSet": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPUSet lists the host CPUs of the cell in the cpuset format, e.g. 0-3,8-11",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"hugePages": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/node/v1alpha1.HugePages"),
+									},
+								},
+							},
+						},
+					},
+					"distances": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Distances to the other cells, as reported by the firmware",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/node/v1alpha1.NUMADistance"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"id"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "kubevirt.io/api/node/v1alpha1.HugePages", "kubevirt.io/api/node/v1alpha1.NUMADistance"},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_NUMADistance(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cellID": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+				},
+				Required: []string{"cellID", "value"},
+			},
+		},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_NodeCapabilities(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeCapabilities reports the virtualization capabilities of a node, as discovered by virt-handler. It is named after the node and is garbage collected along with it.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("kubevirt.io/api/node/v1alpha1.NodeCapabilitiesStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta", "kubevirt.io/api/node/v1alpha1.NodeCapabilitiesStatus"},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_NodeCapabilitiesList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "NodeCapabilitiesList is a list of NodeCapabilities",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Ref: ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/node/v1alpha1.NodeCapabilities"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta", "kubevirt.io/api/node/v1alpha1.NodeCapabilities"},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_NodeCapabilitiesStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hypervisor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hypervisor holds the versions of the virtualization stack of the node",
+							Ref:         ref("kubevirt.io/api/node/v1alpha1.HypervisorVersions"),
+						},
+					},
+					"cpu": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CPU holds the CPU models and features which can be provided to guests",
+							Ref:         ref("kubevirt.io/api/node/v1alpha1.CPUCapabilities"),
+						},
+					},
+					"machineTypes": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "MachineTypes lists the machine types supported by the emulator",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/node/v1alpha1.MachineType"),
+									},
+								},
+							},
+						},
+					},
+					"numaCells": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NUMACells describes the NUMA topology of the node, along with the hugepages of every cell",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/node/v1alpha1.NUMACell"),
+									},
+								},
+							},
+						},
+					},
+					"iommuGroups": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "IOMMUGroups lists the PCI devices of the node by IOMMU group",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("kubevirt.io/api/node/v1alpha1.IOMMUGroup"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"kubevirt.io/api/node/v1alpha1.CPUCapabilities", "kubevirt.io/api/node/v1alpha1.HypervisorVersions", "kubevirt.io/api/node/v1alpha1.IOMMUGroup", "kubevirt.io/api/node/v1alpha1.MachineType", "kubevirt.io/api/node/v1alpha1.NUMACell"},
+	}
+}
+
+func schema_kubevirtio_api_node_v1alpha1_TSCCounter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"frequency": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"integer"},
+							Format: "int64",
+						},
+					},
+					"scalable": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"boolean"},
+							Format: "",
+						},
+					},
+				},
+				Required: []string{"frequency", "scalable"},
+			},
+		},
+	}
+}
+
 func schema_kubevirtio_api_pool_v1alpha1_VirtualMachinePool(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -29,6 +29,7 @@ import (
 	instancetypev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha2"
 	migrationsv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	nodev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/node/v1alpha1"
 	poolv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 )
@@ -40,6 +41,7 @@ type Interface interface {
 	InstancetypeV1alpha1() instancetypev1alpha1.InstancetypeV1alpha1Interface
 	InstancetypeV1alpha2() instancetypev1alpha2.InstancetypeV1alpha2Interface
 	MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1Interface
+	NodeV1alpha1() nodev1alpha1.NodeV1alpha1Interface
 	PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface
 	SnapshotV1alpha1() snapshotv1alpha1.SnapshotV1alpha1Interface
 }
@@ -53,6 +55,7 @@ type Clientset struct {
 	instancetypeV1alpha1 *instancetypev1alpha1.InstancetypeV1alpha1Client
 	instancetypeV1alpha2 *instancetypev1alpha2.InstancetypeV1alpha2Client
 	migrationsV1alpha1   *migrationsv1alpha1.MigrationsV1alpha1Client
+	nodeV1alpha1         *nodev1alpha1.NodeV1alpha1Client
 	poolV1alpha1         *poolv1alpha1.PoolV1alpha1Client
 	snapshotV1alpha1     *snapshotv1alpha1.SnapshotV1alpha1Client
 }
@@ -82,6 +85,11 @@ func (c *Clientset) MigrationsV1alpha1() migrationsv1alpha1.MigrationsV1alpha1In
 	return c.migrationsV1alpha1
 }
 
+// NodeV1alpha1 retrieves the NodeV1alpha1Client
+func (c *Clientset) NodeV1alpha1() nodev1alpha1.NodeV1alpha1Interface {
+	return c.nodeV1alpha1
+}
+
 // PoolV1alpha1 retrieves the PoolV1alpha1Client
 func (c *Clientset) PoolV1alpha1() poolv1alpha1.PoolV1alpha1Interface {
 	return c.poolV1alpha1
@@ -133,6 +141,10 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	if err != nil {
 		return nil, err
 	}
+	cs.nodeV1alpha1, err = nodev1alpha1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.poolV1alpha1, err = poolv1alpha1.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
@@ -158,6 +170,7 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 	cs.instancetypeV1alpha1 = instancetypev1alpha1.NewForConfigOrDie(c)
 	cs.instancetypeV1alpha2 = instancetypev1alpha2.NewForConfigOrDie(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.NewForConfigOrDie(c)
+	cs.nodeV1alpha1 = nodev1alpha1.NewForConfigOrDie(c)
 	cs.poolV1alpha1 = poolv1alpha1.NewForConfigOrDie(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.NewForConfigOrDie(c)
 
@@ -173,6 +186,7 @@ func New(c rest.Interface) *Clientset {
 	cs.instancetypeV1alpha1 = instancetypev1alpha1.New(c)
 	cs.instancetypeV1alpha2 = instancetypev1alpha2.New(c)
 	cs.migrationsV1alpha1 = migrationsv1alpha1.New(c)
+	cs.nodeV1alpha1 = nodev1alpha1.New(c)
 	cs.poolV1alpha1 = poolv1alpha1.New(c)
 	cs.snapshotV1alpha1 = snapshotv1alpha1.New(c)
 
@@ -29,6 +29,7 @@ import (
 	instancetypev1alpha1 "kubevirt.io/api/instancetype/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/api/instancetype/v1alpha2"
 	migrationsv1alpha1 "kubevirt.io/api/migrations/v1alpha1"
+	nodev1alpha1 "kubevirt.io/api/node/v1alpha1"
 	poolv1alpha1 "kubevirt.io/api/pool/v1alpha1"
 	snapshotv1alpha1 "kubevirt.io/api/snapshot/v1alpha1"
 )
@@ -42,6 +43,7 @@ var localSchemeBuilder = runtime.SchemeBuilder{
 	instancetypev1alpha1.AddToScheme,
 	instancetypev1alpha2.AddToScheme,
 	migrationsv1alpha1.AddToScheme,
+	nodev1alpha1.AddToScheme,
 	poolv1alpha1.AddToScheme,
 	snapshotv1alpha1.AddToScheme,
 }
@@ -0,0 +1,133 @@
+/*
+Copyright 2023 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+	v1alpha1 "kubevirt.io/api/node/v1alpha1"
+)
+
+// FakeNodeCapabilities implements NodeCapabilitiesInterface
+type FakeNodeCapabilities struct {
+	Fake *FakeNodeV1alpha1
+}
+
+var nodecapabilitiesResource = schema.GroupVersionResource{Group: "node.kubevirt.io", Version: "v1alpha1", Resource: "nodecapabilities"}
+
+var nodecapabilitiesKind = schema.GroupVersionKind{Group: "node.kubevirt.io", Version: "v1alpha1", Kind: "NodeCapabilities"}
+
+// Get takes name of the nodeCapabilities, and returns the corresponding nodeCapabilities object, and an error if there is any.
+func (c *FakeNodeCapabilities) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.NodeCapabilities, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootGetAction(nodecapabilitiesResource, name), &v1alpha1.NodeCapabilities{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeCapabilities), err
+}
+
+// List takes label and field selectors, and returns the list of NodeCapabilities that match those selectors.
+func (c *FakeNodeCapabilities) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.NodeCapabilitiesList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootListAction(nodecapabilitiesResource, nodecapabilitiesKind, opts), &v1alpha1.NodeCapabilitiesList{})
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.NodeCapabilitiesList{ListMeta: obj.(*v1alpha1.NodeCapabilitiesList).ListMeta}
+	for _, item := range obj.(*v1alpha1.NodeCapabilitiesList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested nodeCapabilities.
+func (c *FakeNodeCapabilities) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewRootWatchAction(nodecapabilitiesResource, opts))
+}
+
+// Create takes the representation of a nodeCapabilities and creates it.  Returns the server's representation of the nodeCapabilities, and an error, if there is any.
+func (c *FakeNodeCapabilities) Create(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.CreateOptions) (result *v1alpha1.NodeCapabilities, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootCreateAction(nodecapabilitiesResource, nodeCapabilities), &v1alpha1.NodeCapabilities{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeCapabilities), err
+}
+
+// Update takes the representation of a nodeCapabilities and updates it. Returns the server's representation of the nodeCapabilities, and an error, if there is any.
+func (c *FakeNodeCapabilities) Update(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.UpdateOptions) (result *v1alpha1.NodeCapabilities, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateAction(nodecapabilitiesResource, nodeCapabilities), &v1alpha1.NodeCapabilities{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeCapabilities), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeNodeCapabilities) UpdateStatus(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.UpdateOptions) (*v1alpha1.NodeCapabilities, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootUpdateSubresourceAction(nodecapabilitiesResource, "status", nodeCapabilities), &v1alpha1.NodeCapabilities{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeCapabilities), err
+}
+
+// Delete takes name of the nodeCapabilities and deletes it. Returns an error if one occurs.
+func (c *FakeNodeCapabilities) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewRootDeleteAction(nodecapabilitiesResource, name), &v1alpha1.NodeCapabilities{})
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeNodeCapabilities) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewRootDeleteCollectionAction(nodecapabilitiesResource, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.NodeCapabilitiesList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched nodeCapabilities.
+func (c *FakeNodeCapabilities) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NodeCapabilities, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewRootPatchSubresourceAction(nodecapabilitiesResource, name, pt, data, subresources...), &v1alpha1.NodeCapabilities{})
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.NodeCapabilities), err
+}
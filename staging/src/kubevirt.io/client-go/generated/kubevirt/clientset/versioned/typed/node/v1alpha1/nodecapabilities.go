@@ -0,0 +1,184 @@
+/*
+Copyright 2023 The KubeVirt Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+	v1alpha1 "kubevirt.io/api/node/v1alpha1"
+	scheme "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/scheme"
+)
+
+// NodeCapabilitiesGetter has a method to return a NodeCapabilitiesInterface.
+// A group's client should implement this interface.
+type NodeCapabilitiesGetter interface {
+	NodeCapabilities() NodeCapabilitiesInterface
+}
+
+// NodeCapabilitiesInterface has methods to work with NodeCapabilities resources.
+type NodeCapabilitiesInterface interface {
+	Create(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.CreateOptions) (*v1alpha1.NodeCapabilities, error)
+	Update(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.UpdateOptions) (*v1alpha1.NodeCapabilities, error)
+	UpdateStatus(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.UpdateOptions) (*v1alpha1.NodeCapabilities, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.NodeCapabilities, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.NodeCapabilitiesList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NodeCapabilities, err error)
+	NodeCapabilitiesExpansion
+}
+
+// nodeCapabilities implements NodeCapabilitiesInterface
+type nodeCapabilities struct {
+	client rest.Interface
+}
+
+// newNodeCapabilities returns a NodeCapabilities
+func newNodeCapabilities(c *NodeV1alpha1Client) *nodeCapabilities {
+	return &nodeCapabilities{
+		client: c.RESTClient(),
+	}
+}
+
+// Get takes name of the nodeCapabilities, and returns the corresponding nodeCapabilities object, and an error if there is any.
+func (c *nodeCapabilities) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.NodeCapabilities, err error) {
+	result = &v1alpha1.NodeCapabilities{}
+	err = c.client.Get().
+		Resource("nodecapabilities").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of NodeCapabilities that match those selectors.
+func (c *nodeCapabilities) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.NodeCapabilitiesList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.NodeCapabilitiesList{}
+	err = c.client.Get().
+		Resource("nodecapabilities").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested nodeCapabilities.
+func (c *nodeCapabilities) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Resource("nodecapabilities").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a nodeCapabilities and creates it.  Returns the server's representation of the nodeCapabilities, and an error, if there is any.
+func (c *nodeCapabilities) Create(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.CreateOptions) (result *v1alpha1.NodeCapabilities, err error) {
+	result = &v1alpha1.NodeCapabilities{}
+	err = c.client.Post().
+		Resource("nodecapabilities").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(nodeCapabilities).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a nodeCapabilities and updates it. Returns the server's representation of the nodeCapabilities, and an error, if there is any.
+func (c *nodeCapabilities) Update(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.UpdateOptions) (result *v1alpha1.NodeCapabilities, err error) {
+	result = &v1alpha1.NodeCapabilities{}
+	err = c.client.Put().
+		Resource("nodecapabilities").
+		Name(nodeCapabilities.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(nodeCapabilities).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *nodeCapabilities) UpdateStatus(ctx context.Context, nodeCapabilities *v1alpha1.NodeCapabilities, opts v1.UpdateOptions) (result *v1alpha1.NodeCapabilities, err error) {
+	result = &v1alpha1.NodeCapabilities{}
+	err = c.client.Put().
+		Resource("nodecapabilities").
+		Name(nodeCapabilities.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(nodeCapabilities).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the nodeCapabilities and deletes it. Returns an error if one occurs.
+func (c *nodeCapabilities) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Resource("nodecapabilities").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *nodeCapabilities) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Resource("nodecapabilities").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched nodeCapabilities.
+func (c *nodeCapabilities) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.NodeCapabilities, err error) {
+	result = &v1alpha1.NodeCapabilities{}
+	err = c.client.Patch(pt).
+		Resource("nodecapabilities").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
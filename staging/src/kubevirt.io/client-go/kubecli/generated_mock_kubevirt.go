@@ -5,6 +5,7 @@ package kubecli
 
 import (
 	context "context"
+	io "io"
 	net "net"
 	time "time"
 
@@ -72,8 +73,9 @@ import (
 	v1alpha16 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
 	v1alpha2 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha2"
 	v1alpha17 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
-	v1alpha18 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
-	v1alpha19 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
+	v1alpha18 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/node/v1alpha1"
+	v1alpha19 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
+	v1alpha110 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	versioned2 "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned"
 	versioned3 "kubevirt.io/client-go/generated/prometheus-operator/clientset/versioned"
 	version "kubevirt.io/client-go/version"
@@ -130,9 +132,9 @@ func (_mr *_MockKubevirtClientRecorder) ReplicaSet(arg0 interface{}) *gomock.Cal
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "ReplicaSet", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha18.VirtualMachinePoolInterface {
+func (_m *MockKubevirtClient) VirtualMachinePool(namespace string) v1alpha19.VirtualMachinePoolInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachinePool", namespace)
-	ret0, _ := ret[0].(v1alpha18.VirtualMachinePoolInterface)
+	ret0, _ := ret[0].(v1alpha19.VirtualMachinePoolInterface)
 	return ret0
 }
 
@@ -170,9 +172,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineInstancePreset(arg0 interf
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineInstancePreset", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1alpha19.VirtualMachineSnapshotInterface {
+func (_m *MockKubevirtClient) VirtualMachineSnapshot(namespace string) v1alpha110.VirtualMachineSnapshotInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshot", namespace)
-	ret0, _ := ret[0].(v1alpha19.VirtualMachineSnapshotInterface)
+	ret0, _ := ret[0].(v1alpha110.VirtualMachineSnapshotInterface)
 	return ret0
 }
 
@@ -180,9 +182,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshot(arg0 interface{})
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshot", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1alpha19.VirtualMachineSnapshotContentInterface {
+func (_m *MockKubevirtClient) VirtualMachineSnapshotContent(namespace string) v1alpha110.VirtualMachineSnapshotContentInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineSnapshotContent", namespace)
-	ret0, _ := ret[0].(v1alpha19.VirtualMachineSnapshotContentInterface)
+	ret0, _ := ret[0].(v1alpha110.VirtualMachineSnapshotContentInterface)
 	return ret0
 }
 
@@ -190,9 +192,9 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineSnapshotContent(arg0 inter
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineSnapshotContent", arg0)
 }
 
-func (_m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1alpha19.VirtualMachineRestoreInterface {
+func (_m *MockKubevirtClient) VirtualMachineRestore(namespace string) v1alpha110.VirtualMachineRestoreInterface {
 	ret := _m.ctrl.Call(_m, "VirtualMachineRestore", namespace)
-	ret0, _ := ret[0].(v1alpha19.VirtualMachineRestoreInterface)
+	ret0, _ := ret[0].(v1alpha110.VirtualMachineRestoreInterface)
 	return ret0
 }
 
@@ -290,6 +292,16 @@ func (_mr *_MockKubevirtClientRecorder) VirtualMachineClone(arg0 interface{}) *g
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VirtualMachineClone", arg0)
 }
 
+func (_m *MockKubevirtClient) NodeCapabilities() v1alpha18.NodeCapabilitiesInterface {
+	ret := _m.ctrl.Call(_m, "NodeCapabilities")
+	ret0, _ := ret[0].(v1alpha18.NodeCapabilitiesInterface)
+	return ret0
+}
+
+func (_mr *_MockKubevirtClientRecorder) NodeCapabilities() *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "NodeCapabilities")
+}
+
 func (_m *MockKubevirtClient) ClusterProfiler() *ClusterProfiler {
 	ret := _m.ctrl.Call(_m, "ClusterProfiler")
 	ret0, _ := ret[0].(*ClusterProfiler)
@@ -1076,6 +1088,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNC(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNC", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) VNCWithOptions(name string, options *VNCOptions) (StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "VNCWithOptions", name, options)
+	ret0, _ := ret[0].(StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNCWithOptions(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNCWithOptions", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) Screenshot(ctx context.Context, name string, options *v120.ScreenshotOptions) ([]byte, error) {
 	ret := _m.ctrl.Call(_m, "Screenshot", ctx, name, options)
 	ret0, _ := ret[0].([]byte)
@@ -1087,6 +1110,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Screenshot(arg0, arg1,
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Screenshot", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) VNCToken(ctx context.Context, name string, options *v120.VNCTokenOptions) (*v120.VNCToken, error) {
+	ret := _m.ctrl.Call(_m, "VNCToken", ctx, name, options)
+	ret0, _ := ret[0].(*v120.VNCToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNCToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNCToken", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "PortForward", name, port, protocol)
 	ret0, _ := ret[0].(StreamInterface)
@@ -1181,6 +1215,68 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) DomainStats(ctx context.Context, name string) (*v120.VirtualMachineInstanceDomainStats, error) {
+	ret := _m.ctrl.Call(_m, "DomainStats", ctx, name)
+	ret0, _ := ret[0].(*v120.VirtualMachineInstanceDomainStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) DomainStats(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "DomainStats", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) GuestExec(ctx context.Context, name string, options *v120.GuestExecOptions) (*v120.GuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", ctx, name, options)
+	ret0, _ := ret[0].(*v120.GuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestExec(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) AddSSHKey(ctx context.Context, name string, options *v120.AddSSHKeyOptions) error {
+	ret := _m.ctrl.Call(_m, "AddSSHKey", ctx, name, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AddSSHKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddSSHKey", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) RemoveSSHKey(ctx context.Context, name string, options *v120.RemoveSSHKeyOptions) error {
+	ret := _m.ctrl.Call(_m, "RemoveSSHKey", ctx, name, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveSSHKey(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveSSHKey", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) GuestFileDownload(ctx context.Context, name string, options *v120.GuestFileOptions, out io.Writer) error {
+	ret := _m.ctrl.Call(_m, "GuestFileDownload", ctx, name, options, out)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestFileDownload(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileDownload", arg0, arg1, arg2, arg3)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) GuestFileUpload(ctx context.Context, name string, options *v120.GuestFileOptions, in io.Reader) error {
+	ret := _m.ctrl.Call(_m, "GuestFileUpload", ctx, name, options, in)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestFileUpload(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileUpload", arg0, arg1, arg2, arg3)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v120.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1222,6 +1318,26 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AddInterface(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddInterface", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) AddHostDevice(ctx context.Context, name string, addHostDeviceOptions *v120.AddHostDeviceOptions) error {
+	ret := _m.ctrl.Call(_m, "AddHostDevice", ctx, name, addHostDeviceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) AddHostDevice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddHostDevice", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) RemoveHostDevice(ctx context.Context, name string, removeHostDeviceOptions *v120.RemoveHostDeviceOptions) error {
+	ret := _m.ctrl.Call(_m, "RemoveHostDevice", ctx, name, removeHostDeviceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveHostDevice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveHostDevice", arg0, arg1, arg2)
+}
+
 // Mock of ReplicaSetInterface interface
 type MockReplicaSetInterface struct {
 	ctrl     *gomock.Controller
@@ -1693,6 +1809,26 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) AddInterface(arg0, arg1, arg2 i
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddInterface", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInterface) AddHostDevice(ctx context.Context, name string, addHostDeviceOptions *v120.AddHostDeviceOptions) error {
+	ret := _m.ctrl.Call(_m, "AddHostDevice", ctx, name, addHostDeviceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) AddHostDevice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "AddHostDevice", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInterface) RemoveHostDevice(ctx context.Context, name string, removeHostDeviceOptions *v120.RemoveHostDeviceOptions) error {
+	ret := _m.ctrl.Call(_m, "RemoveHostDevice", ctx, name, removeHostDeviceOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveHostDevice(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveHostDevice", arg0, arg1, arg2)
+}
+
 // Mock of VirtualMachineInstanceMigrationInterface interface
 type MockVirtualMachineInstanceMigrationInterface struct {
 	ctrl     *gomock.Controller
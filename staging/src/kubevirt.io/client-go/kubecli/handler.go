@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	v1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -30,6 +31,11 @@ const (
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	guestExecTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestexec"
+	guestFileTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfile"
+	addSSHKeyTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/addsshkey"
+	removeSSHKeyTemplateURI   = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/removesshkey"
+	domainStatsTemplateURI    = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/domainstats"
 )
 
 func NewVirtHandlerClient(virtCli KubevirtClient, httpCli *http.Client) VirtHandlerClient {
@@ -50,6 +56,7 @@ type VirtHandlerClient interface {
 type VirtHandlerConn interface {
 	ConnectionDetails() (ip string, port int, err error)
 	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SerialPortURI(vmi *virtv1.VirtualMachineInstance, serialPort string) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
@@ -60,10 +67,16 @@ type VirtHandlerConn interface {
 	SoftRebootURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	Pod() (pod *v1.Pod, err error)
 	Put(url string, body io.ReadCloser) error
+	PutWithResponse(url string, body io.ReadCloser) (string, error)
 	Get(url string) (string, error)
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestFileURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	AddSSHKeyURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	RemoveSSHKeyURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	DomainStatsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -170,6 +183,14 @@ func (v *virtHandlerConn) ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string
 	return v.formatURI(consoleTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) SerialPortURI(vmi *virtv1.VirtualMachineInstance, serialPort string) (string, error) {
+	baseURI, err := v.formatURI(consoleTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s?serialPort=%s", baseURI, url.QueryEscape(serialPort)), nil
+}
+
 func (v *virtHandlerConn) USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(usbredirTemplateURI, vmi)
 }
@@ -247,6 +268,17 @@ func (v *virtHandlerConn) Put(url string, body io.ReadCloser) error {
 	return nil
 }
 
+func (v *virtHandlerConn) PutWithResponse(url string, body io.ReadCloser) (string, error) {
+	req, err := http.NewRequest(http.MethodPut, url, body)
+	if err != nil {
+		return "", err
+	}
+
+	req.Header.Add("Accept", "application/json")
+	req.Header.Add("Content-Type", "application/json")
+	return v.doRequest(req)
+}
+
 func (v *virtHandlerConn) Get(url string) (string, error) {
 	req, err := http.NewRequest(http.MethodGet, url, nil)
 	if err != nil {
@@ -280,3 +312,23 @@ func (v *virtHandlerConn) UserListURI(vmi *virtv1.VirtualMachineInstance) (strin
 func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
+
+func (v *virtHandlerConn) GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestExecTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) GuestFileURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestFileTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) AddSSHKeyURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(addSSHKeyTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) RemoveSSHKeyURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(removeSSHKeyTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) DomainStatsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(domainStatsTemplateURI, vmi)
+}
@@ -53,6 +53,7 @@ import (
 	vmexportv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/export/v1alpha1"
 	instancetypev1alpha2 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/instancetype/v1alpha2"
 	migrationsv1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/migrations/v1alpha1"
+	nodev1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/node/v1alpha1"
 	poolv1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/pool/v1alpha1"
 	vmsnapshotv1alpha1 "kubevirt.io/client-go/generated/kubevirt/clientset/versioned/typed/snapshot/v1alpha1"
 	networkclient "kubevirt.io/client-go/generated/network-attachment-definition-client/clientset/versioned"
@@ -80,6 +81,7 @@ type KubevirtClient interface {
 	ExpandSpec(namespace string) ExpandSpecInterface
 	ServerVersion() ServerVersionInterface
 	VirtualMachineClone(namespace string) clonev1alpha1.VirtualMachineCloneInterface
+	NodeCapabilities() nodev1alpha1.NodeCapabilitiesInterface
 	ClusterProfiler() *ClusterProfiler
 	GuestfsVersion() *GuestfsVersion
 	RestClient() *rest.RESTClient
@@ -214,6 +216,10 @@ func (k kubevirt) VirtualMachineClone(namespace string) clonev1alpha1.VirtualMac
 	return k.generatedKubeVirtClient.CloneV1alpha1().VirtualMachineClones(namespace)
 }
 
+func (k kubevirt) NodeCapabilities() nodev1alpha1.NodeCapabilitiesInterface {
+	return k.generatedKubeVirtClient.NodeV1alpha1().NodeCapabilities()
+}
+
 func (k kubevirt) VirtualMachineCloneClient() *clonev1alpha1.CloneV1alpha1Client {
 	return k.cloneClient // TODO ihol3 delete function? who's using it?
 }
@@ -239,7 +245,9 @@ type VirtualMachineInstanceInterface interface {
 	SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error)
 	USBRedir(vmiName string) (StreamInterface, error)
 	VNC(name string) (StreamInterface, error)
+	VNCWithOptions(name string, options *VNCOptions) (StreamInterface, error)
 	Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error)
+	VNCToken(ctx context.Context, name string, options *v1.VNCTokenOptions) (*v1.VNCToken, error)
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
 	Unpause(ctx context.Context, name string, unpauseOptions *v1.UnpauseOptions) error
@@ -249,10 +257,18 @@ type VirtualMachineInstanceInterface interface {
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	DomainStats(ctx context.Context, name string) (*v1.VirtualMachineInstanceDomainStats, error)
+	GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions) (*v1.GuestExecResult, error)
+	GuestFileDownload(ctx context.Context, name string, options *v1.GuestFileOptions, out io.Writer) error
+	GuestFileUpload(ctx context.Context, name string, options *v1.GuestFileOptions, in io.Reader) error
+	AddSSHKey(ctx context.Context, name string, options *v1.AddSSHKeyOptions) error
+	RemoveSSHKey(ctx context.Context, name string, options *v1.RemoveSSHKeyOptions) error
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
 	AddInterface(ctx context.Context, name string, addInterfaceOptions *v1.AddInterfaceOptions) error
+	AddHostDevice(ctx context.Context, name string, addHostDeviceOptions *v1.AddHostDeviceOptions) error
+	RemoveHostDevice(ctx context.Context, name string, removeHostDeviceOptions *v1.RemoveHostDeviceOptions) error
 }
 
 type ReplicaSetInterface interface {
@@ -301,6 +317,8 @@ type VirtualMachineInterface interface {
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
 	AddInterface(ctx context.Context, name string, addInterfaceOptions *v1.AddInterfaceOptions) error
+	AddHostDevice(ctx context.Context, name string, addHostDeviceOptions *v1.AddHostDeviceOptions) error
+	RemoveHostDevice(ctx context.Context, name string, removeHostDeviceOptions *v1.RemoveHostDeviceOptions) error
 }
 
 type VirtualMachineInstanceMigrationInterface interface {
@@ -296,3 +296,25 @@ func (v *vm) AddInterface(ctx context.Context, name string, addInterfaceOptions
 
 	return v.restClient.Put().RequestURI(uri).Body(JSON).Do(ctx).Error()
 }
+
+func (v *vm) AddHostDevice(ctx context.Context, name string, addHostDeviceOptions *v1.AddHostDeviceOptions) error {
+	uri := fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion, v.namespace, name, "addhostdevice")
+
+	JSON, err := json.Marshal(addHostDeviceOptions)
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Error()
+}
+
+func (v *vm) RemoveHostDevice(ctx context.Context, name string, removeHostDeviceOptions *v1.RemoveHostDeviceOptions) error {
+	uri := fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion, v.namespace, name, "removehostdevice")
+
+	JSON, err := json.Marshal(removeHostDeviceOptions)
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Error()
+}
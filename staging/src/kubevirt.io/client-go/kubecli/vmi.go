@@ -171,6 +171,31 @@ func (v *vmis) VNC(name string) (StreamInterface, error) {
 	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vnc", url.Values{})
 }
 
+// VNCOptions select how the client joins the session of the VNC console
+type VNCOptions struct {
+	// ReadOnly connects as an observer, whose input is not sent to the console
+	ReadOnly bool
+	// Exclusive fails if the console is held by another client, instead of taking it over
+	Exclusive bool
+}
+
+func (v *vmis) VNCWithOptions(name string, options *VNCOptions) (StreamInterface, error) {
+	queryParams := url.Values{}
+	if options != nil {
+		setConsoleSessionParams(queryParams, options.ReadOnly, options.Exclusive)
+	}
+	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vnc", queryParams)
+}
+
+func setConsoleSessionParams(queryParams url.Values, readOnly bool, exclusive bool) {
+	if readOnly {
+		queryParams.Set("readOnly", "true")
+	}
+	if exclusive {
+		queryParams.Set("exclusive", "true")
+	}
+}
+
 func (v *vmis) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
@@ -195,9 +220,22 @@ type connectionStruct struct {
 
 type SerialConsoleOptions struct {
 	ConnectionTimeout time.Duration
+	// SerialPort is the name of an additional serial port to connect to, instead of the serial console
+	SerialPort string
+	// ReadOnly connects as an observer, whose input is not sent to the console
+	ReadOnly bool
+	// Exclusive fails if the console is held by another client, instead of taking it over
+	Exclusive bool
 }
 
 func (v *vmis) SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error) {
+	queryParams := url.Values{}
+	if options != nil && options.SerialPort != "" {
+		queryParams.Set("serialPort", options.SerialPort)
+	}
+	if options != nil {
+		setConsoleSessionParams(queryParams, options.ReadOnly, options.Exclusive)
+	}
 
 	if options != nil && options.ConnectionTimeout != 0 {
 		timeoutChan := time.Tick(options.ConnectionTimeout)
@@ -216,7 +254,7 @@ func (v *vmis) SerialConsole(name string, options *SerialConsoleOptions) (Stream
 				default:
 				}
 
-				con, err := asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+				con, err := asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 				if err != nil {
 					asyncSubresourceError, ok := err.(*AsyncSubresourceError)
 					// return if response status code does not equal to 400
@@ -236,7 +274,7 @@ func (v *vmis) SerialConsole(name string, options *SerialConsoleOptions) (Stream
 		conStruct := <-connectionChan
 		return conStruct.con, conStruct.err
 	} else {
-		return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", url.Values{})
+		return asyncSubresourceHelper(v.config, v.resource, v.namespace, name, "console", queryParams)
 	}
 }
 
@@ -454,6 +492,93 @@ func (v *vmis) FilesystemList(ctx context.Context, name string) (v1.VirtualMachi
 	return fsList, err
 }
 
+func (v *vmis) DomainStats(ctx context.Context, name string) (*v1.VirtualMachineInstanceDomainStats, error) {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "domainstats")
+
+	// The result has no ObjectMeta, see the workaround described in GuestOsInfo
+	rawStats, err := v.restClient.Get().AbsPath(uri).Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	domStats := &v1.VirtualMachineInstanceDomainStats{}
+	if err := json.Unmarshal(rawStats, domStats); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal domainstats response: %v", err)
+	}
+	return domStats, nil
+}
+
+func (v *vmis) GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions) (*v1.GuestExecResult, error) {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "guestexec")
+
+	JSON, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+
+	// The result has no ObjectMeta, see the workaround described in GuestOsInfo
+	rawResult, err := v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &v1.GuestExecResult{}
+	if err := json.Unmarshal(rawResult, result); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal guestexec response: %v", err)
+	}
+	return result, nil
+}
+
+func (v *vmis) guestFileRequest(request *rest.Request, name string, options *v1.GuestFileOptions) *rest.Request {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "guestfile")
+	request = request.AbsPath(uri).Param("path", options.Path)
+	if options.Permissions != "" {
+		request = request.Param("permissions", options.Permissions)
+	}
+	if options.Owner != "" {
+		request = request.Param("owner", options.Owner)
+	}
+	return request
+}
+
+func (v *vmis) GuestFileDownload(ctx context.Context, name string, options *v1.GuestFileOptions, out io.Writer) error {
+	stream, err := v.guestFileRequest(v.restClient.Get(), name, options).Stream(ctx)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	_, err = io.Copy(out, stream)
+	return err
+}
+
+func (v *vmis) GuestFileUpload(ctx context.Context, name string, options *v1.GuestFileOptions, in io.Reader) error {
+	return v.guestFileRequest(v.restClient.Put(), name, options).
+		SetHeader("Content-Type", "application/octet-stream").
+		Body(in).
+		Do(ctx).
+		Error()
+}
+
+func (v *vmis) putSSHKeyOptions(ctx context.Context, name string, subresource string, options interface{}) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, subresource)
+
+	JSON, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Error()
+}
+
+func (v *vmis) AddSSHKey(ctx context.Context, name string, options *v1.AddSSHKeyOptions) error {
+	return v.putSSHKeyOptions(ctx, name, "addsshkey", options)
+}
+
+func (v *vmis) RemoveSSHKey(ctx context.Context, name string, options *v1.RemoveSSHKeyOptions) error {
+	return v.putSSHKeyOptions(ctx, name, "removesshkey", options)
+}
+
 func (v *vmis) Screenshot(ctx context.Context, name string, screenshotOptions *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if screenshotOptions.MoveCursor == true {
@@ -469,6 +594,26 @@ func (v *vmis) Screenshot(ctx context.Context, name string, screenshotOptions *v
 	return raw, nil
 }
 
+func (v *vmis) VNCToken(ctx context.Context, name string, options *v1.VNCTokenOptions) (*v1.VNCToken, error) {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "vnc/token")
+	req := v.restClient.Get().AbsPath(uri)
+	if options != nil {
+		req = req.Param("readOnly", strconv.FormatBool(options.ReadOnly))
+		if options.ExpirationSeconds != 0 {
+			req = req.Param("expirationSeconds", strconv.Itoa(int(options.ExpirationSeconds)))
+		}
+	}
+	rawToken, err := req.Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+	token := &v1.VNCToken{}
+	if err := json.Unmarshal(rawToken, token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
 func (v *vmis) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "addvolume")
 
@@ -517,3 +662,25 @@ func (v *vmis) AddInterface(ctx context.Context, name string, addInterfaceOption
 
 	return v.restClient.Put().RequestURI(uri).Body(JSON).Do(ctx).Error()
 }
+
+func (v *vmis) AddHostDevice(ctx context.Context, name string, addHostDeviceOptions *v1.AddHostDeviceOptions) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "addhostdevice")
+
+	JSON, err := json.Marshal(addHostDeviceOptions)
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Error()
+}
+
+func (v *vmis) RemoveHostDevice(ctx context.Context, name string, removeHostDeviceOptions *v1.RemoveHostDeviceOptions) error {
+	uri := fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion, v.namespace, name, "removehostdevice")
+
+	JSON, err := json.Marshal(removeHostDeviceOptions)
+	if err != nil {
+		return err
+	}
+
+	return v.restClient.Put().AbsPath(uri).Body(JSON).Do(ctx).Error()
+}
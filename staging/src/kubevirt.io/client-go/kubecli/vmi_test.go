@@ -187,6 +187,26 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should join the session of the console", func(options *VNCOptions, query string) {
+		client, err := GetKubevirtClientFromFlags(server.URL(), "")
+		Expect(err).ToNot(HaveOccurred())
+
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(subVMIPath, "vnc"), query),
+			func(w http.ResponseWriter, r *http.Request) {
+				_, err := upgrader.Upgrade(w, r, nil)
+				if err != nil {
+					return
+				}
+			},
+		))
+		_, err = client.VirtualMachineInstance(k8sv1.NamespaceDefault).VNCWithOptions("testvm", options)
+		Expect(err).ToNot(HaveOccurred())
+	},
+		Entry("as an observer", &VNCOptions{ReadOnly: true}, "readOnly=true"),
+		Entry("with exclusive access", &VNCOptions{Exclusive: true}, "exclusive=true"),
+	)
+
 	DescribeTable("should handle a failure connecting to the VM", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -380,6 +400,25 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch a VNC token from VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		token := v1.VNCToken{Token: "token", ExpirationTimestamp: k8smetav1.Unix(1700000000, 0)}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "vnc/token"), "expirationSeconds=60&readOnly=true"),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, token),
+		))
+		fetchedToken, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).VNCToken(context.Background(), "testvm", &v1.VNCTokenOptions{ReadOnly: true, ExpirationSeconds: 60})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedToken.Token).To(Equal(token.Token))
+		Expect(fetchedToken.ExpirationTimestamp.Equal(&token.ExpirationTimestamp)).To(BeTrue())
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	DescribeTable("should fetch UserList from VirtualMachineInstance via subresource", func(proxyPath string) {
 		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
 		Expect(err).ToNot(HaveOccurred())
@@ -428,6 +467,34 @@ var _ = Describe("Kubevirt VirtualMachineInstance Client", func() {
 		Entry("with proxied server URL", proxyPath),
 	)
 
+	DescribeTable("should fetch the domain stats of a VirtualMachineInstance via subresource", func(proxyPath string) {
+		client, err := GetKubevirtClientFromFlags(server.URL()+proxyPath, "")
+		Expect(err).ToNot(HaveOccurred())
+
+		domStats := v1.VirtualMachineInstanceDomainStats{
+			Timestamp:          k8smetav1.NewMicroTime(time.Unix(1700000000, 123456000)),
+			CPUTimeNanoseconds: 1000,
+			VCPUs:              2,
+			Disks: []v1.DomainDiskStats{
+				{Name: "rootdisk", ReadBytes: 1024},
+			},
+		}
+		server.AppendHandlers(ghttp.CombineHandlers(
+			ghttp.VerifyRequest("GET", path.Join(proxyPath, subVMIPath, "domainstats")),
+			ghttp.RespondWithJSONEncoded(http.StatusOK, domStats),
+		))
+		fetchedStats, err := client.VirtualMachineInstance(k8sv1.NamespaceDefault).DomainStats(context.Background(), "testvm")
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fetchedStats.Timestamp.Equal(&domStats.Timestamp)).To(BeTrue())
+		Expect(fetchedStats.VCPUs).To(Equal(domStats.VCPUs))
+		Expect(fetchedStats.CPUTimeNanoseconds).To(Equal(domStats.CPUTimeNanoseconds))
+		Expect(fetchedStats.Disks).To(Equal(domStats.Disks))
+	},
+		Entry("with regular server URL", ""),
+		Entry("with proxied server URL", proxyPath),
+	)
+
 	AfterEach(func() {
 		server.Close()
 	})
@@ -329,6 +329,10 @@ var _ = Describe("[rfe_id:500][crit:high][arm64][vendor:cnv-qe@redhat.com][level
 				"virtualmachineinstances", "filesystemlist",
 				allowGetFor("admin", "edit", "view"),
 				denyAllFor("default")),
+			Entry("on vmi domainstats",
+				"virtualmachineinstances", "domainstats",
+				allowGetFor("admin", "edit", "view"),
+				denyAllFor("default")),
 			Entry("on vmi addvolume",
 				"virtualmachineinstances", "addvolume",
 				allowUpdateFor("admin", "edit"),
@@ -428,6 +432,9 @@ var _ = Describe("[rfe_id:500][crit:high][arm64][vendor:cnv-qe@redhat.com][level
 				Entry("[test_id:2921]given a vmi (console)", "virtualmachineinstances/console", "get"),
 				Entry("[test_id:2921]given a vmi (vnc)", "virtualmachineinstances/vnc", "get"),
 				Entry("[test_id:2921]given a vmi (vnc/screenshot)", "virtualmachineinstances/vnc/screenshot", "get"),
+				Entry("given a vmi (addsshkey)", "virtualmachineinstances/addsshkey", "update"),
+				Entry("given a vmi (removesshkey)", "virtualmachineinstances/removesshkey", "update"),
+				Entry("given a vmi (domainstats)", "virtualmachineinstances/domainstats", "get"),
 			)
 		})
 
@@ -470,6 +477,9 @@ var _ = Describe("[rfe_id:500][crit:high][arm64][vendor:cnv-qe@redhat.com][level
 				Entry("[test_id:2921]given a vmi (console)", "virtualmachineinstances/console", "get"),
 				Entry("[test_id:2921]given a vmi (vnc)", "virtualmachineinstances/vnc", "get"),
 				Entry("[test_id:2921]given a vmi (vnc/screenshot)", "virtualmachineinstances/vnc/screenshot", "get"),
+				Entry("given a vmi (addsshkey)", "virtualmachineinstances/addsshkey", "update"),
+				Entry("given a vmi (removesshkey)", "virtualmachineinstances/removesshkey", "update"),
+				Entry("given a vmi (domainstats)", "virtualmachineinstances/domainstats", "get"),
 				Entry("[test_id:2921]given a vmi (guestosinfo)", "virtualmachineinstances/guestosinfo", "get"),
 			)
 		})
@@ -21,6 +21,8 @@ package tests_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"time"
 
 	"kubevirt.io/kubevirt/tests/decorators"
@@ -30,6 +32,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"kubevirt.io/kubevirt/tests/testsuite"
 
@@ -158,6 +161,33 @@ var _ = Describe("[rfe_id:127][posneg:negative][crit:medium][vendor:cnv-qe@redha
 				expectConsoleOutput(vmi, "login")
 			})
 
+			It("should only allow read-only connections to a console held by another connection with exclusive access", func() {
+				vmi := libvmi.NewAlpine()
+				vmi = tests.RunVMIAndExpectLaunch(vmi, 30)
+
+				By("opening 1st console connection")
+				expecter, _, err := console.NewExpecter(virtClient, vmi, 30*time.Second)
+				Expect(err).ToNot(HaveOccurred())
+				defer expecter.Close()
+
+				By("refusing a 2nd read-write console connection asking for exclusive access")
+				_, err = virtClient.VirtualMachineInstance(vmi.Namespace).SerialConsole(vmi.Name, &kubecli.SerialConsoleOptions{ConnectionTimeout: 30 * time.Second, Exclusive: true})
+				var asyncErr *kubecli.AsyncSubresourceError
+				Expect(errors.As(err, &asyncErr)).To(BeTrue())
+				Expect(asyncErr.GetStatusCode()).To(Equal(http.StatusConflict))
+
+				By("opening a read-only console connection")
+				_, err = virtClient.VirtualMachineInstance(vmi.Namespace).SerialConsole(vmi.Name, &kubecli.SerialConsoleOptions{ConnectionTimeout: 30 * time.Second, ReadOnly: true})
+				Expect(err).ToNot(HaveOccurred())
+
+				By("reporting both connections on the VMI status")
+				Eventually(func() []v1.VirtualMachineInstanceConsoleSession {
+					vmi, err := virtClient.VirtualMachineInstance(vmi.Namespace).Get(context.Background(), vmi.Name, &metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					return vmi.Status.ConsoleSessions
+				}, 30*time.Second, time.Second).Should(HaveLen(2))
+			})
+
 			It("[test_id:1592]should wait until the virtual machine is in running state and return a stream interface", func() {
 				vmi := libvmi.NewAlpine()
 				By("Creating a new VirtualMachineInstance")
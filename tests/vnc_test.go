@@ -21,6 +21,7 @@ package tests_test
 
 import (
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"fmt"
 	"image"
@@ -43,6 +44,7 @@ import (
 	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -53,6 +55,7 @@ import (
 	launcherApi "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 
 	"kubevirt.io/kubevirt/tests"
+	"kubevirt.io/kubevirt/tests/flags"
 	"kubevirt.io/kubevirt/tests/framework/kubevirt"
 	"kubevirt.io/kubevirt/tests/libwait"
 )
@@ -250,6 +253,52 @@ var _ = Describe("[rfe_id:127][crit:medium][arm64][vendor:cnv-qe@redhat.com][lev
 			}, 60*time.Second).ShouldNot(HaveOccurred())
 		})
 
+		Context("with a VNC token", func() {
+			var wsURL string
+
+			BeforeEach(func() {
+				By("forwarding a port to virt-api, as a web client without cluster credentials would connect to it")
+				pods, err := virtClient.CoreV1().Pods(flags.KubeVirtInstallNamespace).List(context.Background(), metav1.ListOptions{
+					LabelSelector: v1.AppLabel + "=virt-api",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(pods.Items).ToNot(BeEmpty())
+				stopChan := make(chan struct{})
+				DeferCleanup(func() { close(stopChan) })
+				Expect(tests.ForwardPorts(&pods.Items[0], []string{"18443:8443"}, stopChan, 10*time.Second)).To(Succeed())
+				wsURL = "wss://localhost:18443/vnc"
+			})
+
+			dialVNC := func(token string) (*websocket.Conn, *http.Response, error) {
+				dialer := &websocket.Dialer{
+					TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
+					Subprotocols:    []string{"binary"},
+				}
+				return dialer.Dial(wsURL+"?token="+url.QueryEscape(token), nil)
+			}
+
+			It("should connect to VNC over a websocket with the token", func() {
+				token, err := virtClient.VirtualMachineInstance(vmi.Namespace).VNCToken(context.Background(), vmi.Name, &v1.VNCTokenOptions{ExpirationSeconds: 60})
+				Expect(err).ToNot(HaveOccurred())
+
+				conn, _, err := dialVNC(token.Token)
+				Expect(err).ToNot(HaveOccurred())
+				defer conn.Close()
+
+				By("receiving the protocol version of the VNC server")
+				_, msg, err := conn.ReadMessage()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(msg)).To(HavePrefix("RFB "))
+			})
+
+			It("should refuse a websocket connection with an invalid token", func() {
+				_, resp, err := dialVNC("invalid")
+				Expect(err).To(HaveOccurred())
+				Expect(resp).ToNot(BeNil())
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
 		It("should allow creating a VNC screenshot in PNG format", func() {
 			filePath := filepath.Join(GinkgoT().TempDir(), "screenshot.png")
 			domain, err := tests.GetRunningVMIDomainSpec(vmi)